@@ -0,0 +1,32 @@
+// Package openapi embeds the contract of the HTTP API and the page documenting it.
+package openapi
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/base64"
+	"regexp"
+)
+
+// Spec is the OpenAPI document the HTTP server interface is generated from.
+//
+//go:embed swagger.yaml
+var Spec []byte
+
+// DocsPage renders Spec with Swagger UI, which is loaded from a CDN.
+//
+//go:embed docs.html
+var DocsPage []byte
+
+// DocsContentSecurityPolicy lets the docs page load Swagger UI from the CDN and run
+// its own inline script, allowed by the hash of the script.
+var DocsContentSecurityPolicy = docsContentSecurityPolicy()
+
+func docsContentSecurityPolicy() string {
+	policy := "default-src 'none'; style-src https://unpkg.com 'unsafe-inline'; img-src 'self' data:; connect-src 'self'; frame-ancestors 'none'; script-src https://unpkg.com"
+	for _, m := range regexp.MustCompile(`(?s)<script>(.*?)</script>`).FindAllSubmatch(DocsPage, -1) {
+		sum := sha256.Sum256(m[1])
+		policy += " 'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'"
+	}
+	return policy
+}
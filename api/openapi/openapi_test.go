@@ -0,0 +1,14 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocsContentSecurityPolicy(t *testing.T) {
+	t.Parallel()
+
+	assert.Contains(t, DocsContentSecurityPolicy, "script-src https://unpkg.com 'sha256-")
+	assert.Contains(t, DocsContentSecurityPolicy, "frame-ancestors 'none'")
+}
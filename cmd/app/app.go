@@ -6,8 +6,10 @@ import (
 	"github.com/shrtyk/pvz-service/internal/config"
 	pAuth "github.com/shrtyk/pvz-service/internal/core/ports/auth"
 	"github.com/shrtyk/pvz-service/internal/core/ports/metrics"
+	"github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
 	pRepo "github.com/shrtyk/pvz-service/internal/core/ports/repository"
 	pService "github.com/shrtyk/pvz-service/internal/core/ports/service"
+	"github.com/shrtyk/pvz-service/internal/infrastructure/health"
 )
 
 type Application struct {
@@ -17,6 +19,8 @@ type Application struct {
 	TokenService pAuth.TokenService
 	AppService   pService.Service
 	Metrics      metrics.Collector
+	Health       *health.Checker
+	RateLimiter  ratelimit.Limiter
 }
 
 type option func(*Application)
@@ -66,3 +70,15 @@ func WithMetrics(m metrics.Collector) option {
 		app.Metrics = m
 	}
 }
+
+func WithHealthChecker(c *health.Checker) option {
+	return func(app *Application) {
+		app.Health = c
+	}
+}
+
+func WithRateLimiter(l ratelimit.Limiter) option {
+	return func(app *Application) {
+		app.RateLimiter = l
+	}
+}
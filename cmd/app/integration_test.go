@@ -23,11 +23,15 @@ import (
 	"github.com/shrtyk/pvz-service/internal/config"
 	"github.com/shrtyk/pvz-service/internal/core/service"
 	pkgpg "github.com/shrtyk/pvz-service/internal/dbs/postgres"
+	"github.com/shrtyk/pvz-service/internal/infrastructure/health"
 	"github.com/shrtyk/pvz-service/internal/infrastructure/prometheus"
 	pwdservice "github.com/shrtyk/pvz-service/internal/infrastructure/pwd_service"
+	"github.com/shrtyk/pvz-service/internal/infrastructure/ratelimit"
 	"github.com/shrtyk/pvz-service/internal/infrastructure/repository"
 	ts "github.com/shrtyk/pvz-service/internal/infrastructure/tservice"
+	"github.com/shrtyk/pvz-service/migrations"
 	"github.com/shrtyk/pvz-service/pkg/logger"
+	"github.com/shrtyk/pvz-service/pkg/requestid"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 )
@@ -87,11 +91,16 @@ func TestIntegration(t *testing.T) {
 	moderatorToken := getDummyToken(t, baseURL, roleModerator)
 	employeeToken := getDummyToken(t, baseURL, roleEmployee)
 
-	var pvzID uuid.UUID
+	var (
+		pvzID   uuid.UUID
+		pvzETag string
+	)
 	t.Run("Create PVZ", func(t *testing.T) {
 		pvz := createPVZ(t, baseURL, moderatorToken)
 		require.NotNil(t, pvz.Id)
+		require.NotNil(t, pvz.Version)
 		pvzID = *pvz.Id
+		pvzETag = fmt.Sprintf(`"%d"`, *pvz.Version)
 	})
 
 	require.NotNil(t, pvzID, "PVZ ID should not be nil after creation")
@@ -111,6 +120,23 @@ func TestIntegration(t *testing.T) {
 		closeReception(t, baseURL, employeeToken, pvzID)
 	})
 
+	t.Run("Stock Changes Keep PVZ ETag", func(t *testing.T) {
+		status, _ := updatePVZ(t, baseURL, moderatorToken, pvzID, pvzETag)
+		require.Equal(t, http.StatusOK, status, "products coming and going must not invalidate the PVZ ETag")
+	})
+
+	t.Run("Update PVZ With If-Match", func(t *testing.T) {
+		status, oldETag := updatePVZ(t, baseURL, moderatorToken, pvzID, "")
+		require.Equal(t, http.StatusOK, status)
+
+		status, newETag := updatePVZ(t, baseURL, moderatorToken, pvzID, oldETag)
+		require.Equal(t, http.StatusOK, status)
+		require.NotEqual(t, oldETag, newETag)
+
+		status, _ = updatePVZ(t, baseURL, moderatorToken, pvzID, oldETag)
+		require.Equal(t, http.StatusPreconditionFailed, status, "old ETag must not match a changed PVZ")
+	})
+
 	t.Run("Verify Reception Closed", func(t *testing.T) {
 		reqBody, err := json.Marshal(dto.PostProductsJSONBody{PvzId: pvzID, Type: "одежда"})
 		require.NoError(t, err)
@@ -119,12 +145,14 @@ func TestIntegration(t *testing.T) {
 		require.NoError(t, err)
 		req.Header.Set("Authorization", "Bearer "+employeeToken)
 		req.Header.Set("Content-Type", contentTypeJSON)
+		req.Header.Set(requestid.Header, "integration-test")
 
 		resp, err := testHTTPClient.Do(req)
 		require.NoError(t, err)
 		defer require.NoError(t, resp.Body.Close())
 
-		require.Equal(t, http.StatusBadRequest, resp.StatusCode, "should not be able to add products to a closed reception")
+		require.Equal(t, http.StatusConflict, resp.StatusCode, "should not be able to add products to a closed reception")
+		require.Equal(t, "integration-test", resp.Header.Get(requestid.Header))
 	})
 }
 
@@ -222,6 +250,10 @@ func startTestApp(t *testing.T, appCfg *testAppConfig) string {
 	metrics := prometheus.NewPrometheusCollector()
 	pwdService := pwdservice.NewPasswordService()
 	appService := service.NewAppService(cfg.AppCfg.Timeout, repo, pwdService, tService, metrics)
+	checker := health.NewChecker(cfg.HealthCfg.CheckTimeout)
+	checker.Register("postgres", health.PostgresCheck(db))
+	checker.Register("migrations", health.MigrationsCheck(db, migrations.MustLatestVersion()))
+	checker.Register("token_keys", health.TokenKeysCheck(tService))
 
 	app := NewApplication()
 	app.Init(
@@ -231,6 +263,8 @@ func startTestApp(t *testing.T, appCfg *testAppConfig) string {
 		WithRepo(repo),
 		WithService(appService),
 		WithMetrics(metrics),
+		WithHealthChecker(checker),
+		WithRateLimiter(ratelimit.MustCreateLimiter(&cfg.RateLimitCfg, db)),
 	)
 
 	go func() {
@@ -240,24 +274,24 @@ func startTestApp(t *testing.T, appCfg *testAppConfig) string {
 	baseURL := fmt.Sprintf("http://localhost:%s", httpPortStr)
 
 	require.Eventually(t, func() bool {
-		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, fmt.Sprintf("%s/healthz", baseURL), nil)
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, fmt.Sprintf("%s/readyz", baseURL), nil)
 		if err != nil {
 			return false
 		}
 		resp, err := testHTTPClient.Do(req)
 		if err != nil {
-			t.Logf("healthz check failed: %v", err)
+			t.Logf("readyz check failed: %v", err)
 			return false
 		}
 		defer require.NoError(t, resp.Body.Close())
 		if resp.StatusCode != http.StatusOK {
-			t.Logf("healthz check returned status %d", resp.StatusCode)
+			t.Logf("readyz check returned status %d", resp.StatusCode)
 			return false
 		}
 		return true
 	}, 15*time.Second, 200*time.Millisecond)
 
-	return baseURL
+	return baseURL + "/api/v1"
 }
 
 func getDummyToken(t *testing.T, baseURL, role string) string {
@@ -305,6 +339,28 @@ func createPVZ(t *testing.T, baseURL, token string) *dto.PVZ {
 	return &pvz
 }
 
+func updatePVZ(t *testing.T, baseURL, token string, pvzID uuid.UUID, ifMatch string) (int, string) {
+	t.Helper()
+	reqBody, err := json.Marshal(dto.PVZ{City: "Казань"})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/pvz/%s", baseURL, pvzID), bytes.NewBuffer(reqBody))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", contentTypeJSON)
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+
+	resp, err := testHTTPClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	return resp.StatusCode, resp.Header.Get("ETag")
+}
+
 func createReception(t *testing.T, baseURL, token string, pvzID uuid.UUID) *dto.Reception {
 	t.Helper()
 	reqBody, err := json.Marshal(dto.PostReceptionsJSONBody{PvzId: pvzID})
@@ -351,7 +407,7 @@ func addProduct(t *testing.T, baseURL, token string, pvzID uuid.UUID) {
 
 func closeReception(t *testing.T, baseURL, token string, pvzID uuid.UUID) {
 	t.Helper()
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/close_last_reception", baseURL, pvzID), nil)
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/pvz/%s/close_last_reception", baseURL, pvzID), nil)
 	require.NoError(t, err)
 	req.Header.Set("Authorization", "Bearer "+token)
 
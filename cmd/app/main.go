@@ -8,27 +8,46 @@ import (
 	"github.com/shrtyk/pvz-service/internal/config"
 	"github.com/shrtyk/pvz-service/internal/core/service"
 	"github.com/shrtyk/pvz-service/internal/dbs/postgres"
+	"github.com/shrtyk/pvz-service/internal/infrastructure/export"
+	"github.com/shrtyk/pvz-service/internal/infrastructure/health"
 	"github.com/shrtyk/pvz-service/internal/infrastructure/prometheus"
 	pwdservice "github.com/shrtyk/pvz-service/internal/infrastructure/pwd_service"
+	"github.com/shrtyk/pvz-service/internal/infrastructure/ratelimit"
 	"github.com/shrtyk/pvz-service/internal/infrastructure/repository"
+	"github.com/shrtyk/pvz-service/internal/infrastructure/tracing"
 	ts "github.com/shrtyk/pvz-service/internal/infrastructure/tservice"
+	"github.com/shrtyk/pvz-service/migrations"
 	"github.com/shrtyk/pvz-service/pkg/logger"
 )
 
 func main() {
 	cfg := config.MustInitConfig()
 	log := logger.MustCreateNewLogger(cfg.AppCfg.Env)
+	tp := tracing.MustSetupTracing(context.Background(), &cfg.TracingCfg)
 	db := postgres.MustCreateConnectionPool(&cfg.PostgresCfg)
-	repo := repository.NewRepo(db)
+	metrics := prometheus.NewPrometheusCollector()
+	metrics.RegisterDBStats(db, cfg.PostgresCfg.DBName)
+	repo := repository.NewRepo(db, repository.WithMetrics(metrics))
 	tokenService := ts.MustCreateTokenService(&cfg.AuthTokenCfg)
 	pwdService := pwdservice.NewPasswordService()
-	metrics := prometheus.NewPrometheusCollector()
+	checker := health.NewChecker(cfg.HealthCfg.CheckTimeout)
+	checker.Register("postgres", health.PostgresCheck(db))
+	checker.Register("migrations", health.MigrationsCheck(db, migrations.MustLatestVersion()))
+	checker.Register("token_keys", health.TokenKeysCheck(tokenService))
 	appService := service.NewAppService(
 		cfg.AppCfg.Timeout,
 		repo,
 		pwdService,
 		tokenService,
 		metrics,
+		service.WithGlobalBarcodeUniqueness(cfg.ProductsCfg.GlobalBarcodeUniqueness),
+		service.WithReopenWindow(cfg.ReceptionsCfg.ReopenWindow),
+		service.WithStoragePeriod(cfg.ProductsCfg.StoragePeriod),
+		service.WithPickupCodeTTL(cfg.ProductsCfg.PickupCodeTTL),
+		service.WithPickupCodeMaxAttempts(cfg.ProductsCfg.PickupCodeMaxAttempts),
+		service.WithPvzCapacity(cfg.PvzCfg.Capacity),
+		service.WithExports(export.NewEncoderFactory(), export.MustCreateDirStorage(cfg.ExportsCfg.Dir)),
+		service.WithIdempotencyTTL(cfg.IdempotencyCfg.TTL),
 	)
 
 	app := NewApplication()
@@ -39,10 +58,18 @@ func main() {
 		WithRepo(repo),
 		WithService(appService),
 		WithMetrics(metrics),
+		WithHealthChecker(checker),
+		WithRateLimiter(ratelimit.MustCreateLimiter(&cfg.RateLimitCfg, db)),
 	)
 
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
 	defer cancel()
 
 	app.Serve(ctx)
+
+	tCtx, tCancel := context.WithTimeout(context.Background(), cfg.AppCfg.ShutdownTimeout)
+	defer tCancel()
+	if err := tp.Shutdown(tCtx); err != nil {
+		log.Error("Failed to flush traces", logger.WithErr(err))
+	}
 }
@@ -6,36 +6,133 @@ import (
 	"log/slog"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/shrtyk/pvz-service/internal/api/grpc"
 	appHttp "github.com/shrtyk/pvz-service/internal/api/http"
+	"github.com/shrtyk/pvz-service/internal/scheduler"
 	"github.com/shrtyk/pvz-service/pkg/logger"
 )
 
 func (app Application) Serve(ctx context.Context) {
 	var wg sync.WaitGroup
 
+	hCfg := app.Cfg.HttpServerCfg
+	routerOpts := []appHttp.RouterOption{
+		appHttp.WithLegacyRoutes(hCfg.LegacyDeprecatedAt, hCfg.LegacySunset),
+		appHttp.WithReadinessChecker(app.Health),
+	}
+	if hCfg.ValidateSpec {
+		routerOpts = append(routerOpts, appHttp.WithSpecValidation())
+		app.Logger.Warn("HTTP requests and responses are validated against the OpenAPI spec")
+	}
+	if app.RateLimiter != nil {
+		routerOpts = append(routerOpts, appHttp.WithRateLimiter(app.RateLimiter))
+		routerOpts = append(routerOpts, appHttp.WithTrustedProxies(app.Cfg.RateLimitCfg.TrustedProxies))
+	}
+	if hCfg.PublicMetrics {
+		routerOpts = append(routerOpts, appHttp.WithMetricsEndpoint())
+	}
+	if cCfg := app.Cfg.CORSCfg; len(cCfg.AllowedOrigins) > 0 {
+		routerOpts = append(routerOpts, appHttp.WithCORS(appHttp.CORSPolicy{
+			AllowedOrigins:   cCfg.AllowedOrigins,
+			AllowedMethods:   cCfg.AllowedMethods,
+			AllowedHeaders:   cCfg.AllowedHeaders,
+			ExposedHeaders:   cCfg.ExposedHeaders,
+			AllowCredentials: cCfg.AllowCredentials,
+			MaxAge:           cCfg.MaxAge,
+		}))
+	}
+	sCfg := app.Cfg.SecurityCfg
+	routerOpts = append(routerOpts, appHttp.WithSecurityHeaders(appHttp.SecurityHeaders{
+		HSTSMaxAge:            sCfg.HSTSMaxAge,
+		HSTSIncludeSubdomains: sCfg.HSTSIncludeSubdomains,
+		ContentSecurityPolicy: sCfg.ContentSecurityPolicy,
+		FrameOptions:          sCfg.FrameOptions,
+	}))
+	if sCfg.CSRFEnabled {
+		routerOpts = append(routerOpts, appHttp.WithCSRFProtection())
+	}
+
 	httpServ := http.Server{
-		Addr:         ":" + app.Cfg.HttpServerCfg.Port,
-		Handler:      appHttp.NewRouter(app.AppService, app.TokenService, app.Logger, app.Metrics),
-		IdleTimeout:  app.Cfg.HttpServerCfg.IdleTimeout,
-		WriteTimeout: app.Cfg.HttpServerCfg.WriteTimeout,
-		ReadTimeout:  app.Cfg.HttpServerCfg.ReadTimeout,
+		Addr:         ":" + hCfg.Port,
+		Handler:      appHttp.NewRouter(app.AppService, app.TokenService, app.Logger, app.Metrics, routerOpts...),
+		IdleTimeout:  hCfg.IdleTimeout,
+		WriteTimeout: hCfg.WriteTimeout,
+		ReadTimeout:  hCfg.ReadTimeout,
+		ErrorLog:     slog.NewLogLogger(app.Logger.Handler(), slog.LevelError),
+	}
+	aCfg := app.Cfg.AdminServerCfg
+	adminServ := http.Server{
+		Addr:         ":" + aCfg.Port,
+		Handler:      appHttp.NewAdminRouter(app.Health, app.Cfg.Redacted()),
+		IdleTimeout:  hCfg.IdleTimeout,
+		WriteTimeout: aCfg.WriteTimeout,
+		ReadTimeout:  hCfg.ReadTimeout,
 		ErrorLog:     slog.NewLogLogger(app.Logger.Handler(), slog.LevelError),
 	}
-	grpcServ := grpc.NewGRPCServer(&wg, app.AppService, app.Logger, app.Cfg.GrpcServerCfg.Port)
+	grpcServ := grpc.NewGRPCServer(&wg, app.AppService, app.TokenService, app.Logger, app.Metrics, app.RateLimiter, app.Cfg.GrpcServerCfg.Port)
 
 	eChan := make(chan error, 1)
 	go func() {
 		<-ctx.Done()
 
+		// Readiness fails first, the servers keep serving until load balancers
+		// stop routing new requests here.
+		if app.Health != nil {
+			app.Health.Shutdown()
+		}
+		grpcServ.Drain()
+		app.Logger.Info("Draining before shutdown", slog.Duration("delay", app.Cfg.AppCfg.DrainDelay))
+		time.Sleep(app.Cfg.AppCfg.DrainDelay)
+
 		tCtx, tCancel := context.WithTimeout(context.Background(), app.Cfg.AppCfg.ShutdownTimeout)
 		defer tCancel()
 
-		eChan <- grpcServ.Shutdown(tCtx)
-		eChan <- httpServ.Shutdown(tCtx)
+		// The admin server goes last to keep serving metrics while the others drain.
+		eChan <- errors.Join(
+			grpcServ.Shutdown(tCtx),
+			httpServ.Shutdown(tCtx),
+			adminServ.Shutdown(tCtx),
+		)
 	}()
 
+	if rCfg := app.Cfg.ReceptionsCfg; rCfg.AutoCloseEnabled {
+		scheduler.NewReceptionAutoCloser(
+			&wg, app.AppService, app.Metrics, app.Logger,
+			rCfg.AutoCloseInterval, rCfg.AutoCloseIdle,
+		).Start(ctx)
+		app.Logger.Info(
+			"Reception auto closer started",
+			slog.Duration("interval", rCfg.AutoCloseInterval),
+			slog.Duration("idle_for", rCfg.AutoCloseIdle),
+		)
+	}
+
+	scheduler.NewPvzStockReporter(
+		&wg, app.AppService, app.Metrics, app.Logger, app.Cfg.PvzCfg.StockReportInterval,
+	).Start(ctx)
+
+	scheduler.NewExportWorker(
+		&wg, app.AppService, app.Logger, app.Cfg.ExportsCfg.PollInterval,
+	).Start(ctx)
+
+	scheduler.NewDailyStatsRefresher(
+		&wg, app.AppService, app.Logger, app.Cfg.StatsCfg.RefreshInterval, app.Cfg.StatsCfg.RefreshDays,
+	).Start(ctx)
+
+	if aCfg.Enabled {
+		go func() {
+			if err := adminServ.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				app.Logger.Error("Admin server failed", logger.WithErr(err))
+			}
+		}()
+		app.Logger.Info(
+			"Admin server successfully started",
+			slog.String("address", adminServ.Addr),
+		)
+	}
+
 	app.Logger.Info(
 		"GRPC server successfully started",
 		slog.String("address", ":"+app.Cfg.GrpcServerCfg.Port),
@@ -0,0 +1,91 @@
+// Command backfill recomputes the daily analytics rollups for a range of
+// UTC days, e.g. after the rollup tables were created or the data was
+// fixed by hand. Days are refreshed one by one, so the command may be
+// interrupted and run again at any point.
+//
+//	go run ./cmd/backfill -from 2026-01-01 -to 2026-10-18
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/config"
+	"github.com/shrtyk/pvz-service/internal/dbs/postgres"
+	"github.com/shrtyk/pvz-service/internal/infrastructure/repository"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+)
+
+const dayLayout = time.DateOnly
+
+var (
+	fromFlag   string
+	toFlag     string
+	dayTimeout time.Duration
+)
+
+func init() {
+	flag.StringVar(&fromFlag, "from", "", "First day to backfill, YYYY-MM-DD")
+	flag.StringVar(&toFlag, "to", "", "Last day to backfill, YYYY-MM-DD, today by default")
+	flag.DurationVar(&dayTimeout, "day_timeout", time.Minute, "Timeout of a single day refresh")
+}
+
+func main() {
+	cfg := config.MustInitConfig()
+	log := logger.MustCreateNewLogger(cfg.AppCfg.Env)
+
+	from, to, err := parseRange(fromFlag, toFlag, time.Now())
+	if err != nil {
+		log.Error("Invalid range", logger.WithErr(err))
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	db := postgres.MustCreateConnectionPool(&cfg.PostgresCfg)
+	defer func() { _ = db.Close() }()
+	repo := repository.NewRepo(db)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	log.Info("Backfill started", slog.String("from", from.Format(dayLayout)), slog.String("to", to.Format(dayLayout)))
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		tctx, tcancel := context.WithTimeout(logger.ToCtx(ctx, log), dayTimeout)
+		err := repo.RefreshDailyStats(tctx, day, day.AddDate(0, 0, 1))
+		tcancel()
+		if err != nil {
+			log.Error("Failed to refresh daily stats", slog.String("day", day.Format(dayLayout)), logger.WithErr(err))
+			os.Exit(1)
+		}
+		log.Info("Day refreshed", slog.String("day", day.Format(dayLayout)))
+	}
+	log.Info("Backfill finished")
+}
+
+// parseRange parses the inclusive range of days to backfill.
+func parseRange(fromStr, toStr string, now time.Time) (from, to time.Time, err error) {
+	if fromStr == "" {
+		return from, to, errors.New("-from is required")
+	}
+	if from, err = time.Parse(dayLayout, fromStr); err != nil {
+		return from, to, err
+	}
+
+	to = now.UTC().Truncate(24 * time.Hour)
+	if toStr != "" {
+		if to, err = time.Parse(dayLayout, toStr); err != nil {
+			return from, to, err
+		}
+	}
+
+	if to.Before(from) {
+		return from, to, errors.New("-to must not be before -from")
+	}
+	return from, to, nil
+}
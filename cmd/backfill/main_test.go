@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseRange(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		from, to string
+		wantFrom time.Time
+		wantTo   time.Time
+		wantErr  bool
+	}{
+		{
+			name:     "both days",
+			from:     "2026-10-01",
+			to:       "2026-10-05",
+			wantFrom: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			wantTo:   time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "to defaults to today",
+			from:     "2026-10-01",
+			wantFrom: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			wantTo:   time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+		},
+		{name: "missing from", wantErr: true},
+		{name: "malformed from", from: "01.10.2026", wantErr: true},
+		{name: "malformed to", from: "2026-10-01", to: "tomorrow", wantErr: true},
+		{name: "to before from", from: "2026-10-05", to: "2026-10-01", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			from, to, err := parseRange(tt.from, tt.to, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFrom, from)
+			assert.Equal(t, tt.wantTo, to)
+		})
+	}
+}
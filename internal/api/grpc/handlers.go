@@ -3,8 +3,9 @@ package grpc
 import (
 	"context"
 
-	"github.com/shrtyk/pvz-service/pkg/logger"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
 	pvz "github.com/shrtyk/pvz-service/proto/pvz/gen"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -13,7 +14,12 @@ func (s *Server) GetPVZList(
 	ctx context.Context,
 	in *pvz.GetPVZListRequest,
 ) (*pvz.GetPVZListResponse, error) {
-	pvzs, err := s.appService.GetAllPvzs(logger.ToCtx(ctx, s.logger))
+	filter, sort, err := toDomainPvzsQuery(in)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pvzs, err := s.appService.GetAllPvzs(ctx, filter, sort)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -22,3 +28,24 @@ func (s *Server) GetPVZList(
 		Pvzs: toProtoFromDomainPvzs(pvzs),
 	}, nil
 }
+
+func (s *Server) StreamPVZData(
+	in *pvz.StreamPVZDataRequest,
+	stream grpc.ServerStreamingServer[pvz.PVZData],
+) error {
+	ctx := stream.Context()
+
+	params, err := toDomainPvzsReadParams(in)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = s.appService.StreamPvzsData(ctx, params, func(data *domain.PvzReceptions) error {
+		return stream.Send(toProtoPvzData(data))
+	})
+	if err != nil {
+		return mapAppServiceErrsToGRPC(err)
+	}
+
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -35,12 +36,13 @@ func TestGetPVZList(t *testing.T) {
 	testCases := []struct {
 		name          string
 		setupMock     func(mockService *mocks.MockService)
+		req           *pvz.GetPVZListRequest
 		checkResponse func(t *testing.T, resp *pvz.GetPVZListResponse, err error)
 	}{
 		{
 			name: "success",
 			setupMock: func(mockService *mocks.MockService) {
-				mockService.EXPECT().GetAllPvzs(mock.Anything).Return(sampleDomainPvzs, nil)
+				mockService.EXPECT().GetAllPvzs(mock.Anything, mock.Anything, mock.Anything).Return(sampleDomainPvzs, nil)
 			},
 			checkResponse: func(t *testing.T, resp *pvz.GetPVZListResponse, err error) {
 				require.NoError(t, err)
@@ -52,7 +54,7 @@ func TestGetPVZList(t *testing.T) {
 		{
 			name: "success with empty list",
 			setupMock: func(mockService *mocks.MockService) {
-				mockService.EXPECT().GetAllPvzs(mock.Anything).Return([]*domain.Pvz{}, nil)
+				mockService.EXPECT().GetAllPvzs(mock.Anything, mock.Anything, mock.Anything).Return([]*domain.Pvz{}, nil)
 			},
 			checkResponse: func(t *testing.T, resp *pvz.GetPVZListResponse, err error) {
 				require.NoError(t, err)
@@ -60,10 +62,19 @@ func TestGetPVZList(t *testing.T) {
 				assert.Len(t, resp.Pvzs, 0)
 			},
 		},
+		{
+			name:      "invalid filter",
+			setupMock: func(mockService *mocks.MockService) {},
+			req:       &pvz.GetPVZListRequest{Cities: []string{"Тверь"}},
+			checkResponse: func(t *testing.T, resp *pvz.GetPVZListResponse, err error) {
+				assert.Nil(t, resp)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
 		{
 			name: "error",
 			setupMock: func(mockService *mocks.MockService) {
-				mockService.EXPECT().GetAllPvzs(mock.Anything).Return(nil, errors.New("internal service error"))
+				mockService.EXPECT().GetAllPvzs(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("internal service error"))
 			},
 			checkResponse: func(t *testing.T, resp *pvz.GetPVZListResponse, err error) {
 				require.Error(t, err)
@@ -90,10 +101,94 @@ func TestGetPVZList(t *testing.T) {
 				logger:     log,
 			}
 
-			req := &pvz.GetPVZListRequest{}
+			req := tc.req
+			if req == nil {
+				req = &pvz.GetPVZListRequest{}
+			}
 			resp, err := s.GetPVZList(ctx, req)
 
 			tc.checkResponse(t, resp, err)
 		})
 	}
 }
+
+type fakePVZDataStream struct {
+	grpc.ServerStream
+	sent []*pvz.PVZData
+}
+
+func (f *fakePVZDataStream) Context() context.Context {
+	return context.Background()
+}
+
+func (f *fakePVZDataStream) Send(data *pvz.PVZData) error {
+	f.sent = append(f.sent, data)
+	return nil
+}
+
+func TestStreamPVZData(t *testing.T) {
+	t.Parallel()
+
+	log, _ := logger.NewTestLogger()
+	closedAt := time.Now()
+	data := &domain.PvzReceptions{
+		Pvz: &domain.Pvz{Id: uuid.New(), RegistrationDate: time.Now(), City: domain.Moscow},
+		Receptions: []*domain.ReceptionProducts{{
+			Reception: &domain.Reception{Id: uuid.New(), Status: domain.Close, ClosedAt: &closedAt, Version: 2},
+			Products:  []*domain.Product{{Id: uuid.New(), Type: domain.ProductTypeClothing}},
+		}},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mockService := mocks.NewMockService(t)
+		mockService.EXPECT().
+			StreamPvzsData(mock.Anything, mock.MatchedBy(func(p *domain.PvzsReadParams) bool {
+				return p.Page == 1 && p.Limit == 200 && len(p.Cities) == 1
+			}), mock.Anything).
+			Run(func(_ context.Context, _ *domain.PvzsReadParams, yield func(*domain.PvzReceptions) error) {
+				_ = yield(data)
+			}).
+			Return(&domain.PvzsPage{}, nil)
+
+		s := Server{appService: mockService, logger: log}
+		stream := &fakePVZDataStream{}
+		err := s.StreamPVZData(&pvz.StreamPVZDataRequest{
+			Query: &pvz.GetPVZListRequest{Cities: []string{"Москва"}},
+			Limit: 200,
+		}, stream)
+
+		require.NoError(t, err)
+		require.Len(t, stream.sent, 1)
+		got := stream.sent[0]
+		assert.Equal(t, data.Pvz.Id.String(), got.Pvz.Id)
+		require.Len(t, got.Receptions, 1)
+		assert.Equal(t, pvz.ReceptionStatus_RECEPTION_STATUS_CLOSED, got.Receptions[0].Reception.Status)
+		assert.NotNil(t, got.Receptions[0].Reception.ClosedAt)
+		assert.Equal(t, int32(2), got.Receptions[0].Reception.Version)
+		assert.Len(t, got.Receptions[0].Products, 1)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		t.Parallel()
+
+		s := Server{appService: mocks.NewMockService(t), logger: log}
+		err := s.StreamPVZData(&pvz.StreamPVZDataRequest{Limit: 5000}, &fakePVZDataStream{})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		t.Parallel()
+
+		mockService := mocks.NewMockService(t)
+		mockService.EXPECT().StreamPvzsData(mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("db is down"))
+
+		s := Server{appService: mockService, logger: log}
+		err := s.StreamPVZData(&pvz.StreamPVZDataRequest{}, &fakePVZDataStream{})
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+	"encoding/binary"
+	"hash"
+
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	"github.com/shrtyk/pvz-service/internal/core/ports/service"
+	ts "github.com/shrtyk/pvz-service/internal/infrastructure/tservice"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// idempotencyKeyMD is the metadata key clients put an idempotency key under,
+// the same as the Idempotency-Key header of the HTTP API.
+const idempotencyKeyMD = "idempotency-key"
+
+// idempotencyKeyFromMD returns the idempotency key sent with the call, an empty
+// key means the call isn't idempotent.
+func idempotencyKeyFromMD(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+
+	vals := md.Get(idempotencyKeyMD)
+	if len(vals) == 0 {
+		return "", nil
+	}
+
+	if err := domain.ValidateIdempotencyKey(vals[0]); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid %s: %s", idempotencyKeyMD, err)
+	}
+	return vals[0], nil
+}
+
+// writeFingerprint feeds a length prefixed message into h, so that different
+// sequences of messages never hash the same.
+func writeFingerprint(h hash.Hash, m proto.Message) error {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return err
+	}
+	h.Write(binary.AppendUvarint(nil, uint64(len(b))))
+	h.Write(b)
+	return nil
+}
+
+// idempotentCall runs call unless the request identified by key and fingerprint was
+// already processed, in which case the stored response is returned instead. Only
+// successful responses are stored, after a failure the call may be retried with the same key.
+func idempotentCall[T proto.Message](
+	ctx context.Context,
+	appService service.Service,
+	key, fingerprint string,
+	call func() (T, error),
+) (T, error) {
+	var zero T
+
+	req := &domain.IdempotentRequest{UserId: uuid.Nil, Key: key, Fingerprint: fingerprint}
+	if claims, err := ts.ClaimsFromCtx(ctx); err == nil {
+		if id, err := uuid.Parse(claims.UserID()); err == nil {
+			req.UserId = id
+		}
+	}
+
+	stored, err := appService.BeginIdempotentRequest(ctx, req)
+	if err != nil {
+		return zero, mapAppServiceErrsToGRPC(err)
+	}
+	if stored != nil {
+		resp := zero.ProtoReflect().New().Interface().(T)
+		if err := proto.Unmarshal(stored.Body, resp); err != nil {
+			return zero, status.Error(codes.Internal, err.Error())
+		}
+		return resp, nil
+	}
+
+	// The outcome is stored even if the client is gone by then.
+	sctx := context.WithoutCancel(ctx)
+	l := logger.FromCtx(ctx)
+
+	resp, err := call()
+	if err != nil {
+		if aErr := appService.AbortIdempotentRequest(sctx, req); aErr != nil {
+			l.Error("Failed to release idempotency key", logger.WithErr(aErr))
+		}
+		return zero, err
+	}
+
+	body, err := proto.Marshal(resp)
+	if err == nil {
+		err = appService.FinishIdempotentRequest(sctx, req, &domain.IdempotentResponse{
+			StatusCode: int(codes.OK),
+			Body:       body,
+		})
+	}
+	if err != nil {
+		l.Error("Failed to store idempotent response", logger.WithErr(err))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,150 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	"github.com/shrtyk/pvz-service/internal/core/domain/auth"
+	ps "github.com/shrtyk/pvz-service/internal/core/ports/service"
+	mocks "github.com/shrtyk/pvz-service/internal/core/ports/service/mocks"
+	ts "github.com/shrtyk/pvz-service/internal/infrastructure/tservice"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+	pvz "github.com/shrtyk/pvz-service/proto/pvz/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAddProducts_Idempotency(t *testing.T) {
+	t.Parallel()
+
+	log, _ := logger.NewTestLogger()
+	pvzId := uuid.New()
+	userId := uuid.New()
+
+	ctxWithKey := func(key string) context.Context {
+		ctx := metadata.NewIncomingContext(logger.ToCtx(context.Background(), log), metadata.Pairs(idempotencyKeyMD, key))
+		return ts.ClaimsToCtx(ctx, &auth.AccessTokenClaims{
+			Role:             string(auth.UserRoleEmployee),
+			RegisteredClaims: jwt.RegisteredClaims{Subject: userId.String()},
+		})
+	}
+	reqs := func(productType string) []*pvz.AddProductsRequest {
+		return []*pvz.AddProductsRequest{
+			{PvzId: pvzId.String(), Product: &pvz.ProductInput{Type: productType}},
+		}
+	}
+	added := &domain.ProductsBatchResult{
+		Added: 1,
+		Items: []*domain.BatchItemResult{{Status: domain.BatchItemAdded, Product: &domain.Product{Id: uuid.New()}}},
+	}
+
+	t.Run("first call stores the response", func(t *testing.T) {
+		t.Parallel()
+
+		m := mocks.NewMockService(t)
+		var begun *domain.IdempotentRequest
+		m.EXPECT().BeginIdempotentRequest(mock.Anything, mock.Anything).
+			Run(func(_ context.Context, req *domain.IdempotentRequest) { begun = req }).
+			Return(nil, nil)
+		m.EXPECT().AddProductsBatch(mock.Anything, mock.Anything).Return(added, nil)
+		m.EXPECT().FinishIdempotentRequest(mock.Anything, mock.Anything, mock.MatchedBy(
+			func(resp *domain.IdempotentResponse) bool {
+				var stored pvz.AddProductsResponse
+				return proto.Unmarshal(resp.Body, &stored) == nil && stored.Added == 1
+			},
+		)).Return(nil)
+
+		stream := &fakeAddProductsStream{ctx: ctxWithKey("key"), reqs: reqs("одежда")}
+		require.NoError(t, (&Server{appService: m, logger: log}).AddProducts(stream))
+		assert.Equal(t, int32(1), stream.resp.Added)
+		require.NotNil(t, begun)
+		assert.Equal(t, userId, begun.UserId)
+		assert.Equal(t, "key", begun.Key)
+	})
+
+	t.Run("retry is replayed", func(t *testing.T) {
+		t.Parallel()
+
+		body, err := proto.Marshal(&pvz.AddProductsResponse{Added: 3})
+		require.NoError(t, err)
+
+		m := mocks.NewMockService(t)
+		m.EXPECT().BeginIdempotentRequest(mock.Anything, mock.Anything).
+			Return(&domain.IdempotentResponse{Body: body}, nil)
+
+		stream := &fakeAddProductsStream{ctx: ctxWithKey("key"), reqs: reqs("одежда")}
+		require.NoError(t, (&Server{appService: m, logger: log}).AddProducts(stream))
+		assert.Equal(t, int32(3), stream.resp.Added)
+	})
+
+	t.Run("key reused for another batch", func(t *testing.T) {
+		t.Parallel()
+
+		m := mocks.NewMockService(t)
+		m.EXPECT().BeginIdempotentRequest(mock.Anything, mock.Anything).
+			Return(nil, xerr.NewErr("op", ps.IdempotencyKeyReused))
+
+		stream := &fakeAddProductsStream{ctx: ctxWithKey("key"), reqs: reqs("обувь")}
+		err := (&Server{appService: m, logger: log}).AddProducts(stream)
+		assert.Equal(t, codes.Aborted, status.Code(err))
+	})
+
+	t.Run("failure releases the key", func(t *testing.T) {
+		t.Parallel()
+
+		m := mocks.NewMockService(t)
+		m.EXPECT().BeginIdempotentRequest(mock.Anything, mock.Anything).Return(nil, nil)
+		m.EXPECT().AddProductsBatch(mock.Anything, mock.Anything).
+			Return(nil, xerr.NewErr("op", ps.NoActiveReception))
+		m.EXPECT().AbortIdempotentRequest(mock.Anything, mock.Anything).Return(nil)
+
+		stream := &fakeAddProductsStream{ctx: ctxWithKey("key"), reqs: reqs("одежда")}
+		err := (&Server{appService: m, logger: log}).AddProducts(stream)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		t.Parallel()
+
+		stream := &fakeAddProductsStream{ctx: ctxWithKey(strings.Repeat("k", 256)), reqs: reqs("одежда")}
+		err := (&Server{appService: mocks.NewMockService(t), logger: log}).AddProducts(stream)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("fingerprint depends on the batch", func(t *testing.T) {
+		t.Parallel()
+
+		fingerprints := make(map[string]string)
+		for _, productType := range []string{"одежда", "одежда", "обувь"} {
+			m := mocks.NewMockService(t)
+			m.EXPECT().BeginIdempotentRequest(mock.Anything, mock.Anything).
+				Run(func(_ context.Context, req *domain.IdempotentRequest) {
+					if fp, ok := fingerprints[productType]; ok {
+						assert.Equal(t, fp, req.Fingerprint)
+					}
+					for pt, fp := range fingerprints {
+						if pt != productType {
+							assert.NotEqual(t, fp, req.Fingerprint)
+						}
+					}
+					fingerprints[productType] = req.Fingerprint
+				}).
+				Return(nil, xerr.NewErr("op", ps.IdempotentReqInProgress))
+
+			stream := &fakeAddProductsStream{ctx: ctxWithKey("key"), reqs: reqs(productType)}
+			err := (&Server{appService: m, logger: log}).AddProducts(stream)
+			assert.Equal(t, codes.Aborted, status.Code(err))
+		}
+		assert.Len(t, fingerprints, 2)
+	})
+}
@@ -0,0 +1,312 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/core/domain/auth"
+	pAuth "github.com/shrtyk/pvz-service/internal/core/ports/auth"
+	"github.com/shrtyk/pvz-service/internal/core/ports/metrics"
+	"github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
+	ts "github.com/shrtyk/pvz-service/internal/infrastructure/tservice"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+	"github.com/shrtyk/pvz-service/pkg/requestid"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+	pvz "github.com/shrtyk/pvz-service/proto/pvz/gen"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// protectedMethods lists the roles allowed to call each method. Methods missing
+// here don't require authentication.
+var protectedMethods = map[string][]auth.UserRole{
+	pvz.PVZService_AddProducts_FullMethodName:   {auth.UserRoleEmployee},
+	pvz.PVZService_StreamPVZData_FullMethodName: {auth.UserRoleEmployee, auth.UserRoleModerator},
+}
+
+// observer is the gRPC counterpart of the HTTP logging middleware. It assigns every
+// call a request id, taken from the x-request-id metadata when the caller passes one,
+// echoes it in the response header and puts a logger carrying it into the context.
+type observer struct {
+	log     *slog.Logger
+	metrics metrics.Collector
+}
+
+func (o observer) UnaryInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	newCtx, l, reqID := o.begin(ctx, info.FullMethod)
+	// Fails only outside of a real gRPC call.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestid.MDKey, reqID))
+
+	start := time.Now()
+	resp, err := handler(newCtx, req)
+	o.finish(l, info.FullMethod, start, err)
+
+	return resp, err
+}
+
+func (o observer) StreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	newCtx, l, reqID := o.begin(ss.Context(), info.FullMethod)
+	_ = ss.SetHeader(metadata.Pairs(requestid.MDKey, reqID))
+
+	start := time.Now()
+	err := handler(srv, &wrappedStream{ServerStream: ss, ctx: newCtx})
+	o.finish(l, info.FullMethod, start, err)
+
+	return err
+}
+
+func (o observer) begin(ctx context.Context, method string) (context.Context, *slog.Logger, string) {
+	var upstreamID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(requestid.MDKey); len(vals) > 0 {
+			upstreamID = vals[0]
+		}
+	}
+	reqID := requestid.FromUpstream(upstreamID)
+
+	var ip string
+	if p, ok := peer.FromContext(ctx); ok {
+		ip = p.Addr.String()
+	}
+
+	l := o.log.With(
+		slog.String("ip", ip),
+		slog.String("request_id", reqID),
+		slog.String("method", method),
+	)
+	l = logger.WithTraceID(ctx, l)
+	l.Debug("New gRPC request")
+
+	return requestid.ToCtx(logger.ToCtx(ctx, l), reqID), l, reqID
+}
+
+func (o observer) finish(l *slog.Logger, method string, start time.Time, err error) {
+	dur := time.Since(start)
+	code := status.Code(err)
+
+	o.metrics.ObserveGRPCRequestDuration(method, dur.Seconds())
+	o.metrics.IncGRPCRequestsTotal(method, code.String())
+
+	switch code {
+	case codes.Internal, codes.Unknown:
+		l.Error("Server error", logger.WithErr(err))
+	}
+	l.Debug(
+		"gRPC request processed",
+		slog.String("status_code", code.String()),
+		slog.String("request_duration", fmt.Sprintf("%.5fs", dur.Seconds())),
+	)
+}
+
+type authenticator struct {
+	tokenService pAuth.TokenService
+}
+
+func (a authenticator) UnaryInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	newCtx, err := a.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(newCtx, req)
+}
+
+func (a authenticator) StreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	newCtx, err := a.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &wrappedStream{ServerStream: ss, ctx: newCtx})
+}
+
+func (a authenticator) authorize(ctx context.Context, method string) (context.Context, error) {
+	const op = "grpc.authorize"
+
+	roles, ok := protectedMethods[method]
+	if !ok {
+		return ctx, nil
+	}
+
+	token, err := bearerTokenFromMD(ctx)
+	if err != nil {
+		return nil, mapAuthErrsToGRPC(err)
+	}
+
+	claims, err := a.tokenService.GetTokenClaims(token)
+	if err != nil {
+		return nil, mapAuthErrsToGRPC(err)
+	}
+
+	if !slices.Contains(roles, auth.UserRole(claims.Role)) {
+		return nil, mapAuthErrsToGRPC(xerr.NewErr(op, pAuth.NotAuthorized))
+	}
+
+	return ts.ClaimsToCtx(ctx, claims), nil
+}
+
+// apiKeyMDKey is the metadata counterpart of the X-API-Key header.
+const apiKeyMDKey = "x-api-key"
+
+// rateLimiter is the gRPC counterpart of the HTTP rate limit middleware. Calls are
+// limited per full method name and accounted to the authenticated user, the API key
+// from the x-api-key metadata or the IP. Health checks aren't limited.
+type rateLimiter struct {
+	limiter ratelimit.Limiter
+}
+
+func (rl rateLimiter) UnaryInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	md, err := rl.take(ctx, info.FullMethod)
+	// Fails only outside of a real gRPC call.
+	_ = grpc.SetHeader(ctx, md)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (rl rateLimiter) StreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	md, err := rl.take(ss.Context(), info.FullMethod)
+	_ = ss.SetHeader(md)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+// take counts the call and returns the rate limit metadata of the response, along
+// with ResourceExhausted if the call is over the limit. Calls are let through when
+// the limiter fails.
+func (rl rateLimiter) take(ctx context.Context, method string) (metadata.MD, error) {
+	if strings.HasPrefix(method, "/"+healthpb.Health_ServiceDesc.ServiceName+"/") {
+		return nil, nil
+	}
+
+	var client ratelimit.Client
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(apiKeyMDKey); len(vals) > 0 {
+			client.APIKey = vals[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		client.IP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(client.IP); err == nil {
+			client.IP = host
+		}
+	}
+	if claims, err := ts.ClaimsFromCtx(ctx); err == nil {
+		client.UserID = claims.UserID()
+	}
+
+	res, err := rl.limiter.Take(ctx, method, client)
+	if err != nil {
+		logger.FromCtx(ctx).Error("Failed to rate limit call", logger.WithErr(err))
+		return nil, nil
+	}
+	if res.Limit == 0 {
+		return nil, nil
+	}
+
+	md := metadata.Pairs(
+		"ratelimit-limit", strconv.Itoa(res.Limit),
+		"ratelimit-remaining", strconv.Itoa(res.Remaining),
+		"ratelimit-reset", ceilSeconds(res.Reset),
+	)
+	if !res.Allowed {
+		md.Set("retry-after", ceilSeconds(res.RetryAfter))
+		return md, status.Error(codes.ResourceExhausted, "too many requests, retry later")
+	}
+
+	return md, nil
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
+
+func bearerTokenFromMD(ctx context.Context) (string, error) {
+	const op = "grpc.bearerTokenFromMD"
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", xerr.NewErr(op, pAuth.NotAuthenticated)
+	}
+
+	vals := md.Get("authorization")
+	if len(vals) == 0 || vals[0] == "" {
+		return "", xerr.NewErr(op, pAuth.NotAuthenticated)
+	}
+
+	token := strings.TrimPrefix(vals[0], "Bearer ")
+	if token == vals[0] {
+		return "", xerr.NewErr(op, pAuth.InvalidJwt)
+	}
+
+	return token, nil
+}
+
+func mapAuthErrsToGRPC(err error) error {
+	var aErr *xerr.BaseErr[pAuth.AuthErrKind]
+	if !errors.As(err, &aErr) {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	if aErr.Kind == pAuth.NotAuthorized {
+		return status.Error(codes.PermissionDenied, aErr.Kind.String())
+	}
+
+	return status.Error(codes.Unauthenticated, aErr.Kind.String())
+}
+
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
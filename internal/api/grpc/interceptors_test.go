@@ -0,0 +1,348 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain/auth"
+	pAuth "github.com/shrtyk/pvz-service/internal/core/ports/auth"
+	pAuthMock "github.com/shrtyk/pvz-service/internal/core/ports/auth/mocks"
+	metricsmocks "github.com/shrtyk/pvz-service/internal/core/ports/metrics/mocks"
+	"github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
+	ratelimitmocks "github.com/shrtyk/pvz-service/internal/core/ports/ratelimit/mocks"
+	ts "github.com/shrtyk/pvz-service/internal/infrastructure/tservice"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+	"github.com/shrtyk/pvz-service/pkg/requestid"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+	pvz "github.com/shrtyk/pvz-service/proto/pvz/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestObserver_UnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	method := pvz.PVZService_GetPVZList_FullMethodName
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		handleErr error
+		wantCode  codes.Code
+		wantLog   string
+	}{
+		{
+			name:     "request id from metadata",
+			ctx:      metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestid.MDKey, "upstream-id")),
+			wantCode: codes.OK,
+			wantLog:  `"request_id":"upstream-id"`,
+		},
+		{
+			name:     "request id generated",
+			ctx:      context.Background(),
+			wantCode: codes.OK,
+			wantLog:  "gRPC request processed",
+		},
+		{
+			name:      "server error",
+			ctx:       context.Background(),
+			handleErr: status.Error(codes.Internal, "db is down"),
+			wantCode:  codes.Internal,
+			wantLog:   "Server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, logs := logger.NewTestLogger()
+			m := metricsmocks.NewMockCollector(t)
+			m.EXPECT().ObserveGRPCRequestDuration(method, mock.AnythingOfType("float64")).Return()
+			m.EXPECT().IncGRPCRequestsTotal(method, tt.wantCode.String()).Return()
+			o := observer{log: l, metrics: m}
+
+			var reqID string
+			handler := func(ctx context.Context, req any) (any, error) {
+				reqID = requestid.FromCtx(ctx)
+				return nil, tt.handleErr
+			}
+
+			_, err := o.UnaryInterceptor(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.NotEmpty(t, reqID)
+			assert.Contains(t, logs.String(), tt.wantLog)
+		})
+	}
+}
+
+func TestObserver_StreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	method := pvz.PVZService_AddProducts_FullMethodName
+	l, _ := logger.NewTestLogger()
+	m := metricsmocks.NewMockCollector(t)
+	m.EXPECT().ObserveGRPCRequestDuration(method, mock.AnythingOfType("float64")).Return()
+	m.EXPECT().IncGRPCRequestsTotal(method, codes.Unknown.String()).Return()
+	o := observer{log: l, metrics: m}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestid.MDKey, "upstream-id"))
+	ss := &ctxStream{ctx: ctx}
+	info := &grpc.StreamServerInfo{FullMethod: method}
+
+	err := o.StreamInterceptor(nil, ss, info, func(srv any, stream grpc.ServerStream) error {
+		assert.Equal(t, "upstream-id", requestid.FromCtx(stream.Context()))
+		return errors.New("boom")
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, []string{"upstream-id"}, ss.header.Get(requestid.MDKey))
+}
+
+func TestAuthenticator_UnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	userId := uuid.New()
+	claimsFor := func(role auth.UserRole) *auth.AccessTokenClaims {
+		return &auth.AccessTokenClaims{
+			Role:             string(role),
+			RegisteredClaims: jwt.RegisteredClaims{Subject: userId.String()},
+		}
+	}
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", token))
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		ctx        context.Context
+		setupMock  func(m *pAuthMock.MockTokenService)
+		wantCode   codes.Code
+		wantClaims bool
+	}{
+		{
+			name:      "unprotected method",
+			method:    pvz.PVZService_GetPVZList_FullMethodName,
+			ctx:       context.Background(),
+			setupMock: func(m *pAuthMock.MockTokenService) {},
+			wantCode:  codes.OK,
+		},
+		{
+			name:   "employee is allowed",
+			method: pvz.PVZService_AddProducts_FullMethodName,
+			ctx:    withToken("Bearer token"),
+			setupMock: func(m *pAuthMock.MockTokenService) {
+				m.EXPECT().GetTokenClaims("token").Return(claimsFor(auth.UserRoleEmployee), nil)
+			},
+			wantCode:   codes.OK,
+			wantClaims: true,
+		},
+		{
+			name:   "moderator is denied",
+			method: pvz.PVZService_AddProducts_FullMethodName,
+			ctx:    withToken("Bearer token"),
+			setupMock: func(m *pAuthMock.MockTokenService) {
+				m.EXPECT().GetTokenClaims("token").Return(claimsFor(auth.UserRoleModerator), nil)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:      "missing metadata",
+			method:    pvz.PVZService_AddProducts_FullMethodName,
+			ctx:       context.Background(),
+			setupMock: func(m *pAuthMock.MockTokenService) {},
+			wantCode:  codes.Unauthenticated,
+		},
+		{
+			name:      "not a bearer token",
+			method:    pvz.PVZService_AddProducts_FullMethodName,
+			ctx:       withToken("token"),
+			setupMock: func(m *pAuthMock.MockTokenService) {},
+			wantCode:  codes.Unauthenticated,
+		},
+		{
+			name:   "expired token",
+			method: pvz.PVZService_AddProducts_FullMethodName,
+			ctx:    withToken("Bearer token"),
+			setupMock: func(m *pAuthMock.MockTokenService) {
+				m.EXPECT().GetTokenClaims("token").Return(nil, xerr.NewErr("op", pAuth.ExpiredJwt))
+			},
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tService := pAuthMock.NewMockTokenService(t)
+			tt.setupMock(tService)
+			a := authenticator{tokenService: tService}
+
+			called := false
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				_, err := ts.ClaimsFromCtx(ctx)
+				assert.Equal(t, tt.wantClaims, err == nil)
+				return nil, nil
+			}
+
+			_, err := a.UnaryInterceptor(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			if tt.wantCode == codes.OK {
+				require.NoError(t, err)
+				assert.True(t, called)
+				return
+			}
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantCode, st.Code())
+			assert.False(t, called)
+		})
+	}
+}
+
+func TestAuthenticator_StreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tService := pAuthMock.NewMockTokenService(t)
+	tService.EXPECT().GetTokenClaims("token").Return(&auth.AccessTokenClaims{Role: string(auth.UserRoleEmployee)}, nil)
+	a := authenticator{tokenService: tService}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+	ss := &ctxStream{ctx: ctx}
+	info := &grpc.StreamServerInfo{FullMethod: pvz.PVZService_AddProducts_FullMethodName}
+
+	err := a.StreamInterceptor(nil, ss, info, func(srv any, stream grpc.ServerStream) error {
+		claims, err := ts.ClaimsFromCtx(stream.Context())
+		require.NoError(t, err)
+		assert.Equal(t, string(auth.UserRoleEmployee), claims.Role)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestRateLimiter_UnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	method := pvz.PVZService_GetPVZList_FullMethodName
+	peerCtx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 5000},
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		method     string
+		wantClient *ratelimit.Client
+		result     ratelimit.Result
+		limitErr   error
+		wantCode   codes.Code
+	}{
+		{
+			name: "allowed call of a user",
+			ctx: ts.ClaimsToCtx(peerCtx, &auth.AccessTokenClaims{
+				RegisteredClaims: jwt.RegisteredClaims{Subject: "42"},
+			}),
+			method:     method,
+			wantClient: &ratelimit.Client{UserID: "42", IP: "192.0.2.1"},
+			result:     ratelimit.Result{Allowed: true, Limit: 10, Remaining: 9},
+			wantCode:   codes.OK,
+		},
+		{
+			name:       "call over the limit of an api key",
+			ctx:        metadata.NewIncomingContext(peerCtx, metadata.Pairs(apiKeyMDKey, "key")),
+			method:     method,
+			wantClient: &ratelimit.Client{APIKey: "key", IP: "192.0.2.1"},
+			result:     ratelimit.Result{Limit: 10, RetryAfter: time.Second},
+			wantCode:   codes.ResourceExhausted,
+		},
+		{
+			name:       "limiter failure lets calls through",
+			ctx:        peerCtx,
+			method:     method,
+			wantClient: &ratelimit.Client{IP: "192.0.2.1"},
+			limitErr:   errors.New("db error"),
+			wantCode:   codes.OK,
+		},
+		{
+			name:     "health checks aren't limited",
+			ctx:      peerCtx,
+			method:   healthpb.Health_Check_FullMethodName,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := ratelimitmocks.NewMockLimiter(t)
+			if tt.wantClient != nil {
+				limiter.EXPECT().Take(mock.Anything, tt.method, *tt.wantClient).Return(tt.result, tt.limitErr)
+			}
+			rl := rateLimiter{limiter: limiter}
+			info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+			l, _ := logger.NewTestLogger()
+
+			_, err := rl.UnaryInterceptor(logger.ToCtx(tt.ctx, l), nil, info, func(ctx context.Context, req any) (any, error) {
+				return nil, nil
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestRateLimiter_StreamInterceptor(t *testing.T) {
+	t.Parallel()
+
+	method := pvz.PVZService_AddProducts_FullMethodName
+	limiter := ratelimitmocks.NewMockLimiter(t)
+	limiter.EXPECT().Take(mock.Anything, method, ratelimit.Client{}).
+		Return(ratelimit.Result{Limit: 5, RetryAfter: 1500 * time.Millisecond, Reset: 3 * time.Second}, nil)
+	rl := rateLimiter{limiter: limiter}
+	ss := &ctxStream{ctx: context.Background()}
+	info := &grpc.StreamServerInfo{FullMethod: method}
+
+	err := rl.StreamInterceptor(nil, ss, info, func(srv any, stream grpc.ServerStream) error {
+		t.Fatal("calls over the limit must not be handled")
+		return nil
+	})
+
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, []string{"5"}, ss.header.Get("ratelimit-limit"))
+	assert.Equal(t, []string{"0"}, ss.header.Get("ratelimit-remaining"))
+	assert.Equal(t, []string{"3"}, ss.header.Get("ratelimit-reset"))
+	assert.Equal(t, []string{"2"}, ss.header.Get("retry-after"))
+}
+
+type ctxStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *ctxStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *ctxStream) Context() context.Context {
+	return s.ctx
+}
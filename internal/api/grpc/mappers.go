@@ -1,6 +1,10 @@
 package grpc
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/shrtyk/pvz-service/internal/core/domain"
 	pvz "github.com/shrtyk/pvz-service/proto/pvz/gen"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -14,9 +18,252 @@ func toProtoFromDomainPvzs(domainPvzs []*domain.Pvz) []*pvz.PVZ {
 			RegistrationDate: &timestamppb.Timestamp{
 				Seconds: p.RegistrationDate.Unix(),
 			},
-			City: string(p.City),
+			City:    string(p.City),
+			Version: int32(p.Version),
+		}
+	}
+
+	return res
+}
+
+var receptionStatuses = map[pvz.ReceptionStatus]domain.ReceptionStatus{
+	pvz.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS: domain.InProgress,
+	pvz.ReceptionStatus_RECEPTION_STATUS_CLOSED:      domain.Close,
+	pvz.ReceptionStatus_RECEPTION_STATUS_CANCELLED:   domain.Cancelled,
+}
+
+var protoReceptionStatuses = map[domain.ReceptionStatus]pvz.ReceptionStatus{
+	domain.InProgress: pvz.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS,
+	domain.Close:      pvz.ReceptionStatus_RECEPTION_STATUS_CLOSED,
+	domain.Cancelled:  pvz.ReceptionStatus_RECEPTION_STATUS_CANCELLED,
+}
+
+var pvzSortFields = map[pvz.PvzSortField]domain.PvzsSortField{
+	pvz.PvzSortField_PVZ_SORT_FIELD_REGISTRATION_DATE: domain.PvzsSortByRegistrationDate,
+	pvz.PvzSortField_PVZ_SORT_FIELD_CITY:              domain.PvzsSortByCity,
+}
+
+func toDomainPvzsQuery(in *pvz.GetPVZListRequest) (*domain.PvzsFilter, domain.PvzsSort, error) {
+	filter := new(domain.PvzsFilter)
+	sort := domain.PvzsSort{Desc: in.GetSortDesc()}
+
+	field, ok := pvzSortFields[in.GetSortBy()]
+	if !ok {
+		return nil, sort, fmt.Errorf("unknown sort_by %v", in.GetSortBy())
+	}
+	sort.Field = field
+
+	for _, c := range in.GetCities() {
+		switch city := domain.PVZCity(c); city {
+		case domain.Moscow, domain.SaintPetersburg, domain.Kazan:
+			filter.Cities = append(filter.Cities, city)
+		default:
+			return nil, sort, fmt.Errorf("unknown city %q", c)
+		}
+	}
+
+	for _, st := range in.GetReceptionStatuses() {
+		rs, ok := receptionStatuses[st]
+		if !ok {
+			return nil, sort, fmt.Errorf("unknown reception status %v", st)
+		}
+		filter.ReceptionStatuses = append(filter.ReceptionStatuses, rs)
+	}
+
+	for _, t := range in.GetProductTypes() {
+		switch pt := domain.ProductType(t); pt {
+		case domain.ProductTypeClothing, domain.ProductTypeElectronics, domain.ProductTypeFootwear:
+			filter.ProductTypes = append(filter.ProductTypes, pt)
+		default:
+			return nil, sort, fmt.Errorf("unknown product type %q", t)
+		}
+	}
+
+	var err error
+	if filter.RegisteredFrom, filter.RegisteredTo, err = toTimeRange(
+		in.GetRegisteredFrom(), in.GetRegisteredTo(),
+	); err != nil {
+		return nil, sort, fmt.Errorf("registered range: %w", err)
+	}
+	if filter.StartDate, filter.EndDate, err = toTimeRange(
+		in.GetReceptionFrom(), in.GetReceptionTo(),
+	); err != nil {
+		return nil, sort, fmt.Errorf("reception range: %w", err)
+	}
+
+	if in != nil && in.HasOpenReception != nil {
+		open := in.GetHasOpenReception()
+		filter.HasOpenReception = &open
+	}
+
+	return filter, sort, nil
+}
+
+const (
+	defaultPage    = 1
+	defaultLimit   = 10
+	maxStreamLimit = 1000
+)
+
+func toDomainPvzsReadParams(in *pvz.StreamPVZDataRequest) (*domain.PvzsReadParams, error) {
+	if in.GetPage() < 0 {
+		return nil, errors.New("page must not be negative")
+	}
+	if in.GetLimit() < 0 || in.GetLimit() > maxStreamLimit {
+		return nil, fmt.Errorf("limit must be between 0 and %d", maxStreamLimit)
+	}
+
+	filter, sort, err := toDomainPvzsQuery(in.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	params := &domain.PvzsReadParams{
+		PvzsFilter: *filter,
+		Sort:       sort,
+		Page:       defaultPage,
+		Limit:      defaultLimit,
+	}
+	if in.GetPage() > 0 {
+		params.Page = int(in.GetPage())
+	}
+	if in.GetLimit() > 0 {
+		params.Limit = int(in.GetLimit())
+	}
+
+	return params, nil
+}
+
+func toTimeRange(from, to *timestamppb.Timestamp) (*time.Time, *time.Time, error) {
+	var start, end *time.Time
+	if from != nil {
+		if err := from.CheckValid(); err != nil {
+			return nil, nil, err
+		}
+		t := from.AsTime()
+		start = &t
+	}
+	if to != nil {
+		if err := to.CheckValid(); err != nil {
+			return nil, nil, err
+		}
+		t := to.AsTime()
+		end = &t
+	}
+	if start != nil && end != nil && start.After(*end) {
+		return nil, nil, errors.New("start is after end")
+	}
+	return start, end, nil
+}
+
+func toProtoPvzData(data *domain.PvzReceptions) *pvz.PVZData {
+	res := &pvz.PVZData{
+		Pvz: &pvz.PVZ{
+			Id:               data.Pvz.Id.String(),
+			RegistrationDate: timestamppb.New(data.Pvz.RegistrationDate),
+			City:             string(data.Pvz.City),
+			Version:          int32(data.Pvz.Version),
+		},
+		Receptions: make([]*pvz.ReceptionProducts, len(data.Receptions)),
+	}
+
+	for i, r := range data.Receptions {
+		rec := &pvz.Reception{
+			Id:         r.Reception.Id.String(),
+			DateTime:   timestamppb.New(r.Reception.DateTime),
+			PvzId:      r.Reception.PvzId.String(),
+			Status:     protoReceptionStatuses[r.Reception.Status],
+			AutoClosed: r.Reception.AutoClosed,
+			Version:    int32(r.Reception.Version),
+		}
+		if r.Reception.ClosedAt != nil {
+			rec.ClosedAt = timestamppb.New(*r.Reception.ClosedAt)
+		}
+
+		products := make([]*pvz.Product, len(r.Products))
+		for j, p := range r.Products {
+			products[j] = toProtoProduct(p)
+		}
+
+		res.Receptions[i] = &pvz.ReceptionProducts{
+			Reception: rec,
+			Products:  products,
 		}
 	}
 
 	return res
 }
+
+func toDomainProductInput(in *pvz.ProductInput) (*domain.Product, error) {
+	if err := validateProductInput(in); err != nil {
+		return nil, err
+	}
+
+	p := &domain.Product{
+		Type:    domain.ProductType(in.GetType()),
+		Barcode: in.Barcode,
+	}
+	if in.Weight != nil {
+		w := int(in.GetWeight())
+		p.WeightGrams = &w
+	}
+	if d := in.GetDimensions(); d != nil {
+		p.Dimensions = &domain.ProductDimensions{
+			LengthMm: int(d.GetLength()),
+			WidthMm:  int(d.GetWidth()),
+			HeightMm: int(d.GetHeight()),
+		}
+	}
+
+	return p, nil
+}
+
+func toProtoProduct(p *domain.Product) *pvz.Product {
+	if p == nil {
+		return nil
+	}
+
+	res := &pvz.Product{
+		Id:          p.Id.String(),
+		DateTime:    timestamppb.New(p.DateTime),
+		Type:        string(p.Type),
+		ReceptionId: p.ReceptionId.String(),
+		Barcode:     p.Barcode,
+	}
+	if p.WeightGrams != nil {
+		w := int32(*p.WeightGrams)
+		res.Weight = &w
+	}
+	if p.Dimensions != nil {
+		res.Dimensions = &pvz.ProductDimensions{
+			Length: int32(p.Dimensions.LengthMm),
+			Width:  int32(p.Dimensions.WidthMm),
+			Height: int32(p.Dimensions.HeightMm),
+		}
+	}
+
+	return res
+}
+
+var batchItemStatuses = map[domain.BatchItemStatus]pvz.BatchItemStatus{
+	domain.BatchItemAdded:            pvz.BatchItemStatus_BATCH_ITEM_STATUS_ADDED,
+	domain.BatchItemDuplicateBarcode: pvz.BatchItemStatus_BATCH_ITEM_STATUS_DUPLICATE_BARCODE,
+	domain.BatchItemNotAdded:         pvz.BatchItemStatus_BATCH_ITEM_STATUS_NOT_ADDED,
+	domain.BatchItemOverCapacity:     pvz.BatchItemStatus_BATCH_ITEM_STATUS_OVER_CAPACITY,
+}
+
+func toProtoProductsBatchResult(res *domain.ProductsBatchResult) *pvz.AddProductsResponse {
+	items := make([]*pvz.BatchItemResult, len(res.Items))
+	for i, item := range res.Items {
+		items[i] = &pvz.BatchItemResult{
+			Index:   int32(i),
+			Status:  batchItemStatuses[item.Status],
+			Product: toProtoProduct(item.Product),
+		}
+	}
+
+	return &pvz.AddProductsResponse{
+		Added: int32(res.Added),
+		Items: items,
+	}
+}
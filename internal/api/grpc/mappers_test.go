@@ -6,7 +6,10 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/shrtyk/pvz-service/internal/core/domain"
+	pvz "github.com/shrtyk/pvz-service/proto/pvz/gen"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestToProtoFromDomainPvzs(t *testing.T) {
@@ -27,6 +30,7 @@ func TestToProtoFromDomainPvzs(t *testing.T) {
 					Id:               testUUID,
 					RegistrationDate: testTime,
 					City:             "Moscow",
+					Version:          2,
 				},
 				{
 					Id:               uuid.New(),
@@ -60,7 +64,105 @@ func TestToProtoFromDomainPvzs(t *testing.T) {
 				assert.Equal(t, tt.domainPvzs[0].Id.String(), got[0].Id)
 				assert.Equal(t, string(tt.domainPvzs[0].City), got[0].City)
 				assert.Equal(t, tt.domainPvzs[0].RegistrationDate.Unix(), got[0].RegistrationDate.Seconds)
+				assert.Equal(t, int32(tt.domainPvzs[0].Version), got[0].Version)
 			}
 		})
 	}
 }
+
+func TestToDomainProductInput(t *testing.T) {
+	t.Parallel()
+
+	barcode := "4601234567890"
+	weight := int32(500)
+	p, err := toDomainProductInput(&pvz.ProductInput{
+		Type:       "электроника",
+		Barcode:    &barcode,
+		Weight:     &weight,
+		Dimensions: &pvz.ProductDimensions{Length: 3, Width: 2, Height: 1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProductTypeElectronics, p.Type)
+	assert.Equal(t, &barcode, p.Barcode)
+	assert.Equal(t, 500, *p.WeightGrams)
+	assert.Equal(t, &domain.ProductDimensions{LengthMm: 3, WidthMm: 2, HeightMm: 1}, p.Dimensions)
+
+	_, err = toDomainProductInput(&pvz.ProductInput{Type: "мебель"})
+	assert.Error(t, err)
+}
+
+func TestToDomainPvzsQuery(t *testing.T) {
+	t.Parallel()
+
+	from, to := time.Now().Add(-time.Hour), time.Now()
+	open := false
+	filter, sort, err := toDomainPvzsQuery(&pvz.GetPVZListRequest{
+		Cities:            []string{"Москва"},
+		RegisteredFrom:    timestamppb.New(from),
+		RegisteredTo:      timestamppb.New(to),
+		ReceptionStatuses: []pvz.ReceptionStatus{pvz.ReceptionStatus_RECEPTION_STATUS_CLOSED},
+		ProductTypes:      []string{"обувь"},
+		HasOpenReception:  &open,
+		SortBy:            pvz.PvzSortField_PVZ_SORT_FIELD_CITY,
+		SortDesc:          true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []domain.PVZCity{domain.Moscow}, filter.Cities)
+	assert.True(t, from.Equal(*filter.RegisteredFrom))
+	assert.True(t, to.Equal(*filter.RegisteredTo))
+	assert.Nil(t, filter.StartDate)
+	assert.Equal(t, []domain.ReceptionStatus{domain.Close}, filter.ReceptionStatuses)
+	assert.Equal(t, []domain.ProductType{domain.ProductTypeFootwear}, filter.ProductTypes)
+	assert.False(t, *filter.HasOpenReception)
+	assert.Equal(t, domain.PvzsSort{Field: domain.PvzsSortByCity, Desc: true}, sort)
+
+	filter, sort, err = toDomainPvzsQuery(&pvz.GetPVZListRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, &domain.PvzsFilter{}, filter)
+	assert.Equal(t, domain.PvzsSortByRegistrationDate, sort.Field)
+
+	for name, in := range map[string]*pvz.GetPVZListRequest{
+		"unknown city":      {Cities: []string{"Тверь"}},
+		"unknown type":      {ProductTypes: []string{"мебель"}},
+		"unknown status":    {ReceptionStatuses: []pvz.ReceptionStatus{42}},
+		"unknown sort":      {SortBy: 42},
+		"reversed range":    {ReceptionFrom: timestamppb.New(to), ReceptionTo: timestamppb.New(from)},
+		"invalid timestamp": {RegisteredFrom: &timestamppb.Timestamp{Nanos: -1}},
+	} {
+		_, _, err := toDomainPvzsQuery(in)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestToProtoProductsBatchResult(t *testing.T) {
+	t.Parallel()
+
+	weight := 500
+	prod := &domain.Product{
+		Id:          uuid.New(),
+		ReceptionId: uuid.New(),
+		DateTime:    time.Now(),
+		Type:        domain.ProductTypeClothing,
+		WeightGrams: &weight,
+		Dimensions:  &domain.ProductDimensions{LengthMm: 3, WidthMm: 2, HeightMm: 1},
+	}
+
+	resp := toProtoProductsBatchResult(&domain.ProductsBatchResult{
+		Added: 1,
+		Items: []*domain.BatchItemResult{
+			{Status: domain.BatchItemDuplicateBarcode},
+			{Status: domain.BatchItemAdded, Product: prod},
+		},
+	})
+
+	assert.Equal(t, int32(1), resp.Added)
+	require.Len(t, resp.Items, 2)
+	assert.Equal(t, pvz.BatchItemStatus_BATCH_ITEM_STATUS_DUPLICATE_BARCODE, resp.Items[0].Status)
+	assert.Nil(t, resp.Items[0].Product)
+	assert.Equal(t, int32(1), resp.Items[1].Index)
+	assert.Equal(t, pvz.BatchItemStatus_BATCH_ITEM_STATUS_ADDED, resp.Items[1].Status)
+	assert.Equal(t, prod.Id.String(), resp.Items[1].Product.Id)
+	assert.Equal(t, int32(500), resp.Items[1].Product.GetWeight())
+	assert.Equal(t, int32(3), resp.Items[1].Product.Dimensions.Length)
+	assert.True(t, prod.DateTime.Equal(resp.Items[1].Product.DateTime.AsTime()))
+}
@@ -0,0 +1,196 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	ps "github.com/shrtyk/pvz-service/internal/core/ports/service"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+	pvz "github.com/shrtyk/pvz-service/proto/pvz/gen"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AddProducts receives a batch of products. With an idempotency-key in the metadata
+// the response is stored and replayed when the same batch is sent again.
+func (s *Server) AddProducts(
+	stream grpc.ClientStreamingServer[pvz.AddProductsRequest, pvz.AddProductsResponse],
+) error {
+	ctx := stream.Context()
+
+	key, err := idempotencyKeyFromMD(ctx)
+	if err != nil {
+		return err
+	}
+
+	fp := sha256.New()
+	fp.Write([]byte(pvz.PVZService_AddProducts_FullMethodName + "\n"))
+	batch, err := recvProductsBatch(&fingerprintStream{ClientStreamingServer: stream, h: fp})
+	if err != nil {
+		return err
+	}
+
+	addProducts := func() (*pvz.AddProductsResponse, error) {
+		return s.addProducts(ctx, batch)
+	}
+
+	var resp *pvz.AddProductsResponse
+	if key == "" {
+		resp, err = addProducts()
+	} else {
+		resp, err = idempotentCall(ctx, s.appService, key, hex.EncodeToString(fp.Sum(nil)), addProducts)
+	}
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+func (s *Server) addProducts(ctx context.Context, batch *domain.ProductsBatch) (*pvz.AddProductsResponse, error) {
+	res, err := s.appService.AddProductsBatch(ctx, batch)
+	if err != nil {
+		return nil, mapAppServiceErrsToGRPC(err)
+	}
+
+	resp := toProtoProductsBatchResult(res)
+	if res.Added == 0 {
+		st, dErr := status.New(codes.AlreadyExists, "no products were added").WithDetails(resp)
+		if dErr != nil {
+			return nil, status.Error(codes.Internal, dErr.Error())
+		}
+		return nil, st.Err()
+	}
+
+	return resp, nil
+}
+
+// fingerprintStream feeds every received request into h.
+type fingerprintStream struct {
+	grpc.ClientStreamingServer[pvz.AddProductsRequest, pvz.AddProductsResponse]
+	h hash.Hash
+}
+
+func (s *fingerprintStream) Recv() (*pvz.AddProductsRequest, error) {
+	req, err := s.ClientStreamingServer.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFingerprint(s.h, req); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return req, nil
+}
+
+func recvProductsBatch(
+	stream grpc.ClientStreamingServer[pvz.AddProductsRequest, pvz.AddProductsResponse],
+) (*domain.ProductsBatch, error) {
+	var batch *domain.ProductsBatch
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if batch == nil {
+			pvzId, err := uuid.Parse(req.GetPvzId())
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid pvz_id: %s", err)
+			}
+			batch = &domain.ProductsBatch{
+				PvzId:  pvzId,
+				Atomic: req.GetAtomic(),
+			}
+		}
+
+		if len(batch.Products) == domain.MaxProductsBatchSize {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"too many products: at most %d allowed", domain.MaxProductsBatchSize,
+			)
+		}
+
+		p, err := toDomainProductInput(req.GetProduct())
+		if err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid product #%d: %s", len(batch.Products), err,
+			)
+		}
+		p.PvzId = batch.PvzId
+		batch.Products = append(batch.Products, p)
+	}
+
+	if batch == nil {
+		return nil, status.Error(codes.InvalidArgument, "no products were sent")
+	}
+
+	return batch, nil
+}
+
+func validateProductInput(in *pvz.ProductInput) error {
+	if in == nil {
+		return errors.New("product is required")
+	}
+
+	switch domain.ProductType(in.GetType()) {
+	case domain.ProductTypeClothing, domain.ProductTypeElectronics, domain.ProductTypeFootwear:
+	default:
+		return fmt.Errorf("unknown type %q", in.GetType())
+	}
+
+	if in.Barcode != nil {
+		b := in.GetBarcode()
+		if len(b) == 0 || len(b) > 64 {
+			return errors.New("barcode length must be between 1 and 64")
+		}
+		for i := 0; i < len(b); i++ {
+			if b[i] < 0x20 || b[i] > 0x7e {
+				return errors.New("barcode must contain only printable ascii characters")
+			}
+		}
+	}
+
+	if in.Weight != nil && in.GetWeight() <= 0 {
+		return errors.New("weight must be positive")
+	}
+
+	if d := in.GetDimensions(); d != nil {
+		if d.GetLength() <= 0 || d.GetWidth() <= 0 || d.GetHeight() <= 0 {
+			return errors.New("dimensions must be positive")
+		}
+	}
+
+	return nil
+}
+
+func mapAppServiceErrsToGRPC(err error) error {
+	var sErr *xerr.BaseErr[ps.ServiceErrKind]
+	if !errors.As(err, &sErr) {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	switch sErr.Kind {
+	case ps.NoActiveReception:
+		return status.Error(codes.FailedPrecondition, sErr.Kind.String())
+	case ps.ProductAlreadyExists:
+		return status.Error(codes.AlreadyExists, sErr.Kind.String())
+	case ps.PvzCapacityExceeded:
+		return status.Error(codes.ResourceExhausted, sErr.Kind.String())
+	case ps.IdempotencyKeyReused, ps.IdempotentReqInProgress:
+		return status.Error(codes.Aborted, sErr.Kind.String())
+	default:
+		return status.Error(codes.Internal, sErr.Kind.String())
+	}
+}
@@ -0,0 +1,235 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	ps "github.com/shrtyk/pvz-service/internal/core/ports/service"
+	mocks "github.com/shrtyk/pvz-service/internal/core/ports/service/mocks"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+	pvz "github.com/shrtyk/pvz-service/proto/pvz/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeAddProductsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*pvz.AddProductsRequest
+	resp *pvz.AddProductsResponse
+}
+
+func (f *fakeAddProductsStream) Context() context.Context {
+	if f.ctx != nil {
+		return f.ctx
+	}
+	return context.Background()
+}
+
+func (f *fakeAddProductsStream) Recv() (*pvz.AddProductsRequest, error) {
+	if len(f.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := f.reqs[0]
+	f.reqs = f.reqs[1:]
+	return req, nil
+}
+
+func (f *fakeAddProductsStream) SendAndClose(resp *pvz.AddProductsResponse) error {
+	f.resp = resp
+	return nil
+}
+
+func TestAddProducts(t *testing.T) {
+	t.Parallel()
+
+	log, _ := logger.NewTestLogger()
+	pvzId := uuid.New()
+	barcode := "4601234567890"
+
+	validReqs := func() []*pvz.AddProductsRequest {
+		return []*pvz.AddProductsRequest{
+			{PvzId: pvzId.String(), Atomic: true, Product: &pvz.ProductInput{Type: "одежда", Barcode: &barcode}},
+			{Product: &pvz.ProductInput{Type: "обувь"}},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		reqs      []*pvz.AddProductsRequest
+		setupMock func(m *mocks.MockService)
+		wantCode  codes.Code
+		wantAdded int32
+	}{
+		{
+			name: "success",
+			reqs: validReqs(),
+			setupMock: func(m *mocks.MockService) {
+				m.EXPECT().AddProductsBatch(mock.Anything, mock.MatchedBy(func(b *domain.ProductsBatch) bool {
+					return b.PvzId == pvzId && b.Atomic && len(b.Products) == 2 &&
+						*b.Products[0].Barcode == barcode && b.Products[1].PvzId == pvzId
+				})).Return(&domain.ProductsBatchResult{
+					Added: 2,
+					Items: []*domain.BatchItemResult{
+						{Status: domain.BatchItemAdded, Product: &domain.Product{Id: uuid.New()}},
+						{Status: domain.BatchItemAdded, Product: &domain.Product{Id: uuid.New()}},
+					},
+				}, nil)
+			},
+			wantCode:  codes.OK,
+			wantAdded: 2,
+		},
+		{
+			name: "nothing added",
+			reqs: validReqs(),
+			setupMock: func(m *mocks.MockService) {
+				m.EXPECT().AddProductsBatch(mock.Anything, mock.Anything).Return(&domain.ProductsBatchResult{
+					Items: []*domain.BatchItemResult{
+						{Status: domain.BatchItemDuplicateBarcode},
+						{Status: domain.BatchItemNotAdded},
+					},
+				}, nil)
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:      "empty stream",
+			setupMock: func(m *mocks.MockService) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name: "invalid pvz id",
+			reqs: []*pvz.AddProductsRequest{
+				{PvzId: "not-uuid", Product: &pvz.ProductInput{Type: "одежда"}},
+			},
+			setupMock: func(m *mocks.MockService) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name: "invalid product",
+			reqs: []*pvz.AddProductsRequest{
+				{PvzId: pvzId.String(), Product: &pvz.ProductInput{Type: "мебель"}},
+			},
+			setupMock: func(m *mocks.MockService) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name: "too many products",
+			reqs: func() []*pvz.AddProductsRequest {
+				reqs := make([]*pvz.AddProductsRequest, domain.MaxProductsBatchSize+1)
+				for i := range reqs {
+					reqs[i] = &pvz.AddProductsRequest{PvzId: pvzId.String(), Product: &pvz.ProductInput{Type: "одежда"}}
+				}
+				return reqs
+			}(),
+			setupMock: func(m *mocks.MockService) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name: "no active reception",
+			reqs: validReqs(),
+			setupMock: func(m *mocks.MockService) {
+				m.EXPECT().AddProductsBatch(mock.Anything, mock.Anything).
+					Return(nil, xerr.NewErr("op", ps.NoActiveReception))
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "pvz is full",
+			reqs: validReqs(),
+			setupMock: func(m *mocks.MockService) {
+				m.EXPECT().AddProductsBatch(mock.Anything, mock.Anything).
+					Return(nil, xerr.NewErr("op", ps.PvzCapacityExceeded))
+			},
+			wantCode: codes.ResourceExhausted,
+		},
+		{
+			name: "service error",
+			reqs: validReqs(),
+			setupMock: func(m *mocks.MockService) {
+				m.EXPECT().AddProductsBatch(mock.Anything, mock.Anything).Return(nil, assert.AnError)
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockService := mocks.NewMockService(t)
+			tt.setupMock(mockService)
+
+			s := Server{
+				appService: mockService,
+				logger:     log,
+			}
+			stream := &fakeAddProductsStream{reqs: tt.reqs}
+
+			err := s.AddProducts(stream)
+
+			if tt.wantCode == codes.OK {
+				require.NoError(t, err)
+				require.NotNil(t, stream.resp)
+				assert.Equal(t, tt.wantAdded, stream.resp.Added)
+				return
+			}
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantCode, st.Code())
+			assert.Nil(t, stream.resp)
+		})
+	}
+}
+
+func TestValidateProductInput(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	i32 := func(i int32) *int32 { return &i }
+
+	tests := []struct {
+		name    string
+		in      *pvz.ProductInput
+		wantErr bool
+	}{
+		{name: "valid", in: &pvz.ProductInput{
+			Type:       "электроника",
+			Barcode:    str("4601234567890"),
+			Weight:     i32(10),
+			Dimensions: &pvz.ProductDimensions{Length: 1, Width: 1, Height: 1},
+		}},
+		{name: "nil", in: nil, wantErr: true},
+		{name: "unknown type", in: &pvz.ProductInput{Type: "мебель"}, wantErr: true},
+		{name: "empty barcode", in: &pvz.ProductInput{Type: "одежда", Barcode: str("")}, wantErr: true},
+		{name: "non ascii barcode", in: &pvz.ProductInput{Type: "одежда", Barcode: str("штрих")}, wantErr: true},
+		{name: "non positive weight", in: &pvz.ProductInput{Type: "одежда", Weight: i32(0)}, wantErr: true},
+		{
+			name:    "non positive dimensions",
+			in:      &pvz.ProductInput{Type: "одежда", Dimensions: &pvz.ProductDimensions{Length: 1}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateProductInput(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
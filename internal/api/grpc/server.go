@@ -7,9 +7,15 @@ import (
 	"net"
 	"sync"
 
+	"github.com/shrtyk/pvz-service/internal/core/ports/auth"
+	"github.com/shrtyk/pvz-service/internal/core/ports/metrics"
+	"github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
 	"github.com/shrtyk/pvz-service/internal/core/ports/service"
 	pvz "github.com/shrtyk/pvz-service/proto/pvz/gen"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -19,6 +25,7 @@ type Server struct {
 	appService service.Service
 	logger     *slog.Logger
 	grpcServ   *grpc.Server
+	health     *grpchealth.Server
 
 	pvz.UnimplementedPVZServiceServer
 }
@@ -26,18 +33,39 @@ type Server struct {
 func NewGRPCServer(
 	wg *sync.WaitGroup,
 	appService service.Service,
+	tokenService auth.TokenService,
 	logger *slog.Logger,
+	metrics metrics.Collector,
+	limiter ratelimit.Limiter,
 	port string,
 ) *Server {
+	obs := observer{log: logger, metrics: metrics}
+	authn := authenticator{tokenService: tokenService}
+	unary := []grpc.UnaryServerInterceptor{obs.UnaryInterceptor, authn.UnaryInterceptor}
+	stream := []grpc.StreamServerInterceptor{obs.StreamInterceptor, authn.StreamInterceptor}
+	if limiter != nil {
+		// Goes after the authenticator to count calls of protected methods per user.
+		rl := rateLimiter{limiter: limiter}
+		unary = append(unary, rl.UnaryInterceptor)
+		stream = append(stream, rl.StreamInterceptor)
+	}
+
 	s := &Server{
 		wg:         wg,
 		port:       port,
 		appService: appService,
 		logger:     logger,
-		grpcServ:   grpc.NewServer(),
+		health:     grpchealth.NewServer(),
+		grpcServ: grpc.NewServer(
+			grpc.StatsHandler(otelgrpc.NewServerHandler()),
+			grpc.ChainUnaryInterceptor(unary...),
+			grpc.ChainStreamInterceptor(stream...),
+		),
 	}
 
 	pvz.RegisterPVZServiceServer(s.grpcServ, s)
+	healthpb.RegisterHealthServer(s.grpcServ, s.health)
+	s.health.SetServingStatus(pvz.PVZService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
 	reflection.Register(s.grpcServ)
 
 	return s
@@ -60,6 +88,12 @@ func (s *Server) MustStart() {
 	}()
 }
 
+// Drain reports the server and its services as not serving to health checks, so
+// that clients move to other instances before the server stops.
+func (s *Server) Drain() {
+	s.health.Shutdown()
+}
+
 func (s *Server) Shutdown(ctx context.Context) error {
 	done := make(chan struct{})
 	go func() {
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	pvz "github.com/shrtyk/pvz-service/proto/pvz/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestServer_Health(t *testing.T) {
+	t.Parallel()
+
+	s := NewGRPCServer(&sync.WaitGroup{}, nil, nil, nil, nil, nil, "0")
+
+	check := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		t.Helper()
+		resp, err := s.health.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		return resp.GetStatus()
+	}
+
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, check(""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, check(pvz.PVZService_ServiceDesc.ServiceName))
+
+	s.Drain()
+
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, check(""))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, check(pvz.PVZService_ServiceDesc.ServiceName))
+}
@@ -0,0 +1,70 @@
+package http
+
+import (
+	"net/http"
+	"runtime/debug"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/shrtyk/pvz-service/internal/infrastructure/health"
+)
+
+// BuildInfo describes the running binary, as it was stamped by the Go toolchain.
+type BuildInfo struct {
+	GoVersion    string `json:"go_version"`
+	Path         string `json:"path"`
+	Version      string `json:"version"`
+	Revision     string `json:"revision,omitempty"`
+	RevisionTime string `json:"revision_time,omitempty"`
+	Modified     bool   `json:"modified"`
+}
+
+// NewAdminRouter returns the router of the admin listener serving metrics, probes,
+// pprof profiles, build info and the config dump. None of them are authenticated,
+// so the listener must not be exposed publicly. The config is served as given,
+// it is up to the caller to redact its secrets.
+func NewAdminRouter(checker *health.Checker, config any) chi.Router {
+	r := chi.NewRouter()
+
+	r.Use(middleware.Recoverer)
+	r.NotFound(notFoundHandler)
+	r.MethodNotAllowed(methodNotAllowedHandler)
+
+	mountProbes(r, checker)
+	r.Handle("/metrics", promhttp.Handler())
+	r.Mount("/debug", middleware.Profiler())
+	r.Get("/buildinfo", Handle(func(w http.ResponseWriter, r *http.Request) error {
+		return WriteJSON(w, readBuildInfo(), http.StatusOK, nil)
+	}))
+	r.Get("/config", Handle(func(w http.ResponseWriter, r *http.Request) error {
+		return WriteJSON(w, config, http.StatusOK, nil)
+	}))
+
+	return r
+}
+
+func readBuildInfo() BuildInfo {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return BuildInfo{}
+	}
+
+	info := BuildInfo{
+		GoVersion: bi.GoVersion,
+		Path:      bi.Path,
+		Version:   bi.Main.Version,
+	}
+	for _, s := range bi.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			info.Revision = s.Value
+		case "vcs.time":
+			info.RevisionTime = s.Value
+		case "vcs.modified":
+			info.Modified = s.Value == "true"
+		}
+	}
+
+	return info
+}
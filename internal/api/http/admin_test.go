@@ -0,0 +1,79 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAdminRouter(t *testing.T) {
+	t.Parallel()
+
+	r := NewAdminRouter(nil, map[string]any{
+		"postgres": map[string]any{"password": "[REDACTED]"},
+	})
+
+	testCases := []struct {
+		name         string
+		target       string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "liveness",
+			target:       "/livez",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "metrics",
+			target:       "/metrics",
+			expectedCode: http.StatusOK,
+			expectedBody: "go_goroutines",
+		},
+		{
+			name:         "pprof index",
+			target:       "/debug/pprof/",
+			expectedCode: http.StatusOK,
+			expectedBody: "goroutine",
+		},
+		{
+			name:         "config dump",
+			target:       "/config",
+			expectedCode: http.StatusOK,
+			expectedBody: `"password": "[REDACTED]"`,
+		},
+		{
+			name:         "unknown route",
+			target:       "/api/v1/pvz",
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tc.target, nil))
+
+			assert.Equal(t, tc.expectedCode, rr.Code)
+			assert.Contains(t, rr.Body.String(), tc.expectedBody)
+		})
+	}
+
+	t.Run("build info", func(t *testing.T) {
+		t.Parallel()
+
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/buildinfo", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var info BuildInfo
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &info))
+		assert.NotEmpty(t, info.GoVersion)
+	})
+}
@@ -0,0 +1,39 @@
+package http
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+type pvzsCursor struct {
+	RegistrationDate time.Time      `json:"t"`
+	City             domain.PVZCity `json:"c,omitempty"`
+	Id               uuid.UUID      `json:"id"`
+}
+
+// encodePvzsCursor makes an opaque cursor. Clients must not rely on its format.
+func encodePvzsCursor(c *domain.PvzsCursor) string {
+	b, _ := json.Marshal(pvzsCursor{RegistrationDate: c.RegistrationDate, City: c.City, Id: c.Id})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePvzsCursor(s string) (*domain.PvzsCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	var c pvzsCursor
+	if err := json.Unmarshal(b, &c); err != nil || c.Id == uuid.Nil || c.RegistrationDate.IsZero() {
+		return nil, errInvalidCursor
+	}
+
+	return &domain.PvzsCursor{RegistrationDate: c.RegistrationDate, City: c.City, Id: c.Id}, nil
+}
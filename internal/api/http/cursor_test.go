@@ -0,0 +1,50 @@
+package http
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_pvzsCursor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		c := &domain.PvzsCursor{RegistrationDate: time.Now().UTC(), Id: uuid.New()}
+		got, err := decodePvzsCursor(encodePvzsCursor(c))
+		require.NoError(t, err)
+		assert.True(t, c.RegistrationDate.Equal(got.RegistrationDate))
+		assert.Equal(t, c.Id, got.Id)
+	})
+
+	t.Run("round trip with city", func(t *testing.T) {
+		t.Parallel()
+
+		c := &domain.PvzsCursor{RegistrationDate: time.Now().UTC(), City: domain.Kazan, Id: uuid.New()}
+		got, err := decodePvzsCursor(encodePvzsCursor(c))
+		require.NoError(t, err)
+		assert.Equal(t, domain.Kazan, got.City)
+	})
+
+	for name, raw := range map[string]string{
+		"not base64":    "%%%",
+		"not json":      base64.RawURLEncoding.EncodeToString([]byte("cursor")),
+		"missing id":    base64.RawURLEncoding.EncodeToString([]byte(`{"t":"2026-10-18T10:00:00Z"}`)),
+		"missing date":  base64.RawURLEncoding.EncodeToString([]byte(`{"id":"` + uuid.NewString() + `"}`)),
+		"empty payload": base64.RawURLEncoding.EncodeToString([]byte(`{}`)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := decodePvzsCursor(raw)
+			assert.ErrorIs(t, err, errInvalidCursor)
+		})
+	}
+}
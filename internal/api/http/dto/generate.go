@@ -1,3 +1,4 @@
 package dto
 
 //go:generate oapi-codegen -generate=types -package=dto -o pvz.gen.go ../../../../api/openapi/swagger.yaml
+//go:generate oapi-codegen -generate=chi-server -package=dto -o server.gen.go ../../../../api/openapi/swagger.yaml
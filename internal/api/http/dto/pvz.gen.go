@@ -14,22 +14,112 @@ const (
 	BearerAuthScopes         = "bearerAuth.Scopes"
 )
 
+// Defines values for BatchItemResultStatus.
+const (
+	BatchItemAdded            BatchItemResultStatus = "added"
+	BatchItemDuplicateBarcode BatchItemResultStatus = "duplicate_barcode"
+	BatchItemNotAdded         BatchItemResultStatus = "not_added"
+	BatchItemOverCapacity     BatchItemResultStatus = "over_capacity"
+)
+
+// Defines values for ErrorCode.
+const (
+	ErrCodeActiveReceptionExists       ErrorCode = "active_reception_exists"
+	ErrCodeCSRFTokenMismatch           ErrorCode = "csrf_token_mismatch"
+	ErrCodeDeletedProductNotFound      ErrorCode = "deleted_product_not_found"
+	ErrCodeEmailAlreadyExists          ErrorCode = "email_already_exists"
+	ErrCodeExpiredToken                ErrorCode = "expired_token"
+	ErrCodeExportJobNotFound           ErrorCode = "export_job_not_found"
+	ErrCodeExportNotReady              ErrorCode = "export_not_ready"
+	ErrCodeIdempotencyKeyReused        ErrorCode = "idempotency_key_reused"
+	ErrCodeIdempotentRequestInProgress ErrorCode = "idempotent_request_in_progress"
+	ErrCodeInternal                    ErrorCode = "internal_error"
+	ErrCodeInvalidHeaders              ErrorCode = "invalid_headers"
+	ErrCodeInvalidIdempotencyKey       ErrorCode = "invalid_idempotency_key"
+	ErrCodeInvalidParams               ErrorCode = "invalid_params"
+	ErrCodeInvalidQueryParams          ErrorCode = "invalid_query_params"
+	ErrCodeInvalidToken                ErrorCode = "invalid_token"
+	ErrCodeMalformedBody               ErrorCode = "malformed_body"
+	ErrCodeMethodNotAllowed            ErrorCode = "method_not_allowed"
+	ErrCodeNoActiveReception           ErrorCode = "no_active_reception"
+	ErrCodeNoProductToDelete           ErrorCode = "no_product_to_delete"
+	ErrCodeNotAuthenticated            ErrorCode = "not_authenticated"
+	ErrCodeNotAuthorized               ErrorCode = "not_authorized"
+	ErrCodeNotFound                    ErrorCode = "not_found"
+	ErrCodePickupCodeExpired           ErrorCode = "pickup_code_expired"
+	ErrCodePickupCodeLocked            ErrorCode = "pickup_code_locked"
+	ErrCodePickupCodeNotIssued         ErrorCode = "pickup_code_not_issued"
+	ErrCodeProductAlreadyExists        ErrorCode = "product_already_exists"
+	ErrCodeProductNotFound             ErrorCode = "product_not_found"
+	ErrCodeProductNotInStorage         ErrorCode = "product_not_in_storage"
+	ErrCodePvzCapacityExceeded         ErrorCode = "pvz_capacity_exceeded"
+	ErrCodePvzNotFound                 ErrorCode = "pvz_not_found"
+	ErrCodeRateLimited                 ErrorCode = "rate_limited"
+	ErrCodeReceptionNotClosable        ErrorCode = "reception_not_closable"
+	ErrCodeReceptionNotClosed          ErrorCode = "reception_not_closed"
+	ErrCodeReceptionNotFound           ErrorCode = "reception_not_found"
+	ErrCodeReceptionNotInProgress      ErrorCode = "reception_not_in_progress"
+	ErrCodeReceptionNotReopenable      ErrorCode = "reception_not_reopenable"
+	ErrCodeSpecViolation               ErrorCode = "spec_violation"
+	ErrCodeStorageNotExpired           ErrorCode = "storage_not_expired"
+	ErrCodeValidationFailed            ErrorCode = "validation_failed"
+	ErrCodeVersionMismatch             ErrorCode = "version_mismatch"
+	ErrCodeWrongCredentials            ErrorCode = "wrong_credentials"
+	ErrCodeWrongPickupCode             ErrorCode = "wrong_pickup_code"
+)
+
+// Defines values for ExportJobFormat.
+const (
+	ExportJobCsv  ExportJobFormat = "csv"
+	ExportJobXlsx ExportJobFormat = "xlsx"
+)
+
+// Defines values for ExportJobStatus.
+const (
+	ExportJobDone    ExportJobStatus = "done"
+	ExportJobFailed  ExportJobStatus = "failed"
+	ExportJobPending ExportJobStatus = "pending"
+	ExportJobRunning ExportJobStatus = "running"
+)
+
+// Defines values for NewProductType.
+const (
+	NewProductTypeClothing    NewProductType = "одежда"
+	NewProductTypeElectronics NewProductType = "электроника"
+	NewProductTypeFootwear    NewProductType = "обувь"
+)
+
 // Defines values for PVZCity.
 const (
-	Kazan           PVZCity = "Казань"
-	Moscow          PVZCity = "Москва"
-	SaintPetersburg PVZCity = "Санкт-Петербург"
+	PVZCityKazan           PVZCity = "Казань"
+	PVZCityMoscow          PVZCity = "Москва"
+	PVZCitySaintPetersburg PVZCity = "Санкт-Петербург"
+)
+
+// Defines values for ProductStatus.
+const (
+	ProductStatusInStorage ProductStatus = "in_storage"
+	ProductStatusIssued    ProductStatus = "issued"
+	ProductStatusReturned  ProductStatus = "returned"
 )
 
 // Defines values for ProductType.
 const (
-	ProductTypeClothing    ProductType = "одежда"
-	ProductTypeElectronics ProductType = "электроника"
-	ProductTypeFootwear    ProductType = "обувь"
+	Обувь       ProductType = "обувь"
+	Одежда      ProductType = "одежда"
+	Электроника ProductType = "электроника"
+)
+
+// Defines values for ProductStatusChangeStatus.
+const (
+	ProductStatusChangeStatusInStorage ProductStatusChangeStatus = "in_storage"
+	ProductStatusChangeStatusIssued    ProductStatusChangeStatus = "issued"
+	ProductStatusChangeStatusReturned  ProductStatusChangeStatus = "returned"
 )
 
 // Defines values for ReceptionStatus.
 const (
+	Cancelled  ReceptionStatus = "cancelled"
 	Close      ReceptionStatus = "close"
 	InProgress ReceptionStatus = "in_progress"
 )
@@ -40,12 +130,70 @@ const (
 	UserRoleModerator UserRole = "moderator"
 )
 
+// Defines values for GetAnalyticsReceptionsParamsGroupBy.
+const (
+	ReceptionStatsByCity GetAnalyticsReceptionsParamsGroupBy = "city"
+	ReceptionStatsByPvz  GetAnalyticsReceptionsParamsGroupBy = "pvz"
+)
+
+// Defines values for GetAnalyticsThroughputParamsGroupBy.
+const (
+	ThroughputByCity        GetAnalyticsThroughputParamsGroupBy = "city"
+	ThroughputByProductType GetAnalyticsThroughputParamsGroupBy = "productType"
+	ThroughputByPvz         GetAnalyticsThroughputParamsGroupBy = "pvz"
+)
+
+// Defines values for GetAnalyticsThroughputParamsPeriod.
+const (
+	ThroughputDay  GetAnalyticsThroughputParamsPeriod = "day"
+	ThroughputWeek GetAnalyticsThroughputParamsPeriod = "week"
+)
+
 // Defines values for PostDummyLoginJSONBodyRole.
 const (
 	PostDummyLoginJSONBodyRoleEmployee  PostDummyLoginJSONBodyRole = "employee"
 	PostDummyLoginJSONBodyRoleModerator PostDummyLoginJSONBodyRole = "moderator"
 )
 
+// Defines values for GetExportsReceptionsParamsFormat.
+const (
+	ExportCsv  GetExportsReceptionsParamsFormat = "csv"
+	ExportXlsx GetExportsReceptionsParamsFormat = "xlsx"
+)
+
+// Defines values for GetExportsReceptionsParamsCity.
+const (
+	ExportKazan           GetExportsReceptionsParamsCity = "Казань"
+	ExportMoscow          GetExportsReceptionsParamsCity = "Москва"
+	ExportSaintPetersburg GetExportsReceptionsParamsCity = "Санкт-Петербург"
+)
+
+// Defines values for GetExportsReceptionsParamsReceptionStatus.
+const (
+	ExportCancelled  GetExportsReceptionsParamsReceptionStatus = "cancelled"
+	ExportClose      GetExportsReceptionsParamsReceptionStatus = "close"
+	ExportInProgress GetExportsReceptionsParamsReceptionStatus = "in_progress"
+)
+
+// Defines values for GetExportsReceptionsParamsProductType.
+const (
+	ExportClothing    GetExportsReceptionsParamsProductType = "одежда"
+	ExportElectronics GetExportsReceptionsParamsProductType = "электроника"
+	ExportFootwear    GetExportsReceptionsParamsProductType = "обувь"
+)
+
+// Defines values for GetExportsReceptionsParamsSortBy.
+const (
+	ExportSortByCity             GetExportsReceptionsParamsSortBy = "city"
+	ExportSortByRegistrationDate GetExportsReceptionsParamsSortBy = "registrationDate"
+)
+
+// Defines values for GetExportsReceptionsParamsSortOrder.
+const (
+	ExportSortOrderAsc  GetExportsReceptionsParamsSortOrder = "asc"
+	ExportSortOrderDesc GetExportsReceptionsParamsSortOrder = "desc"
+)
+
 // Defines values for PostProductsJSONBodyType.
 const (
 	PostProductsJSONBodyTypeClothing    PostProductsJSONBodyType = "одежда"
@@ -53,61 +201,314 @@ const (
 	PostProductsJSONBodyTypeFootwear    PostProductsJSONBodyType = "обувь"
 )
 
+// Defines values for GetPvzParamsCity.
+const (
+	GetPvzParamsCityKazan           GetPvzParamsCity = "Казань"
+	GetPvzParamsCityMoscow          GetPvzParamsCity = "Москва"
+	GetPvzParamsCitySaintPetersburg GetPvzParamsCity = "Санкт-Петербург"
+)
+
+// Defines values for GetPvzParamsReceptionStatus.
+const (
+	FilterCancelled  GetPvzParamsReceptionStatus = "cancelled"
+	FilterClose      GetPvzParamsReceptionStatus = "close"
+	FilterInProgress GetPvzParamsReceptionStatus = "in_progress"
+)
+
+// Defines values for GetPvzParamsProductType.
+const (
+	Clothing    GetPvzParamsProductType = "одежда"
+	Electronics GetPvzParamsProductType = "электроника"
+	Footwear    GetPvzParamsProductType = "обувь"
+)
+
+// Defines values for GetPvzParamsSortBy.
+const (
+	SortByCity             GetPvzParamsSortBy = "city"
+	SortByRegistrationDate GetPvzParamsSortBy = "registrationDate"
+)
+
+// Defines values for GetPvzParamsSortOrder.
+const (
+	SortOrderAsc  GetPvzParamsSortOrder = "asc"
+	SortOrderDesc GetPvzParamsSortOrder = "desc"
+)
+
 // Defines values for PostRegisterJSONBodyRole.
 const (
 	Employee  PostRegisterJSONBodyRole = "employee"
 	Moderator PostRegisterJSONBodyRole = "moderator"
 )
 
-// Error defines model for Error.
+// BatchItemResult defines model for BatchItemResult.
+type BatchItemResult struct {
+	// Index Позиция товара в запросе
+	Index   int                   `json:"index"`
+	Product *Product              `json:"product,omitempty"`
+	Status  BatchItemResultStatus `json:"status"`
+}
+
+// BatchItemResultStatus defines model for BatchItemResult.Status.
+type BatchItemResultStatus string
+
+// Error Ошибка в формате application/problem+json (RFC 7807). Вид ошибки передается в code, который не меняется между версиями, в отличие от текста detail
 type Error struct {
-	Message string `json:"message"`
+	Code   ErrorCode `json:"code"`
+	Detail string    `json:"detail"`
+
+	// Errors Ошибки валидации отдельных полей тела запроса
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Instance Путь запроса
+	Instance *string `json:"instance,omitempty"`
+
+	// Message Устарело, то же, что detail. Оставлено для клиентов, которые еще не перешли на code
+	Message   string  `json:"message"`
+	RequestId *string `json:"requestId,omitempty"`
+	Status    int     `json:"status"`
+
+	// Title Текст HTTP статуса ответа
+	Title string `json:"title"`
+
+	// Type Всегда about:blank
+	Type string `json:"type"`
+}
+
+// ErrorCode defines model for ErrorCode.
+type ErrorCode string
+
+// ExportJob defines model for ExportJob.
+type ExportJob struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DownloadUrl Ссылка на файл выгрузки, только для завершенных задач
+	DownloadUrl *string            `json:"downloadUrl,omitempty"`
+	Error       *string            `json:"error,omitempty"`
+	FinishedAt  *time.Time         `json:"finishedAt,omitempty"`
+	Format      ExportJobFormat    `json:"format"`
+	Id          openapi_types.UUID `json:"id"`
+	Status      ExportJobStatus    `json:"status"`
+}
+
+// ExportJobFormat defines model for ExportJob.Format.
+type ExportJobFormat string
+
+// ExportJobStatus defines model for ExportJob.Status.
+type ExportJobStatus string
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Путь к полю в теле запроса, например items[0].barcode
+	Field string `json:"field"`
+
+	// Param Параметр правила, например допустимые значения для oneof
+	Param *string `json:"param,omitempty"`
+
+	// Rule Нарушенное правило валидации, например required или oneof
+	Rule string `json:"rule"`
+}
+
+// ManifestReport Сверка товаров приемки с ожидаемым списком
+type ManifestReport struct {
+	// Expected Количество ожидаемых штрихкодов
+	Expected int `json:"expected"`
+
+	// Missing Ожидались, но не были приняты
+	Missing []string `json:"missing"`
+
+	// Unexpected Приняты, но не ожидались
+	Unexpected []string `json:"unexpected"`
+}
+
+// NewProduct Товар для пакетного добавления в приемку
+type NewProduct struct {
+	// Barcode Штрихкод или трек-номер посылки
+	Barcode *string `json:"barcode,omitempty" validate:"omitempty,min=1,max=64,printascii"`
+
+	// Dimensions Габариты в миллиметрах
+	Dimensions *ProductDimensions `json:"dimensions,omitempty"`
+	Type       NewProductType     `json:"type" validate:"required,oneof=электроника одежда обувь"`
+
+	// Weight Вес в граммах
+	Weight *int `json:"weight,omitempty" validate:"omitempty,gt=0"`
 }
 
+// NewProductType defines model for NewProduct.Type.
+type NewProductType string
+
 // PVZ defines model for PVZ.
 type PVZ struct {
 	City             PVZCity             `json:"city" validate:"required,oneof=Москва Санкт-Петербург Казань"`
 	Id               *openapi_types.UUID `json:"id,omitempty" validate:"omitempty,oapi_uuid"`
 	RegistrationDate *time.Time          `json:"registrationDate,omitempty" validate:"omitempty,datetime"`
+
+	// Version Версия ПВЗ, совпадает со значением заголовка ETag
+	Version *int `json:"version,omitempty"`
 }
 
 // PVZCity defines model for PVZ.City.
 type PVZCity string
 
+// PickupCode Код выдачи товара, показывается только один раз
+type PickupCode struct {
+	PickupCode string             `json:"pickupCode"`
+	ProductId  openapi_types.UUID `json:"productId"`
+}
+
 // Product defines model for Product.
 type Product struct {
-	DateTime    *time.Time          `json:"dateTime,omitempty" validate:"omitempty,datetime"`
+	// Barcode Штрихкод или трек-номер посылки
+	Barcode  *string    `json:"barcode,omitempty" validate:"omitempty,min=1,max=64,printascii"`
+	DateTime *time.Time `json:"dateTime,omitempty" validate:"omitempty,datetime"`
+
+	// Dimensions Габариты в миллиметрах
+	Dimensions  *ProductDimensions  `json:"dimensions,omitempty"`
 	Id          *openapi_types.UUID `json:"id,omitempty" validate:"omitempty,oapi_uuid"`
 	ReceptionId openapi_types.UUID  `json:"receptionId" validate:"required,oapi_uuid"`
-	Type        ProductType         `json:"type" validate:"required,oneof=электроника одежда обувь"`
+
+	// Status Статус товара в ПВЗ
+	Status *ProductStatus `json:"status,omitempty"`
+	Type   ProductType    `json:"type" validate:"required,oneof=электроника одежда обувь"`
+
+	// Weight Вес в граммах
+	Weight *int `json:"weight,omitempty" validate:"omitempty,gt=0"`
 }
 
+// ProductStatus Статус товара в ПВЗ
+type ProductStatus string
+
 // ProductType defines model for Product.Type.
 type ProductType string
 
+// ProductDeletion Запись истории удалений товаров из приемки
+type ProductDeletion struct {
+	DeletedAt time.Time `json:"deletedAt"`
+
+	// DeletedBy Сотрудник, удаливший товар
+	DeletedBy *openapi_types.UUID `json:"deletedBy,omitempty"`
+	Id        openapi_types.UUID  `json:"id"`
+	Product   Product             `json:"product"`
+
+	// RestoredAt Время отмены удаления, если товар был восстановлен
+	RestoredAt *time.Time `json:"restoredAt,omitempty"`
+}
+
+// ProductDimensions Габариты в миллиметрах
+type ProductDimensions struct {
+	Height int `json:"height" validate:"required,gt=0"`
+	Length int `json:"length" validate:"required,gt=0"`
+	Width  int `json:"width" validate:"required,gt=0"`
+}
+
+// ProductInfo defines model for ProductInfo.
+type ProductInfo struct {
+	Product   Product   `json:"product"`
+	Pvz       PVZ       `json:"pvz"`
+	Reception Reception `json:"reception"`
+}
+
+// ProductStatusChange Запись истории статусов товара
+type ProductStatusChange struct {
+	ChangedAt time.Time `json:"changedAt"`
+
+	// ChangedBy Сотрудник, изменивший статус
+	ChangedBy *openapi_types.UUID       `json:"changedBy,omitempty"`
+	Status    ProductStatusChangeStatus `json:"status"`
+}
+
+// ProductStatusChangeStatus defines model for ProductStatusChange.Status.
+type ProductStatusChangeStatus string
+
+// ProductsBatchResult defines model for ProductsBatchResult.
+type ProductsBatchResult struct {
+	// Added Количество добавленных товаров
+	Added int               `json:"added"`
+	Items []BatchItemResult `json:"items"`
+}
+
 // PvzReceptions defines model for PvzReceptions.
 type PvzReceptions struct {
 	Pvz        *PVZ                 `json:"pvz,omitempty"`
 	Receptions *[]ReceptionProducts `json:"receptions,omitempty"`
 }
 
+// PvzStock Заполненность ПВЗ
+type PvzStock struct {
+	// Capacity Вместимость ПВЗ, отсутствует если не ограничена
+	Capacity *int `json:"capacity,omitempty"`
+
+	// Free Свободных мест, отсутствует если вместимость не ограничена
+	Free *int `json:"free,omitempty"`
+
+	// OnHand Товаров на хранении, не выданных и не возвращенных
+	OnHand int                `json:"onHand"`
+	PvzId  openapi_types.UUID `json:"pvzId"`
+}
+
 // Reception defines model for Reception.
 type Reception struct {
+	// AutoClosed Приемка была закрыта автоматически из-за простоя
+	AutoClosed *bool `json:"autoClosed,omitempty"`
+
+	// ClosedAt Время закрытия приемки
+	ClosedAt *time.Time          `json:"closedAt,omitempty"`
 	DateTime time.Time           `json:"dateTime" validate:"required,datetime"`
 	Id       *openapi_types.UUID `json:"id,omitempty" validate:"omitempty,oapi_uuid"`
 	PvzId    openapi_types.UUID  `json:"pvzId" validate:"required,oapi_uuid"`
-	Status   ReceptionStatus     `json:"status" validate:"required,oneof=in_progress close"`
+	Status   ReceptionStatus     `json:"status" validate:"required,oneof=in_progress close cancelled"`
+
+	// Version Версия приемки, увеличивается при каждом изменении статуса или ожидаемого списка
+	Version *int `json:"version,omitempty"`
 }
 
 // ReceptionStatus defines model for Reception.Status.
 type ReceptionStatus string
 
+// ReceptionCloseReport defines model for ReceptionCloseReport.
+type ReceptionCloseReport struct {
+	// Manifest Сверка товаров приемки с ожидаемым списком
+	Manifest  *ManifestReport `json:"manifest,omitempty"`
+	Reception Reception       `json:"reception"`
+}
+
+// ReceptionManifest Список штрихкодов, которые ожидаются в приемке
+type ReceptionManifest struct {
+	Barcodes    []string           `json:"barcodes"`
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+
+	// Version Версия приемки после сохранения списка
+	Version *int `json:"version,omitempty"`
+}
+
 // ReceptionProducts defines model for ReceptionProducts.
 type ReceptionProducts struct {
 	Products  *[]Product `json:"products,omitempty"`
 	Reception *Reception `json:"reception,omitempty"`
 }
 
+// ReceptionStats defines model for ReceptionStats.
+type ReceptionStats struct {
+	// AvgDurationSeconds Среднее время от открытия до закрытия приемки
+	AvgDurationSeconds      float64 `json:"avgDurationSeconds"`
+	AvgProductsPerReception float64 `json:"avgProductsPerReception"`
+
+	// Key Идентификатор ПВЗ или город
+	Key string `json:"key"`
+
+	// Receptions Количество закрытых приемок
+	Receptions int `json:"receptions"`
+}
+
+// ThroughputPoint defines model for ThroughputPoint.
+type ThroughputPoint struct {
+	// Key Идентификатор ПВЗ, город или тип товара
+	Key string `json:"key"`
+
+	// PeriodStart Начало дня или недели (с понедельника) в UTC
+	PeriodStart time.Time `json:"periodStart"`
+	Products    int       `json:"products"`
+}
+
 // Token defines model for Token.
 type Token struct {
 	Jwt string `json:"jwt"`
@@ -123,6 +524,63 @@ type User struct {
 // UserRole defines model for User.Role.
 type UserRole string
 
+// CSRFToken defines model for CSRFToken.
+type CSRFToken = string
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// Conflict Ошибка в формате application/problem+json (RFC 7807). Вид ошибки передается в code, который не меняется между версиями, в отличие от текста detail
+type Conflict = Error
+
+// TooManyRequests Ошибка в формате application/problem+json (RFC 7807). Вид ошибки передается в code, который не меняется между версиями, в отличие от текста detail
+type TooManyRequests = Error
+
+// UnprocessableEntity Ошибка в формате application/problem+json (RFC 7807). Вид ошибки передается в code, который не меняется между версиями, в отличие от текста detail
+type UnprocessableEntity = Error
+
+// GetAnalyticsReceptionsParams defines parameters for GetAnalyticsReceptions.
+type GetAnalyticsReceptionsParams struct {
+	// From Начало периода (включительно), по умолчанию за 30 дней до to
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (не включительно), по умолчанию текущее время. Период не длиннее 366 дней
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// GroupBy Группировка
+	GroupBy *GetAnalyticsReceptionsParamsGroupBy `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+}
+
+// GetAnalyticsReceptionsParamsGroupBy defines parameters for GetAnalyticsReceptions.
+type GetAnalyticsReceptionsParamsGroupBy string
+
+// GetAnalyticsThroughputParams defines parameters for GetAnalyticsThroughput.
+type GetAnalyticsThroughputParams struct {
+	// From Начало периода (включительно), по умолчанию за 30 дней до to
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (не включительно), по умолчанию текущее время. Период не длиннее 366 дней
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// GroupBy Группировка
+	GroupBy *GetAnalyticsThroughputParamsGroupBy `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+
+	// Period Длина периода
+	Period *GetAnalyticsThroughputParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+}
+
+// GetAnalyticsThroughputParamsGroupBy defines parameters for GetAnalyticsThroughput.
+type GetAnalyticsThroughputParamsGroupBy string
+
+// GetAnalyticsThroughputParamsPeriod defines parameters for GetAnalyticsThroughput.
+type GetAnalyticsThroughputParamsPeriod string
+
 // PostDummyLoginJSONBody defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBody struct {
 	Role PostDummyLoginJSONBodyRole `json:"role" validate:"required,oneof=employee moderator"`
@@ -131,6 +589,63 @@ type PostDummyLoginJSONBody struct {
 // PostDummyLoginJSONBodyRole defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBodyRole string
 
+// GetExportsReceptionsParams defines parameters for GetExportsReceptions.
+type GetExportsReceptionsParams struct {
+	// Format Формат файла
+	Format GetExportsReceptionsParamsFormat `form:"format" json:"format"`
+
+	// Async Выполнить выгрузку в фоне и вернуть задачу вместо файла
+	Async *bool `form:"async,omitempty" json:"async,omitempty"`
+
+	// City Города ПВЗ
+	City *[]GetExportsReceptionsParamsCity `form:"city,omitempty" json:"city,omitempty"`
+
+	// RegisteredFrom Начальная дата регистрации ПВЗ
+	RegisteredFrom *time.Time `form:"registeredFrom,omitempty" json:"registeredFrom,omitempty"`
+
+	// RegisteredTo Конечная дата регистрации ПВЗ
+	RegisteredTo *time.Time `form:"registeredTo,omitempty" json:"registeredTo,omitempty"`
+
+	// ReceptionStatus Статусы приемок
+	ReceptionStatus *[]GetExportsReceptionsParamsReceptionStatus `form:"receptionStatus,omitempty" json:"receptionStatus,omitempty"`
+
+	// ProductType Типы товаров в приемке
+	ProductType *[]GetExportsReceptionsParamsProductType `form:"productType,omitempty" json:"productType,omitempty"`
+
+	// HasOpenReception Только ПВЗ с открытой приемкой (true) или без нее (false)
+	HasOpenReception *bool `form:"hasOpenReception,omitempty" json:"hasOpenReception,omitempty"`
+
+	// SortBy Поле сортировки
+	SortBy *GetExportsReceptionsParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// SortOrder Направление сортировки
+	SortOrder *GetExportsReceptionsParamsSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+
+	// StartDate Начальная дата диапазона приемок
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона приемок
+	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
+}
+
+// GetExportsReceptionsParamsFormat defines parameters for GetExportsReceptions.
+type GetExportsReceptionsParamsFormat string
+
+// GetExportsReceptionsParamsCity defines parameters for GetExportsReceptions.
+type GetExportsReceptionsParamsCity string
+
+// GetExportsReceptionsParamsReceptionStatus defines parameters for GetExportsReceptions.
+type GetExportsReceptionsParamsReceptionStatus string
+
+// GetExportsReceptionsParamsProductType defines parameters for GetExportsReceptions.
+type GetExportsReceptionsParamsProductType string
+
+// GetExportsReceptionsParamsSortBy defines parameters for GetExportsReceptions.
+type GetExportsReceptionsParamsSortBy string
+
+// GetExportsReceptionsParamsSortOrder defines parameters for GetExportsReceptions.
+type GetExportsReceptionsParamsSortOrder string
+
 // PostLoginJSONBody defines parameters for PostLogin.
 type PostLoginJSONBody struct {
 	Email    openapi_types.Email `json:"email" validate:"required,email"`
@@ -139,26 +654,165 @@ type PostLoginJSONBody struct {
 
 // PostProductsJSONBody defines parameters for PostProducts.
 type PostProductsJSONBody struct {
-	PvzId openapi_types.UUID       `json:"pvzId" validate:"required,oapi_uuid"`
-	Type  PostProductsJSONBodyType `json:"type" validate:"required,oneof=электроника одежда обувь"`
+	// Barcode Штрихкод или трек-номер посылки
+	Barcode *string `json:"barcode,omitempty" validate:"omitempty,min=1,max=64,printascii"`
+
+	// Dimensions Габариты в миллиметрах
+	Dimensions *ProductDimensions       `json:"dimensions,omitempty"`
+	PvzId      openapi_types.UUID       `json:"pvzId" validate:"required,oapi_uuid"`
+	Type       PostProductsJSONBodyType `json:"type" validate:"required,oneof=электроника одежда обувь"`
+
+	// Weight Вес в граммах
+	Weight *int `json:"weight,omitempty" validate:"omitempty,gt=0"`
+}
+
+// PostProductsParams defines parameters for PostProducts.
+type PostProductsParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает сохраненный ответ первого запроса с заголовком Idempotent-Replayed. Ключ хранится для пользователя (по умолчанию 24 часа), повтор ключа с другим запросом или во время обработки первого запроса возвращает 409
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
 // PostProductsJSONBodyType defines parameters for PostProducts.
 type PostProductsJSONBodyType string
 
+// PostProductsBatchJSONBody defines parameters for PostProductsBatch.
+type PostProductsBatchJSONBody struct {
+	Atomic   *bool              `json:"atomic,omitempty"`
+	Products []NewProduct       `json:"products" validate:"required,min=1,max=1000,dive"`
+	PvzId    openapi_types.UUID `json:"pvzId" validate:"required,oapi_uuid"`
+}
+
+// PostProductsBatchParams defines parameters for PostProductsBatch.
+type PostProductsBatchParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает сохраненный ответ первого запроса с заголовком Idempotent-Replayed. Ключ хранится для пользователя (по умолчанию 24 часа), повтор ключа с другим запросом или во время обработки первого запроса возвращает 409
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostProductsProductIdIssueJSONBody defines parameters for PostProductsProductIdIssue.
+type PostProductsProductIdIssueJSONBody struct {
+	PickupCode string `json:"pickupCode" validate:"required,len=6,numeric"`
+}
+
+// PostProductsProductIdIssueParams defines parameters for PostProductsProductIdIssue.
+type PostProductsProductIdIssueParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает сохраненный ответ первого запроса с заголовком Idempotent-Replayed. Ключ хранится для пользователя (по умолчанию 24 часа), повтор ключа с другим запросом или во время обработки первого запроса возвращает 409
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostProductsProductIdRestoreParams defines parameters for PostProductsProductIdRestore.
+type PostProductsProductIdRestoreParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает сохраненный ответ первого запроса с заголовком Idempotent-Replayed. Ключ хранится для пользователя (по умолчанию 24 часа), повтор ключа с другим запросом или во время обработки первого запроса возвращает 409
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostProductsProductIdReturnParams defines parameters for PostProductsProductIdReturn.
+type PostProductsProductIdReturnParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает сохраненный ответ первого запроса с заголовком Idempotent-Replayed. Ключ хранится для пользователя (по умолчанию 24 часа), повтор ключа с другим запросом или во время обработки первого запроса возвращает 409
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // GetPvzParams defines parameters for GetPvz.
 type GetPvzParams struct {
-	// StartDate Начальная дата диапазона
+	// City Города ПВЗ
+	City *[]GetPvzParamsCity `form:"city,omitempty" json:"city,omitempty"`
+
+	// RegisteredFrom Начальная дата регистрации ПВЗ
+	RegisteredFrom *time.Time `form:"registeredFrom,omitempty" json:"registeredFrom,omitempty"`
+
+	// RegisteredTo Конечная дата регистрации ПВЗ
+	RegisteredTo *time.Time `form:"registeredTo,omitempty" json:"registeredTo,omitempty"`
+
+	// ReceptionStatus Статусы приемок
+	ReceptionStatus *[]GetPvzParamsReceptionStatus `form:"receptionStatus,omitempty" json:"receptionStatus,omitempty"`
+
+	// ProductType Типы товаров в приемке
+	ProductType *[]GetPvzParamsProductType `form:"productType,omitempty" json:"productType,omitempty"`
+
+	// HasOpenReception Только ПВЗ с открытой приемкой (true) или без нее (false)
+	HasOpenReception *bool `form:"hasOpenReception,omitempty" json:"hasOpenReception,omitempty"`
+
+	// SortBy Поле сортировки
+	SortBy *GetPvzParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// SortOrder Направление сортировки
+	SortOrder *GetPvzParamsSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+
+	// StartDate Начальная дата диапазона приемок
 	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
 
-	// EndDate Конечная дата диапазона
+	// EndDate Конечная дата диапазона приемок
 	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
 
 	// Page Номер страницы
 	Page *int `form:"page,omitempty" json:"page,omitempty"`
 
-	// Limit Количество элементов на странице
+	// Limit Количество элементов на странице, не более 30 для application/json
+	// и не более 1000 для application/x-ndjson
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор из заголовка X-Next-Cursor предыдущего ответа, при его наличии page игнорируется.
+	// Фильтры и сортировка должны совпадать с запросом, вернувшим курсор
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// WithTotal Вернуть общее количество ПВЗ, подходящих под фильтр, в заголовке X-Total-Count
+	WithTotal *bool `form:"withTotal,omitempty" json:"withTotal,omitempty"`
+}
+
+// GetPvzParamsCity defines parameters for GetPvz.
+type GetPvzParamsCity string
+
+// GetPvzParamsReceptionStatus defines parameters for GetPvz.
+type GetPvzParamsReceptionStatus string
+
+// GetPvzParamsProductType defines parameters for GetPvz.
+type GetPvzParamsProductType string
+
+// GetPvzParamsSortBy defines parameters for GetPvz.
+type GetPvzParamsSortBy string
+
+// GetPvzParamsSortOrder defines parameters for GetPvz.
+type GetPvzParamsSortOrder string
+
+// PostPvzParams defines parameters for PostPvz.
+type PostPvzParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает сохраненный ответ первого запроса с заголовком Idempotent-Replayed. Ключ хранится для пользователя (по умолчанию 24 часа), повтор ключа с другим запросом или во время обработки первого запроса возвращает 409
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetPvzPvzIdParams defines parameters for GetPvzPvzId.
+type GetPvzPvzIdParams struct {
+	// IfNoneMatch Значение ETag, полученное ранее. Если ресурс не изменился, возвращается 304 без тела
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// PutPvzPvzIdParams defines parameters for PutPvzPvzId.
+type PutPvzPvzIdParams struct {
+	// IfMatch Значение ETag приемки, полученное ранее. Если приемка с тех пор изменилась, запрос не выполняется и возвращается 412. Без заголовка или со значением * проверка не выполняется
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostPvzPvzIdCancelLastReceptionParams defines parameters for PostPvzPvzIdCancelLastReception.
+type PostPvzPvzIdCancelLastReceptionParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает сохраненный ответ первого запроса с заголовком Idempotent-Replayed. Ключ хранится для пользователя (по умолчанию 24 часа), повтор ключа с другим запросом или во время обработки первого запроса возвращает 409
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch Значение ETag приемки, полученное ранее. Если приемка с тех пор изменилась, запрос не выполняется и возвращается 412. Без заголовка или со значением * проверка не выполняется
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostPvzPvzIdCloseLastReceptionParams defines parameters for PostPvzPvzIdCloseLastReception.
+type PostPvzPvzIdCloseLastReceptionParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает сохраненный ответ первого запроса с заголовком Idempotent-Replayed. Ключ хранится для пользователя (по умолчанию 24 часа), повтор ключа с другим запросом или во время обработки первого запроса возвращает 409
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch Значение ETag приемки, полученное ранее. Если приемка с тех пор изменилась, запрос не выполняется и возвращается 412. Без заголовка или со значением * проверка не выполняется
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostPvzPvzIdDeleteLastProductParams defines parameters for PostPvzPvzIdDeleteLastProduct.
+type PostPvzPvzIdDeleteLastProductParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает сохраненный ответ первого запроса с заголовком Idempotent-Replayed. Ключ хранится для пользователя (по умолчанию 24 часа), повтор ключа с другим запросом или во время обработки первого запроса возвращает 409
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
 // PostReceptionsJSONBody defines parameters for PostReceptions.
@@ -166,6 +820,38 @@ type PostReceptionsJSONBody struct {
 	PvzId openapi_types.UUID `json:"pvzId" validate:"required,oapi_uuid"`
 }
 
+// PostReceptionsParams defines parameters for PostReceptions.
+type PostReceptionsParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает сохраненный ответ первого запроса с заголовком Idempotent-Replayed. Ключ хранится для пользователя (по умолчанию 24 часа), повтор ключа с другим запросом или во время обработки первого запроса возвращает 409
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetReceptionsReceptionIdParams defines parameters for GetReceptionsReceptionId.
+type GetReceptionsReceptionIdParams struct {
+	// IfNoneMatch Значение ETag, полученное ранее. Если ресурс не изменился, возвращается 304 без тела
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// PutReceptionsReceptionIdManifestJSONBody defines parameters for PutReceptionsReceptionIdManifest.
+type PutReceptionsReceptionIdManifestJSONBody struct {
+	Barcodes []string `json:"barcodes" validate:"required,max=5000,dive,min=1,max=64,printascii"`
+}
+
+// PutReceptionsReceptionIdManifestParams defines parameters for PutReceptionsReceptionIdManifest.
+type PutReceptionsReceptionIdManifestParams struct {
+	// IfMatch Значение ETag приемки, полученное ранее. Если приемка с тех пор изменилась, запрос не выполняется и возвращается 412. Без заголовка или со значением * проверка не выполняется
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostReceptionsReceptionIdReopenParams defines parameters for PostReceptionsReceptionIdReopen.
+type PostReceptionsReceptionIdReopenParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает сохраненный ответ первого запроса с заголовком Idempotent-Replayed. Ключ хранится для пользователя (по умолчанию 24 часа), повтор ключа с другим запросом или во время обработки первого запроса возвращает 409
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch Значение ETag приемки, полученное ранее. Если приемка с тех пор изменилась, запрос не выполняется и возвращается 412. Без заголовка или со значением * проверка не выполняется
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // PostRegisterJSONBody defines parameters for PostRegister.
 type PostRegisterJSONBody struct {
 	Email    openapi_types.Email      `json:"email" validate:"required,email"`
@@ -176,6 +862,12 @@ type PostRegisterJSONBody struct {
 // PostRegisterJSONBodyRole defines parameters for PostRegister.
 type PostRegisterJSONBodyRole string
 
+// PostTokensRefreshParams defines parameters for PostTokensRefresh.
+type PostTokensRefreshParams struct {
+	// XCSRFToken CSRF токен из cookie csrf_token или заголовка X-CSRF-Token ответа, выдавшего refresh токен. Запросы с refresh токеном в cookie без совпадающего CSRF токена отклоняются с 403
+	XCSRFToken *CSRFToken `json:"X-CSRF-Token,omitempty"`
+}
+
 // PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
 type PostDummyLoginJSONRequestBody PostDummyLoginJSONBody
 
@@ -185,11 +877,23 @@ type PostLoginJSONRequestBody PostLoginJSONBody
 // PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
 type PostProductsJSONRequestBody PostProductsJSONBody
 
+// PostProductsBatchJSONRequestBody defines body for PostProductsBatch for application/json ContentType.
+type PostProductsBatchJSONRequestBody PostProductsBatchJSONBody
+
+// PostProductsProductIdIssueJSONRequestBody defines body for PostProductsProductIdIssue for application/json ContentType.
+type PostProductsProductIdIssueJSONRequestBody PostProductsProductIdIssueJSONBody
+
 // PostPvzJSONRequestBody defines body for PostPvz for application/json ContentType.
 type PostPvzJSONRequestBody = PVZ
 
+// PutPvzPvzIdJSONRequestBody defines body for PutPvzPvzId for application/json ContentType.
+type PutPvzPvzIdJSONRequestBody = PVZ
+
 // PostReceptionsJSONRequestBody defines body for PostReceptions for application/json ContentType.
 type PostReceptionsJSONRequestBody PostReceptionsJSONBody
 
+// PutReceptionsReceptionIdManifestJSONRequestBody defines body for PutReceptionsReceptionIdManifest for application/json ContentType.
+type PutReceptionsReceptionIdManifestJSONRequestBody PutReceptionsReceptionIdManifestJSONBody
+
 // PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
 type PostRegisterJSONRequestBody PostRegisterJSONBody
@@ -1,15 +1,25 @@
 package http
 
 import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime/types"
 	"github.com/shrtyk/pvz-service/internal/api/http/dto"
 	"github.com/shrtyk/pvz-service/internal/core/domain"
 	"github.com/shrtyk/pvz-service/internal/core/domain/auth"
+	"github.com/shrtyk/pvz-service/pkg/requestid"
 )
 
 const (
 	defaultPage  = 1
 	defaultLimit = 10
+	maxLimit     = 30
+	// maxStreamLimit bounds the page size of NDJSON responses, which are not buffered.
+	maxStreamLimit = 1000
 )
 
 func toDomainPVZ(dtoPvz *dto.PVZ) *domain.Pvz {
@@ -31,7 +41,25 @@ func toDTOPVZ(domainPvz *domain.Pvz) *dto.PVZ {
 		Id:               &domainPvz.Id,
 		RegistrationDate: &domainPvz.RegistrationDate,
 		City:             dto.PVZCity(domainPvz.City),
+		Version:          &domainPvz.Version,
+	}
+}
+
+func toDTOPvzStock(stock *domain.PvzStock) *dto.PvzStock {
+	if stock == nil {
+		return nil
 	}
+
+	dt := &dto.PvzStock{
+		PvzId:  stock.PvzId,
+		OnHand: stock.OnHand,
+	}
+	if stock.Capacity > 0 {
+		free := max(stock.Capacity-stock.OnHand, 0)
+		dt.Capacity = &stock.Capacity
+		dt.Free = &free
+	}
+	return dt
 }
 
 func toDomainReception(dtoRec *dto.PostReceptionsJSONBody) *domain.Reception {
@@ -50,10 +78,58 @@ func toDTOReception(domainRec *domain.Reception) *dto.Reception {
 	}
 
 	return &dto.Reception{
-		Id:       &domainRec.Id,
-		PvzId:    domainRec.PvzId,
-		Status:   dto.ReceptionStatus(domainRec.Status),
-		DateTime: domainRec.DateTime,
+		Id:         &domainRec.Id,
+		PvzId:      domainRec.PvzId,
+		Status:     dto.ReceptionStatus(domainRec.Status),
+		DateTime:   domainRec.DateTime,
+		ClosedAt:   domainRec.ClosedAt,
+		AutoClosed: &domainRec.AutoClosed,
+		Version:    &domainRec.Version,
+	}
+}
+
+func toDTOReceptionCloseReport(report *domain.ReceptionCloseReport) *dto.ReceptionCloseReport {
+	if report == nil {
+		return nil
+	}
+
+	res := &dto.ReceptionCloseReport{
+		Reception: *toDTOReception(report.Reception),
+	}
+	if m := report.Manifest; m != nil {
+		res.Manifest = &dto.ManifestReport{
+			Expected:   m.Expected,
+			Missing:    m.Missing,
+			Unexpected: m.Unexpected,
+		}
+	}
+
+	return res
+}
+
+func toDomainReceptionManifest(
+	receptionId *uuid.UUID,
+	dtoManifest *dto.PutReceptionsReceptionIdManifestJSONRequestBody,
+) *domain.ReceptionManifest {
+	if receptionId == nil || dtoManifest == nil {
+		return nil
+	}
+
+	return &domain.ReceptionManifest{
+		ReceptionId: *receptionId,
+		Barcodes:    dtoManifest.Barcodes,
+	}
+}
+
+func toDTOReceptionManifest(manifest *domain.ReceptionManifest) *dto.ReceptionManifest {
+	if manifest == nil {
+		return nil
+	}
+
+	return &dto.ReceptionManifest{
+		ReceptionId: manifest.ReceptionId,
+		Barcodes:    manifest.Barcodes,
+		Version:     &manifest.Version,
 	}
 }
 
@@ -63,8 +139,81 @@ func toDomainProduct(dtoProd *dto.PostProductsJSONRequestBody) *domain.Product {
 	}
 
 	return &domain.Product{
-		PvzId: dtoProd.PvzId,
-		Type:  domain.ProductType(dtoProd.Type),
+		PvzId:       dtoProd.PvzId,
+		Type:        domain.ProductType(dtoProd.Type),
+		Barcode:     dtoProd.Barcode,
+		WeightGrams: dtoProd.Weight,
+		Dimensions:  toDomainProductDimensions(dtoProd.Dimensions),
+	}
+}
+
+func toDomainProductsBatch(dtoBatch *dto.PostProductsBatchJSONRequestBody) *domain.ProductsBatch {
+	if dtoBatch == nil {
+		return nil
+	}
+
+	batch := &domain.ProductsBatch{
+		PvzId:    dtoBatch.PvzId,
+		Products: make([]*domain.Product, 0, len(dtoBatch.Products)),
+	}
+	if dtoBatch.Atomic != nil {
+		batch.Atomic = *dtoBatch.Atomic
+	}
+
+	for _, p := range dtoBatch.Products {
+		batch.Products = append(batch.Products, &domain.Product{
+			PvzId:       dtoBatch.PvzId,
+			Type:        domain.ProductType(p.Type),
+			Barcode:     p.Barcode,
+			WeightGrams: p.Weight,
+			Dimensions:  toDomainProductDimensions(p.Dimensions),
+		})
+	}
+
+	return batch
+}
+
+func toDTOProductsBatchResult(res *domain.ProductsBatchResult) *dto.ProductsBatchResult {
+	if res == nil {
+		return nil
+	}
+
+	items := make([]dto.BatchItemResult, 0, len(res.Items))
+	for i, item := range res.Items {
+		items = append(items, dto.BatchItemResult{
+			Index:   i,
+			Status:  dto.BatchItemResultStatus(item.Status),
+			Product: toDTOProduct(item.Product),
+		})
+	}
+
+	return &dto.ProductsBatchResult{
+		Added: res.Added,
+		Items: items,
+	}
+}
+
+func toDomainProductDimensions(dtoDims *dto.ProductDimensions) *domain.ProductDimensions {
+	if dtoDims == nil {
+		return nil
+	}
+
+	return &domain.ProductDimensions{
+		LengthMm: dtoDims.Length,
+		WidthMm:  dtoDims.Width,
+		HeightMm: dtoDims.Height,
+	}
+}
+
+func toDTOProductDimensions(domainDims *domain.ProductDimensions) *dto.ProductDimensions {
+	if domainDims == nil {
+		return nil
+	}
+
+	return &dto.ProductDimensions{
+		Length: domainDims.LengthMm,
+		Width:  domainDims.WidthMm,
+		Height: domainDims.HeightMm,
 	}
 }
 
@@ -73,12 +222,153 @@ func toDTOProduct(domainProd *domain.Product) *dto.Product {
 		return nil
 	}
 
-	return &dto.Product{
+	dt := &dto.Product{
 		Id:          &domainProd.Id,
 		ReceptionId: domainProd.ReceptionId,
 		DateTime:    &domainProd.DateTime,
 		Type:        dto.ProductType(domainProd.Type),
+		Barcode:     domainProd.Barcode,
+		Weight:      domainProd.WeightGrams,
+		Dimensions:  toDTOProductDimensions(domainProd.Dimensions),
+	}
+	if domainProd.Status != "" {
+		status := dto.ProductStatus(domainProd.Status)
+		dt.Status = &status
+	}
+	return dt
+}
+
+func toDTOProductStatusHistory(history []*domain.ProductStatusChange) []*dto.ProductStatusChange {
+	res := make([]*dto.ProductStatusChange, len(history))
+	for i, c := range history {
+		res[i] = &dto.ProductStatusChange{
+			Status:    dto.ProductStatusChangeStatus(c.Status),
+			ChangedAt: c.ChangedAt,
+			ChangedBy: c.ChangedBy,
+		}
+	}
+	return res
+}
+
+var throughputGroups = map[dto.GetAnalyticsThroughputParamsGroupBy]domain.AnalyticsGroup{
+	dto.ThroughputByPvz:         domain.AnalyticsByPvz,
+	dto.ThroughputByCity:        domain.AnalyticsByCity,
+	dto.ThroughputByProductType: domain.AnalyticsByProductType,
+}
+
+// toDomainThroughputParams expects params parsed by ThroughputParamsFromURL, so the range is always set.
+func toDomainThroughputParams(params *dto.GetAnalyticsThroughputParams) *domain.ThroughputParams {
+	res := &domain.ThroughputParams{
+		AnalyticsRange: domain.AnalyticsRange{From: *params.From, To: *params.To},
+		Period:         domain.AnalyticsDay,
+		GroupBy:        domain.AnalyticsByPvz,
 	}
+	if params.GroupBy != nil {
+		res.GroupBy = throughputGroups[*params.GroupBy]
+	}
+	if params.Period != nil && *params.Period == dto.ThroughputWeek {
+		res.Period = domain.AnalyticsWeek
+	}
+	return res
+}
+
+func toDomainReceptionStatsParams(params *dto.GetAnalyticsReceptionsParams) *domain.ReceptionStatsParams {
+	res := &domain.ReceptionStatsParams{
+		AnalyticsRange: domain.AnalyticsRange{From: *params.From, To: *params.To},
+		GroupBy:        domain.AnalyticsByPvz,
+	}
+	if params.GroupBy != nil && *params.GroupBy == dto.ReceptionStatsByCity {
+		res.GroupBy = domain.AnalyticsByCity
+	}
+	return res
+}
+
+func toDTOThroughput(points []*domain.ThroughputPoint) []*dto.ThroughputPoint {
+	res := make([]*dto.ThroughputPoint, len(points))
+	for i, p := range points {
+		res[i] = &dto.ThroughputPoint{
+			PeriodStart: p.PeriodStart,
+			Key:         p.Key,
+			Products:    p.Products,
+		}
+	}
+	return res
+}
+
+func toDTOReceptionStats(stats []*domain.ReceptionStats) []*dto.ReceptionStats {
+	res := make([]*dto.ReceptionStats, len(stats))
+	for i, s := range stats {
+		res[i] = &dto.ReceptionStats{
+			Key:                     s.Key,
+			Receptions:              s.Receptions,
+			AvgDurationSeconds:      s.AvgDuration.Seconds(),
+			AvgProductsPerReception: s.AvgProducts,
+		}
+	}
+	return res
+}
+
+func toDTOExportJob(job *domain.ExportJob) *dto.ExportJob {
+	dt := &dto.ExportJob{
+		Id:         job.Id,
+		Format:     dto.ExportJobFormat(job.Format),
+		Status:     dto.ExportJobStatus(job.Status),
+		CreatedAt:  job.CreatedAt,
+		FinishedAt: job.FinishedAt,
+		Error:      job.Error,
+	}
+	if job.Status == domain.ExportJobDone {
+		url := exportJobPath(&job.Id) + "/file"
+		dt.DownloadUrl = &url
+	}
+	return dt
+}
+
+func exportJobPath(jobId *uuid.UUID) string {
+	return apiV1Prefix + "/exports/jobs/" + jobId.String()
+}
+
+func toDTOProductInfo(info *domain.ProductInfo) *dto.ProductInfo {
+	if info == nil {
+		return nil
+	}
+
+	dt := new(dto.ProductInfo)
+	if p := toDTOProduct(info.Product); p != nil {
+		dt.Product = *p
+	}
+	if r := toDTOReception(info.Reception); r != nil {
+		dt.Reception = *r
+	}
+	if pvz := toDTOPVZ(info.Pvz); pvz != nil {
+		dt.Pvz = *pvz
+	}
+	return dt
+}
+
+func toDTOProductDeletion(dm *domain.ProductDeletion) *dto.ProductDeletion {
+	if dm == nil {
+		return nil
+	}
+
+	dt := &dto.ProductDeletion{
+		Id:         dm.Id,
+		DeletedBy:  dm.DeletedBy,
+		DeletedAt:  dm.DeletedAt,
+		RestoredAt: dm.RestoredAt,
+	}
+	if p := toDTOProduct(dm.Product); p != nil {
+		dt.Product = *p
+	}
+	return dt
+}
+
+func toDTOProductDeletions(dd []*domain.ProductDeletion) []*dto.ProductDeletion {
+	res := make([]*dto.ProductDeletion, len(dd))
+	for i, d := range dd {
+		res[i] = toDTOProductDeletion(d)
+	}
+	return res
 }
 
 func toDomainPvzReadParams(dtoParams *dto.GetPvzParams) *domain.PvzsReadParams {
@@ -91,7 +381,7 @@ func toDomainPvzReadParams(dtoParams *dto.GetPvzParams) *domain.PvzsReadParams {
 		return domainParams
 	}
 
-	if dtoParams.Limit != nil && *dtoParams.Limit >= 1 && *dtoParams.Limit <= 30 {
+	if dtoParams.Limit != nil && *dtoParams.Limit >= 1 && *dtoParams.Limit <= maxLimit {
 		domainParams.Limit = *dtoParams.Limit
 	}
 
@@ -101,6 +391,34 @@ func toDomainPvzReadParams(dtoParams *dto.GetPvzParams) *domain.PvzsReadParams {
 
 	domainParams.StartDate = dtoParams.StartDate
 	domainParams.EndDate = dtoParams.EndDate
+	domainParams.RegisteredFrom = dtoParams.RegisteredFrom
+	domainParams.RegisteredTo = dtoParams.RegisteredTo
+	domainParams.HasOpenReception = dtoParams.HasOpenReception
+
+	if dtoParams.City != nil {
+		for _, c := range *dtoParams.City {
+			domainParams.Cities = append(domainParams.Cities, domain.PVZCity(c))
+		}
+	}
+	if dtoParams.ReceptionStatus != nil {
+		for _, st := range *dtoParams.ReceptionStatus {
+			domainParams.ReceptionStatuses = append(domainParams.ReceptionStatuses, domain.ReceptionStatus(st))
+		}
+	}
+	if dtoParams.ProductType != nil {
+		for _, t := range *dtoParams.ProductType {
+			domainParams.ProductTypes = append(domainParams.ProductTypes, domain.ProductType(t))
+		}
+	}
+
+	domainParams.Sort.Field = domain.PvzsSortByRegistrationDate
+	if dtoParams.SortBy != nil && *dtoParams.SortBy == dto.SortByCity {
+		domainParams.Sort.Field = domain.PvzsSortByCity
+	}
+	domainParams.Sort.Desc = dtoParams.SortOrder != nil && *dtoParams.SortOrder == dto.SortOrderDesc
+	if dtoParams.WithTotal != nil {
+		domainParams.WithTotal = *dtoParams.WithTotal
+	}
 
 	return domainParams
 }
@@ -182,3 +500,49 @@ func toDomainUserData(dtoUserParams *dto.PostRegisterJSONRequestBody) *auth.Regi
 		Role:          auth.UserRole(dtoUserParams.Role),
 	}
 }
+
+// toDTOProblem describes e as an RFC 7807 problem. The type is always about:blank,
+// the kind of the error is told by its stable code instead.
+func toDTOProblem(r *http.Request, e *HTTPError) *dto.Error {
+	code := e.ErrCode
+	if code == "" && e.Code >= http.StatusInternalServerError {
+		code = dto.ErrCodeInternal
+	}
+
+	instance := r.URL.Path
+	problem := &dto.Error{
+		Type:     "about:blank",
+		Title:    http.StatusText(e.Code),
+		Status:   e.Code,
+		Detail:   e.Message,
+		Message:  e.Message,
+		Instance: &instance,
+		Code:     code,
+		Errors:   toDTOFieldErrors(e.Err),
+	}
+	if id := requestid.FromCtx(r.Context()); id != "" {
+		problem.RequestId = &id
+	}
+
+	return problem
+}
+
+// toDTOFieldErrors lists the fields that failed validation, if err is about them.
+func toDTOFieldErrors(err error) *[]dto.FieldError {
+	var vErrs validator.ValidationErrors
+	if !errors.As(err, &vErrs) {
+		return nil
+	}
+
+	res := make([]dto.FieldError, len(vErrs))
+	for i, fe := range vErrs {
+		// The namespace starts with the name of the validated struct.
+		_, field, _ := strings.Cut(fe.Namespace(), ".")
+		res[i] = dto.FieldError{Field: field, Rule: fe.Tag()}
+		if param := fe.Param(); param != "" {
+			res[i].Param = &param
+		}
+	}
+
+	return &res
+}
@@ -1,6 +1,9 @@
 package http
 
 import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -8,7 +11,9 @@ import (
 	"github.com/shrtyk/pvz-service/internal/api/http/dto"
 	"github.com/shrtyk/pvz-service/internal/core/domain"
 	"github.com/shrtyk/pvz-service/internal/core/domain/auth"
+	"github.com/shrtyk/pvz-service/pkg/requestid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_toDomainPVZ(t *testing.T) {
@@ -51,6 +56,49 @@ func Test_toDTOPVZ(t *testing.T) {
 	})
 }
 
+func Test_toDTOPvzStock(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	capacity, free, none := 8, 3, 0
+	tests := []struct {
+		name         string
+		stock        *domain.PvzStock
+		wantCapacity *int
+		wantFree     *int
+	}{
+		{
+			name:  "unlimited",
+			stock: &domain.PvzStock{PvzId: pvzID, OnHand: 5},
+		},
+		{
+			name:         "limited",
+			stock:        &domain.PvzStock{PvzId: pvzID, OnHand: 5, Capacity: 8},
+			wantCapacity: &capacity,
+			wantFree:     &free,
+		},
+		{
+			name:         "over capacity after it was lowered",
+			stock:        &domain.PvzStock{PvzId: pvzID, OnHand: 10, Capacity: 8},
+			wantCapacity: &capacity,
+			wantFree:     &none,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res := toDTOPvzStock(tt.stock)
+			assert.Equal(t, pvzID, res.PvzId)
+			assert.Equal(t, tt.stock.OnHand, res.OnHand)
+			assert.Equal(t, tt.wantCapacity, res.Capacity)
+			assert.Equal(t, tt.wantFree, res.Free)
+		})
+	}
+
+	assert.Nil(t, toDTOPvzStock(nil))
+}
+
 func Test_toDomainPvzReadParams(t *testing.T) {
 	t.Parallel()
 
@@ -78,6 +126,29 @@ func Test_toDomainPvzReadParams(t *testing.T) {
 		assert.Equal(t, limit, domainParams.Limit)
 		assert.Equal(t, &startDate, domainParams.StartDate)
 		assert.Equal(t, &endDate, domainParams.EndDate)
+		assert.Equal(t, domain.PvzsSort{Field: domain.PvzsSortByRegistrationDate}, domainParams.Sort)
+	})
+
+	t.Run("with filters and sort", func(t *testing.T) {
+		t.Parallel()
+		cities := []dto.GetPvzParamsCity{dto.GetPvzParamsCityKazan}
+		statuses := []dto.GetPvzParamsReceptionStatus{dto.FilterClose, dto.FilterCancelled}
+		types := []dto.GetPvzParamsProductType{dto.Footwear}
+		open := true
+		sortBy, sortOrder := dto.SortByCity, dto.SortOrderDesc
+		domainParams := toDomainPvzReadParams(&dto.GetPvzParams{
+			City:             &cities,
+			ReceptionStatus:  &statuses,
+			ProductType:      &types,
+			HasOpenReception: &open,
+			SortBy:           &sortBy,
+			SortOrder:        &sortOrder,
+		})
+		assert.Equal(t, []domain.PVZCity{domain.Kazan}, domainParams.Cities)
+		assert.Equal(t, []domain.ReceptionStatus{domain.Close, domain.Cancelled}, domainParams.ReceptionStatuses)
+		assert.Equal(t, []domain.ProductType{domain.ProductTypeFootwear}, domainParams.ProductTypes)
+		assert.Equal(t, &open, domainParams.HasOpenReception)
+		assert.Equal(t, domain.PvzsSort{Field: domain.PvzsSortByCity, Desc: true}, domainParams.Sort)
 	})
 }
 
@@ -117,14 +188,68 @@ func Test_toDTOReception(t *testing.T) {
 			PvzId:    pvzID,
 			Status:   domain.InProgress,
 			DateTime: time.Now(),
+			Version:  2,
 		}
 		dtoRec := toDTOReception(domainRec)
 		assert.Equal(t, &recID, dtoRec.Id)
 		assert.Equal(t, pvzID, dtoRec.PvzId)
 		assert.Equal(t, dto.ReceptionStatus(domain.InProgress), dtoRec.Status)
+		assert.False(t, *dtoRec.AutoClosed)
+		assert.Equal(t, 2, *dtoRec.Version)
+	})
+
+	t.Run("auto closed", func(t *testing.T) {
+		t.Parallel()
+		dtoRec := toDTOReception(&domain.Reception{Status: domain.Close, AutoClosed: true})
+		assert.True(t, *dtoRec.AutoClosed)
+	})
+}
+
+func Test_toDTOReceptionCloseReport(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, toDTOReceptionCloseReport(nil))
+
+	closedAt := time.Now()
+	rec := &domain.Reception{Id: uuid.New(), Status: domain.Close, ClosedAt: &closedAt}
+
+	t.Run("without manifest", func(t *testing.T) {
+		t.Parallel()
+		res := toDTOReceptionCloseReport(&domain.ReceptionCloseReport{Reception: rec})
+		assert.Equal(t, dto.Close, res.Reception.Status)
+		assert.Equal(t, &closedAt, res.Reception.ClosedAt)
+		assert.Nil(t, res.Manifest)
+	})
+
+	t.Run("with manifest", func(t *testing.T) {
+		t.Parallel()
+		res := toDTOReceptionCloseReport(&domain.ReceptionCloseReport{
+			Reception: rec,
+			Manifest:  &domain.ManifestReport{Expected: 2, Missing: []string{"a1"}, Unexpected: []string{"z9"}},
+		})
+		require.NotNil(t, res.Manifest)
+		assert.Equal(t, 2, res.Manifest.Expected)
+		assert.Equal(t, []string{"a1"}, res.Manifest.Missing)
+		assert.Equal(t, []string{"z9"}, res.Manifest.Unexpected)
 	})
 }
 
+func Test_toDomainReceptionManifest(t *testing.T) {
+	t.Parallel()
+
+	recID := uuid.New()
+	assert.Nil(t, toDomainReceptionManifest(nil, nil))
+
+	m := toDomainReceptionManifest(&recID, &dto.PutReceptionsReceptionIdManifestJSONRequestBody{Barcodes: []string{"a1"}})
+	assert.Equal(t, recID, m.ReceptionId)
+	assert.Equal(t, []string{"a1"}, m.Barcodes)
+
+	assert.Nil(t, toDTOReceptionManifest(nil))
+	dtoM := toDTOReceptionManifest(m)
+	assert.Equal(t, recID, dtoM.ReceptionId)
+	assert.Equal(t, []string{"a1"}, dtoM.Barcodes)
+}
+
 func Test_toDomainProduct(t *testing.T) {
 	t.Parallel()
 
@@ -143,9 +268,93 @@ func Test_toDomainProduct(t *testing.T) {
 		domainProd := toDomainProduct(dtoProd)
 		assert.Equal(t, pvzID, domainProd.PvzId)
 		assert.Equal(t, domain.ProductType(dto.PostProductsJSONBodyTypeClothing), domainProd.Type)
+		assert.Nil(t, domainProd.Barcode)
+		assert.Nil(t, domainProd.Dimensions)
+	})
+
+	t.Run("with barcode, weight and dimensions", func(t *testing.T) {
+		t.Parallel()
+		barcode := "4601234567890"
+		weight := 1200
+		dtoProd := &dto.PostProductsJSONRequestBody{
+			PvzId:      uuid.New(),
+			Type:       dto.PostProductsJSONBodyTypeElectronics,
+			Barcode:    &barcode,
+			Weight:     &weight,
+			Dimensions: &dto.ProductDimensions{Length: 300, Width: 200, Height: 100},
+		}
+		domainProd := toDomainProduct(dtoProd)
+		assert.Equal(t, &barcode, domainProd.Barcode)
+		assert.Equal(t, &weight, domainProd.WeightGrams)
+		assert.Equal(t, &domain.ProductDimensions{LengthMm: 300, WidthMm: 200, HeightMm: 100}, domainProd.Dimensions)
+	})
+}
+
+func Test_toDomainProductsBatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil dto", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, toDomainProductsBatch(nil))
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		pvzID := uuid.New()
+		barcode := "4601234567890"
+		atomic := true
+		dtoBatch := &dto.PostProductsBatchJSONRequestBody{
+			PvzId:  pvzID,
+			Atomic: &atomic,
+			Products: []dto.NewProduct{
+				{Type: "одежда", Barcode: &barcode},
+				{Type: "обувь", Dimensions: &dto.ProductDimensions{Length: 1, Width: 2, Height: 3}},
+			},
+		}
+
+		batch := toDomainProductsBatch(dtoBatch)
+		assert.Equal(t, pvzID, batch.PvzId)
+		assert.True(t, batch.Atomic)
+		require.Len(t, batch.Products, 2)
+		assert.Equal(t, pvzID, batch.Products[0].PvzId)
+		assert.Equal(t, domain.ProductTypeClothing, batch.Products[0].Type)
+		assert.Equal(t, &barcode, batch.Products[0].Barcode)
+		assert.Equal(t, &domain.ProductDimensions{LengthMm: 1, WidthMm: 2, HeightMm: 3}, batch.Products[1].Dimensions)
+	})
+
+	t.Run("atomic defaults to false", func(t *testing.T) {
+		t.Parallel()
+		batch := toDomainProductsBatch(&dto.PostProductsBatchJSONRequestBody{
+			Products: []dto.NewProduct{{Type: "одежда"}},
+		})
+		assert.False(t, batch.Atomic)
 	})
 }
 
+func Test_toDTOProductsBatchResult(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, toDTOProductsBatchResult(nil))
+
+	prodID := uuid.New()
+	res := toDTOProductsBatchResult(&domain.ProductsBatchResult{
+		Added: 1,
+		Items: []*domain.BatchItemResult{
+			{Status: domain.BatchItemDuplicateBarcode},
+			{Status: domain.BatchItemAdded, Product: &domain.Product{Id: prodID}},
+		},
+	})
+
+	assert.Equal(t, 1, res.Added)
+	require.Len(t, res.Items, 2)
+	assert.Equal(t, 0, res.Items[0].Index)
+	assert.Equal(t, dto.BatchItemDuplicateBarcode, res.Items[0].Status)
+	assert.Nil(t, res.Items[0].Product)
+	assert.Equal(t, 1, res.Items[1].Index)
+	assert.Equal(t, dto.BatchItemAdded, res.Items[1].Status)
+	assert.Equal(t, &prodID, res.Items[1].Product.Id)
+}
+
 func Test_toDTOProduct(t *testing.T) {
 	t.Parallel()
 
@@ -169,6 +378,114 @@ func Test_toDTOProduct(t *testing.T) {
 		assert.Equal(t, recID, dtoProd.ReceptionId)
 		assert.Equal(t, dto.ProductType(domain.ProductTypeClothing), dtoProd.Type)
 	})
+
+	t.Run("with barcode, weight and dimensions", func(t *testing.T) {
+		t.Parallel()
+		barcode := "4601234567890"
+		weight := 1200
+		domainProd := &domain.Product{
+			Id:          uuid.New(),
+			ReceptionId: uuid.New(),
+			Type:        domain.ProductTypeElectronics,
+			Barcode:     &barcode,
+			WeightGrams: &weight,
+			Dimensions:  &domain.ProductDimensions{LengthMm: 300, WidthMm: 200, HeightMm: 100},
+		}
+		dtoProd := toDTOProduct(domainProd)
+		assert.Equal(t, &barcode, dtoProd.Barcode)
+		assert.Equal(t, &weight, dtoProd.Weight)
+		assert.Equal(t, &dto.ProductDimensions{Length: 300, Width: 200, Height: 100}, dtoProd.Dimensions)
+	})
+
+	t.Run("with status", func(t *testing.T) {
+		t.Parallel()
+		dtoProd := toDTOProduct(&domain.Product{Status: domain.ProductIssued})
+		require.NotNil(t, dtoProd.Status)
+		assert.Equal(t, dto.ProductStatusIssued, *dtoProd.Status)
+		assert.Nil(t, toDTOProduct(&domain.Product{}).Status)
+	})
+}
+
+func Test_toDTOProductStatusHistory(t *testing.T) {
+	t.Parallel()
+
+	actorID := uuid.New()
+	res := toDTOProductStatusHistory([]*domain.ProductStatusChange{
+		{Status: domain.ProductInStorage, ChangedAt: time.Now()},
+		{Status: domain.ProductReturned, ChangedAt: time.Now(), ChangedBy: &actorID},
+	})
+
+	require.Len(t, res, 2)
+	assert.Equal(t, dto.ProductStatusChangeStatusInStorage, res[0].Status)
+	assert.Nil(t, res[0].ChangedBy)
+	assert.Equal(t, dto.ProductStatusChangeStatusReturned, res[1].Status)
+	assert.Equal(t, &actorID, res[1].ChangedBy)
+}
+
+func Test_toDTOProductInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil domain", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, toDTOProductInfo(nil))
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		pvzID := uuid.New()
+		recID := uuid.New()
+		prodID := uuid.New()
+		barcode := "TRACK-001"
+		info := &domain.ProductInfo{
+			Product: &domain.Product{
+				Id:          prodID,
+				PvzId:       pvzID,
+				ReceptionId: recID,
+				Type:        domain.ProductTypeFootwear,
+				Barcode:     &barcode,
+			},
+			Reception: &domain.Reception{Id: recID, PvzId: pvzID, Status: domain.Close},
+			Pvz:       &domain.Pvz{Id: pvzID, City: domain.Kazan},
+		}
+		dtoInfo := toDTOProductInfo(info)
+		assert.Equal(t, &prodID, dtoInfo.Product.Id)
+		assert.Equal(t, &barcode, dtoInfo.Product.Barcode)
+		assert.Equal(t, &recID, dtoInfo.Reception.Id)
+		assert.Equal(t, dto.ReceptionStatus(domain.Close), dtoInfo.Reception.Status)
+		assert.Equal(t, &pvzID, dtoInfo.Pvz.Id)
+		assert.Equal(t, dto.PVZCity(domain.Kazan), dtoInfo.Pvz.City)
+	})
+}
+
+func Test_toDTOProductDeletions(t *testing.T) {
+	t.Parallel()
+
+	actorID := uuid.New()
+	restoredAt := time.Now()
+	deletions := []*domain.ProductDeletion{
+		{
+			Id:        uuid.New(),
+			Product:   &domain.Product{Id: uuid.New(), Type: domain.ProductTypeClothing},
+			DeletedBy: &actorID,
+			DeletedAt: time.Now(),
+		},
+		{
+			Id:         uuid.New(),
+			Product:    &domain.Product{Id: uuid.New(), Type: domain.ProductTypeFootwear},
+			DeletedAt:  time.Now(),
+			RestoredAt: &restoredAt,
+		},
+	}
+
+	res := toDTOProductDeletions(deletions)
+
+	require.Len(t, res, 2)
+	assert.Equal(t, deletions[0].Id, res[0].Id)
+	assert.Equal(t, &actorID, res[0].DeletedBy)
+	assert.Equal(t, deletions[0].Product.Id, *res[0].Product.Id)
+	assert.Nil(t, res[0].RestoredAt)
+	assert.Equal(t, &restoredAt, res[1].RestoredAt)
+	assert.Nil(t, toDTOProductDeletion(nil))
 }
 
 func Test_toDTOReceptionProducts(t *testing.T) {
@@ -270,4 +587,69 @@ func Test_toDomainUserData(t *testing.T) {
 		assert.Equal(t, "password", domainUser.PlainPassword)
 		assert.Equal(t, auth.UserRoleEmployee, domainUser.Role)
 	})
-}
\ No newline at end of file
+}
+func Test_toDTOProblem(t *testing.T) {
+	t.Parallel()
+
+	t.Run("client error", func(t *testing.T) {
+		t.Parallel()
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/receptions", nil)
+		r = r.WithContext(requestid.ToCtx(r.Context(), "req-1"))
+
+		problem := toDTOProblem(r, &HTTPError{
+			Code:    http.StatusConflict,
+			ErrCode: dto.ErrCodeActiveReceptionExists,
+			Message: "active reception already exists",
+		})
+
+		assert.Equal(t, "about:blank", problem.Type)
+		assert.Equal(t, "Conflict", problem.Title)
+		assert.Equal(t, http.StatusConflict, problem.Status)
+		assert.Equal(t, "active reception already exists", problem.Detail)
+		assert.Equal(t, problem.Detail, problem.Message)
+		assert.Equal(t, "/api/v1/receptions", *problem.Instance)
+		assert.Equal(t, dto.ErrCodeActiveReceptionExists, problem.Code)
+		assert.Equal(t, "req-1", *problem.RequestId)
+		assert.Nil(t, problem.Errors)
+	})
+
+	t.Run("server error without code", func(t *testing.T) {
+		t.Parallel()
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/pvz", nil)
+
+		problem := toDTOProblem(r, &HTTPError{Code: http.StatusInternalServerError})
+
+		assert.Equal(t, dto.ErrCodeInternal, problem.Code)
+		assert.Nil(t, problem.RequestId)
+	})
+}
+
+func Test_toDTOFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not a validation error", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, toDTOFieldErrors(errors.New("boom")))
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+		barcode := "штрихкод"
+		err := MustNewValidator().Struct(&dto.PostProductsJSONBody{
+			Barcode: &barcode,
+			PvzId:   uuid.New(),
+			Type:    "мебель",
+		})
+		require.Error(t, err)
+
+		fieldErrs := toDTOFieldErrors(err)
+		require.NotNil(t, fieldErrs)
+		require.Len(t, *fieldErrs, 2)
+		assert.Equal(t, "barcode", (*fieldErrs)[0].Field)
+		assert.Equal(t, "printascii", (*fieldErrs)[0].Rule)
+		assert.Nil(t, (*fieldErrs)[0].Param)
+		assert.Equal(t, "type", (*fieldErrs)[1].Field)
+		assert.Equal(t, "oneof", (*fieldErrs)[1].Rule)
+		assert.Equal(t, "электроника одежда обувь", *(*fieldErrs)[1].Param)
+	})
+}
@@ -4,11 +4,15 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/shrtyk/pvz-service/internal/api/http/dto"
 	"github.com/shrtyk/pvz-service/internal/core/ports/auth"
 	ps "github.com/shrtyk/pvz-service/internal/core/ports/service"
 	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
 )
 
+// mapAppServiceErrsToHTTP maps service errors to their status and stable code.
+// Requests conflicting with the current state of a resource get 409, the ones
+// breaking a business rule that no state change would fix get 422.
 func mapAppServiceErrsToHTTP(err error) *HTTPError {
 	e := new(HTTPError)
 
@@ -18,17 +22,60 @@ func mapAppServiceErrsToHTTP(err error) *HTTPError {
 		e.Err = bErr
 
 		switch bErr.Kind {
-		case ps.Unexpected, ps.FailedToAddPvz:
-			e.Code = http.StatusInternalServerError
-		case ps.ActiveReceptionExists,
-			ps.PvzNotFound,
-			ps.NoActiveReception,
-			ps.NoProdOrActiveReception,
-			ps.FailedToCloseReception,
-			ps.EmailAlreadyExists:
-			e.Code = http.StatusBadRequest
+		case ps.ActiveReceptionExists:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodeActiveReceptionExists
+		case ps.NoActiveReception:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodeNoActiveReception
+		case ps.NoProdOrActiveReception:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodeNoProductToDelete
+		case ps.FailedToCloseReception:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodeReceptionNotClosable
+		case ps.EmailAlreadyExists:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodeEmailAlreadyExists
+		case ps.ProductAlreadyExists:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodeProductAlreadyExists
+		case ps.ReceptionNotInProgress:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodeReceptionNotInProgress
+		case ps.ProductNotInStorage:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodeProductNotInStorage
+		case ps.ReceptionNotClosed:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodeReceptionNotClosed
+		case ps.PickupCodeNotIssued:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodePickupCodeNotIssued
+		case ps.PickupCodeExpired:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodePickupCodeExpired
+		case ps.PickupCodeLocked:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodePickupCodeLocked
+		case ps.PvzCapacityExceeded:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodePvzCapacityExceeded
+		case ps.ExportNotReady:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodeExportNotReady
+		case ps.IdempotencyKeyReused:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodeIdempotencyKeyReused
+		case ps.IdempotentReqInProgress:
+			e.Code, e.ErrCode = http.StatusConflict, dto.ErrCodeIdempotentRequestInProgress
+		case ps.ReceptionNotReopenable:
+			e.Code, e.ErrCode = http.StatusUnprocessableEntity, dto.ErrCodeReceptionNotReopenable
+		case ps.StorageNotExpired:
+			e.Code, e.ErrCode = http.StatusUnprocessableEntity, dto.ErrCodeStorageNotExpired
+		case ps.WrongPickupCode:
+			e.Code, e.ErrCode = http.StatusForbidden, dto.ErrCodeWrongPickupCode
+		case ps.VersionMismatch:
+			e.Code, e.ErrCode = http.StatusPreconditionFailed, dto.ErrCodeVersionMismatch
+		case ps.PvzNotFound:
+			e.Code, e.ErrCode = http.StatusNotFound, dto.ErrCodePvzNotFound
+		case ps.ProductNotFound:
+			e.Code, e.ErrCode = http.StatusNotFound, dto.ErrCodeProductNotFound
+		case ps.DeletedProductNotFound:
+			e.Code, e.ErrCode = http.StatusNotFound, dto.ErrCodeDeletedProductNotFound
+		case ps.ReceptionNotFound:
+			e.Code, e.ErrCode = http.StatusNotFound, dto.ErrCodeReceptionNotFound
+		case ps.ExportJobNotFound:
+			e.Code, e.ErrCode = http.StatusNotFound, dto.ErrCodeExportJobNotFound
 		case ps.WrongCredentials:
-			e.Code = http.StatusUnauthorized
+			e.Code, e.ErrCode = http.StatusUnauthorized, dto.ErrCodeWrongCredentials
+		default:
+			return InternalError(bErr)
 		}
 		return e
 	}
@@ -45,12 +92,16 @@ func mapTokenServiceErrsToHTTP(err error) *HTTPError {
 		e.Err = err
 
 		switch bErr.Kind {
-		case auth.JwtCreation, auth.JwtClaimsFromCtx:
-			e.Code = http.StatusInternalServerError
-		case auth.InvalidJwt, auth.ExpiredJwt, auth.NotAuthenticated:
-			e.Code = http.StatusUnauthorized
+		case auth.InvalidJwt:
+			e.Code, e.ErrCode = http.StatusUnauthorized, dto.ErrCodeInvalidToken
+		case auth.ExpiredJwt:
+			e.Code, e.ErrCode = http.StatusUnauthorized, dto.ErrCodeExpiredToken
+		case auth.NotAuthenticated:
+			e.Code, e.ErrCode = http.StatusUnauthorized, dto.ErrCodeNotAuthenticated
 		case auth.NotAuthorized:
-			e.Code = http.StatusForbidden
+			e.Code, e.ErrCode = http.StatusForbidden, dto.ErrCodeNotAuthorized
+		default:
+			return InternalError(err)
 		}
 		return e
 	}
@@ -28,7 +28,87 @@ func Test_mapAppServiceErrsToHTTP(t *testing.T) {
 		{
 			name:       "active reception exists",
 			err:        xerr.NewErr("op", ps.ActiveReceptionExists),
-			wantStatus: http.StatusBadRequest,
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "product already exists",
+			err:        xerr.NewErr("op", ps.ProductAlreadyExists),
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "product not found",
+			err:        xerr.NewErr("op", ps.ProductNotFound),
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "deleted product not found",
+			err:        xerr.NewErr("op", ps.DeletedProductNotFound),
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "reception not in progress",
+			err:        xerr.NewErr("op", ps.ReceptionNotInProgress),
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "reception not found",
+			err:        xerr.NewErr("op", ps.ReceptionNotFound),
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "reception not reopenable",
+			err:        xerr.NewErr("op", ps.ReceptionNotReopenable),
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "storage period not over",
+			err:        xerr.NewErr("op", ps.StorageNotExpired),
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "pvz capacity exceeded",
+			err:        xerr.NewErr("op", ps.PvzCapacityExceeded),
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "export job not found",
+			err:        xerr.NewErr("op", ps.ExportJobNotFound),
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "export not ready",
+			err:        xerr.NewErr("op", ps.ExportNotReady),
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "idempotency key reused",
+			err:        xerr.NewErr("op", ps.IdempotencyKeyReused),
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "idempotent request in progress",
+			err:        xerr.NewErr("op", ps.IdempotentReqInProgress),
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "version mismatch",
+			err:        xerr.NewErr("op", ps.VersionMismatch),
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:       "wrong pickup code",
+			err:        xerr.NewErr("op", ps.WrongPickupCode),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "pickup code expired",
+			err:        xerr.NewErr("op", ps.PickupCodeExpired),
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "pickup code locked",
+			err:        xerr.NewErr("op", ps.PickupCodeLocked),
+			wantStatus: http.StatusConflict,
 		},
 		{
 			name:       "default error",
@@ -3,10 +3,15 @@ package http
 import (
 	"fmt"
 	"net/http"
+
+	"github.com/shrtyk/pvz-service/internal/api/http/dto"
 )
 
 type HTTPError struct {
-	Code    int
+	Code int
+	// ErrCode is the stable machine-readable kind of the error. Unlike Message,
+	// it is part of the API contract, so clients may rely on it.
+	ErrCode dto.ErrorCode
 	Message string
 	Err     error
 }
@@ -22,6 +27,7 @@ func (e *HTTPError) Unwrap() error {
 func BadRequestBodyError(err error) *HTTPError {
 	return &HTTPError{
 		Code:    http.StatusBadRequest,
+		ErrCode: dto.ErrCodeMalformedBody,
 		Message: "Badly formed request body",
 		Err:     err,
 	}
@@ -30,23 +36,45 @@ func BadRequestBodyError(err error) *HTTPError {
 func BadRequestQueryParamsError(err error) *HTTPError {
 	return &HTTPError{
 		Code:    http.StatusBadRequest,
+		ErrCode: dto.ErrCodeInvalidQueryParams,
 		Message: "Wrong formated url query params",
 		Err:     err,
 	}
 }
 
+func BadRequestParamsError(err error) *HTTPError {
+	return &HTTPError{
+		Code:    http.StatusBadRequest,
+		ErrCode: dto.ErrCodeInvalidParams,
+		Message: "Badly formed request parameters",
+		Err:     err,
+	}
+}
+
+func BadRequestHeadersError(err error) *HTTPError {
+	return &HTTPError{
+		Code:    http.StatusBadRequest,
+		ErrCode: dto.ErrCodeInvalidHeaders,
+		Message: "Badly formed request headers",
+		Err:     err,
+	}
+}
+
 func InternalError(err error) *HTTPError {
 	return &HTTPError{
 		Code:    http.StatusInternalServerError,
+		ErrCode: dto.ErrCodeInternal,
 		Message: "Internal error",
 		Err:     err,
 	}
 }
 
+// ValidationError reports a well-formed request body with invalid field values.
+// Details of every failed field are taken out of err if it holds validator.ValidationErrors.
 func ValidationError(err error) *HTTPError {
 	return &HTTPError{
-		// Would be better to use StatusUnprocessableEntity but i followed swagger.yaml
-		Code:    http.StatusBadRequest,
+		Code:    http.StatusUnprocessableEntity,
+		ErrCode: dto.ErrCodeValidationFailed,
 		Message: "Invalid data in request body",
 		Err:     err,
 	}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/shrtyk/pvz-service/internal/api/http/dto"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -39,39 +40,60 @@ func TestErrorConstructors(t *testing.T) {
 	originalErr := errors.New("test error")
 
 	testCases := []struct {
-		name          string
-		constructor   func(err error) *HTTPError
-		expectedCode  int
-		expectedMsg   string
-		expectedError error
+		name            string
+		constructor     func(err error) *HTTPError
+		expectedCode    int
+		expectedErrCode dto.ErrorCode
+		expectedMsg     string
+		expectedError   error
 	}{
 		{
-			name:          "BadRequestBodyError",
-			constructor:   BadRequestBodyError,
-			expectedCode:  http.StatusBadRequest,
-			expectedMsg:   "Badly formed request body",
-			expectedError: originalErr,
+			name:            "BadRequestBodyError",
+			constructor:     BadRequestBodyError,
+			expectedCode:    http.StatusBadRequest,
+			expectedErrCode: dto.ErrCodeMalformedBody,
+			expectedMsg:     "Badly formed request body",
+			expectedError:   originalErr,
 		},
 		{
-			name:          "BadRequestQueryParamsError",
-			constructor:   BadRequestQueryParamsError,
-			expectedCode:  http.StatusBadRequest,
-			expectedMsg:   "Wrong formated url query params",
-			expectedError: originalErr,
+			name:            "BadRequestQueryParamsError",
+			constructor:     BadRequestQueryParamsError,
+			expectedCode:    http.StatusBadRequest,
+			expectedErrCode: dto.ErrCodeInvalidQueryParams,
+			expectedMsg:     "Wrong formated url query params",
+			expectedError:   originalErr,
 		},
 		{
-			name:          "InternalError",
-			constructor:   InternalError,
-			expectedCode:  http.StatusInternalServerError,
-			expectedMsg:   "Internal error",
-			expectedError: originalErr,
+			name:            "BadRequestParamsError",
+			constructor:     BadRequestParamsError,
+			expectedCode:    http.StatusBadRequest,
+			expectedErrCode: dto.ErrCodeInvalidParams,
+			expectedMsg:     "Badly formed request parameters",
+			expectedError:   originalErr,
 		},
 		{
-			name:          "ValidationError",
-			constructor:   ValidationError,
-			expectedCode:  http.StatusBadRequest,
-			expectedMsg:   "Invalid data in request body",
-			expectedError: originalErr,
+			name:            "BadRequestHeadersError",
+			constructor:     BadRequestHeadersError,
+			expectedCode:    http.StatusBadRequest,
+			expectedErrCode: dto.ErrCodeInvalidHeaders,
+			expectedMsg:     "Badly formed request headers",
+			expectedError:   originalErr,
+		},
+		{
+			name:            "InternalError",
+			constructor:     InternalError,
+			expectedCode:    http.StatusInternalServerError,
+			expectedErrCode: dto.ErrCodeInternal,
+			expectedMsg:     "Internal error",
+			expectedError:   originalErr,
+		},
+		{
+			name:            "ValidationError",
+			constructor:     ValidationError,
+			expectedCode:    http.StatusUnprocessableEntity,
+			expectedErrCode: dto.ErrCodeValidationFailed,
+			expectedMsg:     "Invalid data in request body",
+			expectedError:   originalErr,
 		},
 	}
 
@@ -80,6 +102,7 @@ func TestErrorConstructors(t *testing.T) {
 			t.Parallel()
 			err := tc.constructor(originalErr)
 			assert.Equal(t, tc.expectedCode, err.Code)
+			assert.Equal(t, tc.expectedErrCode, err.ErrCode)
 			assert.Equal(t, tc.expectedMsg, err.Message)
 			assert.Equal(t, tc.expectedError, err.Err)
 		})
@@ -0,0 +1,91 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	etagHeader        = "ETag"
+	ifMatchHeader     = "If-Match"
+	ifNoneMatchHeader = "If-None-Match"
+)
+
+var errInvalidIfMatch = errors.New("If-Match must be a single strong entity tag or *")
+
+// versionETag formats a resource version as a strong entity tag.
+func versionETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// etagHeaders returns response headers carrying the entity tag of version.
+func etagHeaders(version int) http.Header {
+	h := make(http.Header)
+	h.Set(etagHeader, versionETag(version))
+	return h
+}
+
+// IfMatchVersion returns the resource version the client expects to change. It returns
+// nil if the request has no If-Match header or it is "*", as any version matches then.
+// Only a single strong entity tag produced by versionETag is accepted.
+func IfMatchVersion(r *http.Request) (*int, error) {
+	values := r.Header.Values(ifMatchHeader)
+	if len(values) == 0 {
+		return nil, nil
+	}
+	if len(values) > 1 {
+		return nil, errInvalidIfMatch
+	}
+
+	tag := strings.TrimSpace(values[0])
+	if tag == "*" {
+		return nil, nil
+	}
+
+	version, ok := parseVersionETag(tag)
+	if !ok {
+		return nil, errInvalidIfMatch
+	}
+
+	return &version, nil
+}
+
+// notModified reports whether the If-None-Match header of a read request matches
+// version, in which case the client already has the current representation.
+// Entity tags are compared weakly as RFC 9110 requires for If-None-Match.
+func notModified(r *http.Request, version int) bool {
+	for _, value := range r.Header.Values(ifNoneMatchHeader) {
+		for tag := range strings.SplitSeq(value, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" {
+				return true
+			}
+			v, ok := parseVersionETag(strings.TrimPrefix(tag, "W/"))
+			if ok && v == version {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func parseVersionETag(tag string) (int, bool) {
+	if len(tag) < 3 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, false
+	}
+
+	version, err := strconv.Atoi(tag[1 : len(tag)-1])
+	if err != nil || version < 1 {
+		return 0, false
+	}
+
+	return version, true
+}
+
+// writeNotModified answers a conditional read whose entity tag still matches.
+func writeNotModified(w http.ResponseWriter, version int) {
+	w.Header().Set(etagHeader, versionETag(version))
+	w.WriteHeader(http.StatusNotModified)
+}
@@ -0,0 +1,84 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIfMatchVersion(t *testing.T) {
+	t.Parallel()
+
+	version := func(v int) *int { return &v }
+	tests := []struct {
+		name    string
+		values  []string
+		want    *int
+		wantErr bool
+	}{
+		{name: "no header"},
+		{name: "any version", values: []string{"*"}},
+		{name: "strong etag", values: []string{`"3"`}, want: version(3)},
+		{name: "surrounding spaces", values: []string{` "12" `}, want: version(12)},
+		{name: "weak etag", values: []string{`W/"3"`}, wantErr: true},
+		{name: "list of etags", values: []string{`"3", "4"`}, wantErr: true},
+		{name: "repeated header", values: []string{`"3"`, `"4"`}, wantErr: true},
+		{name: "unquoted", values: []string{"3"}, wantErr: true},
+		{name: "not a version", values: []string{`"abc"`}, wantErr: true},
+		{name: "zero version", values: []string{`"0"`}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			for _, v := range tt.values {
+				r.Header.Add("If-Match", v)
+			}
+
+			got, err := IfMatchVersion(r)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_notModified(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		values []string
+		want   bool
+	}{
+		{name: "no header"},
+		{name: "same version", values: []string{`"2"`}, want: true},
+		{name: "weak tag of the same version", values: []string{`W/"2"`}, want: true},
+		{name: "one of the list", values: []string{`"1", "2"`}, want: true},
+		{name: "any", values: []string{"*"}, want: true},
+		{name: "older version", values: []string{`"1"`}},
+		{name: "malformed", values: []string{"2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			for _, v := range tt.values {
+				r.Header.Add("If-None-Match", v)
+			}
+
+			assert.Equal(t, tt.want, notModified(r, 2))
+		})
+	}
+}
@@ -1,15 +1,22 @@
 package http
 
 import (
+	"encoding/json"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/api/openapi"
 	"github.com/shrtyk/pvz-service/internal/api/http/dto"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
 	"github.com/shrtyk/pvz-service/internal/core/domain/auth"
 	pAuth "github.com/shrtyk/pvz-service/internal/core/ports/auth"
 	pService "github.com/shrtyk/pvz-service/internal/core/ports/service"
+	"github.com/shrtyk/pvz-service/pkg/logger"
 	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
 )
 
@@ -31,9 +38,17 @@ func NewHandlers(appService pService.Service, tokenService pAuth.TokenService) *
 	}
 }
 
-func (h *handlers) HealthZ(w http.ResponseWriter, r *http.Request) error {
-	w.WriteHeader(http.StatusOK)
-	return nil
+func (h *handlers) OpenAPISpecHandler(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Set("Content-Type", "application/yaml")
+	_, err := w.Write(openapi.Spec)
+	return err
+}
+
+func (h *handlers) DocsHandler(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Security-Policy", openapi.DocsContentSecurityPolicy)
+	_, err := w.Write(openapi.DocsPage)
+	return err
 }
 
 func (h *handlers) DummyLoginHandler(w http.ResponseWriter, r *http.Request) error {
@@ -79,7 +94,7 @@ func (h *handlers) NewPVZHandler(w http.ResponseWriter, r *http.Request) error {
 		return mapAppServiceErrsToHTTP(err)
 	}
 
-	err = WriteJSON(w, toDTOPVZ(newPvz), http.StatusCreated, nil)
+	err = WriteJSON(w, toDTOPVZ(newPvz), http.StatusCreated, etagHeaders(newPvz.Version))
 	if err != nil {
 		return InternalError(err)
 	}
@@ -87,6 +102,38 @@ func (h *handlers) NewPVZHandler(w http.ResponseWriter, r *http.Request) error {
 	return nil
 }
 
+func (h *handlers) UpdatePvzHandler(w http.ResponseWriter, r *http.Request) error {
+	pvzId, err := PvzIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	ifVersion, err := IfMatchVersion(r)
+	if err != nil {
+		return BadRequestHeadersError(err)
+	}
+
+	rBody := new(dto.PutPvzPvzIdJSONRequestBody)
+	if err = ReadJson(w, r, rBody); err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	if err = h.validator.Struct(rBody); err != nil {
+		return ValidationError(err)
+	}
+
+	pvz, err := h.appService.UpdatePvz(r.Context(), pvzId, domain.PVZCity(rBody.City), ifVersion)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	if err = WriteJSON(w, toDTOPVZ(pvz), http.StatusOK, etagHeaders(pvz.Version)); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
 func (h *handlers) NewReceptionHandler(w http.ResponseWriter, r *http.Request) error {
 	rBody := new(dto.PostReceptionsJSONBody)
 	if err := ReadJson(w, r, rBody); err != nil {
@@ -103,7 +150,7 @@ func (h *handlers) NewReceptionHandler(w http.ResponseWriter, r *http.Request) e
 		return mapAppServiceErrsToHTTP(err)
 	}
 
-	err = WriteJSON(w, toDTOReception(newRec), http.StatusCreated, nil)
+	err = WriteJSON(w, toDTOReception(newRec), http.StatusCreated, etagHeaders(newRec.Version))
 	if err != nil {
 		return InternalError(err)
 	}
@@ -111,6 +158,52 @@ func (h *handlers) NewReceptionHandler(w http.ResponseWriter, r *http.Request) e
 	return nil
 }
 
+func (h *handlers) PvzByIdHandler(w http.ResponseWriter, r *http.Request) error {
+	pvzId, err := PvzIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	pvz, err := h.appService.Pvz(r.Context(), pvzId)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	if notModified(r, pvz.Version) {
+		writeNotModified(w, pvz.Version)
+		return nil
+	}
+
+	if err = WriteJSON(w, toDTOPVZ(pvz), http.StatusOK, etagHeaders(pvz.Version)); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
+func (h *handlers) ReceptionByIdHandler(w http.ResponseWriter, r *http.Request) error {
+	receptionId, err := ReceptionIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	rec, err := h.appService.Reception(r.Context(), receptionId)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	if notModified(r, rec.Version) {
+		writeNotModified(w, rec.Version)
+		return nil
+	}
+
+	if err = WriteJSON(w, toDTOReception(rec), http.StatusOK, etagHeaders(rec.Version)); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
 func (h *handlers) AddProductHandler(w http.ResponseWriter, r *http.Request) error {
 	rBody := new(dto.PostProductsJSONRequestBody)
 	if err := ReadJson(w, r, rBody); err != nil {
@@ -134,29 +227,341 @@ func (h *handlers) AddProductHandler(w http.ResponseWriter, r *http.Request) err
 	return nil
 }
 
+func (h *handlers) AddProductsBatchHandler(w http.ResponseWriter, r *http.Request) error {
+	rBody := new(dto.PostProductsBatchJSONRequestBody)
+	if err := ReadJson(w, r, rBody); err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	if err := h.validator.Struct(rBody); err != nil {
+		return ValidationError(err)
+	}
+
+	res, err := h.appService.AddProductsBatch(r.Context(), toDomainProductsBatch(rBody))
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	status := http.StatusCreated
+	if res.Added == 0 {
+		status = http.StatusConflict
+	}
+
+	if err = WriteJSON(w, toDTOProductsBatchResult(res), status, nil); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
+func (h *handlers) ProductByBarcodeHandler(w http.ResponseWriter, r *http.Request) error {
+	barcode, err := BarcodeParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	infos, err := h.appService.ProductsByBarcode(r.Context(), barcode)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	res := make([]*dto.ProductInfo, len(infos))
+	for i, info := range infos {
+		res[i] = toDTOProductInfo(info)
+	}
+	if err = WriteJSON(w, res, http.StatusOK, nil); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
 func (h *handlers) DeleteLastProductHandler(w http.ResponseWriter, r *http.Request) error {
 	pvzId, err := PvzIdParam(r)
 	if err != nil {
 		return BadRequestBodyError(err)
 	}
 
-	if err = h.appService.DeleteLastProductPvz(r.Context(), pvzId); err != nil {
+	actorId, err := UserIdFromCtx(r)
+	if err != nil {
+		return mapTokenServiceErrsToHTTP(err)
+	}
+
+	if err = h.appService.DeleteLastProductPvz(r.Context(), pvzId, actorId); err != nil {
 		return mapAppServiceErrsToHTTP(err)
 	}
 
 	return nil
 }
 
+func (h *handlers) DeleteProductHandler(w http.ResponseWriter, r *http.Request) error {
+	productId, err := ProductIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	actorId, err := UserIdFromCtx(r)
+	if err != nil {
+		return mapTokenServiceErrsToHTTP(err)
+	}
+
+	if err = h.appService.DeleteProduct(r.Context(), productId, actorId); err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	return nil
+}
+
+func (h *handlers) RestoreProductHandler(w http.ResponseWriter, r *http.Request) error {
+	productId, err := ProductIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	prod, err := h.appService.RestoreProduct(r.Context(), productId)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	if err = WriteJSON(w, toDTOProduct(prod), http.StatusOK, nil); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
+func (h *handlers) IssuePickupCodeHandler(w http.ResponseWriter, r *http.Request) error {
+	productId, err := ProductIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	code, err := h.appService.IssuePickupCode(r.Context(), productId)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	// The code is a secret, so it is kept out of caches and idempotent replays.
+	resp := &dto.PickupCode{ProductId: *productId, PickupCode: code}
+	headers := http.Header{"Cache-Control": {noStore}}
+	if err = WriteJSON(w, resp, http.StatusCreated, headers); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
+func (h *handlers) IssueProductHandler(w http.ResponseWriter, r *http.Request) error {
+	productId, err := ProductIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	rBody := new(dto.PostProductsProductIdIssueJSONRequestBody)
+	if err := ReadJson(w, r, rBody); err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	if err := h.validator.Struct(rBody); err != nil {
+		return ValidationError(err)
+	}
+
+	actorId, err := UserIdFromCtx(r)
+	if err != nil {
+		return mapTokenServiceErrsToHTTP(err)
+	}
+
+	prod, err := h.appService.IssueProduct(r.Context(), productId, rBody.PickupCode, actorId)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	if err = WriteJSON(w, toDTOProduct(prod), http.StatusOK, nil); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
+func (h *handlers) ReturnProductHandler(w http.ResponseWriter, r *http.Request) error {
+	productId, err := ProductIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	actorId, err := UserIdFromCtx(r)
+	if err != nil {
+		return mapTokenServiceErrsToHTTP(err)
+	}
+
+	prod, err := h.appService.ReturnProduct(r.Context(), productId, actorId)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	if err = WriteJSON(w, toDTOProduct(prod), http.StatusOK, nil); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
+func (h *handlers) ProductStatusHistoryHandler(w http.ResponseWriter, r *http.Request) error {
+	productId, err := ProductIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	history, err := h.appService.ProductStatusHistory(r.Context(), productId)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	if err = WriteJSON(w, toDTOProductStatusHistory(history), http.StatusOK, nil); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
+func (h *handlers) ProductDeletionsHandler(w http.ResponseWriter, r *http.Request) error {
+	receptionId, err := ReceptionIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	deletions, err := h.appService.ProductDeletions(r.Context(), receptionId)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	if err = WriteJSON(w, toDTOProductDeletions(deletions), http.StatusOK, nil); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
+func (h *handlers) PvzStockHandler(w http.ResponseWriter, r *http.Request) error {
+	pvzId, err := PvzIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	stock, err := h.appService.PvzStock(r.Context(), pvzId)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	if err = WriteJSON(w, toDTOPvzStock(stock), http.StatusOK, nil); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
 func (h *handlers) CloseReceptionHandler(w http.ResponseWriter, r *http.Request) error {
 	pvzId, err := PvzIdParam(r)
 	if err != nil {
 		return BadRequestBodyError(err)
 	}
 
-	if err := h.appService.CloseReceptionInPvz(r.Context(), pvzId); err != nil {
+	ifVersion, err := IfMatchVersion(r)
+	if err != nil {
+		return BadRequestHeadersError(err)
+	}
+
+	report, err := h.appService.CloseReceptionInPvz(r.Context(), pvzId, ifVersion)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	headers := etagHeaders(report.Reception.Version)
+	if err = WriteJSON(w, toDTOReceptionCloseReport(report), http.StatusOK, headers); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
+func (h *handlers) CancelReceptionHandler(w http.ResponseWriter, r *http.Request) error {
+	pvzId, err := PvzIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	ifVersion, err := IfMatchVersion(r)
+	if err != nil {
+		return BadRequestHeadersError(err)
+	}
+
+	rec, err := h.appService.CancelReceptionInPvz(r.Context(), pvzId, ifVersion)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	if err = WriteJSON(w, toDTOReception(rec), http.StatusOK, etagHeaders(rec.Version)); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
+func (h *handlers) ReopenReceptionHandler(w http.ResponseWriter, r *http.Request) error {
+	receptionId, err := ReceptionIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	ifVersion, err := IfMatchVersion(r)
+	if err != nil {
+		return BadRequestHeadersError(err)
+	}
+
+	rec, err := h.appService.ReopenReception(r.Context(), receptionId, ifVersion)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	if err = WriteJSON(w, toDTOReception(rec), http.StatusOK, etagHeaders(rec.Version)); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
+func (h *handlers) SetReceptionManifestHandler(w http.ResponseWriter, r *http.Request) error {
+	receptionId, err := ReceptionIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	ifVersion, err := IfMatchVersion(r)
+	if err != nil {
+		return BadRequestHeadersError(err)
+	}
+
+	rBody := new(dto.PutReceptionsReceptionIdManifestJSONRequestBody)
+	if err := ReadJson(w, r, rBody); err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	if err := h.validator.Struct(rBody); err != nil {
+		return ValidationError(err)
+	}
+
+	manifest := toDomainReceptionManifest(receptionId, rBody)
+	manifest.IfVersion = ifVersion
+	manifest, err = h.appService.SetReceptionManifest(r.Context(), manifest)
+	if err != nil {
 		return mapAppServiceErrsToHTTP(err)
 	}
 
+	headers := etagHeaders(manifest.Version)
+	if err = WriteJSON(w, toDTOReceptionManifest(manifest), http.StatusOK, headers); err != nil {
+		return InternalError(err)
+	}
+
 	return nil
 }
 
@@ -167,14 +572,223 @@ func (h *handlers) GetPvzHandler(w http.ResponseWriter, r *http.Request) error {
 	}
 
 	domainParams := toDomainPvzReadParams(params)
+	if params.Cursor != nil {
+		if domainParams.Cursor, err = decodePvzsCursor(*params.Cursor); err != nil {
+			return BadRequestQueryParamsError(err)
+		}
+	}
+
+	if acceptsNDJSON(r) {
+		if params.Limit != nil && *params.Limit > maxLimit && *params.Limit <= maxStreamLimit {
+			domainParams.Limit = *params.Limit
+		}
+		return h.streamPvzData(w, r, domainParams)
+	}
+
+	page, err := h.appService.GetPvzsData(r.Context(), domainParams)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	headers := make(http.Header)
+	if page.NextCursor != nil {
+		headers.Set("X-Next-Cursor", encodePvzsCursor(page.NextCursor))
+	}
+	if page.Total != nil {
+		headers.Set("X-Total-Count", strconv.Itoa(*page.Total))
+	}
+
+	resp := toDTOPvzData(page.Items)
+	if err = WriteJSON(w, resp, http.StatusOK, headers); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
+// streamPvzData writes PVZs as NDJSON while they are read. Pagination headers are
+// only known at the end, so they are sent as trailers.
+func (h *handlers) streamPvzData(w http.ResponseWriter, r *http.Request, params *domain.PvzsReadParams) error {
+	// A page of up to maxStreamLimit PVZs may take longer than the server
+	// write timeout, so the deadline is lifted for this response.
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Time{})
+	enc := json.NewEncoder(w)
+	started := false
+
+	page, err := h.appService.StreamPvzsData(r.Context(), params, func(p *domain.PvzReceptions) error {
+		if !started {
+			w.Header().Set("Content-Type", ndjsonContentType)
+			w.Header().Set("Trailer", "X-Next-Cursor, X-Total-Count")
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+		if err := enc.Encode(toDTOPvzReceptions(p)); err != nil {
+			return err
+		}
+		return rc.Flush()
+	})
+	if err != nil {
+		if !started {
+			return mapAppServiceErrsToHTTP(err)
+		}
+		// The status is already sent, drop the connection so the client
+		// doesn't take a truncated stream for a complete one.
+		logger.FromCtx(r.Context()).Error("Failed to stream PVZ data", logger.WithErr(err))
+		panic(http.ErrAbortHandler)
+	}
+
+	if !started {
+		w.Header().Set("Content-Type", ndjsonContentType)
+	}
+	if page.NextCursor != nil {
+		w.Header().Set("X-Next-Cursor", encodePvzsCursor(page.NextCursor))
+	}
+	if page.Total != nil {
+		w.Header().Set("X-Total-Count", strconv.Itoa(*page.Total))
+	}
+
+	return nil
+}
+
+func (h *handlers) ExportReceptionsHandler(w http.ResponseWriter, r *http.Request) error {
+	exportParams, err := ExportParamsFromURL(r)
+	if err != nil {
+		return BadRequestQueryParamsError(err)
+	}
+	pvzParams, err := PvzParamsFromURL(r)
+	if err != nil {
+		return BadRequestQueryParamsError(err)
+	}
+
+	readParams := toDomainPvzReadParams(pvzParams)
+	format := domain.ExportFormat(exportParams.Format)
+
+	if exportParams.Async == nil || !*exportParams.Async {
+		return h.streamExport(w, r, format, &readParams.PvzsFilter, readParams.Sort)
+	}
+
+	userId, err := UserIdFromCtx(r)
+	if err != nil {
+		return mapTokenServiceErrsToHTTP(err)
+	}
+
+	job, err := h.appService.CreateExportJob(r.Context(), &domain.ExportJob{
+		Format:    format,
+		Filter:    readParams.PvzsFilter,
+		Sort:      readParams.Sort,
+		CreatedBy: *userId,
+	})
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	headers := make(http.Header)
+	headers.Set("Location", exportJobPath(&job.Id))
+	if err = WriteJSON(w, toDTOExportJob(job), http.StatusAccepted, headers); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
+// streamExport writes the export file while PVZs are read. Large exports take
+// longer than the server write timeout, so the deadline is lifted for this response.
+func (h *handlers) streamExport(
+	w http.ResponseWriter,
+	r *http.Request,
+	format domain.ExportFormat,
+	filter *domain.PvzsFilter,
+	sort domain.PvzsSort,
+) error {
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	ew := newExportWriter(w, format, "receptions."+string(format))
+	if err := h.appService.ExportPvzsData(r.Context(), format, filter, sort, ew); err != nil {
+		if !ew.started {
+			return mapAppServiceErrsToHTTP(err)
+		}
+		logger.FromCtx(r.Context()).Error("Failed to export PVZ data", logger.WithErr(err))
+		panic(http.ErrAbortHandler)
+	}
+
+	return nil
+}
+
+func (h *handlers) ExportJobHandler(w http.ResponseWriter, r *http.Request) error {
+	jobId, err := ExportJobIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	job, err := h.appService.ExportJob(r.Context(), jobId)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	if err = WriteJSON(w, toDTOExportJob(job), http.StatusOK, nil); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
+func (h *handlers) ExportFileHandler(w http.ResponseWriter, r *http.Request) error {
+	jobId, err := ExportJobIdParam(r)
+	if err != nil {
+		return BadRequestBodyError(err)
+	}
+
+	job, f, err := h.appService.OpenExportFile(r.Context(), jobId)
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
 
-	pvzsData, err := h.appService.GetPvzsData(r.Context(), domainParams)
+	ew := newExportWriter(w, job.Format, job.FileName())
+	if _, err := io.Copy(ew, f); err != nil {
+		if !ew.started {
+			return InternalError(err)
+		}
+		logger.FromCtx(r.Context()).Error("Failed to send export file", logger.WithErr(err))
+		panic(http.ErrAbortHandler)
+	}
+
+	return nil
+}
+
+func (h *handlers) ProductsThroughputHandler(w http.ResponseWriter, r *http.Request) error {
+	params, err := ThroughputParamsFromURL(r)
+	if err != nil {
+		return BadRequestQueryParamsError(err)
+	}
+
+	points, err := h.appService.ProductsThroughput(r.Context(), toDomainThroughputParams(params))
 	if err != nil {
 		return mapAppServiceErrsToHTTP(err)
 	}
 
-	resp := toDTOPvzData(pvzsData)
-	if err = WriteJSON(w, resp, http.StatusOK, nil); err != nil {
+	if err = WriteJSON(w, toDTOThroughput(points), http.StatusOK, nil); err != nil {
+		return InternalError(err)
+	}
+
+	return nil
+}
+
+func (h *handlers) ReceptionStatsHandler(w http.ResponseWriter, r *http.Request) error {
+	params, err := ReceptionStatsParamsFromURL(r)
+	if err != nil {
+		return BadRequestQueryParamsError(err)
+	}
+
+	stats, err := h.appService.ReceptionStats(r.Context(), toDomainReceptionStatsParams(params))
+	if err != nil {
+		return mapAppServiceErrsToHTTP(err)
+	}
+
+	if err = WriteJSON(w, toDTOReceptionStats(stats), http.StatusOK, nil); err != nil {
 		return InternalError(err)
 	}
 
@@ -225,7 +839,7 @@ func (h *handlers) LoginUserHandler(w http.ResponseWriter, r *http.Request) erro
 		return mapAppServiceErrsToHTTP(err)
 	}
 
-	h.setRefreshCookie(w, rToken)
+	h.setRefreshCookie(w, r, rToken)
 
 	err = WriteJSON(w, &dto.Token{Jwt: aToken}, http.StatusOK, nil)
 	if err != nil {
@@ -251,7 +865,7 @@ func (h *handlers) RefreshTokensHandler(w http.ResponseWriter, r *http.Request)
 		return mapAppServiceErrsToHTTP(err)
 	}
 
-	h.setRefreshCookie(w, newRToken)
+	h.setRefreshCookie(w, r, newRToken)
 	err = WriteJSON(w, &dto.Token{Jwt: newAToken}, http.StatusCreated, nil)
 	if err != nil {
 		return InternalError(err)
@@ -260,16 +874,25 @@ func (h *handlers) RefreshTokensHandler(w http.ResponseWriter, r *http.Request)
 	return nil
 }
 
-func (h *handlers) setRefreshCookie(w http.ResponseWriter, rToken *auth.RefreshToken) {
+// setRefreshCookie sets the refresh token cookie, sent only to the refresh endpoint
+// of the API version the token was issued by, and a new CSRF token protecting it.
+func (h *handlers) setRefreshCookie(w http.ResponseWriter, r *http.Request, rToken *auth.RefreshToken) {
+	path := "/tokens/refresh"
+	if strings.HasPrefix(r.URL.Path, apiV1Prefix+"/") {
+		path = apiV1Prefix + path
+	}
+	maxAge := int(time.Until(rToken.ExpiresAt).Seconds())
+
 	http.SetCookie(w, &http.Cookie{
 		Name:     refreshTokenKey,
 		Value:    rToken.Token,
-		Path:     "/tokens/refresh",
-		MaxAge:   int(time.Until(rToken.ExpiresAt).Seconds()),
+		Path:     path,
+		MaxAge:   maxAge,
 		HttpOnly: true,
 		Secure:   true,
 		SameSite: http.SameSiteStrictMode,
 	})
+	setCSRFToken(w, maxAge)
 }
 
 func (h *handlers) getRefreshTokenOutOfCookie(r *http.Request) (string, error) {
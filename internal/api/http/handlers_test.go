@@ -5,20 +5,27 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/shrtyk/pvz-service/internal/api/http/dto"
 	"github.com/shrtyk/pvz-service/internal/core/domain"
 	"github.com/shrtyk/pvz-service/internal/core/domain/auth"
 	pAuthMock "github.com/shrtyk/pvz-service/internal/core/ports/auth/mocks"
+	pService "github.com/shrtyk/pvz-service/internal/core/ports/service"
 	pServiceMock "github.com/shrtyk/pvz-service/internal/core/ports/service/mocks"
+	ts "github.com/shrtyk/pvz-service/internal/infrastructure/tservice"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 type handlerWithMocks struct {
@@ -36,6 +43,14 @@ func setup(t *testing.T) (*handlers, *handlerWithMocks) {
 	return h, fields
 }
 
+func withClaims(r *http.Request, userID uuid.UUID, role auth.UserRole) *http.Request {
+	claims := &auth.AccessTokenClaims{
+		Role:             string(role),
+		RegisteredClaims: jwt.RegisteredClaims{Subject: userID.String()},
+	}
+	return r.WithContext(ts.ClaimsToCtx(r.Context(), claims))
+}
+
 // failingWriter is a mock http.ResponseWriter that fails on Write().
 type failingWriter struct {
 	headers    http.Header
@@ -88,7 +103,7 @@ func TestHandlers_DummyLoginHandler(t *testing.T) {
 			name:       "validation error",
 			body:       dto.PostDummyLoginJSONRequestBody{Role: "invalid"},
 			setup:      func(f *handlerWithMocks) {},
-			wantStatus: http.StatusBadRequest,
+			wantStatus: http.StatusUnprocessableEntity,
 			writer:     httptest.NewRecorder(),
 		},
 		{
@@ -158,7 +173,7 @@ func TestHandlers_NewPVZHandler(t *testing.T) {
 			name:       "validation error",
 			body:       dto.PVZ{City: ""},
 			setup:      func(f *handlerWithMocks) {},
-			wantStatus: http.StatusBadRequest,
+			wantStatus: http.StatusUnprocessableEntity,
 		},
 		{
 			name: "service error",
@@ -224,7 +239,7 @@ func TestHandlers_NewReceptionHandler(t *testing.T) {
 			name:       "validation error",
 			body:       dto.PostReceptionsJSONBody{},
 			setup:      func(f *handlerWithMocks) {},
-			wantStatus: http.StatusBadRequest,
+			wantStatus: http.StatusUnprocessableEntity,
 		},
 		{
 			name: "service error",
@@ -290,7 +305,26 @@ func TestHandlers_AddProductHandler(t *testing.T) {
 			name:       "validation error",
 			body:       dto.PostProductsJSONRequestBody{PvzId: uuid.New(), Type: ""},
 			setup:      func(f *handlerWithMocks) {},
-			wantStatus: http.StatusBadRequest,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "invalid dimensions",
+			body: dto.PostProductsJSONRequestBody{
+				PvzId:      uuid.New(),
+				Type:       "одежда",
+				Dimensions: &dto.ProductDimensions{Length: 10, Width: 0, Height: 10},
+			},
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "duplicate barcode",
+			body: dto.PostProductsJSONRequestBody{PvzId: uuid.New(), Type: "одежда"},
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("AddProductPVZ", mock.Anything, mock.Anything).
+					Return(nil, xerr.NewErr("op", pService.ProductAlreadyExists)).Once()
+			},
+			wantStatus: http.StatusConflict,
 		},
 		{
 			name: "service error",
@@ -334,14 +368,209 @@ func TestHandlers_AddProductHandler(t *testing.T) {
 	}
 }
 
+func TestHandlers_AddProductsBatchHandler(t *testing.T) {
+	t.Parallel()
+
+	validBody := dto.PostProductsBatchJSONRequestBody{
+		PvzId:    uuid.New(),
+		Products: []dto.NewProduct{{Type: "одежда"}, {Type: "обувь"}},
+	}
+
+	tests := []struct {
+		name       string
+		body       any
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: validBody,
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("AddProductsBatch", mock.Anything, mock.Anything).
+					Return(&domain.ProductsBatchResult{
+						Added: 1,
+						Items: []*domain.BatchItemResult{
+							{Status: domain.BatchItemAdded, Product: &domain.Product{}},
+							{Status: domain.BatchItemDuplicateBarcode},
+						},
+					}, nil).Once()
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "nothing added",
+			body: validBody,
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("AddProductsBatch", mock.Anything, mock.Anything).
+					Return(&domain.ProductsBatchResult{
+						Items: []*domain.BatchItemResult{
+							{Status: domain.BatchItemDuplicateBarcode},
+							{Status: domain.BatchItemNotAdded},
+						},
+					}, nil).Once()
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "empty products",
+			body:       dto.PostProductsBatchJSONRequestBody{PvzId: uuid.New()},
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "invalid product",
+			body: dto.PostProductsBatchJSONRequestBody{
+				PvzId:    uuid.New(),
+				Products: []dto.NewProduct{{Type: "одежда"}, {Type: "мебель"}},
+			},
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "too many products",
+			body: dto.PostProductsBatchJSONRequestBody{
+				PvzId:    uuid.New(),
+				Products: make([]dto.NewProduct, domain.MaxProductsBatchSize+1),
+			},
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "no active reception",
+			body: validBody,
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("AddProductsBatch", mock.Anything, mock.Anything).
+					Return(nil, xerr.NewErr("op", pService.NoActiveReception)).Once()
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "service error",
+			body: validBody,
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("AddProductsBatch", mock.Anything, mock.Anything).
+					Return(nil, assert.AnError).Once()
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:       "wrong body error",
+			body:       "",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			bodyBytes, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/products/batch", bytes.NewReader(bodyBytes))
+			rr := httptest.NewRecorder()
+
+			err := h.AddProductsBatchHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if assert.ErrorAs(t, err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+			}
+		})
+	}
+}
+
+func TestHandlers_ProductByBarcodeHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		barcode    string
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+	}{
+		{
+			name:    "success",
+			barcode: "4601234567890",
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ProductsByBarcode", mock.Anything, "4601234567890").
+					Return([]*domain.ProductInfo{{
+						Product:   &domain.Product{},
+						Reception: &domain.Reception{},
+						Pvz:       &domain.Pvz{},
+					}}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "empty barcode",
+			barcode:    "",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "not found",
+			barcode: "unknown",
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ProductsByBarcode", mock.Anything, "unknown").
+					Return(nil, xerr.NewErr("op", pService.ProductNotFound)).Once()
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:    "service error",
+			barcode: "4601234567890",
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ProductsByBarcode", mock.Anything, "4601234567890").
+					Return(nil, assert.AnError).Once()
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			req := httptest.NewRequest(http.MethodGet, "/products/"+tt.barcode, nil)
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("barcode", tt.barcode)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			rr := httptest.NewRecorder()
+
+			err := h.ProductByBarcodeHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+			}
+		})
+	}
+}
+
 func TestHandlers_DeleteLastProductHandler(t *testing.T) {
 	t.Parallel()
 
 	pvzID := uuid.New()
+	userID := uuid.New()
 
 	tests := []struct {
 		name       string
 		pvzID      string
+		noClaims   bool
 		setup      func(f *handlerWithMocks)
 		wantStatus int
 	}{
@@ -349,7 +578,7 @@ func TestHandlers_DeleteLastProductHandler(t *testing.T) {
 			name:  "success",
 			pvzID: pvzID.String(),
 			setup: func(f *handlerWithMocks) {
-				f.appService.On("DeleteLastProductPvz", mock.Anything, &pvzID).
+				f.appService.On("DeleteLastProductPvz", mock.Anything, &pvzID, &userID).
 					Return(nil).Once()
 			},
 			wantStatus: http.StatusOK,
@@ -364,11 +593,18 @@ func TestHandlers_DeleteLastProductHandler(t *testing.T) {
 			name:  "service error",
 			pvzID: pvzID.String(),
 			setup: func(f *handlerWithMocks) {
-				f.appService.On("DeleteLastProductPvz", mock.Anything, &pvzID).
+				f.appService.On("DeleteLastProductPvz", mock.Anything, &pvzID, &userID).
 					Return(assert.AnError).Once()
 			},
 			wantStatus: http.StatusInternalServerError,
 		},
+		{
+			name:       "no claims in context",
+			pvzID:      pvzID.String(),
+			noClaims:   true,
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusInternalServerError,
+		},
 	}
 
 	for _, tt := range tests {
@@ -382,6 +618,9 @@ func TestHandlers_DeleteLastProductHandler(t *testing.T) {
 			chiCtx := chi.NewRouteContext()
 			chiCtx.URLParams.Add("pvzId", tt.pvzID)
 			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			if !tt.noClaims {
+				req = withClaims(req, userID, auth.UserRoleEmployee)
+			}
 			rr := httptest.NewRecorder()
 
 			err := h.DeleteLastProductHandler(rr, req)
@@ -398,40 +637,50 @@ func TestHandlers_DeleteLastProductHandler(t *testing.T) {
 	}
 }
 
-func TestHandlers_CloseReceptionHandler(t *testing.T) {
+func TestHandlers_DeleteProductHandler(t *testing.T) {
 	t.Parallel()
 
-	pvzID := uuid.New()
+	productID := uuid.New()
+	userID := uuid.New()
 
 	tests := []struct {
 		name       string
-		pvzID      string
+		productID  string
 		setup      func(f *handlerWithMocks)
 		wantStatus int
 	}{
 		{
-			name:  "success",
-			pvzID: pvzID.String(),
+			name:      "success",
+			productID: productID.String(),
 			setup: func(f *handlerWithMocks) {
-				f.appService.On("CloseReceptionInPvz", mock.Anything, &pvzID).
+				f.appService.On("DeleteProduct", mock.Anything, &productID, &userID).
 					Return(nil).Once()
 			},
 			wantStatus: http.StatusOK,
 		},
 		{
-			name:       "invalid pvzId",
-			pvzID:      "invalid-uuid",
+			name:       "invalid productId",
+			productID:  "invalid-uuid",
 			setup:      func(f *handlerWithMocks) {},
 			wantStatus: http.StatusBadRequest,
 		},
 		{
-			name:  "service error",
-			pvzID: pvzID.String(),
+			name:      "product not found",
+			productID: productID.String(),
 			setup: func(f *handlerWithMocks) {
-				f.appService.On("CloseReceptionInPvz", mock.Anything, &pvzID).
-					Return(assert.AnError).Once()
+				f.appService.On("DeleteProduct", mock.Anything, &productID, &userID).
+					Return(xerr.NewErr("op", pService.ProductNotFound)).Once()
 			},
-			wantStatus: http.StatusInternalServerError,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:      "reception closed",
+			productID: productID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("DeleteProduct", mock.Anything, &productID, &userID).
+					Return(xerr.NewErr("op", pService.ReceptionNotInProgress)).Once()
+			},
+			wantStatus: http.StatusConflict,
 		},
 	}
 
@@ -442,13 +691,14 @@ func TestHandlers_CloseReceptionHandler(t *testing.T) {
 			h, f := setup(t)
 			tt.setup(f)
 
-			req := httptest.NewRequest(http.MethodPost, "/receptions/"+tt.pvzID+"/close", nil)
+			req := httptest.NewRequest(http.MethodDelete, "/products/"+tt.productID, nil)
 			chiCtx := chi.NewRouteContext()
-			chiCtx.URLParams.Add("pvzId", tt.pvzID)
+			chiCtx.URLParams.Add("productId", tt.productID)
 			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			req = withClaims(req, userID, auth.UserRoleEmployee)
 			rr := httptest.NewRecorder()
 
-			err := h.CloseReceptionHandler(rr, req)
+			err := h.DeleteProductHandler(rr, req)
 
 			if err != nil {
 				var httpErr *HTTPError
@@ -462,51 +712,57 @@ func TestHandlers_CloseReceptionHandler(t *testing.T) {
 	}
 }
 
-func TestHandlers_GetPvzHandler(t *testing.T) {
+func TestHandlers_RestoreProductHandler(t *testing.T) {
 	t.Parallel()
 
+	productID := uuid.New()
+
 	tests := []struct {
 		name       string
-		url        string
+		productID  string
 		setup      func(f *handlerWithMocks)
 		wantStatus int
 	}{
 		{
-			name: "success",
-			url:  "/pvz",
+			name:      "success",
+			productID: productID.String(),
 			setup: func(f *handlerWithMocks) {
-				f.appService.On("GetPvzsData", mock.Anything, mock.Anything).
-					Return([]*domain.PvzReceptions{}, nil).Once()
+				f.appService.On("RestoreProduct", mock.Anything, &productID).
+					Return(&domain.Product{Id: productID}, nil).Once()
 			},
 			wantStatus: http.StatusOK,
 		},
 		{
-			name:       "bad query params",
-			url:        "/pvz?page=abc",
+			name:       "invalid productId",
+			productID:  "invalid-uuid",
 			setup:      func(f *handlerWithMocks) {},
 			wantStatus: http.StatusBadRequest,
 		},
 		{
-			name: "service error",
-			url:  "/pvz",
+			name:      "nothing to restore",
+			productID: productID.String(),
 			setup: func(f *handlerWithMocks) {
-				f.appService.On("GetPvzsData", mock.Anything, mock.Anything).
-					Return(nil, assert.AnError).Once()
+				f.appService.On("RestoreProduct", mock.Anything, &productID).
+					Return(nil, xerr.NewErr("op", pService.DeletedProductNotFound)).Once()
 			},
-			wantStatus: http.StatusInternalServerError,
+			wantStatus: http.StatusNotFound,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
+
 			h, f := setup(t)
 			tt.setup(f)
 
-			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			req := httptest.NewRequest(http.MethodPost, "/products/"+tt.productID+"/restore", nil)
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("productId", tt.productID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
 			rr := httptest.NewRecorder()
 
-			err := h.GetPvzHandler(rr, req)
+			err := h.RestoreProductHandler(rr, req)
 
 			if err != nil {
 				var httpErr *HTTPError
@@ -520,17 +776,1542 @@ func TestHandlers_GetPvzHandler(t *testing.T) {
 	}
 }
 
-func TestHandlers_HealthZ(t *testing.T) {
+func TestHandlers_IssuePickupCodeHandler(t *testing.T) {
 	t.Parallel()
 
-	h, _ := setup(t)
-	req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
-	rr := httptest.NewRecorder()
+	productID := uuid.New()
+
+	tests := []struct {
+		name       string
+		productID  string
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+	}{
+		{
+			name:      "success",
+			productID: productID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("IssuePickupCode", mock.Anything, &productID).
+					Return("042195", nil).Once()
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "invalid productId",
+			productID:  "invalid-uuid",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "product already issued",
+			productID: productID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("IssuePickupCode", mock.Anything, &productID).
+					Return("", xerr.NewErr("op", pService.ProductNotInStorage)).Once()
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			req := httptest.NewRequest(http.MethodPost, "/products/"+tt.productID+"/pickup_code", nil)
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("productId", tt.productID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			rr := httptest.NewRecorder()
+
+			err := h.IssuePickupCodeHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+				assert.Contains(t, rr.Body.String(), "042195")
+				assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+			}
+		})
+	}
+}
+
+func TestHandlers_IssueProductHandler(t *testing.T) {
+	t.Parallel()
 
-	err := h.HealthZ(rr, req)
+	productID := uuid.New()
+	userID := uuid.New()
 
-	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, rr.Code)
+	tests := []struct {
+		name       string
+		productID  string
+		body       string
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+	}{
+		{
+			name:      "success",
+			productID: productID.String(),
+			body:      `{"pickupCode":"042195"}`,
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("IssueProduct", mock.Anything, &productID, "042195", &userID).
+					Return(&domain.Product{Id: productID, Status: domain.ProductIssued}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid productId",
+			productID:  "invalid-uuid",
+			body:       `{"pickupCode":"042195"}`,
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "malformed pickup code",
+			productID:  productID.String(),
+			body:       `{"pickupCode":"12ab"}`,
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:      "wrong pickup code",
+			productID: productID.String(),
+			body:      `{"pickupCode":"000000"}`,
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("IssueProduct", mock.Anything, &productID, "000000", &userID).
+					Return(nil, xerr.NewErr("op", pService.WrongPickupCode)).Once()
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:      "reception not closed",
+			productID: productID.String(),
+			body:      `{"pickupCode":"042195"}`,
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("IssueProduct", mock.Anything, &productID, "042195", &userID).
+					Return(nil, xerr.NewErr("op", pService.ReceptionNotClosed)).Once()
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			req := httptest.NewRequest(http.MethodPost, "/products/"+tt.productID+"/issue", bytes.NewBufferString(tt.body))
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("productId", tt.productID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			req = withClaims(req, userID, auth.UserRoleEmployee)
+			rr := httptest.NewRecorder()
+
+			err := h.IssueProductHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+				assert.Contains(t, rr.Body.String(), `"status": "issued"`)
+			}
+		})
+	}
+}
+
+func TestHandlers_ReturnProductHandler(t *testing.T) {
+	t.Parallel()
+
+	productID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name       string
+		productID  string
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+	}{
+		{
+			name:      "success",
+			productID: productID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ReturnProduct", mock.Anything, &productID, &userID).
+					Return(&domain.Product{Id: productID, Status: domain.ProductReturned}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid productId",
+			productID:  "invalid-uuid",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "storage period is not over",
+			productID: productID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ReturnProduct", mock.Anything, &productID, &userID).
+					Return(nil, xerr.NewErr("op", pService.StorageNotExpired)).Once()
+			},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			req := httptest.NewRequest(http.MethodPost, "/products/"+tt.productID+"/return", nil)
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("productId", tt.productID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			req = withClaims(req, userID, auth.UserRoleEmployee)
+			rr := httptest.NewRecorder()
+
+			err := h.ReturnProductHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+			}
+		})
+	}
+}
+
+func TestHandlers_ProductStatusHistoryHandler(t *testing.T) {
+	t.Parallel()
+
+	productID := uuid.New()
+
+	tests := []struct {
+		name       string
+		productID  string
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+	}{
+		{
+			name:      "success",
+			productID: productID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ProductStatusHistory", mock.Anything, &productID).
+					Return([]*domain.ProductStatusChange{{Status: domain.ProductInStorage}}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid productId",
+			productID:  "invalid-uuid",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "product not found",
+			productID: productID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ProductStatusHistory", mock.Anything, &productID).
+					Return(nil, xerr.NewErr("op", pService.ProductNotFound)).Once()
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			req := httptest.NewRequest(http.MethodGet, "/products/"+tt.productID+"/history", nil)
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("productId", tt.productID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			rr := httptest.NewRecorder()
+
+			err := h.ProductStatusHistoryHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+			}
+		})
+	}
+}
+
+func TestHandlers_ProductDeletionsHandler(t *testing.T) {
+	t.Parallel()
+
+	recID := uuid.New()
+
+	tests := []struct {
+		name       string
+		recID      string
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+	}{
+		{
+			name:  "success",
+			recID: recID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ProductDeletions", mock.Anything, &recID).
+					Return([]*domain.ProductDeletion{{Id: uuid.New(), Product: &domain.Product{}}}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid receptionId",
+			recID:      "invalid-uuid",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "service error",
+			recID: recID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ProductDeletions", mock.Anything, &recID).
+					Return(nil, assert.AnError).Once()
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			req := httptest.NewRequest(http.MethodGet, "/receptions/"+tt.recID+"/deletions", nil)
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("receptionId", tt.recID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			rr := httptest.NewRecorder()
+
+			err := h.ProductDeletionsHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+			}
+		})
+	}
+}
+
+func TestHandlers_CloseReceptionHandler(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	version := 2
+
+	tests := []struct {
+		name       string
+		pvzID      string
+		ifMatch    string
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+		wantETag   string
+	}{
+		{
+			name:    "success",
+			pvzID:   pvzID.String(),
+			ifMatch: `"2"`,
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("CloseReceptionInPvz", mock.Anything, &pvzID, &version).
+					Return(&domain.ReceptionCloseReport{
+						Reception: &domain.Reception{PvzId: pvzID, Status: domain.Close, Version: 3},
+						Manifest:  &domain.ManifestReport{Expected: 1, Missing: []string{"a1"}, Unexpected: []string{}},
+					}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+			wantETag:   `"3"`,
+		},
+		{
+			name:    "version mismatch",
+			pvzID:   pvzID.String(),
+			ifMatch: `"2"`,
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("CloseReceptionInPvz", mock.Anything, &pvzID, &version).
+					Return(nil, xerr.NewErr("op", pService.VersionMismatch)).Once()
+			},
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:       "weak if-match",
+			pvzID:      pvzID.String(),
+			ifMatch:    `W/"2"`,
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid pvzId",
+			pvzID:      "invalid-uuid",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "service error",
+			pvzID: pvzID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("CloseReceptionInPvz", mock.Anything, &pvzID, (*int)(nil)).
+					Return(nil, assert.AnError).Once()
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			req := httptest.NewRequest(http.MethodPost, "/receptions/"+tt.pvzID+"/close", nil)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("pvzId", tt.pvzID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			rr := httptest.NewRecorder()
+
+			err := h.CloseReceptionHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+				assert.Equal(t, tt.wantETag, rr.Header().Get("ETag"))
+			}
+		})
+	}
+}
+
+func TestHandlers_PvzStockHandler(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+
+	tests := []struct {
+		name       string
+		pvzID      string
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:  "success",
+			pvzID: pvzID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("PvzStock", mock.Anything, &pvzID).
+					Return(&domain.PvzStock{PvzId: pvzID, OnHand: 7, Capacity: 10}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `"free": 3`,
+		},
+		{
+			name:       "invalid pvzId",
+			pvzID:      "invalid-uuid",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "pvz not found",
+			pvzID: pvzID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("PvzStock", mock.Anything, &pvzID).
+					Return(nil, xerr.NewErr("op", pService.PvzNotFound)).Once()
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			req := httptest.NewRequest(http.MethodGet, "/pvz/"+tt.pvzID+"/stats", nil)
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("pvzId", tt.pvzID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			rr := httptest.NewRecorder()
+
+			err := h.PvzStockHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+				assert.Contains(t, rr.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestHandlers_PvzByIdHandler(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+
+	tests := []struct {
+		name        string
+		pvzID       string
+		ifNoneMatch string
+		setup       func(f *handlerWithMocks)
+		wantStatus  int
+	}{
+		{
+			name:  "success",
+			pvzID: pvzID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("Pvz", mock.Anything, &pvzID).
+					Return(&domain.Pvz{Id: pvzID, City: domain.Moscow, Version: 1}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:        "not modified",
+			pvzID:       pvzID.String(),
+			ifNoneMatch: `"1"`,
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("Pvz", mock.Anything, &pvzID).
+					Return(&domain.Pvz{Id: pvzID, City: domain.Moscow, Version: 1}, nil).Once()
+			},
+			wantStatus: http.StatusNotModified,
+		},
+		{
+			name:       "invalid pvzId",
+			pvzID:      "invalid-uuid",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "pvz not found",
+			pvzID: pvzID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("Pvz", mock.Anything, &pvzID).
+					Return(nil, xerr.NewErr("op", pService.PvzNotFound)).Once()
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			req := httptest.NewRequest(http.MethodGet, "/pvz/"+tt.pvzID, nil)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("pvzId", tt.pvzID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			rr := httptest.NewRecorder()
+
+			err := h.PvzByIdHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+				assert.Equal(t, `"1"`, rr.Header().Get("ETag"))
+			}
+		})
+	}
+}
+
+func TestHandlers_ReceptionByIdHandler(t *testing.T) {
+	t.Parallel()
+
+	recID := uuid.New()
+
+	tests := []struct {
+		name        string
+		recID       string
+		ifNoneMatch string
+		setup       func(f *handlerWithMocks)
+		wantStatus  int
+	}{
+		{
+			name:        "changed since the cached version",
+			recID:       recID.String(),
+			ifNoneMatch: `"1"`,
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("Reception", mock.Anything, &recID).
+					Return(&domain.Reception{Id: recID, Status: domain.Close, Version: 2}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:        "not modified",
+			recID:       recID.String(),
+			ifNoneMatch: `"1", W/"2"`,
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("Reception", mock.Anything, &recID).
+					Return(&domain.Reception{Id: recID, Status: domain.Close, Version: 2}, nil).Once()
+			},
+			wantStatus: http.StatusNotModified,
+		},
+		{
+			name:  "not found",
+			recID: recID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("Reception", mock.Anything, &recID).
+					Return(nil, xerr.NewErr("op", pService.ReceptionNotFound)).Once()
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			req := httptest.NewRequest(http.MethodGet, "/receptions/"+tt.recID, nil)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("receptionId", tt.recID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			rr := httptest.NewRecorder()
+
+			err := h.ReceptionByIdHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+				assert.Equal(t, `"2"`, rr.Header().Get("ETag"))
+			}
+		})
+	}
+}
+
+func TestHandlers_CancelReceptionHandler(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+
+	tests := []struct {
+		name       string
+		pvzID      string
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+	}{
+		{
+			name:  "success",
+			pvzID: pvzID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("CancelReceptionInPvz", mock.Anything, &pvzID, (*int)(nil)).
+					Return(&domain.Reception{PvzId: pvzID, Status: domain.Cancelled}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid pvzId",
+			pvzID:      "invalid-uuid",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "no active reception",
+			pvzID: pvzID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("CancelReceptionInPvz", mock.Anything, &pvzID, (*int)(nil)).
+					Return(nil, xerr.NewErr("op", pService.NoActiveReception)).Once()
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			req := httptest.NewRequest(http.MethodPost, "/pvz/"+tt.pvzID+"/cancel_last_reception", nil)
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("pvzId", tt.pvzID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			rr := httptest.NewRecorder()
+
+			err := h.CancelReceptionHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+			}
+		})
+	}
+}
+
+func TestHandlers_ReopenReceptionHandler(t *testing.T) {
+	t.Parallel()
+
+	recID := uuid.New()
+
+	tests := []struct {
+		name       string
+		recID      string
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+	}{
+		{
+			name:  "success",
+			recID: recID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ReopenReception", mock.Anything, &recID, (*int)(nil)).
+					Return(&domain.Reception{Id: recID, Status: domain.InProgress}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid receptionId",
+			recID:      "invalid-uuid",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "not found",
+			recID: recID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ReopenReception", mock.Anything, &recID, (*int)(nil)).
+					Return(nil, xerr.NewErr("op", pService.ReceptionNotFound)).Once()
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:  "window expired",
+			recID: recID.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ReopenReception", mock.Anything, &recID, (*int)(nil)).
+					Return(nil, xerr.NewErr("op", pService.ReceptionNotReopenable)).Once()
+			},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			req := httptest.NewRequest(http.MethodPost, "/receptions/"+tt.recID+"/reopen", nil)
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("receptionId", tt.recID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			rr := httptest.NewRecorder()
+
+			err := h.ReopenReceptionHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+			}
+		})
+	}
+}
+
+func TestHandlers_UpdatePvzHandler(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	version := 1
+
+	tests := []struct {
+		name       string
+		pvzID      string
+		ifMatch    string
+		body       any
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+		wantETag   string
+	}{
+		{
+			name:    "success",
+			pvzID:   pvzID.String(),
+			ifMatch: `"1"`,
+			body:    dto.PVZ{City: dto.PVZCityKazan},
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("UpdatePvz", mock.Anything, &pvzID, domain.Kazan, &version).
+					Return(&domain.Pvz{Id: pvzID, City: domain.Kazan, Version: 2}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+			wantETag:   `"2"`,
+		},
+		{
+			name:    "stale etag",
+			pvzID:   pvzID.String(),
+			ifMatch: `"1"`,
+			body:    dto.PVZ{City: dto.PVZCityKazan},
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("UpdatePvz", mock.Anything, &pvzID, domain.Kazan, &version).
+					Return(nil, xerr.NewErr("op", pService.VersionMismatch)).Once()
+			},
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:  "not found",
+			pvzID: pvzID.String(),
+			body:  dto.PVZ{City: dto.PVZCityMoscow},
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("UpdatePvz", mock.Anything, &pvzID, domain.Moscow, (*int)(nil)).
+					Return(nil, xerr.NewErr("op", pService.PvzNotFound)).Once()
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "invalid pvzId",
+			pvzID:      "invalid-uuid",
+			body:       dto.PVZ{City: dto.PVZCityMoscow},
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid If-Match",
+			pvzID:      pvzID.String(),
+			ifMatch:    `W/"1"`,
+			body:       dto.PVZ{City: dto.PVZCityMoscow},
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid city",
+			pvzID:      pvzID.String(),
+			body:       map[string]any{"city": "Тверь"},
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			bodyBytes, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPut, "/pvz/"+tt.pvzID, bytes.NewReader(bodyBytes))
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("pvzId", tt.pvzID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			rr := httptest.NewRecorder()
+
+			err := h.UpdatePvzHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if assert.ErrorAs(t, err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+				assert.Equal(t, tt.wantETag, rr.Header().Get("ETag"))
+			}
+			f.appService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandlers_SetReceptionManifestHandler(t *testing.T) {
+	t.Parallel()
+
+	recID := uuid.New()
+
+	tests := []struct {
+		name       string
+		recID      string
+		body       any
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+	}{
+		{
+			name:  "success",
+			recID: recID.String(),
+			body:  dto.PutReceptionsReceptionIdManifestJSONRequestBody{Barcodes: []string{"a1", "b2"}},
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("SetReceptionManifest", mock.Anything, &domain.ReceptionManifest{
+					ReceptionId: recID,
+					Barcodes:    []string{"a1", "b2"},
+				}).Return(&domain.ReceptionManifest{ReceptionId: recID, Barcodes: []string{"a1", "b2"}}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid receptionId",
+			recID:      "invalid-uuid",
+			body:       dto.PutReceptionsReceptionIdManifestJSONRequestBody{Barcodes: []string{"a1"}},
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing barcodes",
+			recID:      recID.String(),
+			body:       map[string]any{},
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "invalid barcode",
+			recID:      recID.String(),
+			body:       dto.PutReceptionsReceptionIdManifestJSONRequestBody{Barcodes: []string{""}},
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:  "reception closed",
+			recID: recID.String(),
+			body:  dto.PutReceptionsReceptionIdManifestJSONRequestBody{Barcodes: []string{"a1"}},
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("SetReceptionManifest", mock.Anything, mock.Anything).
+					Return(nil, xerr.NewErr("op", pService.ReceptionNotInProgress)).Once()
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			bodyBytes, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPut, "/receptions/"+tt.recID+"/manifest", bytes.NewReader(bodyBytes))
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("receptionId", tt.recID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			rr := httptest.NewRecorder()
+
+			err := h.SetReceptionManifestHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if assert.ErrorAs(t, err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+			}
+		})
+	}
+}
+
+func TestHandlers_GetPvzHandler(t *testing.T) {
+	t.Parallel()
+
+	pageCursor := &domain.PvzsCursor{RegistrationDate: time.Now().UTC(), Id: uuid.New()}
+	cursor := encodePvzsCursor(pageCursor)
+	total := 42
+
+	tests := []struct {
+		name        string
+		url         string
+		setup       func(f *handlerWithMocks)
+		wantStatus  int
+		wantHeaders map[string]string
+	}{
+		{
+			name: "success",
+			url:  "/pvz",
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("GetPvzsData", mock.Anything, mock.Anything).
+					Return(&domain.PvzsPage{Items: []*domain.PvzReceptions{}}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "next cursor and total",
+			url:  "/pvz?cursor=" + cursor + "&withTotal=true",
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("GetPvzsData", mock.Anything, mock.MatchedBy(func(p *domain.PvzsReadParams) bool {
+					return p.WithTotal && p.Cursor != nil && *p.Cursor == *pageCursor
+				})).Return(&domain.PvzsPage{
+					Items:      []*domain.PvzReceptions{},
+					NextCursor: pageCursor,
+					Total:      &total,
+				}, nil).Once()
+			},
+			wantStatus:  http.StatusOK,
+			wantHeaders: map[string]string{"X-Next-Cursor": cursor, "X-Total-Count": "42"},
+		},
+		{
+			name:       "bad query params",
+			url:        "/pvz?page=abc",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid cursor",
+			url:        "/pvz?cursor=abc",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "service error",
+			url:  "/pvz",
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("GetPvzsData", mock.Anything, mock.Anything).
+					Return(nil, assert.AnError).Once()
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h, f := setup(t)
+			tt.setup(f)
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rr := httptest.NewRecorder()
+
+			err := h.GetPvzHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+			} else {
+				assert.Equal(t, tt.wantStatus, rr.Code)
+				for k, v := range tt.wantHeaders {
+					assert.Equal(t, v, rr.Header().Get(k))
+				}
+			}
+		})
+	}
+}
+
+func TestHandlers_GetPvzHandler_NDJSON(t *testing.T) {
+	t.Parallel()
+
+	items := []*domain.PvzReceptions{
+		{Pvz: &domain.Pvz{Id: uuid.New(), City: domain.Moscow}},
+		{Pvz: &domain.Pvz{Id: uuid.New(), City: domain.Kazan}},
+	}
+	yieldAll := func(args mock.Arguments) {
+		yield := args.Get(2).(func(*domain.PvzReceptions) error)
+		for _, it := range items {
+			if err := yield(it); err != nil {
+				return
+			}
+		}
+	}
+	ndjsonReq := func(url string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		req.Header.Set("Accept", "application/x-ndjson; q=1.0, application/json; q=0.5")
+		return req
+	}
+
+	t.Run("streams lines with trailers", func(t *testing.T) {
+		t.Parallel()
+		h, f := setup(t)
+
+		next := &domain.PvzsCursor{RegistrationDate: time.Now().UTC(), City: domain.Kazan, Id: items[1].Pvz.Id}
+		total := 7
+		f.appService.On("StreamPvzsData", mock.Anything, mock.MatchedBy(func(p *domain.PvzsReadParams) bool {
+			return p.Limit == 500
+		}), mock.Anything).Run(yieldAll).Return(&domain.PvzsPage{NextCursor: next, Total: &total}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		err := h.GetPvzHandler(rr, ndjsonReq("/pvz?limit=500&withTotal=true"))
+
+		assert.NoError(t, err)
+		res := rr.Result()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/x-ndjson", res.Header.Get("Content-Type"))
+		assert.Equal(t, encodePvzsCursor(next), res.Trailer.Get("X-Next-Cursor"))
+		assert.Equal(t, "7", res.Trailer.Get("X-Total-Count"))
+
+		dec := json.NewDecoder(rr.Body)
+		for _, it := range items {
+			var line dto.PvzReceptions
+			if assert.NoError(t, dec.Decode(&line)) {
+				assert.Equal(t, it.Pvz.Id, *line.Pvz.Id)
+			}
+		}
+		assert.False(t, dec.More())
+	})
+
+	t.Run("limit above stream maximum falls back to default", func(t *testing.T) {
+		t.Parallel()
+		h, f := setup(t)
+
+		f.appService.On("StreamPvzsData", mock.Anything, mock.MatchedBy(func(p *domain.PvzsReadParams) bool {
+			return p.Limit == defaultLimit
+		}), mock.Anything).Return(&domain.PvzsPage{}, nil).Once()
+
+		rr := httptest.NewRecorder()
+		err := h.GetPvzHandler(rr, ndjsonReq("/pvz?limit=5000"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Empty(t, rr.Body.String())
+	})
+
+	t.Run("error before first line", func(t *testing.T) {
+		t.Parallel()
+		h, f := setup(t)
+
+		f.appService.On("StreamPvzsData", mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, assert.AnError).Once()
+
+		err := h.GetPvzHandler(httptest.NewRecorder(), ndjsonReq("/pvz"))
+
+		var httpErr *HTTPError
+		if assert.True(t, errors.As(err, &httpErr)) {
+			assert.Equal(t, http.StatusInternalServerError, httpErr.Code)
+		}
+	})
+
+	t.Run("error mid stream aborts the response", func(t *testing.T) {
+		t.Parallel()
+		h, f := setup(t)
+
+		f.appService.On("StreamPvzsData", mock.Anything, mock.Anything, mock.Anything).
+			Run(yieldAll).Return(nil, assert.AnError).Once()
+
+		rr := httptest.NewRecorder()
+		assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+			_ = h.GetPvzHandler(rr, ndjsonReq("/pvz"))
+		})
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
+
+func TestHandlers_ExportReceptionsHandler(t *testing.T) {
+	t.Parallel()
+
+	writeRows := func(args mock.Arguments) {
+		w := args.Get(4).(io.Writer)
+		_, _ = io.WriteString(w, "pvz_id,city\n")
+	}
+
+	t.Run("streams csv", func(t *testing.T) {
+		t.Parallel()
+		h, f := setup(t)
+
+		f.appService.On("ExportPvzsData", mock.Anything, domain.ExportCSV,
+			mock.MatchedBy(func(filter *domain.PvzsFilter) bool {
+				return len(filter.Cities) == 1 && filter.Cities[0] == domain.Kazan
+			}),
+			domain.PvzsSort{Field: domain.PvzsSortByCity, Desc: true},
+			mock.Anything,
+		).Run(writeRows).Return(nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet,
+			"/exports/receptions?format=csv&city=%D0%9A%D0%B0%D0%B7%D0%B0%D0%BD%D1%8C&sortBy=city&sortOrder=desc", nil)
+		rr := httptest.NewRecorder()
+
+		err := h.ExportReceptionsHandler(rr, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", rr.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename=receptions.csv`, rr.Header().Get("Content-Disposition"))
+		assert.Equal(t, "pvz_id,city\n", rr.Body.String())
+	})
+
+	t.Run("creates async job", func(t *testing.T) {
+		t.Parallel()
+		h, f := setup(t)
+
+		userId := uuid.New()
+		job := &domain.ExportJob{Id: uuid.New(), Format: domain.ExportXLSX, Status: domain.ExportJobPending}
+		f.appService.On("CreateExportJob", mock.Anything, mock.MatchedBy(func(j *domain.ExportJob) bool {
+			return j.Format == domain.ExportXLSX && j.CreatedBy == userId && j.Filter.HasOpenReception != nil
+		})).Return(job, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/exports/receptions?format=xlsx&async=true&hasOpenReception=false", nil)
+		rr := httptest.NewRecorder()
+
+		err := h.ExportReceptionsHandler(rr, withClaims(req, userId, auth.UserRoleModerator))
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		assert.Equal(t, "/api/v1/exports/jobs/"+job.Id.String(), rr.Header().Get("Location"))
+		var resp dto.ExportJob
+		if assert.NoError(t, json.NewDecoder(rr.Body).Decode(&resp)) {
+			assert.Equal(t, dto.ExportJobPending, resp.Status)
+			assert.Nil(t, resp.DownloadUrl)
+		}
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		t.Parallel()
+
+		for _, url := range []string{
+			"/exports/receptions",
+			"/exports/receptions?format=pdf",
+			"/exports/receptions?format=csv&async=maybe",
+			"/exports/receptions?format=csv&city=Paris",
+		} {
+			h, _ := setup(t)
+			err := h.ExportReceptionsHandler(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, url, nil))
+
+			var httpErr *HTTPError
+			if assert.True(t, errors.As(err, &httpErr), url) {
+				assert.Equal(t, http.StatusBadRequest, httpErr.Code, url)
+			}
+		}
+	})
+
+	t.Run("error before first row", func(t *testing.T) {
+		t.Parallel()
+		h, f := setup(t)
+
+		f.appService.On("ExportPvzsData", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(assert.AnError).Once()
+
+		rr := httptest.NewRecorder()
+		err := h.ExportReceptionsHandler(rr, httptest.NewRequest(http.MethodGet, "/exports/receptions?format=csv", nil))
+
+		var httpErr *HTTPError
+		if assert.True(t, errors.As(err, &httpErr)) {
+			assert.Equal(t, http.StatusInternalServerError, httpErr.Code)
+		}
+		assert.Empty(t, rr.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("error mid export aborts the response", func(t *testing.T) {
+		t.Parallel()
+		h, f := setup(t)
+
+		f.appService.On("ExportPvzsData", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Run(writeRows).Return(assert.AnError).Once()
+
+		rr := httptest.NewRecorder()
+		assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+			_ = h.ExportReceptionsHandler(rr, httptest.NewRequest(http.MethodGet, "/exports/receptions?format=csv", nil))
+		})
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
+
+func TestHandlers_ExportJobHandler(t *testing.T) {
+	t.Parallel()
+
+	jobId := uuid.New()
+
+	tests := []struct {
+		name       string
+		jobId      string
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+		wantUrl    string
+	}{
+		{
+			name:  "done job has download url",
+			jobId: jobId.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ExportJob", mock.Anything, &jobId).
+					Return(&domain.ExportJob{Id: jobId, Format: domain.ExportCSV, Status: domain.ExportJobDone}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+			wantUrl:    "/api/v1/exports/jobs/" + jobId.String() + "/file",
+		},
+		{
+			name:       "invalid jobId",
+			jobId:      "invalid-uuid",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "job not found",
+			jobId: jobId.String(),
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ExportJob", mock.Anything, &jobId).
+					Return(nil, xerr.NewErr("op", pService.ExportJobNotFound)).Once()
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			req := httptest.NewRequest(http.MethodGet, "/exports/jobs/"+tt.jobId, nil)
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("jobId", tt.jobId)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			rr := httptest.NewRecorder()
+
+			err := h.ExportJobHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+				return
+			}
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			var resp dto.ExportJob
+			if assert.NoError(t, json.NewDecoder(rr.Body).Decode(&resp)) && assert.NotNil(t, resp.DownloadUrl) {
+				assert.Equal(t, tt.wantUrl, *resp.DownloadUrl)
+			}
+		})
+	}
+}
+
+func TestHandlers_ExportFileHandler(t *testing.T) {
+	t.Parallel()
+
+	jobId := uuid.New()
+	job := &domain.ExportJob{Id: jobId, Format: domain.ExportXLSX, Status: domain.ExportJobDone}
+
+	tests := []struct {
+		name       string
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "success",
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("OpenExportFile", mock.Anything, &jobId).
+					Return(job, io.NopCloser(strings.NewReader("PK")), nil).Once()
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "PK",
+		},
+		{
+			name: "not ready",
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("OpenExportFile", mock.Anything, &jobId).
+					Return(nil, nil, xerr.NewErr("op", pService.ExportNotReady)).Once()
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			req := httptest.NewRequest(http.MethodGet, "/exports/jobs/"+jobId.String()+"/file", nil)
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("jobId", jobId.String())
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, chiCtx))
+			rr := httptest.NewRecorder()
+
+			err := h.ExportFileHandler(rr, req)
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+				return
+			}
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			assert.Equal(t, xlsxContentType, rr.Header().Get("Content-Type"))
+			assert.Equal(t, "attachment; filename="+job.FileName(), rr.Header().Get("Content-Disposition"))
+			assert.Equal(t, tt.wantBody, rr.Body.String())
+		})
+	}
+}
+
+func TestHandlers_ProductsThroughputHandler(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		url        string
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			url:  "/analytics/throughput?from=2026-10-01T00:00:00Z&to=2026-10-18T00:00:00Z&groupBy=city&period=week",
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ProductsThroughput", mock.Anything, &domain.ThroughputParams{
+					AnalyticsRange: domain.AnalyticsRange{
+						From: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+						To:   time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+					},
+					Period:  domain.AnalyticsWeek,
+					GroupBy: domain.AnalyticsByCity,
+				}).Return([]*domain.ThroughputPoint{{PeriodStart: day, Key: "Москва", Products: 5}}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid range",
+			url:        "/analytics/throughput?from=2026-10-18T00:00:00Z&to=2026-10-01T00:00:00Z",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "service error",
+			url:  "/analytics/throughput",
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ProductsThroughput", mock.Anything, mock.Anything).
+					Return(nil, xerr.NewErr("op", pService.Unexpected)).Once()
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			rr := httptest.NewRecorder()
+			err := h.ProductsThroughputHandler(rr, httptest.NewRequest(http.MethodGet, tt.url, nil))
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+				return
+			}
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			var resp []dto.ThroughputPoint
+			if assert.NoError(t, json.NewDecoder(rr.Body).Decode(&resp)) && assert.Len(t, resp, 1) {
+				assert.Equal(t, day, resp[0].PeriodStart)
+				assert.Equal(t, 5, resp[0].Products)
+			}
+		})
+	}
+}
+
+func TestHandlers_ReceptionStatsHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		url        string
+		setup      func(f *handlerWithMocks)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			url:  "/analytics/receptions?groupBy=city",
+			setup: func(f *handlerWithMocks) {
+				f.appService.On("ReceptionStats", mock.Anything, mock.MatchedBy(func(p *domain.ReceptionStatsParams) bool {
+					return p.GroupBy == domain.AnalyticsByCity && p.To.Sub(p.From) == defaultAnalyticsRange
+				})).Return([]*domain.ReceptionStats{
+					{Key: "Казань", Receptions: 3, AvgDuration: 90 * time.Second, AvgProducts: 2.5},
+				}, nil).Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "unsupported group",
+			url:        "/analytics/receptions?groupBy=productType",
+			setup:      func(f *handlerWithMocks) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, f := setup(t)
+			tt.setup(f)
+
+			rr := httptest.NewRecorder()
+			err := h.ReceptionStatsHandler(rr, httptest.NewRequest(http.MethodGet, tt.url, nil))
+
+			if err != nil {
+				var httpErr *HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, tt.wantStatus, httpErr.Code)
+				}
+				return
+			}
+			assert.Equal(t, tt.wantStatus, rr.Code)
+			var resp []dto.ReceptionStats
+			if assert.NoError(t, json.NewDecoder(rr.Body).Decode(&resp)) && assert.Len(t, resp, 1) {
+				assert.Equal(t, float64(90), resp[0].AvgDurationSeconds)
+				assert.Equal(t, 2.5, resp[0].AvgProductsPerReception)
+			}
+		})
+	}
 }
 
 func TestHandlers_RegisterUserHandler(t *testing.T) {
@@ -644,9 +2425,14 @@ func TestHandlers_LoginUserHandler(t *testing.T) {
 			check: func(t *testing.T, w http.ResponseWriter) {
 				rr := w.(*httptest.ResponseRecorder)
 				cookies := rr.Result().Cookies()
-				assert.Len(t, cookies, 1)
+				require.Len(t, cookies, 2)
 				assert.Equal(t, refreshTokenKey, cookies[0].Name)
 				assert.Equal(t, "refresh-token", cookies[0].Value)
+				assert.Equal(t, "/tokens/refresh", cookies[0].Path)
+				assert.Equal(t, csrfCookieName, cookies[1].Name)
+				assert.NotEmpty(t, cookies[1].Value)
+				assert.False(t, cookies[1].HttpOnly)
+				assert.Equal(t, cookies[1].Value, rr.Header().Get(CSRFHeader))
 			},
 		},
 		{
@@ -741,9 +2527,12 @@ func TestHandlers_RefreshTokensHandler(t *testing.T) {
 			check: func(t *testing.T, w http.ResponseWriter) {
 				rr := w.(*httptest.ResponseRecorder)
 				cookies := rr.Result().Cookies()
-				assert.Len(t, cookies, 1)
+				require.Len(t, cookies, 2)
 				assert.Equal(t, refreshTokenKey, cookies[0].Name)
 				assert.Equal(t, "new-refresh-token", cookies[0].Value)
+				assert.Equal(t, "/api/v1/tokens/refresh", cookies[0].Path)
+				assert.Equal(t, csrfCookieName, cookies[1].Name)
+				assert.Equal(t, cookies[1].Value, rr.Header().Get(CSRFHeader))
 			},
 		},
 		{
@@ -784,7 +2573,7 @@ func TestHandlers_RefreshTokensHandler(t *testing.T) {
 			h, f := setup(t)
 			tt.setup(f)
 
-			req := httptest.NewRequest(http.MethodPost, "/tokens/refresh", nil)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/tokens/refresh", nil)
 			if tt.cookie != nil {
 				req.AddCookie(tt.cookie)
 			}
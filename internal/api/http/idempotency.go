@@ -0,0 +1,187 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/api/http/dto"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+)
+
+const (
+	idempotencyKeyHeader     = "Idempotency-Key"
+	idempotentReplayedHeader = "Idempotent-Replayed"
+	// noStore marks responses carrying secrets, which are never stored for replays.
+	noStore = "no-store"
+)
+
+// replayedHeaders are the response headers stored along with idempotent responses.
+var replayedHeaders = []string{"Content-Type", "Location", etagHeader}
+
+// IdempotencyMW makes POST requests sent with an Idempotency-Key header safe to retry.
+// The response to the first request is stored per user and replayed on retries, while
+// reusing the key for a different request is a conflict. Server errors aren't stored,
+// so that the request may be retried with the same key.
+//
+// Only requests of authenticated users are idempotent. Anonymous ones, like logins
+// and token refreshes, are passed through, so that the keys of different callers
+// never meet and the tokens they get are never stored. Responses setting cookies
+// aren't stored either, as they can't be replayed without them, nor are responses
+// with Cache-Control: no-store, like the ones carrying pickup codes.
+func (m Middlewares) IdempotencyMW(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotencyKeyHeader)
+		if r.Method != http.MethodPost || key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		userId, err := UserIdFromCtx(r)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		req, httpErr := idempotentRequest(w, r, *userId, key)
+		if httpErr != nil {
+			WriteHTTPError(w, r, httpErr)
+			return
+		}
+
+		stored, err := m.appService.BeginIdempotentRequest(r.Context(), req)
+		if err != nil {
+			WriteHTTPError(w, r, mapAppServiceErrsToHTTP(err))
+			return
+		}
+		if stored != nil {
+			writeIdempotentResponse(w, stored)
+			return
+		}
+
+		// The outcome is stored even if the client is gone by then.
+		ctx := context.WithoutCancel(r.Context())
+		l := logger.FromCtx(ctx)
+		rw := &recordingWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		defer func() {
+			if p := recover(); p != nil {
+				if err := m.appService.AbortIdempotentRequest(ctx, req); err != nil {
+					l.Error("Failed to release idempotency key", logger.WithErr(err))
+				}
+				panic(p)
+			}
+		}()
+
+		next.ServeHTTP(rw, r)
+
+		if rw.statusCode >= http.StatusInternalServerError || !replayable(rw.Header()) {
+			if err := m.appService.AbortIdempotentRequest(ctx, req); err != nil {
+				l.Error("Failed to release idempotency key", logger.WithErr(err))
+			}
+			return
+		}
+		if err := m.appService.FinishIdempotentRequest(ctx, req, rw.response()); err != nil {
+			l.Error("Failed to store idempotent response", logger.WithErr(err))
+		}
+	})
+}
+
+// idempotentRequest identifies the request by its user, key, method, path and body.
+// The body is read up front and put back for the handler.
+func idempotentRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+	userId uuid.UUID,
+	key string,
+) (*domain.IdempotentRequest, *HTTPError) {
+	if err := domain.ValidateIdempotencyKey(key); err != nil {
+		return nil, &HTTPError{
+			Code:    http.StatusBadRequest,
+			ErrCode: dto.ErrCodeInvalidIdempotencyKey,
+			Message: "Invalid " + idempotencyKeyHeader + " header",
+			Err:     err,
+		}
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err != nil {
+		return nil, BadRequestBodyError(err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	h.Write(body)
+
+	return &domain.IdempotentRequest{
+		UserId:      userId,
+		Key:         key,
+		Fingerprint: hex.EncodeToString(h.Sum(nil)),
+	}, nil
+}
+
+// replayable tells whether a successful response may be stored and replayed.
+func replayable(h http.Header) bool {
+	if len(h.Values("Set-Cookie")) > 0 {
+		return false
+	}
+	return !strings.Contains(h.Get("Cache-Control"), noStore)
+}
+
+func writeIdempotentResponse(w http.ResponseWriter, resp *domain.IdempotentResponse) {
+	for k, v := range resp.Headers {
+		w.Header().Set(k, v)
+	}
+	w.Header().Set(idempotentReplayedHeader, "true")
+	w.WriteHeader(resp.StatusCode)
+	_, _ = w.Write(resp.Body)
+}
+
+// recordingWriter keeps a copy of the response it writes.
+type recordingWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *recordingWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+
+	w.statusCode = statusCode
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *recordingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *recordingWriter) response() *domain.IdempotentResponse {
+	resp := &domain.IdempotentResponse{
+		StatusCode: w.statusCode,
+		Headers:    make(map[string]string),
+		Body:       w.body.Bytes(),
+	}
+	for _, k := range replayedHeaders {
+		if v := w.Header().Get(k); v != "" {
+			resp.Headers[k] = v
+		}
+	}
+	return resp
+}
@@ -0,0 +1,241 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	"github.com/shrtyk/pvz-service/internal/core/domain/auth"
+	metricsmocks "github.com/shrtyk/pvz-service/internal/core/ports/metrics/mocks"
+	pService "github.com/shrtyk/pvz-service/internal/core/ports/service"
+	pServiceMock "github.com/shrtyk/pvz-service/internal/core/ports/service/mocks"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newIdempotencyMW(t *testing.T) (*pServiceMock.MockService, func(http.Handler) http.Handler) {
+	t.Helper()
+	appService := pServiceMock.NewMockService(t)
+	l, _ := logger.NewTestLogger()
+	return appService, NewMiddlewares(appService, nil, l, new(metricsmocks.MockCollector)).IdempotencyMW
+}
+
+// idempotentPost is a request of an authenticated user, as anonymous ones aren't idempotent.
+func idempotentPost(key, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/receptions", strings.NewReader(body))
+	req.Header.Set(idempotencyKeyHeader, key)
+	return withClaims(req, uuid.New(), auth.UserRoleEmployee)
+}
+
+func idempotentGet() *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/pvz", nil)
+	req.Header.Set(idempotencyKeyHeader, "key")
+	return req
+}
+
+func TestMiddlewares_IdempotencyMW(t *testing.T) {
+	t.Parallel()
+
+	created := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		w.Header().Set("Location", "/receptions/1")
+		w.Header().Set("X-Other", "1")
+		_ = WriteJSON(w, map[string]string{"got": string(b)}, http.StatusCreated, nil)
+	})
+
+	t.Run("requests without key are passed through", func(t *testing.T) {
+		t.Parallel()
+
+		_, mw := newIdempotencyMW(t)
+		for _, req := range []*http.Request{
+			httptest.NewRequest(http.MethodPost, "/receptions", nil),
+			idempotentGet(),
+		} {
+			rr := httptest.NewRecorder()
+			mw(created).ServeHTTP(rr, req)
+			assert.Equal(t, http.StatusCreated, rr.Code)
+		}
+	})
+
+	t.Run("anonymous requests are passed through", func(t *testing.T) {
+		t.Parallel()
+
+		_, mw := newIdempotencyMW(t)
+		req := httptest.NewRequest(http.MethodPost, "/tokens/refresh", nil)
+		req.Header.Set(idempotencyKeyHeader, "key")
+		rr := httptest.NewRecorder()
+		mw(created).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Empty(t, rr.Header().Get(idempotentReplayedHeader))
+	})
+
+	t.Run("responses setting cookies are not stored", func(t *testing.T) {
+		t.Parallel()
+
+		appService, mw := newIdempotencyMW(t)
+		appService.EXPECT().BeginIdempotentRequest(mock.Anything, mock.Anything).Return(nil, nil)
+		appService.EXPECT().AbortIdempotentRequest(mock.Anything, mock.Anything).Return(nil)
+
+		rr := httptest.NewRecorder()
+		mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.SetCookie(w, &http.Cookie{Name: refreshTokenKey, Value: "token"})
+			w.WriteHeader(http.StatusCreated)
+		})).ServeHTTP(rr, idempotentPost("key", "{}"))
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("responses carrying secrets are not stored", func(t *testing.T) {
+		t.Parallel()
+
+		appService, mw := newIdempotencyMW(t)
+		appService.EXPECT().BeginIdempotentRequest(mock.Anything, mock.Anything).Return(nil, nil)
+		appService.EXPECT().AbortIdempotentRequest(mock.Anything, mock.Anything).Return(nil)
+
+		rr := httptest.NewRecorder()
+		mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Cache-Control", "no-store")
+			w.WriteHeader(http.StatusCreated)
+		})).ServeHTTP(rr, idempotentPost("key", "{}"))
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		t.Parallel()
+
+		_, mw := newIdempotencyMW(t)
+		rr := httptest.NewRecorder()
+		mw(created).ServeHTTP(rr, idempotentPost(strings.Repeat("k", domain.MaxIdempotencyKeyLen+1), "{}"))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("first request stores its response", func(t *testing.T) {
+		t.Parallel()
+
+		appService, mw := newIdempotencyMW(t)
+		userId := uuid.New()
+		var begun *domain.IdempotentRequest
+		appService.EXPECT().BeginIdempotentRequest(mock.Anything, mock.Anything).
+			Run(func(_ context.Context, req *domain.IdempotentRequest) { begun = req }).
+			Return(nil, nil)
+		appService.EXPECT().FinishIdempotentRequest(
+			mock.Anything,
+			mock.Anything,
+			mock.MatchedBy(func(resp *domain.IdempotentResponse) bool {
+				return resp.StatusCode == http.StatusCreated &&
+					resp.Headers["Location"] == "/receptions/1" &&
+					resp.Headers["Content-Type"] == "application/json" &&
+					len(resp.Headers) == 2 &&
+					strings.Contains(string(resp.Body), `"got": "{\"pvzId\":\"1\"}"`)
+			}),
+		).Return(nil)
+
+		rr := httptest.NewRecorder()
+		req := withClaims(idempotentPost("key", `{"pvzId":"1"}`), userId, auth.UserRoleEmployee)
+		mw(created).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"got": "{\"pvzId\":\"1\"}"`)
+		require.NotNil(t, begun)
+		assert.Equal(t, userId, begun.UserId)
+		assert.Equal(t, "key", begun.Key)
+		assert.NotEmpty(t, begun.Fingerprint)
+	})
+
+	t.Run("retry is replayed", func(t *testing.T) {
+		t.Parallel()
+
+		appService, mw := newIdempotencyMW(t)
+		appService.EXPECT().BeginIdempotentRequest(mock.Anything, mock.Anything).Return(&domain.IdempotentResponse{
+			StatusCode: http.StatusCreated,
+			Headers:    map[string]string{"Location": "/receptions/1"},
+			Body:       []byte(`{"id":"1"}`),
+		}, nil)
+
+		rr := httptest.NewRecorder()
+		mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("handler must not be called")
+		})).ServeHTTP(rr, idempotentPost("key", "{}"))
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Equal(t, "/receptions/1", rr.Header().Get("Location"))
+		assert.Equal(t, "true", rr.Header().Get(idempotentReplayedHeader))
+		assert.Equal(t, `{"id":"1"}`, rr.Body.String())
+	})
+
+	t.Run("key reused for another request", func(t *testing.T) {
+		t.Parallel()
+
+		appService, mw := newIdempotencyMW(t)
+		appService.EXPECT().BeginIdempotentRequest(mock.Anything, mock.Anything).
+			Return(nil, xerr.NewErr("op", pService.IdempotencyKeyReused))
+
+		rr := httptest.NewRecorder()
+		mw(created).ServeHTTP(rr, idempotentPost("key", "{}"))
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("server error releases the key", func(t *testing.T) {
+		t.Parallel()
+
+		appService, mw := newIdempotencyMW(t)
+		appService.EXPECT().BeginIdempotentRequest(mock.Anything, mock.Anything).Return(nil, nil)
+		appService.EXPECT().AbortIdempotentRequest(mock.Anything, mock.Anything).Return(errors.New("db is down"))
+
+		rr := httptest.NewRecorder()
+		mw(Handle(func(w http.ResponseWriter, r *http.Request) error {
+			return errors.New("boom")
+		})).ServeHTTP(rr, idempotentPost("key", "{}"))
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("panic releases the key", func(t *testing.T) {
+		t.Parallel()
+
+		appService, mw := newIdempotencyMW(t)
+		appService.EXPECT().BeginIdempotentRequest(mock.Anything, mock.Anything).Return(nil, nil)
+		appService.EXPECT().AbortIdempotentRequest(mock.Anything, mock.Anything).Return(nil)
+
+		h := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { panic("boom") }))
+		assert.PanicsWithValue(t, "boom", func() {
+			h.ServeHTTP(httptest.NewRecorder(), idempotentPost("key", "{}"))
+		})
+	})
+}
+
+func Test_idempotentRequest(t *testing.T) {
+	t.Parallel()
+
+	userId := uuid.New()
+	fingerprint := func(r *http.Request) string {
+		req, err := idempotentRequest(httptest.NewRecorder(), r, userId, "key")
+		require.Nil(t, err)
+		return req.Fingerprint
+	}
+
+	base := fingerprint(idempotentPost("key", `{"a":1}`))
+	assert.Equal(t, base, fingerprint(idempotentPost("key", `{"a":1}`)))
+	assert.NotEqual(t, base, fingerprint(idempotentPost("key", `{"a":2}`)))
+	assert.NotEqual(t, base, fingerprint(httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(`{"a":1}`))))
+
+	req, err := idempotentRequest(httptest.NewRecorder(), idempotentPost("key", "{}"), userId, "key")
+	require.Nil(t, err)
+	assert.Equal(t, userId, req.UserId)
+
+	_, err = idempotentRequest(httptest.NewRecorder(), idempotentPost("key", "{}"), userId, "ключ")
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, err.Code)
+
+	big := idempotentPost("key", strings.Repeat("a", maxBodyBytes+1))
+	_, err = idempotentRequest(httptest.NewRecorder(), big, userId, "key")
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, err.Code)
+}
@@ -8,16 +8,24 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/google/uuid"
+	"github.com/go-chi/chi/v5"
+	"github.com/shrtyk/pvz-service/internal/api/http/dto"
 	"github.com/shrtyk/pvz-service/internal/core/domain/auth"
 	pAuth "github.com/shrtyk/pvz-service/internal/core/ports/auth"
 	"github.com/shrtyk/pvz-service/internal/core/ports/metrics"
+	aService "github.com/shrtyk/pvz-service/internal/core/ports/service"
 	ts "github.com/shrtyk/pvz-service/internal/infrastructure/tservice"
 	"github.com/shrtyk/pvz-service/pkg/logger"
+	"github.com/shrtyk/pvz-service/pkg/requestid"
 	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Middlewares struct {
+	appService    aService.Service
 	tokenService  pAuth.TokenService
 	log           *slog.Logger
 	metrics       metrics.Collector
@@ -25,6 +33,7 @@ type Middlewares struct {
 }
 
 func NewMiddlewares(
+	appService aService.Service,
 	tokenService pAuth.TokenService,
 	log *slog.Logger,
 	metrics metrics.Collector,
@@ -34,6 +43,7 @@ func NewMiddlewares(
 	}
 
 	return &Middlewares{
+		appService:    appService,
 		tokenService:  tokenService,
 		log:           log,
 		metrics:       metrics,
@@ -45,6 +55,9 @@ func (m Middlewares) PanicRecoveryMW(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		defer func() {
 			if err := recover(); err != nil {
+				if err == http.ErrAbortHandler {
+					panic(err)
+				}
 				m.log.Error(
 					"Error occurred",
 					"error", fmt.Sprintf("%s", err),
@@ -52,6 +65,7 @@ func (m Middlewares) PanicRecoveryMW(next http.Handler) http.Handler {
 				w.Header().Set("Connection", "close")
 				WriteHTTPError(w, r, &HTTPError{
 					Code:    http.StatusInternalServerError,
+					ErrCode: dto.ErrCodeInternal,
 					Message: "The server encountered a problem and could not process your request",
 					Err:     fmt.Errorf("%s", err),
 				})
@@ -78,6 +92,10 @@ func (w *customResponseWriter) WriteHeader(statusCode int) {
 	w.ResponseWriter.WriteHeader(statusCode)
 }
 
+func (w *customResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 func (w *customResponseWriter) Write(b []byte) (int, error) {
 	if !w.wroteHeader {
 		w.WriteHeader(http.StatusOK)
@@ -85,10 +103,51 @@ func (w *customResponseWriter) Write(b []byte) (int, error) {
 	return w.ResponseWriter.Write(b)
 }
 
+// unmatchedRoute labels the metrics of requests no route was found for, so that
+// arbitrary paths don't turn into label values.
+const unmatchedRoute = "unmatched"
+
+// routePattern returns the pattern of the route that served r, like /api/v1/pvz/{pvzId}.
+// It is known only once the request has been routed.
+func routePattern(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		return rctx.RoutePattern()
+	}
+	return ""
+}
+
+// NewTracingMW starts a span for every request, continuing the trace of the caller when
+// it sends W3C trace context headers. Spans are named after the route that served the
+// request, health checks and metrics scrapes are not traced.
+func NewTracingMW(tp trace.TracerProvider, prop propagation.TextMapPropagator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		routed := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r)
+
+			pattern := routePattern(r)
+			if pattern == "" {
+				return
+			}
+			span := trace.SpanFromContext(r.Context())
+			span.SetName(r.Method + " " + pattern)
+			span.SetAttributes(semconv.HTTPRoute(pattern))
+		})
+
+		return otelhttp.NewHandler(routed, "HTTP request",
+			otelhttp.WithTracerProvider(tp),
+			otelhttp.WithPropagators(prop),
+			otelhttp.WithFilter(func(r *http.Request) bool {
+				return !probePaths[r.URL.Path]
+			}),
+		)
+	}
+}
+
 func (m Middlewares) LoggingMW(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ua, ip := UserAgentAndIP(r)
-		reqID := uuid.NewString()
+		reqID := requestid.FromUpstream(r.Header.Get(requestid.Header))
+		w.Header().Set(requestid.Header, reqID)
 
 		l := m.log.With(
 			slog.String("ip", ip),
@@ -98,8 +157,11 @@ func (m Middlewares) LoggingMW(next http.Handler) http.Handler {
 			slog.String("uri", r.URL.RequestURI()),
 		)
 
+		l = logger.WithTraceID(r.Context(), l)
+
 		l.Debug("New HTTP request")
 		newCtx := logger.ToCtx(r.Context(), l)
+		newCtx = requestid.ToCtx(newCtx, reqID)
 		newReq := r.WithContext(newCtx)
 		custWriter := &customResponseWriter{
 			ResponseWriter: w,
@@ -110,8 +172,12 @@ func (m Middlewares) LoggingMW(next http.Handler) http.Handler {
 		next.ServeHTTP(custWriter, newReq)
 		reqEnd := time.Since(reqStart)
 
-		m.metrics.ObserveHTTPRequestDuration(r.Method, reqEnd.Seconds())
-		m.metrics.IncHTTPRequestsTotal(r.Method, strconv.Itoa(custWriter.statusCode))
+		route := routePattern(newReq)
+		if route == "" {
+			route = unmatchedRoute
+		}
+		m.metrics.ObserveHTTPRequestDuration(r.Method, route, reqEnd.Seconds())
+		m.metrics.IncHTTPRequestsTotal(r.Method, route, strconv.Itoa(custWriter.statusCode))
 
 		ttp := fmt.Sprintf("%.5fs", reqEnd.Seconds())
 		l.Debug(
@@ -124,7 +190,7 @@ func (m Middlewares) LoggingMW(next http.Handler) http.Handler {
 
 func (m Middlewares) AuthenticationMW(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Vary", "Authorization")
+		w.Header().Add("Vary", "Authorization")
 
 		bt, err := BearerToken(r)
 		if err != nil {
@@ -168,3 +234,47 @@ func (m *Middlewares) AuthorizeRoles(allowedRoles ...auth.UserRole) func(http.Ha
 		})
 	}
 }
+
+// OperationSecurityMW enforces the security requirement the generated server wrapper
+// put into the request context. Bearer scopes of an operation list the roles allowed
+// to call it, no scopes let any authenticated user in. Operations without bearer
+// security are passed through.
+func (m *Middlewares) OperationSecurityMW(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scopes, ok := r.Context().Value(dto.BearerAuthScopes).([]string)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(scopes) == 0 {
+			m.AuthenticationMW(next).ServeHTTP(w, r)
+			return
+		}
+
+		roles := make([]auth.UserRole, 0, len(scopes))
+		for _, scope := range scopes {
+			roles = append(roles, auth.UserRole(scope))
+		}
+
+		m.AuthenticationMW(m.AuthorizeRoles(roles...)(next)).ServeHTTP(w, r)
+	})
+}
+
+// DeprecatedMW marks responses of routes superseded by the same routes under
+// successorPrefix. The Deprecation (RFC 9745) and Sunset (RFC 8594) headers tell
+// clients when the routes were deprecated and when they are going to be removed,
+// and the Link header points to the successor of the requested route.
+func DeprecatedMW(successorPrefix string, deprecatedAt, sunset time.Time) func(http.Handler) http.Handler {
+	deprecation := "@" + strconv.FormatInt(deprecatedAt.Unix(), 10)
+	sunsetDate := sunset.UTC().Format(http.TimeFormat)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Deprecation", deprecation)
+			w.Header().Set("Sunset", sunsetDate)
+			w.Header().Add("Link", fmt.Sprintf(`<%s%s>; rel="successor-version"`, successorPrefix, r.URL.Path))
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -1,19 +1,29 @@
 package http
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/shrtyk/pvz-service/internal/api/http/dto"
 	"github.com/shrtyk/pvz-service/internal/core/domain/auth"
 	pAuthMock "github.com/shrtyk/pvz-service/internal/core/ports/auth/mocks"
 	metricsmocks "github.com/shrtyk/pvz-service/internal/core/ports/metrics/mocks"
 	tservice "github.com/shrtyk/pvz-service/internal/infrastructure/tservice"
 	"github.com/shrtyk/pvz-service/pkg/logger"
+	"github.com/shrtyk/pvz-service/pkg/requestid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestMiddlewares_PanicRecoveryMW(t *testing.T) {
@@ -50,7 +60,7 @@ func TestMiddlewares_PanicRecoveryMW(t *testing.T) {
 
 			l, logs := logger.NewTestLogger()
 			metrics := new(metricsmocks.MockCollector)
-			m := NewMiddlewares(nil, l, metrics)
+			m := NewMiddlewares(nil, nil, l, metrics)
 
 			req := httptest.NewRequest(http.MethodGet, "/", nil)
 			rr := httptest.NewRecorder()
@@ -66,6 +76,50 @@ func TestMiddlewares_PanicRecoveryMW(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("abort handler is passed through", func(t *testing.T) {
+		t.Parallel()
+
+		l, _ := logger.NewTestLogger()
+		m := NewMiddlewares(nil, nil, l, new(metricsmocks.MockCollector))
+		h := m.PanicRecoveryMW(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic(http.ErrAbortHandler)
+		}))
+
+		assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+			h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+		})
+	})
+}
+
+func TestNewTracingMW(t *testing.T) {
+	t.Parallel()
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+	rec := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+
+	r := chi.NewRouter()
+	r.Use(NewTracingMW(tp, propagation.TraceContext{}))
+	r.Get("/pvz/{pvzId}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, traceID, trace.SpanContextFromContext(r.Context()).TraceID().String())
+		w.WriteHeader(http.StatusOK)
+	})
+	r.Get("/livez", func(w http.ResponseWriter, r *http.Request) {
+		assert.False(t, trace.SpanContextFromContext(r.Context()).IsValid())
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/pvz/1", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/livez", nil))
+
+	spans := rec.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "GET /pvz/{pvzId}", spans[0].Name())
+	assert.Equal(t, traceID, spans[0].Parent().TraceID().String())
+	assert.Contains(t, spans[0].Attributes(), semconv.HTTPRoute("/pvz/{pvzId}"))
 }
 
 func TestMiddlewares_LoggingMW(t *testing.T) {
@@ -73,24 +127,27 @@ func TestMiddlewares_LoggingMW(t *testing.T) {
 
 	l, logs := logger.NewTestLogger()
 	metrics := new(metricsmocks.MockCollector)
-	m := NewMiddlewares(nil, l, metrics)
+	m := NewMiddlewares(nil, nil, l, metrics)
 
-	metrics.On("ObserveHTTPRequestDuration", http.MethodGet, mock.AnythingOfType("float64")).Return()
-	metrics.On("IncHTTPRequestsTotal", http.MethodGet, "202").Return()
+	metrics.On("ObserveHTTPRequestDuration", http.MethodGet, unmatchedRoute, mock.AnythingOfType("float64")).Return()
+	metrics.On("IncHTTPRequestsTotal", http.MethodGet, unmatchedRoute, "202").Return()
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctxL := logger.FromCtx(r.Context())
 		assert.NotNil(t, ctxL)
+		assert.Equal(t, "upstream-id", requestid.FromCtx(r.Context()))
 		w.WriteHeader(http.StatusAccepted)
 	})
 
 	req := httptest.NewRequest(http.MethodGet, "/test", nil)
 	req.Header.Set("User-Agent", "test-agent")
+	req.Header.Set(requestid.Header, "upstream-id")
 	rr := httptest.NewRecorder()
 
 	m.LoggingMW(handler).ServeHTTP(rr, req)
 
 	assert.Equal(t, http.StatusAccepted, rr.Code)
+	assert.Equal(t, "upstream-id", rr.Header().Get(requestid.Header))
 	logStr := logs.String()
 	assert.Contains(
 		t,
@@ -270,6 +327,93 @@ func TestMiddlewares_AuthorizeRoles(t *testing.T) {
 	}
 }
 
+func TestMiddlewares_OperationSecurityMW(t *testing.T) {
+	t.Parallel()
+
+	employeeClaims := &auth.AccessTokenClaims{
+		Role: string(auth.UserRoleEmployee),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: "1",
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		scopes          []string
+		authHeader      string
+		expectNext      bool
+		expectErrHandle bool
+	}{
+		{
+			name:       "operation without bearer security",
+			expectNext: true,
+		},
+		{
+			name:            "no token",
+			scopes:          []string{"employee"},
+			expectErrHandle: true,
+		},
+		{
+			name:       "any authenticated user",
+			scopes:     []string{},
+			authHeader: "Bearer valid-token",
+			expectNext: true,
+		},
+		{
+			name:       "role in scopes",
+			scopes:     []string{"employee", "moderator"},
+			authHeader: "Bearer valid-token",
+			expectNext: true,
+		},
+		{
+			name:            "role not in scopes",
+			scopes:          []string{"moderator"},
+			authHeader:      "Bearer valid-token",
+			expectErrHandle: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := pAuthMock.NewMockTokenService(t)
+			if tc.authHeader != "" {
+				ts.On("GetTokenClaims", "valid-token").Return(employeeClaims, nil)
+			}
+
+			l, _ := logger.NewTestLogger()
+			errHandled := false
+			m := &Middlewares{
+				tokenService: ts,
+				log:          l,
+				metrics:      new(metricsmocks.MockCollector),
+				handleAuthErr: func(w http.ResponseWriter, r *http.Request, err error) {
+					errHandled = true
+				},
+			}
+
+			nextCalled := false
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				nextCalled = true
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.scopes != nil {
+				req = req.WithContext(context.WithValue(req.Context(), dto.BearerAuthScopes, tc.scopes))
+			}
+			if tc.authHeader != "" {
+				req.Header.Set("Authorization", tc.authHeader)
+			}
+
+			m.OperationSecurityMW(nextHandler).ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tc.expectNext, nextCalled)
+			assert.Equal(t, tc.expectErrHandle, errHandled)
+		})
+	}
+}
+
 func TestCustomResponseWriter(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,48 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/shrtyk/pvz-service/internal/infrastructure/health"
+)
+
+// probePaths aren't traced, as orchestrators and scrapers call them every few seconds.
+var probePaths = map[string]bool{
+	"/livez":   true,
+	"/readyz":  true,
+	"/metrics": true,
+}
+
+// mountProbes mounts the liveness and readiness probes. Without a checker the
+// app is ready as long as it is alive.
+func mountProbes(r chi.Router, checker *health.Checker) {
+	if checker == nil {
+		checker = health.NewChecker(0)
+	}
+
+	r.Get("/livez", Handle(liveZ))
+	r.Get("/readyz", Handle(readyZ(checker)))
+}
+
+// liveZ reports the process is able to serve requests. It doesn't touch any
+// dependency, so that their outages don't get the app restarted.
+func liveZ(w http.ResponseWriter, r *http.Request) error {
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// readyZ reports the status of every dependency of the app. It fails while
+// one of them is down or the app is shutting down.
+func readyZ(checker *health.Checker) AppHandler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		report := checker.Check(r.Context())
+
+		status := http.StatusOK
+		if report.Status != health.StatusUp {
+			status = http.StatusServiceUnavailable
+		}
+
+		return WriteJSON(w, report, status, nil)
+	}
+}
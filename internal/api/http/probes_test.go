@@ -0,0 +1,99 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/shrtyk/pvz-service/internal/infrastructure/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbes(t *testing.T) {
+	t.Parallel()
+
+	dbErr := errors.New("connection refused")
+
+	testCases := []struct {
+		name           string
+		checker        func() *health.Checker
+		target         string
+		expectedCode   int
+		expectedReport *health.Report
+	}{
+		{
+			name:         "liveness doesn't check dependencies",
+			target:       "/livez",
+			expectedCode: http.StatusOK,
+			checker: func() *health.Checker {
+				c := health.NewChecker(time.Second)
+				c.Register("postgres", func(ctx context.Context) error { return dbErr })
+				return c
+			},
+		},
+		{
+			name:           "ready without a checker",
+			target:         "/readyz",
+			expectedCode:   http.StatusOK,
+			checker:        func() *health.Checker { return nil },
+			expectedReport: &health.Report{Status: health.StatusUp, Components: map[string]health.ComponentReport{}},
+		},
+		{
+			name:         "not ready while a dependency is down",
+			target:       "/readyz",
+			expectedCode: http.StatusServiceUnavailable,
+			checker: func() *health.Checker {
+				c := health.NewChecker(time.Second)
+				c.Register("postgres", func(ctx context.Context) error { return dbErr })
+				return c
+			},
+			expectedReport: &health.Report{
+				Status: health.StatusDown,
+				Components: map[string]health.ComponentReport{
+					"postgres": {Status: health.StatusDown, Error: dbErr.Error()},
+				},
+			},
+		},
+		{
+			name:         "not ready while shutting down",
+			target:       "/readyz",
+			expectedCode: http.StatusServiceUnavailable,
+			checker: func() *health.Checker {
+				c := health.NewChecker(time.Second)
+				c.Shutdown()
+				return c
+			},
+			expectedReport: &health.Report{
+				Status: health.StatusDown,
+				Components: map[string]health.ComponentReport{
+					"app": {Status: health.StatusDown, Error: health.ErrShuttingDown.Error()},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := chi.NewRouter()
+			mountProbes(r, tc.checker())
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tc.target, nil))
+
+			assert.Equal(t, tc.expectedCode, rr.Code)
+			if tc.expectedReport != nil {
+				var report health.Report
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &report))
+				assert.Equal(t, *tc.expectedReport, report)
+			}
+		})
+	}
+}
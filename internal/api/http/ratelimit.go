@@ -0,0 +1,121 @@
+package http
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/api/http/dto"
+	pr "github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
+	ts "github.com/shrtyk/pvz-service/internal/infrastructure/tservice"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+)
+
+// APIKeyHeader identifies clients, like integrations sharing an IP, to the rate limiter.
+const APIKeyHeader = "X-API-Key"
+
+// NewRateLimitMW counts requests against the limits of their operations, named like
+// "POST /pvz/{pvzId}/close_last_reception" for both the v1 and the legacy routes.
+// Requests are accounted to the authenticated user, so it has to run after the
+// authentication. Anonymous requests are accounted to the IP of the peer, or to the
+// one forwarded by it if it is a trusted proxy. Responses of limited operations carry
+// the RateLimit-* headers, and requests over the limit get 429. Requests are let
+// through when the limiter fails, as throttling isn't worth an outage.
+func NewRateLimitMW(limiter pr.Limiter, trustedProxies []netip.Prefix) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			operation := r.Method + " " + strings.TrimPrefix(routePattern(r), apiV1Prefix)
+
+			client := pr.Client{
+				APIKey: r.Header.Get(APIKeyHeader),
+				IP:     clientIP(r, trustedProxies),
+			}
+			if claims, err := ts.ClaimsFromCtx(r.Context()); err == nil {
+				client.UserID = claims.UserID()
+			}
+
+			res, err := limiter.Take(r.Context(), operation, client)
+			if err != nil {
+				logger.FromCtx(r.Context()).Error("Failed to rate limit request", logger.WithErr(err))
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if res.Limit > 0 {
+				w.Header().Set("RateLimit-Limit", strconv.Itoa(res.Limit))
+				w.Header().Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+				w.Header().Set("RateLimit-Reset", ceilSeconds(res.Reset))
+			}
+			if !res.Allowed {
+				w.Header().Set("Retry-After", ceilSeconds(res.RetryAfter))
+				WriteHTTPError(w, r, &HTTPError{
+					Code:    http.StatusTooManyRequests,
+					ErrCode: dto.ErrCodeRateLimited,
+					Message: "Too many requests, retry later",
+					Err:     fmt.Errorf("rate limit of %s exceeded", operation),
+				})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientIP returns the IP of the peer. X-Forwarded-For and X-Real-IP can be sent
+// by anyone, so they are only read from trusted proxies. The forwarded chain is
+// walked from the right, skipping trusted proxies, as the addresses on its left
+// come from the client.
+func clientIP(r *http.Request, trustedProxies []netip.Prefix) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !isTrustedProxy(host, trustedProxies) {
+		return host
+	}
+
+	if fwd := r.Header.Values("X-Forwarded-For"); len(fwd) > 0 {
+		hops := strings.Split(strings.Join(fwd, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if _, err := netip.ParseAddr(hop); err != nil {
+				break
+			}
+			host = hop
+			if !isTrustedProxy(hop, trustedProxies) {
+				break
+			}
+		}
+		return host
+	}
+	if realIP := strings.TrimSpace(r.Header.Get("X-Real-IP")); realIP != "" {
+		if _, err := netip.ParseAddr(realIP); err == nil {
+			return realIP
+		}
+	}
+	return host
+}
+
+func isTrustedProxy(ip string, trustedProxies []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range trustedProxies {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
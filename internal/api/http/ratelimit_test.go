@@ -0,0 +1,166 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/shrtyk/pvz-service/internal/core/domain/auth"
+	pr "github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
+	ratelimitmocks "github.com/shrtyk/pvz-service/internal/core/ports/ratelimit/mocks"
+	ts "github.com/shrtyk/pvz-service/internal/infrastructure/tservice"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewRateLimitMW(t *testing.T) {
+	t.Parallel()
+
+	const operation = "POST /pvz/{pvzId}/close_last_reception"
+
+	testCases := []struct {
+		name           string
+		target         string
+		claims         *auth.AccessTokenClaims
+		apiKey         string
+		header         http.Header
+		trustedProxies []netip.Prefix
+		expectedClient pr.Client
+		result         pr.Result
+		err            error
+		expectedCode   int
+		expectedHeader http.Header
+	}{
+		{
+			name:           "allowed request of a user",
+			target:         "/api/v1/pvz/1/close_last_reception",
+			claims:         &auth.AccessTokenClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "42"}},
+			expectedClient: pr.Client{UserID: "42", IP: "192.0.2.1"},
+			result:         pr.Result{Allowed: true, Limit: 10, Remaining: 9, Reset: 1500 * time.Millisecond},
+			expectedCode:   http.StatusOK,
+			expectedHeader: http.Header{
+				"Ratelimit-Limit":     {"10"},
+				"Ratelimit-Remaining": {"9"},
+				"Ratelimit-Reset":     {"2"},
+			},
+		},
+		{
+			name:           "legacy route shares the limit",
+			target:         "/pvz/1/close_last_reception",
+			apiKey:         "key",
+			expectedClient: pr.Client{APIKey: "key", IP: "192.0.2.1"},
+			result:         pr.Result{Allowed: false, Limit: 10, RetryAfter: 200 * time.Millisecond, Reset: 10 * time.Second},
+			expectedCode:   http.StatusTooManyRequests,
+			expectedHeader: http.Header{
+				"Ratelimit-Limit":     {"10"},
+				"Ratelimit-Remaining": {"0"},
+				"Ratelimit-Reset":     {"10"},
+				"Retry-After":         {"1"},
+			},
+		},
+		{
+			name:           "unlimited operation",
+			target:         "/api/v1/pvz/1/close_last_reception",
+			expectedClient: pr.Client{IP: "192.0.2.1"},
+			result:         pr.Result{Allowed: true},
+			expectedCode:   http.StatusOK,
+			expectedHeader: http.Header{},
+		},
+		{
+			name:           "spoofed forwarded headers keep the bucket of the peer",
+			target:         "/api/v1/pvz/1/close_last_reception",
+			header:         http.Header{"X-Forwarded-For": {"203.0.113.7"}, "X-Real-Ip": {"203.0.113.8"}},
+			expectedClient: pr.Client{IP: "192.0.2.1"},
+			result:         pr.Result{Allowed: true},
+			expectedCode:   http.StatusOK,
+			expectedHeader: http.Header{},
+		},
+		{
+			name:           "forwarded headers of an untrusted peer are ignored",
+			target:         "/api/v1/pvz/1/close_last_reception",
+			header:         http.Header{"X-Forwarded-For": {"203.0.113.7"}},
+			trustedProxies: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+			expectedClient: pr.Client{IP: "192.0.2.1"},
+			result:         pr.Result{Allowed: true},
+			expectedCode:   http.StatusOK,
+			expectedHeader: http.Header{},
+		},
+		{
+			name:           "client forwarded by trusted proxies",
+			target:         "/api/v1/pvz/1/close_last_reception",
+			header:         http.Header{"X-Forwarded-For": {"198.51.100.66, 203.0.113.7", "192.0.2.10"}},
+			trustedProxies: []netip.Prefix{netip.MustParsePrefix("192.0.2.0/24")},
+			expectedClient: pr.Client{IP: "203.0.113.7"},
+			result:         pr.Result{Allowed: true},
+			expectedCode:   http.StatusOK,
+			expectedHeader: http.Header{},
+		},
+		{
+			name:           "real IP set by a trusted proxy",
+			target:         "/api/v1/pvz/1/close_last_reception",
+			header:         http.Header{"X-Real-Ip": {"203.0.113.8"}},
+			trustedProxies: []netip.Prefix{netip.MustParsePrefix("192.0.2.0/24")},
+			expectedClient: pr.Client{IP: "203.0.113.8"},
+			result:         pr.Result{Allowed: true},
+			expectedCode:   http.StatusOK,
+			expectedHeader: http.Header{},
+		},
+		{
+			name:           "limiter failure lets requests through",
+			target:         "/api/v1/pvz/1/close_last_reception",
+			expectedClient: pr.Client{IP: "192.0.2.1"},
+			err:            errors.New("db error"),
+			expectedCode:   http.StatusOK,
+			expectedHeader: http.Header{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := ratelimitmocks.NewMockLimiter(t)
+			limiter.EXPECT().Take(mock.Anything, operation, tc.expectedClient).Return(tc.result, tc.err)
+
+			// Like the operation middlewares, it runs once the request is routed.
+			r := chi.NewRouter().With(NewRateLimitMW(limiter, tc.trustedProxies))
+			handler := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }
+			r.Post("/api/v1/pvz/{pvzId}/close_last_reception", handler)
+			r.Post("/pvz/{pvzId}/close_last_reception", handler)
+
+			l, _ := logger.NewTestLogger()
+			req := httptest.NewRequest(http.MethodPost, tc.target, nil)
+			ctx := logger.ToCtx(req.Context(), l)
+			if tc.claims != nil {
+				ctx = ts.ClaimsToCtx(ctx, tc.claims)
+			}
+			req = req.WithContext(ctx)
+			if tc.apiKey != "" {
+				req.Header.Set(APIKeyHeader, tc.apiKey)
+			}
+			for k, v := range tc.header {
+				req.Header[k] = v
+			}
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedCode, rr.Code)
+			for k, v := range tc.expectedHeader {
+				assert.Equal(t, v, rr.Header().Values(k), k)
+			}
+			if len(tc.expectedHeader) == 0 {
+				assert.Empty(t, rr.Header().Get("RateLimit-Limit"))
+			}
+			if tc.expectedCode == http.StatusTooManyRequests {
+				assert.Contains(t, rr.Body.String(), `"code": "rate_limited"`)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -14,13 +16,16 @@ import (
 	"github.com/google/uuid"
 	"github.com/shrtyk/pvz-service/internal/api/http/dto"
 	"github.com/shrtyk/pvz-service/internal/core/ports/auth"
+	ts "github.com/shrtyk/pvz-service/internal/infrastructure/tservice"
 	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
 	"github.com/tomasen/realip"
 )
 
+// maxBodyBytes limits the size of request bodies.
+const maxBodyBytes = 1_048_576
+
 func ReadJson[T any](w http.ResponseWriter, r *http.Request, dst T) error {
-	maxBytes := 1_048_576
-	r.Body = http.MaxBytesReader(w, r.Body, int64(maxBytes))
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
 
 	dec := json.NewDecoder(r.Body)
 	dec.DisallowUnknownFields()
@@ -75,17 +80,56 @@ func IdParam(r *http.Request) (int64, error) {
 }
 
 func PvzIdParam(r *http.Request) (*uuid.UUID, error) {
-	strId := chi.URLParam(r, "pvzId")
+	return uuidParam(r, "pvzId")
+}
+
+func ProductIdParam(r *http.Request) (*uuid.UUID, error) {
+	return uuidParam(r, "productId")
+}
+
+func ReceptionIdParam(r *http.Request) (*uuid.UUID, error) {
+	return uuidParam(r, "receptionId")
+}
+
+func ExportJobIdParam(r *http.Request) (*uuid.UUID, error) {
+	return uuidParam(r, "jobId")
+}
+
+func uuidParam(r *http.Request, key string) (*uuid.UUID, error) {
+	strId := chi.URLParam(r, key)
 	if err := uuid.Validate(strId); err != nil {
 		return nil, err
 	}
 
-	pvzId, err := uuid.Parse(strId)
+	id, err := uuid.Parse(strId)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pvzId, nil
+	return &id, nil
+}
+
+// UserIdFromCtx returns the id of the authenticated user that made the request.
+func UserIdFromCtx(r *http.Request) (*uuid.UUID, error) {
+	claims, err := ts.ClaimsFromCtx(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(claims.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func BarcodeParam(r *http.Request) (string, error) {
+	barcode := chi.URLParam(r, "barcode")
+	if barcode == "" || len(barcode) > 64 {
+		return "", errors.New("invalid barcode parameter")
+	}
+	return barcode, nil
 }
 
 func UserAgentAndIP(r *http.Request) (string, string) {
@@ -110,21 +154,67 @@ func BearerToken(r *http.Request) (string, error) {
 func PvzParamsFromURL(r *http.Request) (*dto.GetPvzParams, error) {
 	params := &dto.GetPvzParams{}
 	query := r.URL.Query()
+	var err error
 
-	if startDateStr := query.Get("startDate"); startDateStr != "" {
-		sd, err := time.Parse(time.RFC3339, startDateStr)
-		if err != nil {
-			return nil, wrapConvertionError("startDate", startDateStr, "time.Time", err)
-		}
-		params.StartDate = &sd
+	if params.StartDate, err = timeParamFromURL(query, "startDate"); err != nil {
+		return nil, err
+	}
+	if params.EndDate, err = timeParamFromURL(query, "endDate"); err != nil {
+		return nil, err
+	}
+	if err = checkTimeRange("startDate", params.StartDate, "endDate", params.EndDate); err != nil {
+		return nil, err
+	}
+
+	if params.RegisteredFrom, err = timeParamFromURL(query, "registeredFrom"); err != nil {
+		return nil, err
+	}
+	if params.RegisteredTo, err = timeParamFromURL(query, "registeredTo"); err != nil {
+		return nil, err
+	}
+	if err = checkTimeRange("registeredFrom", params.RegisteredFrom, "registeredTo", params.RegisteredTo); err != nil {
+		return nil, err
+	}
+
+	if params.City, err = enumParamsFromURL(query, "city",
+		dto.GetPvzParamsCityMoscow, dto.GetPvzParamsCitySaintPetersburg, dto.GetPvzParamsCityKazan,
+	); err != nil {
+		return nil, err
+	}
+	if params.ReceptionStatus, err = enumParamsFromURL(query, "receptionStatus",
+		dto.FilterInProgress,
+		dto.FilterClose,
+		dto.FilterCancelled,
+	); err != nil {
+		return nil, err
+	}
+	if params.ProductType, err = enumParamsFromURL(query, "productType",
+		dto.Electronics, dto.Clothing, dto.Footwear,
+	); err != nil {
+		return nil, err
 	}
 
-	if endDateStr := query.Get("endDate"); endDateStr != "" {
-		ed, err := time.Parse(time.RFC3339, endDateStr)
+	if sortBy, err := enumParamsFromURL(query, "sortBy",
+		dto.SortByRegistrationDate, dto.SortByCity,
+	); err != nil {
+		return nil, err
+	} else if sortBy != nil {
+		params.SortBy = &(*sortBy)[0]
+	}
+	if sortOrder, err := enumParamsFromURL(query, "sortOrder",
+		dto.SortOrderAsc, dto.SortOrderDesc,
+	); err != nil {
+		return nil, err
+	} else if sortOrder != nil {
+		params.SortOrder = &(*sortOrder)[0]
+	}
+
+	if openStr := query.Get("hasOpenReception"); openStr != "" {
+		open, err := strconv.ParseBool(openStr)
 		if err != nil {
-			return nil, wrapConvertionError("endDate", endDateStr, "time.Time", err)
+			return nil, wrapConvertionError("hasOpenReception", openStr, "bool", err)
 		}
-		params.EndDate = &ed
+		params.HasOpenReception = &open
 	}
 
 	if pageStr := query.Get("page"); pageStr != "" {
@@ -143,9 +233,169 @@ func PvzParamsFromURL(r *http.Request) (*dto.GetPvzParams, error) {
 		params.Limit = &l
 	}
 
+	if cursor := query.Get("cursor"); cursor != "" {
+		params.Cursor = &cursor
+	}
+
+	if withTotalStr := query.Get("withTotal"); withTotalStr != "" {
+		wt, err := strconv.ParseBool(withTotalStr)
+		if err != nil {
+			return nil, wrapConvertionError("withTotal", withTotalStr, "bool", err)
+		}
+		params.WithTotal = &wt
+	}
+
+	return params, nil
+}
+
+func timeParamFromURL(query url.Values, name string) (*time.Time, error) {
+	str := query.Get(name)
+	if str == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		return nil, wrapConvertionError(name, str, "time.Time", err)
+	}
+	return &t, nil
+}
+
+func checkTimeRange(fromName string, from *time.Time, toName string, to *time.Time) error {
+	if from != nil && to != nil && from.After(*to) {
+		return fmt.Errorf("'%s' must not be after '%s'", fromName, toName)
+	}
+	return nil
+}
+
+const (
+	defaultAnalyticsRange = 30 * 24 * time.Hour
+	maxAnalyticsRange     = 366 * 24 * time.Hour
+)
+
+func ThroughputParamsFromURL(r *http.Request) (*dto.GetAnalyticsThroughputParams, error) {
+	params := &dto.GetAnalyticsThroughputParams{}
+	query := r.URL.Query()
+	var err error
+
+	if params.From, params.To, err = analyticsRangeFromURL(query); err != nil {
+		return nil, err
+	}
+
+	if groupBy, err := enumParamsFromURL(query, "groupBy",
+		dto.ThroughputByPvz, dto.ThroughputByCity, dto.ThroughputByProductType,
+	); err != nil {
+		return nil, err
+	} else if groupBy != nil {
+		params.GroupBy = &(*groupBy)[0]
+	}
+	if period, err := enumParamsFromURL(query, "period",
+		dto.ThroughputDay, dto.ThroughputWeek,
+	); err != nil {
+		return nil, err
+	} else if period != nil {
+		params.Period = &(*period)[0]
+	}
+
+	return params, nil
+}
+
+func ReceptionStatsParamsFromURL(r *http.Request) (*dto.GetAnalyticsReceptionsParams, error) {
+	params := &dto.GetAnalyticsReceptionsParams{}
+	query := r.URL.Query()
+	var err error
+
+	if params.From, params.To, err = analyticsRangeFromURL(query); err != nil {
+		return nil, err
+	}
+
+	if groupBy, err := enumParamsFromURL(query, "groupBy",
+		dto.ReceptionStatsByPvz, dto.ReceptionStatsByCity,
+	); err != nil {
+		return nil, err
+	} else if groupBy != nil {
+		params.GroupBy = &(*groupBy)[0]
+	}
+
+	return params, nil
+}
+
+// analyticsRangeFromURL reads the from and to params. Missing to means now and missing
+// from means defaultAnalyticsRange before to. Ranges are capped to keep aggregations cheap.
+func analyticsRangeFromURL(query url.Values) (*time.Time, *time.Time, error) {
+	from, err := timeParamFromURL(query, "from")
+	if err != nil {
+		return nil, nil, err
+	}
+	to, err := timeParamFromURL(query, "to")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if to == nil {
+		now := time.Now()
+		to = &now
+	}
+	if from == nil {
+		f := to.Add(-defaultAnalyticsRange)
+		from = &f
+	}
+
+	if !from.Before(*to) {
+		return nil, nil, errors.New("'from' must be before 'to'")
+	}
+	if to.Sub(*from) > maxAnalyticsRange {
+		return nil, nil, fmt.Errorf("range between 'from' and 'to' must not exceed %d days", maxAnalyticsRange/(24*time.Hour))
+	}
+
+	return from, to, nil
+}
+
+// ExportParamsFromURL parses the format and mode of an export. Its filters and
+// sort are the ones of GET /pvz and are parsed by PvzParamsFromURL.
+func ExportParamsFromURL(r *http.Request) (*dto.GetExportsReceptionsParams, error) {
+	params := &dto.GetExportsReceptionsParams{}
+	query := r.URL.Query()
+
+	format, err := enumParamsFromURL(query, "format", dto.ExportCsv, dto.ExportXlsx)
+	if err != nil {
+		return nil, err
+	}
+	if format == nil {
+		return nil, errors.New("missing 'format' query param")
+	}
+	params.Format = (*format)[0]
+
+	if asyncStr := query.Get("async"); asyncStr != "" {
+		async, err := strconv.ParseBool(asyncStr)
+		if err != nil {
+			return nil, wrapConvertionError("async", asyncStr, "bool", err)
+		}
+		params.Async = &async
+	}
+
 	return params, nil
 }
 
+// enumParamsFromURL collects every value of a repeated query param. Empty values are skipped.
+func enumParamsFromURL[T ~string](query url.Values, name string, allowed ...T) (*[]T, error) {
+	var res []T
+	for _, v := range query[name] {
+		if v == "" {
+			continue
+		}
+		if !slices.Contains(allowed, T(v)) {
+			return nil, fmt.Errorf("unknown '%s' query param value '%s'", name, v)
+		}
+		res = append(res, T(v))
+	}
+
+	if len(res) == 0 {
+		return nil, nil
+	}
+	return &res, nil
+}
+
 func wrapConvertionError(paramName, param, paramKind string, err error) error {
 	tmp := "failed to convert '%s' query param '%s' into '%s': %w"
 	return fmt.Errorf(tmp, paramName, param, paramKind, err)
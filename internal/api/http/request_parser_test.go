@@ -6,9 +6,13 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/api/http/dto"
+	dAuth "github.com/shrtyk/pvz-service/internal/core/domain/auth"
+	ts "github.com/shrtyk/pvz-service/internal/infrastructure/tservice"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -155,6 +159,97 @@ func TestPvzIdParam(t *testing.T) {
 	})
 }
 
+func TestProductIdParam(t *testing.T) {
+	t.Parallel()
+
+	productID := uuid.New()
+	r := httptest.NewRequest(http.MethodDelete, "/products/"+productID.String(), nil)
+	chiCtx := chi.NewRouteContext()
+	chiCtx.URLParams.Add("productId", productID.String())
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, chiCtx))
+
+	id, err := ProductIdParam(r)
+
+	require.NoError(t, err)
+	assert.Equal(t, &productID, id)
+}
+
+func TestReceptionIdParam(t *testing.T) {
+	t.Parallel()
+
+	r := httptest.NewRequest(http.MethodGet, "/receptions/abc/deletions", nil)
+	chiCtx := chi.NewRouteContext()
+	chiCtx.URLParams.Add("receptionId", "abc")
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, chiCtx))
+
+	_, err := ReceptionIdParam(r)
+
+	assert.Error(t, err)
+}
+
+func TestUserIdFromCtx(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		userID := uuid.New()
+		r := withClaims(httptest.NewRequest(http.MethodGet, "/", nil), userID, dAuth.UserRoleEmployee)
+
+		id, err := UserIdFromCtx(r)
+
+		require.NoError(t, err)
+		assert.Equal(t, &userID, id)
+	})
+
+	t.Run("no claims", func(t *testing.T) {
+		t.Parallel()
+		_, err := UserIdFromCtx(httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Error(t, err)
+	})
+
+	t.Run("subject is not uuid", func(t *testing.T) {
+		t.Parallel()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r = r.WithContext(ts.ClaimsToCtx(r.Context(), &dAuth.AccessTokenClaims{}))
+
+		_, err := UserIdFromCtx(r)
+		assert.Error(t, err)
+	})
+}
+
+func TestBarcodeParam(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		barcode string
+		wantErr bool
+	}{
+		{name: "success", barcode: "4601234567890"},
+		{name: "empty", barcode: "", wantErr: true},
+		{name: "too long", barcode: strings.Repeat("1", 65), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := httptest.NewRequest(http.MethodGet, "/products/"+tt.barcode, nil)
+			chiCtx := chi.NewRouteContext()
+			chiCtx.URLParams.Add("barcode", tt.barcode)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, chiCtx))
+
+			barcode, err := BarcodeParam(r)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.barcode, barcode)
+		})
+	}
+}
+
 func TestBearerToken(t *testing.T) {
 	t.Parallel()
 
@@ -229,6 +324,68 @@ func TestPvzParamsFromURL(t *testing.T) {
 			url:      "/?startDate=2025-01-01T00:00:00Z&endDate=2025-01-02T00:00:00Z&page=2&limit=20",
 			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
 		},
+		{
+			name:     "success with cursor and total",
+			url:      "/?cursor=abc&withTotal=true&limit=20",
+			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name: "success with filters and sort",
+			url: "/?city=%D0%9C%D0%BE%D1%81%D0%BA%D0%B2%D0%B0&city=%D0%9A%D0%B0%D0%B7%D0%B0%D0%BD%D1%8C" +
+				"&receptionStatus=close&productType=%D0%BE%D0%B1%D1%83%D0%B2%D1%8C&hasOpenReception=false" +
+				"&registeredFrom=2025-01-01T00:00:00Z&registeredTo=2025-02-01T00:00:00Z&sortBy=city&sortOrder=desc",
+			checkErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name:     "unknown city",
+			url:      "/?city=Tver",
+			checkErr: func(t *testing.T, err error) { assert.Error(t, err) },
+		},
+		{
+			name:     "unknown reception status",
+			url:      "/?receptionStatus=open",
+			checkErr: func(t *testing.T, err error) { assert.Error(t, err) },
+		},
+		{
+			name:     "unknown product type",
+			url:      "/?productType=furniture",
+			checkErr: func(t *testing.T, err error) { assert.Error(t, err) },
+		},
+		{
+			name:     "unknown sort field",
+			url:      "/?sortBy=id",
+			checkErr: func(t *testing.T, err error) { assert.Error(t, err) },
+		},
+		{
+			name:     "unknown sort order",
+			url:      "/?sortOrder=up",
+			checkErr: func(t *testing.T, err error) { assert.Error(t, err) },
+		},
+		{
+			name:     "invalid hasOpenReception",
+			url:      "/?hasOpenReception=maybe",
+			checkErr: func(t *testing.T, err error) { assert.Error(t, err) },
+		},
+		{
+			name:     "invalid registeredFrom",
+			url:      "/?registeredFrom=yesterday",
+			checkErr: func(t *testing.T, err error) { assert.Error(t, err) },
+		},
+		{
+			name:     "reversed registration range",
+			url:      "/?registeredFrom=2025-02-01T00:00:00Z&registeredTo=2025-01-01T00:00:00Z",
+			checkErr: func(t *testing.T, err error) { assert.Error(t, err) },
+		},
+		{
+			name:     "reversed reception range",
+			url:      "/?startDate=2025-02-01T00:00:00Z&endDate=2025-01-01T00:00:00Z",
+			checkErr: func(t *testing.T, err error) { assert.Error(t, err) },
+		},
+		{
+			name:     "invalid withTotal",
+			url:      "/?withTotal=maybe",
+			checkErr: func(t *testing.T, err error) { assert.Error(t, err) },
+		},
 		{
 			name:     "invalid start date",
 			url:      "/?startDate=invalid-date",
@@ -262,3 +419,52 @@ func TestPvzParamsFromURL(t *testing.T) {
 		})
 	}
 }
+
+func TestThroughputParamsFromURL(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults to the last 30 days", func(t *testing.T) {
+		t.Parallel()
+
+		params, err := ThroughputParamsFromURL(httptest.NewRequest(http.MethodGet, "/", nil))
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now(), *params.To, time.Minute)
+		assert.Equal(t, defaultAnalyticsRange, params.To.Sub(*params.From))
+		assert.Nil(t, params.GroupBy)
+		assert.Nil(t, params.Period)
+	})
+
+	t.Run("explicit params", func(t *testing.T) {
+		t.Parallel()
+
+		params, err := ThroughputParamsFromURL(httptest.NewRequest(http.MethodGet,
+			"/?from=2026-01-01T00:00:00Z&to=2026-02-01T00:00:00Z&groupBy=productType&period=week", nil))
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), *params.From)
+		assert.Equal(t, dto.ThroughputByProductType, *params.GroupBy)
+		assert.Equal(t, dto.ThroughputWeek, *params.Period)
+	})
+
+	for _, url := range []string{
+		"/?groupBy=courier",
+		"/?period=month",
+		"/?from=yesterday",
+		"/?from=2026-02-01T00:00:00Z&to=2026-01-01T00:00:00Z",
+		"/?from=2026-01-01T00:00:00Z&to=2026-01-01T00:00:00Z",
+		"/?from=2024-01-01T00:00:00Z&to=2026-01-01T00:00:00Z",
+	} {
+		_, err := ThroughputParamsFromURL(httptest.NewRequest(http.MethodGet, url, nil))
+		assert.Error(t, err, url)
+	}
+}
+
+func TestReceptionStatsParamsFromURL(t *testing.T) {
+	t.Parallel()
+
+	params, err := ReceptionStatsParamsFromURL(httptest.NewRequest(http.MethodGet, "/?groupBy=city", nil))
+	require.NoError(t, err)
+	assert.Equal(t, dto.ReceptionStatsByCity, *params.GroupBy)
+
+	_, err = ReceptionStatsParamsFromURL(httptest.NewRequest(http.MethodGet, "/?groupBy=productType", nil))
+	assert.Error(t, err)
+}
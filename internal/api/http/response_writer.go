@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"encoding/json"
 	"maps"
+	"mime"
 	"net/http"
+	"strings"
 
+	"github.com/shrtyk/pvz-service/internal/core/domain"
 	"github.com/shrtyk/pvz-service/pkg/logger"
 )
 
@@ -17,19 +20,71 @@ func WriteHTTPError(w http.ResponseWriter, r *http.Request, e *HTTPError) {
 		l.Info("Client error", logger.WithErr(e))
 	}
 
-	err := WriteJSON(
-		w,
-		map[string]string{"message": e.Message},
-		e.Code,
-		nil,
-	)
+	err := writeJSONAs(w, problemContentType, toDTOProblem(r, e), e.Code, nil)
 	if err != nil {
 		l.Error("Failed to response with error", logger.WithErr(err))
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
 
+// problemContentType is the media type of errors, see RFC 7807.
+const problemContentType = "application/problem+json"
+
+const ndjsonContentType = "application/x-ndjson"
+
+const (
+	csvContentType  = "text/csv; charset=utf-8"
+	xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// exportWriter sends the headers of an export file with its first bytes, so that
+// an export failing before it wrote anything can still respond with an error.
+type exportWriter struct {
+	w        http.ResponseWriter
+	format   domain.ExportFormat
+	fileName string
+	started  bool
+}
+
+func newExportWriter(w http.ResponseWriter, format domain.ExportFormat, fileName string) *exportWriter {
+	return &exportWriter{w: w, format: format, fileName: fileName}
+}
+
+func (ew *exportWriter) Write(p []byte) (int, error) {
+	if !ew.started {
+		contentType := csvContentType
+		if ew.format == domain.ExportXLSX {
+			contentType = xlsxContentType
+		}
+		ew.w.Header().Set("Content-Type", contentType)
+		ew.w.Header().Set(
+			"Content-Disposition",
+			mime.FormatMediaType("attachment", map[string]string{"filename": ew.fileName}),
+		)
+		ew.w.WriteHeader(http.StatusOK)
+		ew.started = true
+	}
+	return ew.w.Write(p)
+}
+
+// acceptsNDJSON reports whether the client asked for newline delimited JSON.
+func acceptsNDJSON(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for mt := range strings.SplitSeq(accept, ",") {
+			mt, _, _ = strings.Cut(mt, ";")
+			if strings.TrimSpace(mt) == ndjsonContentType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func WriteJSON[T any](w http.ResponseWriter, data T, status int, headers http.Header) error {
+	return writeJSONAs(w, "application/json", data, status, headers)
+}
+
+func writeJSONAs[T any](w http.ResponseWriter, contentType string, data T, status int, headers http.Header) error {
 	b, err := json.Marshal(data)
 	if err != nil {
 		return err
@@ -44,7 +99,7 @@ func WriteJSON[T any](w http.ResponseWriter, data T, status int, headers http.He
 	b = buf.Bytes()
 
 	maps.Copy(w.Header(), headers)
-	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Type", contentType)
 	w.WriteHeader(status)
 
 	if _, err = w.Write(b); err != nil {
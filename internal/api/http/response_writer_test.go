@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/shrtyk/pvz-service/internal/api/http/dto"
 	"github.com/shrtyk/pvz-service/pkg/logger"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,6 +34,23 @@ func (m *mockResponseWriter) WriteHeader(statusCode int) {
 	m.statusCode = statusCode
 }
 
+func TestAcceptsNDJSON(t *testing.T) {
+	t.Parallel()
+
+	for accept, want := range map[string]bool{
+		"":                                      false,
+		"application/json":                      false,
+		"application/x-ndjson":                  true,
+		"text/html, application/x-ndjson;q=0.9": true,
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		if accept != "" {
+			r.Header.Set("Accept", accept)
+		}
+		assert.Equal(t, want, acceptsNDJSON(r), accept)
+	}
+}
+
 func TestWriteHTTPError(t *testing.T) {
 	t.Parallel()
 
@@ -52,21 +70,24 @@ func TestWriteHTTPError(t *testing.T) {
 				Err:     errors.New("test error"),
 			},
 			expectedStatusCode: http.StatusInternalServerError,
-			expectedBody:       `{"message":"Internal error"}`,
-			expectedLog:        "Server error",
-			w:                  httptest.NewRecorder(),
+			expectedBody: `{"type":"about:blank","title":"Internal Server Error","status":500,` +
+				`"detail":"Internal error","instance":"/","code":"internal_error","message":"Internal error"}`,
+			expectedLog: "Server error",
+			w:           httptest.NewRecorder(),
 		},
 		{
 			name: "client error",
 			err: &HTTPError{
 				Code:    http.StatusBadRequest,
+				ErrCode: dto.ErrCodeMalformedBody,
 				Message: "Bad request",
 				Err:     errors.New("test error"),
 			},
 			expectedStatusCode: http.StatusBadRequest,
-			expectedBody:       `{"message":"Bad request"}`,
-			expectedLog:        "Client error",
-			w:                  httptest.NewRecorder(),
+			expectedBody: `{"type":"about:blank","title":"Bad Request","status":400,` +
+				`"detail":"Bad request","instance":"/","code":"malformed_body","message":"Bad request"}`,
+			expectedLog: "Client error",
+			w:           httptest.NewRecorder(),
 		},
 		{
 			name: "write json error",
@@ -95,6 +116,7 @@ func TestWriteHTTPError(t *testing.T) {
 				assert.Equal(t, tc.expectedStatusCode, recorder.Code)
 				if tc.expectedBody != "" {
 					assert.JSONEq(t, tc.expectedBody, recorder.Body.String())
+					assert.Equal(t, problemContentType, recorder.Header().Get("Content-Type"))
 				}
 			}
 
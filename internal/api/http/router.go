@@ -1,22 +1,117 @@
 package http
 
 import (
+	"fmt"
 	"log/slog"
+	"net/http"
+	"net/netip"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
-	"github.com/shrtyk/pvz-service/internal/core/domain/auth"
+	"github.com/shrtyk/pvz-service/internal/api/http/dto"
 	pAuth "github.com/shrtyk/pvz-service/internal/core/ports/auth"
 	"github.com/shrtyk/pvz-service/internal/core/ports/metrics"
+	"github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
 	aService "github.com/shrtyk/pvz-service/internal/core/ports/service"
+	"github.com/shrtyk/pvz-service/internal/infrastructure/health"
+	"go.opentelemetry.io/otel"
 )
 
+// apiV1Prefix is where the API described by api/openapi/swagger.yaml is mounted.
+const apiV1Prefix = "/api/v1"
+
 type Router struct {
 	chi.Router
-	aService aService.Service
-	tService pAuth.TokenService
-	logger   *slog.Logger
-	metrics  metrics.Collector
+	aService     aService.Service
+	tService     pAuth.TokenService
+	logger       *slog.Logger
+	metrics      metrics.Collector
+	validateSpec bool
+	serveMetrics bool
+	checker      *health.Checker
+	limiter      ratelimit.Limiter
+	proxies      []netip.Prefix
+	cors         *CORSPolicy
+	headers      *SecurityHeaders
+	csrf         bool
+	legacy       *legacyRoutes
+}
+
+// legacyRoutes are the deprecation dates of the unversioned aliases of the v1 API.
+type legacyRoutes struct {
+	deprecatedAt time.Time
+	sunset       time.Time
+}
+
+type RouterOption func(*Router)
+
+// WithSpecValidation makes the router check requests and responses against the
+// OpenAPI spec. It costs a copy of every response, so it is meant for development.
+func WithSpecValidation() RouterOption {
+	return func(r *Router) {
+		r.validateSpec = true
+	}
+}
+
+// WithMetricsEndpoint exposes /metrics on the router as well. They are always
+// served by the admin router, so this is kept for scrapers of the public port.
+func WithMetricsEndpoint() RouterOption {
+	return func(r *Router) {
+		r.serveMetrics = true
+	}
+}
+
+// WithReadinessChecker makes /readyz report the checks of the checker.
+func WithReadinessChecker(checker *health.Checker) RouterOption {
+	return func(r *Router) {
+		r.checker = checker
+	}
+}
+
+// WithRateLimiter limits the rate of requests to the API operations.
+func WithRateLimiter(limiter ratelimit.Limiter) RouterOption {
+	return func(r *Router) {
+		r.limiter = limiter
+	}
+}
+
+// WithTrustedProxies makes the rate limiter account anonymous requests coming
+// through the proxies to the client IPs they forward.
+func WithTrustedProxies(proxies []netip.Prefix) RouterOption {
+	return func(r *Router) {
+		r.proxies = proxies
+	}
+}
+
+// WithCORS lets browser apps of other origins call the API under the policy.
+func WithCORS(policy CORSPolicy) RouterOption {
+	return func(r *Router) {
+		r.cors = &policy
+	}
+}
+
+// WithSecurityHeaders sets the security headers on all responses of the router.
+func WithSecurityHeaders(headers SecurityHeaders) RouterOption {
+	return func(r *Router) {
+		r.headers = &headers
+	}
+}
+
+// WithCSRFProtection makes operations authenticated by cookies require CSRF tokens.
+func WithCSRFProtection() RouterOption {
+	return func(r *Router) {
+		r.csrf = true
+	}
+}
+
+// WithLegacyRoutes keeps the v1 API at the root as well, as it was served before
+// it got versioned. Responses of these routes announce when they were deprecated
+// and when they are going to be removed.
+func WithLegacyRoutes(deprecatedAt, sunset time.Time) RouterOption {
+	return func(r *Router) {
+		r.legacy = &legacyRoutes{deprecatedAt: deprecatedAt, sunset: sunset}
+	}
 }
 
 func NewRouter(
@@ -24,6 +119,7 @@ func NewRouter(
 	tService pAuth.TokenService,
 	logger *slog.Logger,
 	metrics metrics.Collector,
+	opts ...RouterOption,
 ) *Router {
 	r := &Router{
 		Router:   chi.NewRouter(),
@@ -33,50 +129,104 @@ func NewRouter(
 		metrics:  metrics,
 	}
 
+	for _, opt := range opts {
+		opt(r)
+	}
+
 	r.initRoutes()
 
 	return r
 }
 
 func (r *Router) initRoutes() {
-	mws := NewMiddlewares(r.tService, r.logger, r.metrics)
+	mws := NewMiddlewares(r.aService, r.tService, r.logger, r.metrics)
 	h := NewHandlers(r.aService, r.tService)
 
-	r.Use(mws.PanicRecoveryMW, mws.LoggingMW)
-	r.Post("/dummyLogin", Handle(h.DummyLoginHandler))
-	r.Get("/healthz", Handle(h.HealthZ))
-	r.Handle("/metrics", promhttp.Handler())
+	r.Use(
+		NewTracingMW(otel.GetTracerProvider(), otel.GetTextMapPropagator()),
+		mws.PanicRecoveryMW,
+		mws.LoggingMW,
+	)
+	// Preflight requests are answered before they get to the routes, which don't
+	// serve OPTIONS, and to the spec validation.
+	if r.cors != nil {
+		r.Use(NewCORSMW(*r.cors))
+	}
+	if r.headers != nil {
+		r.Use(NewSecurityHeadersMW(*r.headers))
+	}
+	if r.validateSpec {
+		r.Use(MustNewSpecValidationMW())
+	}
+	r.NotFound(notFoundHandler)
+	r.MethodNotAllowed(methodNotAllowedHandler)
 
-	r.Post("/register", Handle(h.RegisterUserHandler))
-	r.Post("/login", Handle(h.LoginUserHandler))
-	r.Post("/tokens/refresh", Handle(h.RefreshTokensHandler))
+	mountProbes(r, r.checker)
+	if r.serveMetrics {
+		r.Handle("/metrics", promhttp.Handler())
+	}
 
-	// Authenticated only:
-	r.Group(func(r chi.Router) {
-		r.Use(mws.AuthenticationMW)
+	operationMWs := []dto.MiddlewareFunc{mws.IdempotencyMW}
+	if r.limiter != nil {
+		operationMWs = append(operationMWs, NewRateLimitMW(r.limiter, r.proxies))
+	}
+	operationMWs = append(operationMWs, mws.OperationSecurityMW)
+	if r.csrf {
+		operationMWs = append(operationMWs, CSRFMW)
+	}
 
-		// Moderators only:
-		r.Group(func(r chi.Router) {
-			r.Use(mws.AuthorizeRoles(auth.UserRoleModerator))
+	// A new version of the API gets its own spec, generated dto package and handlers
+	// on top of the same application service, and is mounted next to v1.
+	r.Route(apiV1Prefix, func(r chi.Router) {
+		mountV1(r, operationMWs, h)
+	})
 
-			r.Post("/pvz", Handle(h.NewPVZHandler))
+	if r.legacy != nil {
+		r.Group(func(g chi.Router) {
+			g.Use(DeprecatedMW(apiV1Prefix, r.legacy.deprecatedAt, r.legacy.sunset))
+			mountV1(g, operationMWs, h)
 		})
+	}
+}
 
-		// Employees only:
-		r.Group(func(r chi.Router) {
-			r.Use(mws.AuthorizeRoles(auth.UserRoleEmployee))
+// mountV1 mounts the operations of the v1 API along with its spec and docs.
+func mountV1(r chi.Router, mws []dto.MiddlewareFunc, h *handlers) {
+	r.Get("/openapi.yaml", Handle(h.OpenAPISpecHandler))
+	r.Get("/docs", Handle(h.DocsHandler))
+
+	// The operations are routed by the server generated from the OpenAPI spec.
+	// Roles allowed to call an operation are the scopes of its bearer security, and
+	// POST requests with an Idempotency-Key are replayed on retries. Middlewares run
+	// in reverse order, so that idempotency keys are scoped per authorized user and
+	// rate limits are counted per user, replays included. CSRF tokens are checked
+	// first, so that forged requests aren't even counted.
+	dto.HandlerWithOptions(h, dto.ChiServerOptions{
+		BaseRouter:       r,
+		Middlewares:      mws,
+		ErrorHandlerFunc: paramsErrorHandler,
+	})
+}
 
-			r.Post("/receptions", Handle(h.NewReceptionHandler))
-			r.Post("/products", Handle(h.AddProductHandler))
-			r.Post("/{pvzId}/delete_last_product", Handle(h.DeleteLastProductHandler))
-			r.Post("/{pvzId}/close_last_reception", Handle(h.CloseReceptionHandler))
-		})
+// paramsErrorHandler answers requests whose parameters the generated server
+// wrapper failed to bind.
+func paramsErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	WriteHTTPError(w, r, BadRequestParamsError(err))
+}
 
-		// Moderators and employees:
-		r.Group(func(r chi.Router) {
-			r.Use(mws.AuthorizeRoles(auth.UserRoleEmployee, auth.UserRoleModerator))
+func notFoundHandler(w http.ResponseWriter, r *http.Request) {
+	WriteHTTPError(w, r, &HTTPError{
+		Code:    http.StatusNotFound,
+		ErrCode: dto.ErrCodeNotFound,
+		Message: "Resource not found",
+		Err:     fmt.Errorf("no route for %s", r.URL.Path),
+	})
+}
 
-			r.Get("/pvz", Handle(h.GetPvzHandler))
-		})
+func methodNotAllowedHandler(w http.ResponseWriter, r *http.Request) {
+	WriteHTTPError(w, r, &HTTPError{
+		Code:    http.StatusMethodNotAllowed,
+		ErrCode: dto.ErrCodeMethodNotAllowed,
+		Message: "Method not allowed",
+		Err:     fmt.Errorf("method %s is not allowed for %s", r.Method, r.URL.Path),
 	})
 }
@@ -0,0 +1,231 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/shrtyk/pvz-service/api/openapi"
+	"github.com/shrtyk/pvz-service/internal/api/http/dto"
+	"github.com/shrtyk/pvz-service/internal/core/domain/auth"
+	pAuthMock "github.com/shrtyk/pvz-service/internal/core/ports/auth/mocks"
+	metricsmocks "github.com/shrtyk/pvz-service/internal/core/ports/metrics/mocks"
+	pr "github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
+	ratelimitmocks "github.com/shrtyk/pvz-service/internal/core/ports/ratelimit/mocks"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouter(t *testing.T) {
+	t.Parallel()
+
+	const pvzID = "00000000-0000-0000-0000-000000000001"
+
+	moderatorClaims := &auth.AccessTokenClaims{
+		Role: string(auth.UserRoleModerator),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: "1",
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		method       string
+		target       string
+		token        string
+		expectedCode int
+	}{
+		{
+			name:         "spec operation requires authentication",
+			method:       http.MethodPost,
+			target:       "/api/v1/pvz/" + pvzID + "/close_last_reception",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "spec operation requires a role from its scopes",
+			method:       http.MethodPost,
+			target:       "/api/v1/pvz/" + pvzID + "/close_last_reception",
+			token:        "moderator-token",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "malformed path param",
+			method:       http.MethodPost,
+			target:       "/api/v1/pvz/not-a-uuid/close_last_reception",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "path missing from the spec",
+			method:       http.MethodPost,
+			target:       "/api/v1/" + pvzID + "/close_last_reception",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "unversioned route without legacy routes",
+			method:       http.MethodPost,
+			target:       "/pvz/" + pvzID + "/close_last_reception",
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, _ := logger.NewTestLogger()
+			metrics := new(metricsmocks.MockCollector)
+			metrics.On("ObserveHTTPRequestDuration", tc.method, mock.AnythingOfType("string"), mock.AnythingOfType("float64")).Return()
+			metrics.On("IncHTTPRequestsTotal", tc.method, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return()
+			ts := pAuthMock.NewMockTokenService(t)
+			if tc.token != "" {
+				ts.On("GetTokenClaims", tc.token).Return(moderatorClaims, nil)
+			}
+
+			r := NewRouter(nil, ts, l, metrics)
+
+			req := httptest.NewRequest(tc.method, tc.target, nil)
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedCode, rr.Code)
+		})
+	}
+
+	t.Run("serves the spec and its docs", func(t *testing.T) {
+		t.Parallel()
+
+		l, _ := logger.NewTestLogger()
+		metrics := new(metricsmocks.MockCollector)
+		metrics.On("ObserveHTTPRequestDuration", http.MethodGet, mock.AnythingOfType("string"), mock.AnythingOfType("float64")).Return()
+		metrics.On("IncHTTPRequestsTotal", http.MethodGet, mock.AnythingOfType("string"), "200").Return()
+		r := NewRouter(nil, nil, l, metrics)
+
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/v1/openapi.yaml", nil))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/yaml", rr.Header().Get("Content-Type"))
+		assert.Equal(t, openapi.Spec, rr.Body.Bytes())
+
+		rr = httptest.NewRecorder()
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/v1/docs", nil))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `url: "openapi.yaml"`)
+		assert.Equal(t, openapi.DocsContentSecurityPolicy, rr.Header().Get("Content-Security-Policy"))
+	})
+
+	t.Run("unknown routes are described as problems", func(t *testing.T) {
+		t.Parallel()
+
+		l, _ := logger.NewTestLogger()
+		metrics := new(metricsmocks.MockCollector)
+		metrics.On("ObserveHTTPRequestDuration", http.MethodGet, unmatchedRoute, mock.AnythingOfType("float64")).Return()
+		metrics.On("IncHTTPRequestsTotal", http.MethodGet, unmatchedRoute, "404").Return()
+		metrics.On("ObserveHTTPRequestDuration", http.MethodDelete, mock.AnythingOfType("string"), mock.AnythingOfType("float64")).Return()
+		metrics.On("IncHTTPRequestsTotal", http.MethodDelete, mock.AnythingOfType("string"), "405").Return()
+		r := NewRouter(nil, nil, l, metrics)
+
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/v1/unknown", nil))
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.Equal(t, problemContentType, rr.Header().Get("Content-Type"))
+		assert.Contains(t, rr.Body.String(), `"code": "not_found"`)
+
+		rr = httptest.NewRecorder()
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/api/v1/pvz", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"code": "method_not_allowed"`)
+	})
+
+	t.Run("legacy routes", func(t *testing.T) {
+		t.Parallel()
+
+		l, _ := logger.NewTestLogger()
+		metrics := new(metricsmocks.MockCollector)
+		metrics.On("ObserveHTTPRequestDuration", http.MethodPost, "/pvz/{pvzId}/close_last_reception", mock.AnythingOfType("float64")).Return()
+		metrics.On("IncHTTPRequestsTotal", http.MethodPost, "/pvz/{pvzId}/close_last_reception", "401").Return()
+		metrics.On("ObserveHTTPRequestDuration", http.MethodPost, "/api/v1/pvz/{pvzId}/close_last_reception", mock.AnythingOfType("float64")).Return()
+		metrics.On("IncHTTPRequestsTotal", http.MethodPost, "/api/v1/pvz/{pvzId}/close_last_reception", "401").Return()
+		deprecatedAt := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+		sunset := time.Date(2027, 4, 18, 0, 0, 0, 0, time.UTC)
+		r := NewRouter(nil, nil, l, metrics, WithLegacyRoutes(deprecatedAt, sunset))
+
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/pvz/"+pvzID+"/close_last_reception", nil))
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Equal(t, "@1792281600", rr.Header().Get("Deprecation"))
+		assert.Equal(t, "Sun, 18 Apr 2027 00:00:00 GMT", rr.Header().Get("Sunset"))
+		assert.Equal(t, `</api/v1/pvz/`+pvzID+`/close_last_reception>; rel="successor-version"`, rr.Header().Get("Link"))
+
+		rr = httptest.NewRecorder()
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/v1/pvz/"+pvzID+"/close_last_reception", nil))
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Empty(t, rr.Header().Get("Deprecation"))
+	})
+
+	t.Run("metrics are served only on demand", func(t *testing.T) {
+		t.Parallel()
+
+		r := NewRouter(nil, nil, nil, nil)
+		assert.False(t, r.Match(chi.NewRouteContext(), http.MethodGet, "/metrics"))
+
+		r = NewRouter(nil, nil, nil, nil, WithMetricsEndpoint())
+		assert.True(t, r.Match(chi.NewRouteContext(), http.MethodGet, "/metrics"))
+	})
+
+	t.Run("rate limited operations", func(t *testing.T) {
+		t.Parallel()
+
+		l, _ := logger.NewTestLogger()
+		metrics := new(metricsmocks.MockCollector)
+		metrics.On("ObserveHTTPRequestDuration", http.MethodPost, "/api/v1/login", mock.AnythingOfType("float64")).Return()
+		metrics.On("IncHTTPRequestsTotal", http.MethodPost, "/api/v1/login", "429").Return()
+		limiter := ratelimitmocks.NewMockLimiter(t)
+		limiter.EXPECT().Take(mock.Anything, "POST /login", mock.Anything).Return(pr.Result{Limit: 5}, nil)
+		r := NewRouter(nil, nil, l, metrics, WithRateLimiter(limiter))
+
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/v1/login", nil))
+		assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+		assert.Equal(t, "5", rr.Header().Get("RateLimit-Limit"))
+	})
+
+	t.Run("browser protections", func(t *testing.T) {
+		t.Parallel()
+
+		l, _ := logger.NewTestLogger()
+		metrics := new(metricsmocks.MockCollector)
+		metrics.On("ObserveHTTPRequestDuration", mock.Anything, mock.Anything, mock.Anything).Return()
+		metrics.On("IncHTTPRequestsTotal", mock.Anything, mock.Anything, mock.Anything).Return()
+		r := NewRouter(nil, nil, l, metrics,
+			WithCORS(CORSPolicy{AllowedOrigins: []string{"https://admin.example.com"}, AllowedMethods: []string{http.MethodPost}}),
+			WithSecurityHeaders(SecurityHeaders{FrameOptions: "DENY"}),
+			WithCSRFProtection(),
+		)
+
+		req := httptest.NewRequest(http.MethodOptions, "/api/v1/tokens/refresh", nil)
+		req.Header.Set("Origin", "https://admin.example.com")
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Equal(t, "https://admin.example.com", rr.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, http.MethodPost, rr.Header().Get("Access-Control-Allow-Methods"))
+
+		req = httptest.NewRequest(http.MethodPost, "/api/v1/tokens/refresh", nil)
+		req.AddCookie(&http.Cookie{Name: refreshTokenKey, Value: "refresh-token"})
+		rr = httptest.NewRecorder()
+		r.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		assert.Contains(t, rr.Body.String(), string(dto.ErrCodeCSRFTokenMismatch))
+		assert.Equal(t, "DENY", rr.Header().Get("X-Frame-Options"))
+		assert.Equal(t, "nosniff", rr.Header().Get("X-Content-Type-Options"))
+	})
+}
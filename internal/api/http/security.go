@@ -0,0 +1,187 @@
+package http
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/api/http/dto"
+)
+
+const (
+	// CSRFHeader carries the CSRF token of requests authenticated by cookies.
+	CSRFHeader     = "X-CSRF-Token"
+	csrfCookieName = "csrf_token"
+)
+
+// CORSPolicy describes the cross-origin requests browsers are allowed to make.
+type CORSPolicy struct {
+	// AllowedOrigins are like https://admin.example.com, * allows any origin, but
+	// without credentials.
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	ExposedHeaders   []string
+	AllowCredentials bool
+	MaxAge           time.Duration
+}
+
+// NewCORSMW answers preflight requests of the allowed origins and lets their
+// scripts read the responses. Requests of other origins are served without CORS
+// headers, so browsers keep their responses away from the scripts.
+func NewCORSMW(p CORSPolicy) func(http.Handler) http.Handler {
+	anyOrigin := slices.Contains(p.AllowedOrigins, "*")
+	methods := strings.Join(p.AllowedMethods, ", ")
+	headers := strings.Join(p.AllowedHeaders, ", ")
+	exposed := strings.Join(p.ExposedHeaders, ", ")
+	maxAge := strconv.Itoa(int(p.MaxAge.Seconds()))
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Add("Vary", "Origin")
+			listed := slices.Contains(p.AllowedOrigins, origin)
+			if !anyOrigin && !listed {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Credentials are only allowed to the listed origins. Any other site
+			// could otherwise make requests with the cookies of its visitors and
+			// read the answers, so origins let in by * get the wildcard alone.
+			if listed {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				if p.AllowCredentials {
+					w.Header().Set("Access-Control-Allow-Credentials", "true")
+				}
+			} else {
+				w.Header().Set("Access-Control-Allow-Origin", "*")
+			}
+
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				w.Header().Add("Vary", "Access-Control-Request-Method")
+				w.Header().Add("Vary", "Access-Control-Request-Headers")
+				w.Header().Set("Access-Control-Allow-Methods", methods)
+				w.Header().Set("Access-Control-Allow-Headers", headers)
+				w.Header().Set("Access-Control-Max-Age", maxAge)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			if exposed != "" {
+				w.Header().Set("Access-Control-Expose-Headers", exposed)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// SecurityHeaders are the headers hardening browsers against the API responses.
+type SecurityHeaders struct {
+	// HSTSMaxAge is how long browsers stick to HTTPS, zero leaves out the header.
+	HSTSMaxAge            time.Duration
+	HSTSIncludeSubdomains bool
+	ContentSecurityPolicy string
+	FrameOptions          string
+}
+
+// NewSecurityHeadersMW sets the security headers on every response. Handlers serving
+// pages, like the API docs, may loosen the content security policy.
+func NewSecurityHeadersMW(h SecurityHeaders) func(http.Handler) http.Handler {
+	var hsts string
+	if h.HSTSMaxAge > 0 {
+		hsts = "max-age=" + strconv.Itoa(int(h.HSTSMaxAge.Seconds()))
+		if h.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Content-Type-Options", "nosniff")
+			w.Header().Set("Referrer-Policy", "no-referrer")
+			if h.FrameOptions != "" {
+				w.Header().Set("X-Frame-Options", h.FrameOptions)
+			}
+			if h.ContentSecurityPolicy != "" {
+				w.Header().Set("Content-Security-Policy", h.ContentSecurityPolicy)
+			}
+			if hsts != "" {
+				w.Header().Set("Strict-Transport-Security", hsts)
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// CSRFMW protects operations authenticated by the refresh token cookie with double
+// submitted tokens: requests carrying the cookie have to send the token of the
+// csrf_token cookie in the X-CSRF-Token header as well. Other sites can make
+// browsers send the cookies, but can't read them to set the header.
+func CSRFMW(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.Context().Value(dto.RefreshTokenCookieScopes).([]string); !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if _, err := r.Cookie(refreshTokenKey); err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if err := checkCSRFToken(r); err != nil {
+			WriteHTTPError(w, r, &HTTPError{
+				Code:    http.StatusForbidden,
+				ErrCode: dto.ErrCodeCSRFTokenMismatch,
+				Message: "CSRF token is missing or doesn't match",
+				Err:     err,
+			})
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func checkCSRFToken(r *http.Request) error {
+	cookie, err := r.Cookie(csrfCookieName)
+	if err != nil || cookie.Value == "" {
+		return errors.New("no csrf token cookie")
+	}
+	token := r.Header.Get(CSRFHeader)
+	if token == "" {
+		return errors.New("no csrf token header")
+	}
+	if subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(token)) != 1 {
+		return errors.New("csrf token header doesn't match the cookie")
+	}
+	return nil
+}
+
+// setCSRFToken issues a new CSRF token along with a refresh token. It is set in a
+// cookie readable by scripts of the API origin and in the X-CSRF-Token header for
+// apps of other origins, which can't read the cookies of the API.
+func setCSRFToken(w http.ResponseWriter, maxAge int) {
+	token := rand.Text()
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     csrfCookieName,
+		Value:    token,
+		Path:     "/",
+		MaxAge:   maxAge,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	})
+	w.Header().Set(CSRFHeader, token)
+}
@@ -0,0 +1,243 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/api/http/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCORSMW(t *testing.T) {
+	t.Parallel()
+
+	policy := CORSPolicy{
+		AllowedOrigins:   []string{"https://admin.example.com"},
+		AllowedMethods:   []string{http.MethodGet, http.MethodPost},
+		AllowedHeaders:   []string{"Authorization", CSRFHeader},
+		ExposedHeaders:   []string{"ETag"},
+		AllowCredentials: true,
+		MaxAge:           10 * time.Minute,
+	}
+
+	testCases := []struct {
+		name           string
+		policy         CORSPolicy
+		method         string
+		header         http.Header
+		expectedCode   int
+		expectedHeader http.Header
+	}{
+		{
+			name:           "same origin request",
+			policy:         policy,
+			method:         http.MethodGet,
+			expectedCode:   http.StatusOK,
+			expectedHeader: http.Header{},
+		},
+		{
+			name:           "disallowed origin",
+			policy:         policy,
+			method:         http.MethodGet,
+			header:         http.Header{"Origin": {"https://evil.example.com"}},
+			expectedCode:   http.StatusOK,
+			expectedHeader: http.Header{"Vary": {"Origin"}},
+		},
+		{
+			name:         "allowed origin",
+			policy:       policy,
+			method:       http.MethodGet,
+			header:       http.Header{"Origin": {"https://admin.example.com"}},
+			expectedCode: http.StatusOK,
+			expectedHeader: http.Header{
+				"Vary":                             {"Origin"},
+				"Access-Control-Allow-Origin":      {"https://admin.example.com"},
+				"Access-Control-Allow-Credentials": {"true"},
+				"Access-Control-Expose-Headers":    {"ETag"},
+			},
+		},
+		{
+			name:   "preflight request",
+			policy: policy,
+			method: http.MethodOptions,
+			header: http.Header{
+				"Origin":                        {"https://admin.example.com"},
+				"Access-Control-Request-Method": {http.MethodPost},
+			},
+			expectedCode: http.StatusNoContent,
+			expectedHeader: http.Header{
+				"Vary":                             {"Origin", "Access-Control-Request-Method", "Access-Control-Request-Headers"},
+				"Access-Control-Allow-Origin":      {"https://admin.example.com"},
+				"Access-Control-Allow-Credentials": {"true"},
+				"Access-Control-Allow-Methods":     {"GET, POST"},
+				"Access-Control-Allow-Headers":     {"Authorization, X-CSRF-Token"},
+				"Access-Control-Max-Age":           {"600"},
+			},
+		},
+		{
+			name:         "any origin without credentials",
+			policy:       CORSPolicy{AllowedOrigins: []string{"*"}},
+			method:       http.MethodGet,
+			header:       http.Header{"Origin": {"https://app.example.com"}},
+			expectedCode: http.StatusOK,
+			expectedHeader: http.Header{
+				"Vary":                        {"Origin"},
+				"Access-Control-Allow-Origin": {"*"},
+			},
+		},
+		{
+			name:         "any origin gets no credentials",
+			policy:       CORSPolicy{AllowedOrigins: []string{"*"}, AllowCredentials: true},
+			method:       http.MethodGet,
+			header:       http.Header{"Origin": {"https://evil.example.com"}},
+			expectedCode: http.StatusOK,
+			expectedHeader: http.Header{
+				"Vary":                        {"Origin"},
+				"Access-Control-Allow-Origin": {"*"},
+			},
+		},
+		{
+			name: "listed origin next to any origin keeps credentials",
+			policy: CORSPolicy{
+				AllowedOrigins:   []string{"*", "https://admin.example.com"},
+				AllowCredentials: true,
+			},
+			method:       http.MethodGet,
+			header:       http.Header{"Origin": {"https://admin.example.com"}},
+			expectedCode: http.StatusOK,
+			expectedHeader: http.Header{
+				"Vary":                             {"Origin"},
+				"Access-Control-Allow-Origin":      {"https://admin.example.com"},
+				"Access-Control-Allow-Credentials": {"true"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := NewCORSMW(tc.policy)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(tc.method, "/api/v1/pvz", nil)
+			for k, v := range tc.header {
+				req.Header[k] = v
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedCode, rr.Code)
+			assert.Equal(t, tc.expectedHeader, rr.Header())
+		})
+	}
+}
+
+func TestNewSecurityHeadersMW(t *testing.T) {
+	t.Parallel()
+
+	handler := NewSecurityHeadersMW(SecurityHeaders{
+		HSTSMaxAge:            time.Hour,
+		HSTSIncludeSubdomains: true,
+		ContentSecurityPolicy: "default-src 'none'",
+		FrameOptions:          "DENY",
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/v1/pvz", nil))
+
+	assert.Equal(t, http.Header{
+		"X-Content-Type-Options":    {"nosniff"},
+		"Referrer-Policy":           {"no-referrer"},
+		"X-Frame-Options":           {"DENY"},
+		"Content-Security-Policy":   {"default-src 'none'"},
+		"Strict-Transport-Security": {"max-age=3600; includeSubDomains"},
+	}, rr.Header())
+
+	handler = NewSecurityHeadersMW(SecurityHeaders{})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/v1/pvz", nil))
+	assert.Empty(t, rr.Header().Get("Strict-Transport-Security"))
+}
+
+func TestCSRFMW(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		cookieAuth   bool
+		cookies      []*http.Cookie
+		token        string
+		expectedCode int
+	}{
+		{
+			name:         "operation not authenticated by cookie",
+			cookies:      []*http.Cookie{{Name: refreshTokenKey, Value: "refresh"}},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "no refresh cookie",
+			cookieAuth:   true,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "no csrf token",
+			cookieAuth:   true,
+			cookies:      []*http.Cookie{{Name: refreshTokenKey, Value: "refresh"}, {Name: csrfCookieName, Value: "token"}},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "no csrf cookie",
+			cookieAuth:   true,
+			cookies:      []*http.Cookie{{Name: refreshTokenKey, Value: "refresh"}},
+			token:        "token",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "mismatched csrf token",
+			cookieAuth:   true,
+			cookies:      []*http.Cookie{{Name: refreshTokenKey, Value: "refresh"}, {Name: csrfCookieName, Value: "token"}},
+			token:        "forged",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "matching csrf token",
+			cookieAuth:   true,
+			cookies:      []*http.Cookie{{Name: refreshTokenKey, Value: "refresh"}, {Name: csrfCookieName, Value: "token"}},
+			token:        "token",
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := CSRFMW(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/tokens/refresh", nil)
+			if tc.cookieAuth {
+				req = req.WithContext(context.WithValue(req.Context(), dto.RefreshTokenCookieScopes, []string{}))
+			}
+			for _, c := range tc.cookies {
+				req.AddCookie(c)
+			}
+			if tc.token != "" {
+				req.Header.Set(CSRFHeader, tc.token)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedCode, rr.Code)
+			if tc.expectedCode == http.StatusForbidden {
+				assert.Contains(t, rr.Body.String(), string(dto.ErrCodeCSRFTokenMismatch))
+			}
+		})
+	}
+}
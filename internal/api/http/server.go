@@ -0,0 +1,169 @@
+package http
+
+import (
+	"net/http"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/shrtyk/pvz-service/internal/api/http/dto"
+)
+
+// handlers implement the server interface generated from the OpenAPI spec, so that
+// every documented operation has a handler and is routed the way the spec describes it.
+// The generated wrapper already checked the format of the operation parameters, and
+// the handlers still parse them on their own to answer with the messages of the API.
+var _ dto.ServerInterface = (*handlers)(nil)
+
+// GetAnalyticsReceptions serves GET /analytics/receptions.
+func (h *handlers) GetAnalyticsReceptions(w http.ResponseWriter, r *http.Request, _ dto.GetAnalyticsReceptionsParams) {
+	Handle(h.ReceptionStatsHandler)(w, r)
+}
+
+// GetAnalyticsThroughput serves GET /analytics/throughput.
+func (h *handlers) GetAnalyticsThroughput(w http.ResponseWriter, r *http.Request, _ dto.GetAnalyticsThroughputParams) {
+	Handle(h.ProductsThroughputHandler)(w, r)
+}
+
+// PostDummyLogin serves POST /dummyLogin.
+func (h *handlers) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
+	Handle(h.DummyLoginHandler)(w, r)
+}
+
+// GetExportsJobsJobId serves GET /exports/jobs/{jobId}.
+func (h *handlers) GetExportsJobsJobId(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID) {
+	Handle(h.ExportJobHandler)(w, r)
+}
+
+// GetExportsJobsJobIdFile serves GET /exports/jobs/{jobId}/file.
+func (h *handlers) GetExportsJobsJobIdFile(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID) {
+	Handle(h.ExportFileHandler)(w, r)
+}
+
+// GetExportsReceptions serves GET /exports/receptions.
+func (h *handlers) GetExportsReceptions(w http.ResponseWriter, r *http.Request, _ dto.GetExportsReceptionsParams) {
+	Handle(h.ExportReceptionsHandler)(w, r)
+}
+
+// PostLogin serves POST /login.
+func (h *handlers) PostLogin(w http.ResponseWriter, r *http.Request) {
+	Handle(h.LoginUserHandler)(w, r)
+}
+
+// PostProducts serves POST /products.
+func (h *handlers) PostProducts(w http.ResponseWriter, r *http.Request, _ dto.PostProductsParams) {
+	Handle(h.AddProductHandler)(w, r)
+}
+
+// PostProductsBatch serves POST /products/batch.
+func (h *handlers) PostProductsBatch(w http.ResponseWriter, r *http.Request, _ dto.PostProductsBatchParams) {
+	Handle(h.AddProductsBatchHandler)(w, r)
+}
+
+// GetProductsBarcode serves GET /products/{barcode}.
+func (h *handlers) GetProductsBarcode(w http.ResponseWriter, r *http.Request, _ string) {
+	Handle(h.ProductByBarcodeHandler)(w, r)
+}
+
+// DeleteProductsProductId serves DELETE /products/{productId}.
+func (h *handlers) DeleteProductsProductId(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID) {
+	Handle(h.DeleteProductHandler)(w, r)
+}
+
+// GetProductsProductIdHistory serves GET /products/{productId}/history.
+func (h *handlers) GetProductsProductIdHistory(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID) {
+	Handle(h.ProductStatusHistoryHandler)(w, r)
+}
+
+// PostProductsProductIdIssue serves POST /products/{productId}/issue.
+func (h *handlers) PostProductsProductIdIssue(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID, _ dto.PostProductsProductIdIssueParams) {
+	Handle(h.IssueProductHandler)(w, r)
+}
+
+// PostProductsProductIdPickupCode serves POST /products/{productId}/pickup_code.
+func (h *handlers) PostProductsProductIdPickupCode(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID) {
+	Handle(h.IssuePickupCodeHandler)(w, r)
+}
+
+// PostProductsProductIdRestore serves POST /products/{productId}/restore.
+func (h *handlers) PostProductsProductIdRestore(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID, _ dto.PostProductsProductIdRestoreParams) {
+	Handle(h.RestoreProductHandler)(w, r)
+}
+
+// PostProductsProductIdReturn serves POST /products/{productId}/return.
+func (h *handlers) PostProductsProductIdReturn(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID, _ dto.PostProductsProductIdReturnParams) {
+	Handle(h.ReturnProductHandler)(w, r)
+}
+
+// GetPvz serves GET /pvz.
+func (h *handlers) GetPvz(w http.ResponseWriter, r *http.Request, _ dto.GetPvzParams) {
+	Handle(h.GetPvzHandler)(w, r)
+}
+
+// PostPvz serves POST /pvz.
+func (h *handlers) PostPvz(w http.ResponseWriter, r *http.Request, _ dto.PostPvzParams) {
+	Handle(h.NewPVZHandler)(w, r)
+}
+
+// GetPvzPvzId serves GET /pvz/{pvzId}.
+func (h *handlers) GetPvzPvzId(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID, _ dto.GetPvzPvzIdParams) {
+	Handle(h.PvzByIdHandler)(w, r)
+}
+
+// PutPvzPvzId serves PUT /pvz/{pvzId}.
+func (h *handlers) PutPvzPvzId(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID, _ dto.PutPvzPvzIdParams) {
+	Handle(h.UpdatePvzHandler)(w, r)
+}
+
+// PostPvzPvzIdCancelLastReception serves POST /pvz/{pvzId}/cancel_last_reception.
+func (h *handlers) PostPvzPvzIdCancelLastReception(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID, _ dto.PostPvzPvzIdCancelLastReceptionParams) {
+	Handle(h.CancelReceptionHandler)(w, r)
+}
+
+// PostPvzPvzIdCloseLastReception serves POST /pvz/{pvzId}/close_last_reception.
+func (h *handlers) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID, _ dto.PostPvzPvzIdCloseLastReceptionParams) {
+	Handle(h.CloseReceptionHandler)(w, r)
+}
+
+// PostPvzPvzIdDeleteLastProduct serves POST /pvz/{pvzId}/delete_last_product.
+func (h *handlers) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID, _ dto.PostPvzPvzIdDeleteLastProductParams) {
+	Handle(h.DeleteLastProductHandler)(w, r)
+}
+
+// GetPvzPvzIdStats serves GET /pvz/{pvzId}/stats.
+func (h *handlers) GetPvzPvzIdStats(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID) {
+	Handle(h.PvzStockHandler)(w, r)
+}
+
+// PostReceptions serves POST /receptions.
+func (h *handlers) PostReceptions(w http.ResponseWriter, r *http.Request, _ dto.PostReceptionsParams) {
+	Handle(h.NewReceptionHandler)(w, r)
+}
+
+// GetReceptionsReceptionId serves GET /receptions/{receptionId}.
+func (h *handlers) GetReceptionsReceptionId(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID, _ dto.GetReceptionsReceptionIdParams) {
+	Handle(h.ReceptionByIdHandler)(w, r)
+}
+
+// GetReceptionsReceptionIdDeletions serves GET /receptions/{receptionId}/deletions.
+func (h *handlers) GetReceptionsReceptionIdDeletions(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID) {
+	Handle(h.ProductDeletionsHandler)(w, r)
+}
+
+// PutReceptionsReceptionIdManifest serves PUT /receptions/{receptionId}/manifest.
+func (h *handlers) PutReceptionsReceptionIdManifest(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID, _ dto.PutReceptionsReceptionIdManifestParams) {
+	Handle(h.SetReceptionManifestHandler)(w, r)
+}
+
+// PostReceptionsReceptionIdReopen serves POST /receptions/{receptionId}/reopen.
+func (h *handlers) PostReceptionsReceptionIdReopen(w http.ResponseWriter, r *http.Request, _ openapi_types.UUID, _ dto.PostReceptionsReceptionIdReopenParams) {
+	Handle(h.ReopenReceptionHandler)(w, r)
+}
+
+// PostRegister serves POST /register.
+func (h *handlers) PostRegister(w http.ResponseWriter, r *http.Request) {
+	Handle(h.RegisterUserHandler)(w, r)
+}
+
+// PostTokensRefresh serves POST /tokens/refresh.
+func (h *handlers) PostTokensRefresh(w http.ResponseWriter, r *http.Request, _ dto.PostTokensRefreshParams) {
+	Handle(h.RefreshTokensHandler)(w, r)
+}
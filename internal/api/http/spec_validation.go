@@ -0,0 +1,111 @@
+package http
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/shrtyk/pvz-service/api/openapi"
+	"github.com/shrtyk/pvz-service/internal/api/http/dto"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+)
+
+func init() {
+	// Export files are validated as opaque binary strings.
+	openapi3filter.RegisterBodyDecoder("text/csv", openapi3filter.FileBodyDecoder)
+	openapi3filter.RegisterBodyDecoder(xlsxContentType, openapi3filter.FileBodyDecoder)
+}
+
+// MustNewSpecValidationMW returns a middleware checking the requests and responses of
+// the operations described by the OpenAPI spec against it. Requests that don't conform
+// are rejected, while nonconforming responses are only logged, as the handler has done
+// its work by then. Every response is recorded, so it is meant for development only.
+//
+// Authentication isn't checked here, it is up to OperationSecurityMW.
+func MustNewSpecValidationMW() func(http.Handler) http.Handler {
+	router, err := newSpecRouter()
+	if err != nil {
+		panic(err)
+	}
+
+	opts := &openapi3filter.Options{
+		AuthenticationFunc:    openapi3filter.NoopAuthenticationFunc,
+		IncludeResponseStatus: true,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := router.FindRoute(r)
+			if err != nil {
+				// Not an operation of the spec, like /metrics or an unknown path.
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			reqInput := &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    opts,
+			}
+			if err := openapi3filter.ValidateRequest(r.Context(), reqInput); err != nil {
+				WriteHTTPError(w, r, &HTTPError{
+					Code:    http.StatusBadRequest,
+					ErrCode: dto.ErrCodeSpecViolation,
+					Message: "Request doesn't match the API specification",
+					Err:     err,
+				})
+				return
+			}
+
+			rw := &recordingWriter{ResponseWriter: w, statusCode: http.StatusOK}
+			next.ServeHTTP(rw, r)
+
+			respOpts := *opts
+			// NDJSON streams are a sequence of documents, each described by the schema.
+			if ct, _, _ := mime.ParseMediaType(rw.Header().Get("Content-Type")); ct == ndjsonContentType {
+				respOpts.ExcludeResponseBody = true
+			}
+			respInput := &openapi3filter.ResponseValidationInput{
+				RequestValidationInput: reqInput,
+				Status:                 rw.statusCode,
+				Header:                 rw.Header(),
+				Body:                   io.NopCloser(bytes.NewReader(rw.body.Bytes())),
+				Options:                &respOpts,
+			}
+			if err := openapi3filter.ValidateResponse(r.Context(), respInput); err != nil {
+				logger.FromCtx(r.Context()).Error(
+					"Response doesn't match the API specification",
+					slog.String("operation", r.Method+" "+route.Path),
+					slog.Int("status_code", rw.statusCode),
+					logger.WithErr(err),
+				)
+			}
+		})
+	}
+}
+
+func newSpecRouter() (routers.Router, error) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(openapi.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OpenAPI spec: %w", err)
+	}
+	if err := doc.Validate(loader.Context); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI spec: %w", err)
+	}
+
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OpenAPI spec router: %w", err)
+	}
+
+	return router, nil
+}
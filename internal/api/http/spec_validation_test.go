@@ -0,0 +1,124 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/shrtyk/pvz-service/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpecValidationMW(t *testing.T) {
+	t.Parallel()
+
+	mw := MustNewSpecValidationMW()
+
+	testCases := []struct {
+		name             string
+		method           string
+		target           string
+		body             string
+		handler          http.HandlerFunc
+		expectedCode     int
+		expectNext       bool
+		expectedLogEntry string
+	}{
+		{
+			name:   "conforming request and response",
+			method: http.MethodPost,
+			target: "/api/v1/pvz",
+			body:   `{"city":"Казань"}`,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = WriteJSON(w, map[string]any{"city": "Казань", "version": 1}, http.StatusCreated, nil)
+			},
+			expectedCode: http.StatusCreated,
+			expectNext:   true,
+		},
+		{
+			name:         "request body not matching the schema",
+			method:       http.MethodPost,
+			target:       "/api/v1/pvz",
+			body:         `{"city":"Berlin"}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "legacy route not matching the schema",
+			method:       http.MethodPost,
+			target:       "/pvz",
+			body:         `{"city":"Berlin"}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "query param not matching the schema",
+			method:       http.MethodGet,
+			target:       "/api/v1/pvz?limit=0",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "response not matching the schema is only logged",
+			method: http.MethodPost,
+			target: "/pvz",
+			body:   `{"city":"Казань"}`,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = WriteJSON(w, map[string]any{"city": "Berlin"}, http.StatusCreated, nil)
+			},
+			expectedCode:     http.StatusCreated,
+			expectNext:       true,
+			expectedLogEntry: "Response doesn't match the API specification",
+		},
+		{
+			name:   "undocumented response status is only logged",
+			method: http.MethodGet,
+			target: "/api/v1/pvz/00000000-0000-0000-0000-000000000001/stats",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusTeapot)
+			},
+			expectedCode:     http.StatusTeapot,
+			expectNext:       true,
+			expectedLogEntry: "status is not supported",
+		},
+		{
+			name:   "path outside of the spec",
+			method: http.MethodGet,
+			target: "/metrics",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			expectedCode: http.StatusOK,
+			expectNext:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, logs := logger.NewTestLogger()
+
+			nextCalled := false
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				nextCalled = true
+				tc.handler(w, r)
+			})
+
+			req := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+			if tc.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			req = req.WithContext(logger.ToCtx(req.Context(), l))
+			rr := httptest.NewRecorder()
+
+			mw(next).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedCode, rr.Code)
+			assert.Equal(t, tc.expectNext, nextCalled)
+			if tc.expectedLogEntry != "" {
+				assert.Contains(t, logs.String(), tc.expectedLogEntry)
+			} else {
+				assert.NotContains(t, logs.String(), "Response doesn't match")
+			}
+		})
+	}
+}
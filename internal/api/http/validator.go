@@ -2,6 +2,8 @@ package http
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/oapi-codegen/runtime/types"
@@ -13,6 +15,8 @@ var (
 
 func MustNewValidator() *validator.Validate {
 	v := validator.New()
+	// Field errors name fields the way clients send them.
+	v.RegisterTagNameFunc(jsonFieldName)
 
 	err := registerUUIDValidatorImpl(v)
 	if err != nil {
@@ -35,3 +39,14 @@ func registerUUIDValidator(v *validator.Validate) error {
 	}
 	return nil
 }
+
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	}
+	return name
+}
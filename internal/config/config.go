@@ -3,11 +3,14 @@ package config
 import (
 	"flag"
 	"fmt"
+	"net/netip"
 	"os"
+	"reflect"
 	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 	_ "github.com/joho/godotenv/autoload"
+	"github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
 )
 
 var path string
@@ -17,24 +20,52 @@ func init() {
 }
 
 type Config struct {
-	AppCfg        AppCfg        `yaml:"app"`
-	HttpServerCfg HttpServerCfg `yaml:"http_server"`
-	GrpcServerCfg GrpcServerCfg `yaml:"grpc_server"`
-	PostgresCfg   PostgresCfg   `yaml:"postgres"`
-	AuthTokenCfg  AuthTokensCfg `yaml:"auth_tokens"`
+	AppCfg         AppCfg         `yaml:"app"`
+	HttpServerCfg  HttpServerCfg  `yaml:"http_server"`
+	AdminServerCfg AdminServerCfg `yaml:"admin_server"`
+	GrpcServerCfg  GrpcServerCfg  `yaml:"grpc_server"`
+	PostgresCfg    PostgresCfg    `yaml:"postgres"`
+	AuthTokenCfg   AuthTokensCfg  `yaml:"auth_tokens"`
+	ProductsCfg    ProductsCfg    `yaml:"products"`
+	ReceptionsCfg  ReceptionsCfg  `yaml:"receptions"`
+	PvzCfg         PvzCfg         `yaml:"pvz"`
+	ExportsCfg     ExportsCfg     `yaml:"exports"`
+	StatsCfg       StatsCfg       `yaml:"stats"`
+	IdempotencyCfg IdempotencyCfg `yaml:"idempotency"`
+	TracingCfg     TracingCfg     `yaml:"tracing"`
+	HealthCfg      HealthCfg      `yaml:"health"`
+	RateLimitCfg   RateLimitCfg   `yaml:"rate_limit"`
+	CORSCfg        CORSCfg        `yaml:"cors"`
+	SecurityCfg    SecurityCfg    `yaml:"security"`
 }
 
 type AppCfg struct {
 	Env             string        `yaml:"env" env:"APP_ENV" env-default:"prod"`
 	Timeout         time.Duration `yaml:"timeout" env:"APP_TIMEOUT" env-default:"5s"`
 	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"APP_SHUTDOWN_TIMEOUT" env-default:"10s"`
+	// DrainDelay is how long the app keeps serving with failing readiness on
+	// shutdown, so that load balancers notice and stop routing to it.
+	DrainDelay time.Duration `yaml:"drain_delay" env:"APP_DRAIN_DELAY" env-default:"5s"`
 }
 
 type HttpServerCfg struct {
-	Port         string        `yaml:"port" env:"HTTP_SERVER_PORT" env-default:"8080"`
-	IdleTimeout  time.Duration `yaml:"idle_timeout" env:"HTTP_SERVER_IDLE_TIMEOUT" env-default:"5s"`
-	WriteTimeout time.Duration `yaml:"write_timeout" env:"HTTP_SERVER_WRITE_TIMEOUT" env-default:"10s"`
-	ReadTimeout  time.Duration `yaml:"read_timeout" env:"HTTP_SERVER_READ_TIMEOUT" env-default:"10s"`
+	Port               string        `yaml:"port" env:"HTTP_SERVER_PORT" env-default:"8080"`
+	IdleTimeout        time.Duration `yaml:"idle_timeout" env:"HTTP_SERVER_IDLE_TIMEOUT" env-default:"5s"`
+	WriteTimeout       time.Duration `yaml:"write_timeout" env:"HTTP_SERVER_WRITE_TIMEOUT" env-default:"10s"`
+	ReadTimeout        time.Duration `yaml:"read_timeout" env:"HTTP_SERVER_READ_TIMEOUT" env-default:"10s"`
+	ValidateSpec       bool          `yaml:"validate_spec" env:"HTTP_SERVER_VALIDATE_SPEC" env-default:"false"`
+	PublicMetrics      bool          `yaml:"public_metrics" env:"HTTP_SERVER_PUBLIC_METRICS" env-default:"true"`
+	LegacyDeprecatedAt time.Time     `yaml:"legacy_deprecated_at" env:"HTTP_SERVER_LEGACY_DEPRECATED_AT" env-layout:"2006-01-02" env-default:"2026-10-18"`
+	LegacySunset       time.Time     `yaml:"legacy_sunset" env:"HTTP_SERVER_LEGACY_SUNSET" env-layout:"2006-01-02" env-default:"2027-04-18"`
+}
+
+// AdminServerCfg is the listener of metrics, health checks, profiling and
+// debug endpoints. It shouldn't be reachable from outside of the cluster.
+type AdminServerCfg struct {
+	Enabled bool   `yaml:"enabled" env:"ADMIN_SERVER_ENABLED" env-default:"true"`
+	Port    string `yaml:"port" env:"ADMIN_SERVER_PORT" env-default:"8081"`
+	// WriteTimeout has to outlast CPU profiles and traces, which take 30s by default.
+	WriteTimeout time.Duration `yaml:"write_timeout" env:"ADMIN_SERVER_WRITE_TIMEOUT" env-default:"60s"`
 }
 
 type GrpcServerCfg struct {
@@ -43,7 +74,7 @@ type GrpcServerCfg struct {
 
 type PostgresCfg struct {
 	User     string `yaml:"user" env:"PG_USER" env-default:"user"`
-	Password string `yaml:"password" env:"PG_PASSWORD" env-default:"password"`
+	Password string `yaml:"password" env:"PG_PASSWORD" env-default:"password" secret:"true"`
 	Host     string `yaml:"host" env:"PG_HOST" env-default:"postgres"`
 	Port     string `yaml:"port" env:"PG_PORT" env-default:"5432"`
 	DBName   string `yaml:"db_name" env:"PG_DBNAME" env-default:"pvz-db"`
@@ -60,7 +91,105 @@ type AuthTokensCfg struct {
 	PrivateRSAPath  string        `yaml:"private_key_path" env:"PRIVATE_RSA_PATH" env-default:"./keys/rsa/private_key.pem"`
 	JWTLifetime     time.Duration `yaml:"jwt_lifetime" env:"JWT_LIFETIME" env-default:"15m"`
 	RefreshLifetime time.Duration `yaml:"refresh_lifetime" env:"REFRESH_LIFETIME" env-default:"720h"`
-	SecretKey       string        `yaml:"secret_key" env:"SECRET_KEY" env-default:"super-secret-key"`
+	SecretKey       string        `yaml:"secret_key" env:"SECRET_KEY" env-default:"super-secret-key" secret:"true"`
+}
+
+type ProductsCfg struct {
+	GlobalBarcodeUniqueness bool          `yaml:"global_barcode_uniqueness" env:"PRODUCTS_GLOBAL_BARCODE_UNIQUENESS" env-default:"false"`
+	StoragePeriod           time.Duration `yaml:"storage_period" env:"PRODUCTS_STORAGE_PERIOD" env-default:"168h"`
+	PickupCodeTTL           time.Duration `yaml:"pickup_code_ttl" env:"PRODUCTS_PICKUP_CODE_TTL" env-default:"24h"`
+	PickupCodeMaxAttempts   int           `yaml:"pickup_code_max_attempts" env:"PRODUCTS_PICKUP_CODE_MAX_ATTEMPTS" env-default:"5"`
+}
+
+type ReceptionsCfg struct {
+	ReopenWindow      time.Duration `yaml:"reopen_window" env:"RECEPTIONS_REOPEN_WINDOW" env-default:"1h"`
+	AutoCloseEnabled  bool          `yaml:"auto_close_enabled" env:"RECEPTIONS_AUTO_CLOSE_ENABLED" env-default:"true"`
+	AutoCloseIdle     time.Duration `yaml:"auto_close_idle" env:"RECEPTIONS_AUTO_CLOSE_IDLE" env-default:"12h"`
+	AutoCloseInterval time.Duration `yaml:"auto_close_interval" env:"RECEPTIONS_AUTO_CLOSE_INTERVAL" env-default:"5m"`
+}
+
+type PvzCfg struct {
+	Capacity            int           `yaml:"capacity" env:"PVZ_CAPACITY" env-default:"0"`
+	StockReportInterval time.Duration `yaml:"stock_report_interval" env:"PVZ_STOCK_REPORT_INTERVAL" env-default:"30s"`
+}
+
+type ExportsCfg struct {
+	Dir          string        `yaml:"dir" env:"EXPORTS_DIR" env-default:"exports"`
+	PollInterval time.Duration `yaml:"poll_interval" env:"EXPORTS_POLL_INTERVAL" env-default:"5s"`
+}
+
+type StatsCfg struct {
+	RefreshInterval time.Duration `yaml:"refresh_interval" env:"STATS_REFRESH_INTERVAL" env-default:"5m"`
+	RefreshDays     int           `yaml:"refresh_days" env:"STATS_REFRESH_DAYS" env-default:"2"`
+}
+
+type IdempotencyCfg struct {
+	TTL time.Duration `yaml:"ttl" env:"IDEMPOTENCY_TTL" env-default:"24h"`
+}
+
+type TracingCfg struct {
+	// Exporter is one of none, stdout or otlp.
+	Exporter     string  `yaml:"exporter" env:"TRACING_EXPORTER" env-default:"none"`
+	OTLPEndpoint string  `yaml:"otlp_endpoint" env:"TRACING_OTLP_ENDPOINT" env-default:"localhost:4317"`
+	OTLPInsecure bool    `yaml:"otlp_insecure" env:"TRACING_OTLP_INSECURE" env-default:"true"`
+	SampleRatio  float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO" env-default:"1"`
+	ServiceName  string  `yaml:"service_name" env:"TRACING_SERVICE_NAME" env-default:"pvz-service"`
+}
+
+type HealthCfg struct {
+	// CheckTimeout bounds all readiness checks of a probe.
+	CheckTimeout time.Duration `yaml:"check_timeout" env:"HEALTH_CHECK_TIMEOUT" env-default:"2s"`
+}
+
+// RateLimitCfg holds limits like 10/s, 100/m or off. Operations are the route
+// patterns of HTTP requests, like POST /pvz/{pvzId}/close_last_reception, and the
+// full method names of gRPC calls, like /pvz.v1.PVZService/GetPVZList. All other
+// operations share the default limit.
+type RateLimitCfg struct {
+	Enabled bool `yaml:"enabled" env:"RATE_LIMIT_ENABLED" env-default:"true"`
+	// Backend is memory, or postgres to share the limits between replicas.
+	Backend    string                     `yaml:"backend" env:"RATE_LIMIT_BACKEND" env-default:"memory"`
+	Default    string                     `yaml:"default" env:"RATE_LIMIT_DEFAULT" env-default:"300/m"`
+	Operations map[string]ratelimit.Limit `yaml:"operations" env:"RATE_LIMIT_OPERATIONS" env-separator:";" env-default:"POST /login:10/m;POST /register:10/m"`
+	// APIKeys tell apart clients sending them in X-API-Key, like integrations sharing an IP.
+	APIKeys []string `yaml:"api_keys" env:"RATE_LIMIT_API_KEYS" secret:"true"`
+	// TrustedProxies are the CIDRs of the proxies whose X-Forwarded-For and X-Real-IP
+	// are believed. The IP of requests from other peers is their remote address.
+	TrustedProxies []netip.Prefix `yaml:"trusted_proxies" env:"RATE_LIMIT_TRUSTED_PROXIES"`
+}
+
+// CORSCfg lets browser apps served from the allowed origins call the HTTP API.
+// No allowed origins turn CORS off, * allows any origin without credentials.
+// AllowCredentials lets the listed origins send cookies, like the refresh token.
+type CORSCfg struct {
+	AllowedOrigins []string `yaml:"allowed_origins" env:"CORS_ALLOWED_ORIGINS"`
+	AllowedMethods []string `yaml:"allowed_methods" env:"CORS_ALLOWED_METHODS" env-default:"GET,POST,PUT,DELETE"`
+	AllowedHeaders []string `yaml:"allowed_headers" env:"CORS_ALLOWED_HEADERS" env-default:"Authorization,Content-Type,Idempotency-Key,If-Match,If-None-Match,X-Request-ID,X-API-Key,X-CSRF-Token,traceparent,tracestate"`
+	// ExposedHeaders are the response headers scripts of the allowed origins can read.
+	ExposedHeaders   []string      `yaml:"exposed_headers" env:"CORS_EXPOSED_HEADERS" env-default:"ETag,Content-Disposition,X-Request-ID,X-Next-Cursor,X-Total-Count,X-CSRF-Token,Idempotent-Replayed,RateLimit-Limit,RateLimit-Remaining,RateLimit-Reset,Retry-After,Deprecation,Sunset,Link"`
+	AllowCredentials bool          `yaml:"allow_credentials" env:"CORS_ALLOW_CREDENTIALS" env-default:"false"`
+	MaxAge           time.Duration `yaml:"max_age" env:"CORS_MAX_AGE" env-default:"10m"`
+}
+
+// SecurityCfg holds the security headers of the HTTP API responses and the CSRF
+// protection of the endpoints authenticated by cookies.
+type SecurityCfg struct {
+	// HSTSMaxAge is how long browsers stick to HTTPS, zero leaves out the header.
+	HSTSMaxAge            time.Duration `yaml:"hsts_max_age" env:"SECURITY_HSTS_MAX_AGE" env-default:"8760h"`
+	HSTSIncludeSubdomains bool          `yaml:"hsts_include_subdomains" env:"SECURITY_HSTS_INCLUDE_SUBDOMAINS" env-default:"false"`
+	ContentSecurityPolicy string        `yaml:"content_security_policy" env:"SECURITY_CONTENT_SECURITY_POLICY" env-default:"default-src 'none'; frame-ancestors 'none'"`
+	FrameOptions          string        `yaml:"frame_options" env:"SECURITY_FRAME_OPTIONS" env-default:"DENY"`
+	CSRFEnabled           bool          `yaml:"csrf_enabled" env:"SECURITY_CSRF_ENABLED" env-default:"true"`
+}
+
+// DefaultLimit parses the default limit. It is kept as a string, as the config
+// reader can't parse structs out of single values.
+func (c *RateLimitCfg) DefaultLimit() (ratelimit.Limit, error) {
+	var l ratelimit.Limit
+	if err := l.UnmarshalText([]byte(c.Default)); err != nil {
+		return ratelimit.Limit{}, err
+	}
+	return l, nil
 }
 
 func MustInitConfig() *Config {
@@ -78,9 +207,52 @@ func MustInitConfig() *Config {
 		panic(fmt.Sprintf("failed to read environment variables: %s", err))
 	}
 
+	if _, err := cfg.RateLimitCfg.DefaultLimit(); err != nil {
+		panic(fmt.Sprintf("failed to parse default rate limit: %s", err))
+	}
+
 	return cfg
 }
 
+const redactedValue = "[REDACTED]"
+
+// Redacted returns the config keyed by the yaml names of its fields, with the
+// values of fields tagged as secret masked, so that it can be dumped safely.
+func (c *Config) Redacted() map[string]any {
+	return redact(reflect.ValueOf(*c)).(map[string]any)
+}
+
+func redact(v reflect.Value) any {
+	switch val := v.Interface().(type) {
+	case time.Duration:
+		return val.String()
+	case time.Time:
+		return val.Format(time.RFC3339)
+	}
+
+	if v.Kind() != reflect.Struct {
+		return v.Interface()
+	}
+
+	t := v.Type()
+	res := make(map[string]any, t.NumField())
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name := f.Tag.Get("yaml")
+		if name == "" {
+			name = f.Name
+		}
+
+		if f.Tag.Get("secret") == "true" {
+			res[name] = redactedValue
+			continue
+		}
+		res[name] = redact(v.Field(i))
+	}
+
+	return res
+}
+
 func cfgPath() string {
 	if !flag.Parsed() {
 		flag.Parse()
@@ -1,10 +1,13 @@
 package config
 
 import (
+	"encoding/json"
+	"net/netip"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -76,6 +79,34 @@ postgres:
 		t.Setenv("PG_HOST", "env_host")
 		t.Setenv("PG_PORT", "5433")
 		t.Setenv("HTTP_SERVER_PORT", "8080")
+		t.Setenv("HTTP_SERVER_VALIDATE_SPEC", "true")
+		t.Setenv("HTTP_SERVER_LEGACY_SUNSET", "2027-01-31")
+		t.Setenv("HTTP_SERVER_PUBLIC_METRICS", "false")
+		t.Setenv("ADMIN_SERVER_PORT", "9091")
+		t.Setenv("APP_DRAIN_DELAY", "0s")
+		t.Setenv("HEALTH_CHECK_TIMEOUT", "500ms")
+		t.Setenv("RATE_LIMIT_BACKEND", "postgres")
+		t.Setenv("RATE_LIMIT_DEFAULT", "off")
+		t.Setenv("RATE_LIMIT_OPERATIONS", "POST /login:5/m;/pvz.v1.PVZService/GetPVZList:20/s")
+		t.Setenv("RATE_LIMIT_API_KEYS", "key-1,key-2")
+		t.Setenv("RATE_LIMIT_TRUSTED_PROXIES", "10.0.0.0/8,fd00::/8")
+		t.Setenv("CORS_ALLOWED_ORIGINS", "https://admin.example.com,http://localhost:3000")
+		t.Setenv("CORS_MAX_AGE", "1h")
+		t.Setenv("SECURITY_HSTS_MAX_AGE", "0s")
+		t.Setenv("SECURITY_CSRF_ENABLED", "false")
+		t.Setenv("PRODUCTS_GLOBAL_BARCODE_UNIQUENESS", "true")
+		t.Setenv("PRODUCTS_STORAGE_PERIOD", "72h")
+		t.Setenv("PRODUCTS_PICKUP_CODE_TTL", "1h")
+		t.Setenv("PRODUCTS_PICKUP_CODE_MAX_ATTEMPTS", "3")
+		t.Setenv("RECEPTIONS_REOPEN_WINDOW", "30m")
+		t.Setenv("RECEPTIONS_AUTO_CLOSE_ENABLED", "false")
+		t.Setenv("RECEPTIONS_AUTO_CLOSE_IDLE", "6h")
+		t.Setenv("PVZ_CAPACITY", "500")
+		t.Setenv("EXPORTS_DIR", "/var/lib/pvz/exports")
+		t.Setenv("STATS_REFRESH_DAYS", "3")
+		t.Setenv("IDEMPOTENCY_TTL", "1h")
+		t.Setenv("TRACING_EXPORTER", "otlp")
+		t.Setenv("TRACING_SAMPLE_RATIO", "0.25")
 
 		cfg := MustInitConfig()
 
@@ -83,6 +114,43 @@ postgres:
 		assert.Equal(t, "env_host", cfg.PostgresCfg.Host)
 		assert.Equal(t, "5433", cfg.PostgresCfg.Port)
 		assert.Equal(t, "8080", cfg.HttpServerCfg.Port)
+		assert.True(t, cfg.HttpServerCfg.ValidateSpec)
+		assert.Equal(t, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), cfg.HttpServerCfg.LegacyDeprecatedAt)
+		assert.Equal(t, time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC), cfg.HttpServerCfg.LegacySunset)
+		assert.False(t, cfg.HttpServerCfg.PublicMetrics)
+		assert.Equal(t, "9091", cfg.AdminServerCfg.Port)
+		assert.Zero(t, cfg.AppCfg.DrainDelay)
+		assert.Equal(t, 500*time.Millisecond, cfg.HealthCfg.CheckTimeout)
+		assert.Equal(t, "postgres", cfg.RateLimitCfg.Backend)
+		def, err := cfg.RateLimitCfg.DefaultLimit()
+		require.NoError(t, err)
+		assert.True(t, def.Unlimited())
+		assert.Equal(t, map[string]ratelimit.Limit{
+			"POST /login":                   {Requests: 5, Per: time.Minute},
+			"/pvz.v1.PVZService/GetPVZList": {Requests: 20, Per: time.Second},
+		}, cfg.RateLimitCfg.Operations)
+		assert.Equal(t, []string{"key-1", "key-2"}, cfg.RateLimitCfg.APIKeys)
+		assert.Equal(t, []netip.Prefix{
+			netip.MustParsePrefix("10.0.0.0/8"),
+			netip.MustParsePrefix("fd00::/8"),
+		}, cfg.RateLimitCfg.TrustedProxies)
+		assert.Equal(t, []string{"https://admin.example.com", "http://localhost:3000"}, cfg.CORSCfg.AllowedOrigins)
+		assert.Equal(t, time.Hour, cfg.CORSCfg.MaxAge)
+		assert.Zero(t, cfg.SecurityCfg.HSTSMaxAge)
+		assert.False(t, cfg.SecurityCfg.CSRFEnabled)
+		assert.True(t, cfg.ProductsCfg.GlobalBarcodeUniqueness)
+		assert.Equal(t, 72*time.Hour, cfg.ProductsCfg.StoragePeriod)
+		assert.Equal(t, time.Hour, cfg.ProductsCfg.PickupCodeTTL)
+		assert.Equal(t, 3, cfg.ProductsCfg.PickupCodeMaxAttempts)
+		assert.Equal(t, 30*time.Minute, cfg.ReceptionsCfg.ReopenWindow)
+		assert.False(t, cfg.ReceptionsCfg.AutoCloseEnabled)
+		assert.Equal(t, 6*time.Hour, cfg.ReceptionsCfg.AutoCloseIdle)
+		assert.Equal(t, 500, cfg.PvzCfg.Capacity)
+		assert.Equal(t, "/var/lib/pvz/exports", cfg.ExportsCfg.Dir)
+		assert.Equal(t, 3, cfg.StatsCfg.RefreshDays)
+		assert.Equal(t, time.Hour, cfg.IdempotencyCfg.TTL)
+		assert.Equal(t, "otlp", cfg.TracingCfg.Exporter)
+		assert.Equal(t, 0.25, cfg.TracingCfg.SampleRatio)
 	})
 
 	t.Run("should allow environment variables to override file config", func(t *testing.T) {
@@ -108,6 +176,15 @@ postgres:
 		unsetEnvForTest(
 			t,
 			"CONFIG_PATH", "APP_ENV", "APP_TIMEOUT", "PG_HOST", "PG_USER",
+			"PRODUCTS_GLOBAL_BARCODE_UNIQUENESS", "PRODUCTS_STORAGE_PERIOD", "RECEPTIONS_REOPEN_WINDOW",
+			"PRODUCTS_PICKUP_CODE_TTL", "PRODUCTS_PICKUP_CODE_MAX_ATTEMPTS",
+			"RECEPTIONS_AUTO_CLOSE_ENABLED", "RECEPTIONS_AUTO_CLOSE_IDLE", "RECEPTIONS_AUTO_CLOSE_INTERVAL",
+			"PVZ_CAPACITY", "PVZ_STOCK_REPORT_INTERVAL", "EXPORTS_DIR", "EXPORTS_POLL_INTERVAL",
+			"STATS_REFRESH_INTERVAL", "STATS_REFRESH_DAYS", "IDEMPOTENCY_TTL",
+			"TRACING_EXPORTER", "TRACING_OTLP_ENDPOINT", "TRACING_SAMPLE_RATIO",
+			"HTTP_SERVER_PUBLIC_METRICS", "ADMIN_SERVER_ENABLED", "ADMIN_SERVER_PORT",
+			"APP_DRAIN_DELAY", "HEALTH_CHECK_TIMEOUT",
+			"RATE_LIMIT_ENABLED", "RATE_LIMIT_BACKEND", "RATE_LIMIT_DEFAULT", "RATE_LIMIT_OPERATIONS",
 		)
 
 		cfg := MustInitConfig()
@@ -116,6 +193,61 @@ postgres:
 		assert.Equal(t, "5s", cfg.AppCfg.Timeout.String())
 		assert.Equal(t, "postgres", cfg.PostgresCfg.Host)
 		assert.Equal(t, "user", cfg.PostgresCfg.User)
+		assert.False(t, cfg.ProductsCfg.GlobalBarcodeUniqueness)
+		assert.Equal(t, 7*24*time.Hour, cfg.ProductsCfg.StoragePeriod)
+		assert.Equal(t, 24*time.Hour, cfg.ProductsCfg.PickupCodeTTL)
+		assert.Equal(t, 5, cfg.ProductsCfg.PickupCodeMaxAttempts)
+		assert.Equal(t, time.Hour, cfg.ReceptionsCfg.ReopenWindow)
+		assert.True(t, cfg.ReceptionsCfg.AutoCloseEnabled)
+		assert.Equal(t, 12*time.Hour, cfg.ReceptionsCfg.AutoCloseIdle)
+		assert.Equal(t, 5*time.Minute, cfg.ReceptionsCfg.AutoCloseInterval)
+		assert.Zero(t, cfg.PvzCfg.Capacity)
+		assert.Equal(t, 30*time.Second, cfg.PvzCfg.StockReportInterval)
+		assert.Equal(t, "exports", cfg.ExportsCfg.Dir)
+		assert.Equal(t, 5*time.Second, cfg.ExportsCfg.PollInterval)
+		assert.Equal(t, 5*time.Minute, cfg.StatsCfg.RefreshInterval)
+		assert.Equal(t, 2, cfg.StatsCfg.RefreshDays)
+		assert.Equal(t, 24*time.Hour, cfg.IdempotencyCfg.TTL)
+		assert.Equal(t, "none", cfg.TracingCfg.Exporter)
+		assert.Equal(t, "localhost:4317", cfg.TracingCfg.OTLPEndpoint)
+		assert.Equal(t, 1.0, cfg.TracingCfg.SampleRatio)
+		assert.Equal(t, "pvz-service", cfg.TracingCfg.ServiceName)
+		assert.True(t, cfg.HttpServerCfg.PublicMetrics)
+		assert.True(t, cfg.AdminServerCfg.Enabled)
+		assert.Equal(t, "8081", cfg.AdminServerCfg.Port)
+		assert.Equal(t, time.Minute, cfg.AdminServerCfg.WriteTimeout)
+		assert.Equal(t, 5*time.Second, cfg.AppCfg.DrainDelay)
+		assert.Equal(t, 2*time.Second, cfg.HealthCfg.CheckTimeout)
+		assert.True(t, cfg.RateLimitCfg.Enabled)
+		assert.Equal(t, "memory", cfg.RateLimitCfg.Backend)
+		def, err := cfg.RateLimitCfg.DefaultLimit()
+		require.NoError(t, err)
+		assert.Equal(t, ratelimit.Limit{Requests: 300, Per: time.Minute}, def)
+		assert.Equal(t, map[string]ratelimit.Limit{
+			"POST /login":    {Requests: 10, Per: time.Minute},
+			"POST /register": {Requests: 10, Per: time.Minute},
+		}, cfg.RateLimitCfg.Operations)
+		assert.Empty(t, cfg.RateLimitCfg.TrustedProxies)
+		assert.Empty(t, cfg.CORSCfg.AllowedOrigins)
+		assert.Equal(t, []string{"GET", "POST", "PUT", "DELETE"}, cfg.CORSCfg.AllowedMethods)
+		assert.Contains(t, cfg.CORSCfg.AllowedHeaders, "X-CSRF-Token")
+		assert.Contains(t, cfg.CORSCfg.ExposedHeaders, "ETag")
+		assert.False(t, cfg.CORSCfg.AllowCredentials)
+		assert.Equal(t, 10*time.Minute, cfg.CORSCfg.MaxAge)
+		assert.Equal(t, 365*24*time.Hour, cfg.SecurityCfg.HSTSMaxAge)
+		assert.False(t, cfg.SecurityCfg.HSTSIncludeSubdomains)
+		assert.Equal(t, "default-src 'none'; frame-ancestors 'none'", cfg.SecurityCfg.ContentSecurityPolicy)
+		assert.Equal(t, "DENY", cfg.SecurityCfg.FrameOptions)
+		assert.True(t, cfg.SecurityCfg.CSRFEnabled)
+	})
+
+	t.Run("should panic on malformed rate limit", func(t *testing.T) {
+		unsetEnvForTest(t, "CONFIG_PATH")
+		t.Setenv("RATE_LIMIT_DEFAULT", "10 per second")
+
+		assert.Panics(t, func() {
+			MustInitConfig()
+		})
 	})
 
 	t.Run("should panic on malformed config file", func(t *testing.T) {
@@ -128,3 +260,38 @@ postgres:
 		})
 	})
 }
+
+func TestConfig_Redacted(t *testing.T) {
+	t.Parallel()
+
+	cfg := &Config{
+		AppCfg: AppCfg{Env: "dev", Timeout: 5 * time.Second},
+		HttpServerCfg: HttpServerCfg{
+			LegacySunset: time.Date(2027, 4, 18, 0, 0, 0, 0, time.UTC),
+		},
+		PostgresCfg: PostgresCfg{
+			User:         "user",
+			Password:     "pg-password",
+			MaxOpenConns: 20,
+		},
+		AuthTokenCfg: AuthTokensCfg{SecretKey: "secret-key"},
+	}
+
+	dump := cfg.Redacted()
+
+	app := dump["app"].(map[string]any)
+	assert.Equal(t, "dev", app["env"])
+	assert.Equal(t, "5s", app["timeout"])
+	assert.Equal(t, "2027-04-18T00:00:00Z", dump["http_server"].(map[string]any)["legacy_sunset"])
+
+	pg := dump["postgres"].(map[string]any)
+	assert.Equal(t, "user", pg["user"])
+	assert.Equal(t, 20, pg["max_open_conns"])
+	assert.Equal(t, redactedValue, pg["password"])
+	assert.Equal(t, redactedValue, dump["auth_tokens"].(map[string]any)["secret_key"])
+
+	b, err := json.Marshal(dump)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "pg-password")
+	assert.NotContains(t, string(b), "secret-key")
+}
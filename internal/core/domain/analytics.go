@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+type AnalyticsPeriod string
+
+const (
+	AnalyticsDay  AnalyticsPeriod = "day"
+	AnalyticsWeek AnalyticsPeriod = "week"
+)
+
+type AnalyticsGroup string
+
+const (
+	AnalyticsByPvz         AnalyticsGroup = "pvz"
+	AnalyticsByCity        AnalyticsGroup = "city"
+	AnalyticsByProductType AnalyticsGroup = "product_type"
+)
+
+// AnalyticsRange limits analytics to [From, To).
+type AnalyticsRange struct {
+	From time.Time
+	To   time.Time
+}
+
+type ThroughputParams struct {
+	AnalyticsRange
+	Period  AnalyticsPeriod
+	GroupBy AnalyticsGroup
+}
+
+// ThroughputPoint is the number of products received by a group during a
+// period starting at PeriodStart (UTC). Key is a PVZ id, a city or a product type.
+type ThroughputPoint struct {
+	PeriodStart time.Time
+	Key         string
+	Products    int
+}
+
+// ReceptionStatsParams groups closed receptions opened within the range.
+// Only AnalyticsByPvz and AnalyticsByCity are supported.
+type ReceptionStatsParams struct {
+	AnalyticsRange
+	GroupBy AnalyticsGroup
+}
+
+type ReceptionStats struct {
+	Key         string
+	Receptions  int
+	AvgDuration time.Duration
+	AvgProducts float64
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ExportFormat string
+
+const (
+	ExportCSV  ExportFormat = "csv"
+	ExportXLSX ExportFormat = "xlsx"
+)
+
+type ExportJobStatus string
+
+const (
+	ExportJobPending ExportJobStatus = "pending"
+	ExportJobRunning ExportJobStatus = "running"
+	ExportJobDone    ExportJobStatus = "done"
+	ExportJobFailed  ExportJobStatus = "failed"
+)
+
+// ExportJob is an export of receptions and products that runs in the background.
+// Its file is available once the job is done.
+type ExportJob struct {
+	Id         uuid.UUID
+	Format     ExportFormat
+	Filter     PvzsFilter
+	Sort       PvzsSort
+	Status     ExportJobStatus
+	CreatedBy  uuid.UUID
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+	Error      *string
+}
+
+// FileName is the name the job's file is stored and downloaded under.
+func (j *ExportJob) FileName() string {
+	return "receptions-" + j.Id.String() + "." + string(j.Format)
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxIdempotencyKeyLen limits the length of idempotency keys.
+const MaxIdempotencyKeyLen = 255
+
+// ValidateIdempotencyKey checks that key is non-empty printable ASCII of at most MaxIdempotencyKeyLen bytes.
+func ValidateIdempotencyKey(key string) error {
+	if key == "" || len(key) > MaxIdempotencyKeyLen {
+		return errors.New("key length must be between 1 and 255")
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x20 || key[i] > 0x7e {
+			return errors.New("key must contain only printable ascii characters")
+		}
+	}
+	return nil
+}
+
+// IdempotentRequest identifies a request sent with an idempotency key. Keys are
+// scoped per user, the fingerprint tells apart different requests sent with the same key.
+type IdempotentRequest struct {
+	UserId      uuid.UUID
+	Key         string
+	Fingerprint string
+}
+
+// IdempotentResponse is the stored outcome of an idempotent request that is
+// replayed when the request is retried.
+type IdempotentResponse struct {
+	StatusCode int
+	Headers    map[string]string
+	Body       []byte
+}
+
+// IdempotencyRecord is a reserved idempotency key. Response stays nil until
+// the request is processed.
+type IdempotencyRecord struct {
+	IdempotentRequest
+	Response  *IdempotentResponse
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
@@ -14,10 +14,110 @@ const (
 	ProductTypeFootwear    ProductType = "обувь"
 )
 
+type ProductStatus string
+
+const (
+	ProductInStorage ProductStatus = "in_storage"
+	ProductIssued    ProductStatus = "issued"
+	ProductReturned  ProductStatus = "returned"
+)
+
 type Product struct {
 	Id          uuid.UUID
 	PvzId       uuid.UUID
 	Type        ProductType
 	ReceptionId uuid.UUID
 	DateTime    time.Time
+	Barcode     *string
+	WeightGrams *int
+	Dimensions  *ProductDimensions
+	Status      ProductStatus
+}
+
+// ProductDimensions holds parcel dimensions in millimeters.
+type ProductDimensions struct {
+	LengthMm int
+	WidthMm  int
+	HeightMm int
+}
+
+// ProductInfo is a product together with the reception it came with and the PVZ it is stored in.
+type ProductInfo struct {
+	Product   *Product
+	Reception *Reception
+	Pvz       *Pvz
+}
+
+// ProductDeletion is an entry of a reception's undo history.
+type ProductDeletion struct {
+	Id         uuid.UUID
+	Product    *Product
+	DeletedBy  *uuid.UUID
+	DeletedAt  time.Time
+	RestoredAt *time.Time
+}
+
+// PickupCodeLength is the number of digits in a pickup code.
+const PickupCodeLength = 6
+
+// ProductStorageState is what decides whether a product can be issued or returned.
+type ProductStorageState struct {
+	Product         *Product
+	ReceptionStatus ReceptionStatus
+	// PickupCodeHash is nil until a pickup code is issued for the product.
+	PickupCodeHash []byte
+	// PickupCodeExpiresAt is nil when the pickup code does not expire.
+	PickupCodeExpiresAt *time.Time
+	// PickupCodeAttempts counts attempts to enter the current pickup code.
+	PickupCodeAttempts int
+}
+
+// ProductStatusUpdate moves a product that is in storage to another status.
+type ProductStatusUpdate struct {
+	ProductId uuid.UUID
+	Status    ProductStatus
+	ActorId   *uuid.UUID
+}
+
+// ProductStatusChange is an entry of a product status history.
+type ProductStatusChange struct {
+	Status    ProductStatus
+	ChangedAt time.Time
+	ChangedBy *uuid.UUID
+}
+
+// MaxProductsBatchSize limits how many products can be scanned in a single batch.
+const MaxProductsBatchSize = 1000
+
+// ProductsBatch is a list of products scanned into the active reception of a PVZ at once.
+type ProductsBatch struct {
+	PvzId    uuid.UUID
+	Products []*Product
+	// Atomic makes the whole batch fail if any of its items can not be added.
+	Atomic bool
+	// UniqueAcrossReceptions checks barcodes against all receptions, not only the active one,
+	// and keeps them unique in the database, so that concurrent batches can't share them.
+	UniqueAcrossReceptions bool
+	// Capacity limits products on hand in the PVZ, zero means no limit.
+	Capacity int
+}
+
+type BatchItemStatus string
+
+const (
+	BatchItemAdded            BatchItemStatus = "added"
+	BatchItemDuplicateBarcode BatchItemStatus = "duplicate_barcode"
+	BatchItemNotAdded         BatchItemStatus = "not_added"
+	BatchItemOverCapacity     BatchItemStatus = "over_capacity"
+)
+
+// BatchItemResult is the outcome for the batch item with the same index.
+type BatchItemResult struct {
+	Status  BatchItemStatus
+	Product *Product
+}
+
+type ProductsBatchResult struct {
+	Items []*BatchItemResult
+	Added int
 }
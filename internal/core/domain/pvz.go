@@ -18,13 +18,73 @@ type Pvz struct {
 	Id               uuid.UUID
 	City             PVZCity
 	RegistrationDate time.Time
+	// Version is incremented on every change of the PVZ and is used for optimistic locking.
+	Version int
+}
+
+// PvzStock is the occupancy of a PVZ: products in storage that were
+// neither issued nor returned. Zero Capacity means the PVZ is not limited.
+type PvzStock struct {
+	PvzId    uuid.UUID
+	OnHand   int
+	Capacity int
+}
+
+// PvzsFilter selects PVZs. Reception filters (dates, statuses and product types)
+// match PVZs having at least one reception that satisfies all of them at once.
+type PvzsFilter struct {
+	Cities         []PVZCity
+	RegisteredFrom *time.Time
+	RegisteredTo   *time.Time
+	// StartDate and EndDate limit when a reception was opened.
+	StartDate         *time.Time
+	EndDate           *time.Time
+	ReceptionStatuses []ReceptionStatus
+	ProductTypes      []ProductType
+	HasOpenReception  *bool
+}
+
+type PvzsSortField string
+
+const (
+	PvzsSortByRegistrationDate PvzsSortField = "registrationDate"
+	PvzsSortByCity             PvzsSortField = "city"
+)
+
+// PvzsSort orders PVZs by Field, ties are broken by id. The zero value sorts
+// by registration date, oldest first.
+type PvzsSort struct {
+	Field PvzsSortField
+	Desc  bool
 }
 
 type PvzsReadParams struct {
-	StartDate *time.Time
-	EndDate   *time.Time
-	Page      int
-	Limit     int
+	PvzsFilter
+	Sort  PvzsSort
+	Page  int
+	Limit int
+	// Offset, when set, is used instead of the offset derived from Page.
+	Offset int
+	// Cursor continues listing right after the PVZ it points to, Page is ignored when it is set.
+	Cursor *PvzsCursor
+	// WithTotal asks for the number of PVZs matching the filter.
+	WithTotal bool
+}
+
+// PvzsCursor points to the last PVZ of a page. Only the fields of the
+// requested sort are used.
+type PvzsCursor struct {
+	RegistrationDate time.Time
+	City             PVZCity
+	Id               uuid.UUID
+}
+
+type PvzsPage struct {
+	Items []*PvzReceptions
+	// NextCursor is nil on the last page.
+	NextCursor *PvzsCursor
+	// Total is only set if it was requested.
+	Total *int
 }
 
 type PvzReceptions struct {
@@ -11,6 +11,7 @@ type ReceptionStatus string
 const (
 	Close      ReceptionStatus = "close"
 	InProgress ReceptionStatus = "in_progress"
+	Cancelled  ReceptionStatus = "cancelled"
 )
 
 type Reception struct {
@@ -18,4 +19,34 @@ type Reception struct {
 	PvzId    uuid.UUID
 	DateTime time.Time
 	Status   ReceptionStatus
+	ClosedAt *time.Time
+	// AutoClosed is set when the reception was closed by the scheduler after being idle.
+	AutoClosed bool
+	// Version is incremented on every change of the reception status or manifest
+	// and is used for optimistic locking.
+	Version int
+}
+
+// ReceptionManifest is the list of barcodes expected to arrive with a reception.
+// IfVersion, when set, is the reception version the manifest is expected to replace.
+// Version is the reception version after the manifest is set.
+type ReceptionManifest struct {
+	ReceptionId uuid.UUID
+	Barcodes    []string
+	IfVersion   *int
+	Version     int
+}
+
+// ManifestReport compares the products of a reception with its expected manifest.
+type ManifestReport struct {
+	Expected   int
+	Missing    []string
+	Unexpected []string
+}
+
+// ReceptionCloseReport is the result of closing a reception. Manifest is nil
+// when no manifest was attached to the reception.
+type ReceptionCloseReport struct {
+	Reception *Reception
+	Manifest  *ManifestReport
 }
@@ -0,0 +1,27 @@
+package export
+
+import (
+	"io"
+
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+)
+
+// Encoder writes PVZs with their receptions and products as spreadsheet rows.
+//
+//go:generate mockery
+type Encoder interface {
+	Encode(data *domain.PvzReceptions) error
+	// Close writes everything still buffered, it doesn't close the underlying writer.
+	Close() error
+}
+
+type EncoderFactory interface {
+	NewEncoder(format domain.ExportFormat, w io.Writer) (Encoder, error)
+}
+
+// FileStorage keeps the files of export jobs.
+type FileStorage interface {
+	Create(name string) (io.WriteCloser, error)
+	Open(name string) (io.ReadCloser, error)
+	Remove(name string) error
+}
@@ -5,6 +5,17 @@ type Collector interface {
 	IncPVZsCreated()
 	IncReceptionsCreated()
 	IncProductsAdded()
-	IncHTTPRequestsTotal(method, code string)
-	ObserveHTTPRequestDuration(method string, duration float64)
+	AddProductsAdded(n int)
+	IncProductStatusChanges(status string)
+	AddReceptionsAutoClosed(n int)
+	IncReceptionAutoCloseRuns(result string)
+	SetPvzProductsOnHand(pvzId string, n int)
+	// HTTP metrics are labeled with the route pattern rather than the path,
+	// to keep ids out of label values.
+	IncHTTPRequestsTotal(method, route, code string)
+	ObserveHTTPRequestDuration(method, route string, duration float64)
+	IncGRPCRequestsTotal(method, code string)
+	ObserveGRPCRequestDuration(method string, duration float64)
+	ObserveDBQueryDuration(operation string, duration float64)
+	SetOpenReceptions(n int)
 }
@@ -35,12 +35,138 @@ func (_m *MockCollector) EXPECT() *MockCollector_Expecter {
 	return &MockCollector_Expecter{mock: &_m.Mock}
 }
 
-// IncHTTPRequestsTotal provides a mock function for the type MockCollector
-func (_mock *MockCollector) IncHTTPRequestsTotal(method string, code string) {
+// AddProductsAdded provides a mock function for the type MockCollector
+func (_mock *MockCollector) AddProductsAdded(n int) {
+	_mock.Called(n)
+	return
+}
+
+// MockCollector_AddProductsAdded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProductsAdded'
+type MockCollector_AddProductsAdded_Call struct {
+	*mock.Call
+}
+
+// AddProductsAdded is a helper method to define mock.On call
+//   - n int
+func (_e *MockCollector_Expecter) AddProductsAdded(n interface{}) *MockCollector_AddProductsAdded_Call {
+	return &MockCollector_AddProductsAdded_Call{Call: _e.mock.On("AddProductsAdded", n)}
+}
+
+func (_c *MockCollector_AddProductsAdded_Call) Run(run func(n int)) *MockCollector_AddProductsAdded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollector_AddProductsAdded_Call) Return() *MockCollector_AddProductsAdded_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCollector_AddProductsAdded_Call) RunAndReturn(run func(n int)) *MockCollector_AddProductsAdded_Call {
+	_c.Run(run)
+	return _c
+}
+
+// AddReceptionsAutoClosed provides a mock function for the type MockCollector
+func (_mock *MockCollector) AddReceptionsAutoClosed(n int) {
+	_mock.Called(n)
+	return
+}
+
+// MockCollector_AddReceptionsAutoClosed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReceptionsAutoClosed'
+type MockCollector_AddReceptionsAutoClosed_Call struct {
+	*mock.Call
+}
+
+// AddReceptionsAutoClosed is a helper method to define mock.On call
+//   - n int
+func (_e *MockCollector_Expecter) AddReceptionsAutoClosed(n interface{}) *MockCollector_AddReceptionsAutoClosed_Call {
+	return &MockCollector_AddReceptionsAutoClosed_Call{Call: _e.mock.On("AddReceptionsAutoClosed", n)}
+}
+
+func (_c *MockCollector_AddReceptionsAutoClosed_Call) Run(run func(n int)) *MockCollector_AddReceptionsAutoClosed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollector_AddReceptionsAutoClosed_Call) Return() *MockCollector_AddReceptionsAutoClosed_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCollector_AddReceptionsAutoClosed_Call) RunAndReturn(run func(n int)) *MockCollector_AddReceptionsAutoClosed_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IncGRPCRequestsTotal provides a mock function for the type MockCollector
+func (_mock *MockCollector) IncGRPCRequestsTotal(method string, code string) {
 	_mock.Called(method, code)
 	return
 }
 
+// MockCollector_IncGRPCRequestsTotal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncGRPCRequestsTotal'
+type MockCollector_IncGRPCRequestsTotal_Call struct {
+	*mock.Call
+}
+
+// IncGRPCRequestsTotal is a helper method to define mock.On call
+//   - method string
+//   - code string
+func (_e *MockCollector_Expecter) IncGRPCRequestsTotal(method interface{}, code interface{}) *MockCollector_IncGRPCRequestsTotal_Call {
+	return &MockCollector_IncGRPCRequestsTotal_Call{Call: _e.mock.On("IncGRPCRequestsTotal", method, code)}
+}
+
+func (_c *MockCollector_IncGRPCRequestsTotal_Call) Run(run func(method string, code string)) *MockCollector_IncGRPCRequestsTotal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollector_IncGRPCRequestsTotal_Call) Return() *MockCollector_IncGRPCRequestsTotal_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCollector_IncGRPCRequestsTotal_Call) RunAndReturn(run func(method string, code string)) *MockCollector_IncGRPCRequestsTotal_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IncHTTPRequestsTotal provides a mock function for the type MockCollector
+func (_mock *MockCollector) IncHTTPRequestsTotal(method string, route string, code string) {
+	_mock.Called(method, route, code)
+	return
+}
+
 // MockCollector_IncHTTPRequestsTotal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncHTTPRequestsTotal'
 type MockCollector_IncHTTPRequestsTotal_Call struct {
 	*mock.Call
@@ -48,12 +174,13 @@ type MockCollector_IncHTTPRequestsTotal_Call struct {
 
 // IncHTTPRequestsTotal is a helper method to define mock.On call
 //   - method string
+//   - route string
 //   - code string
-func (_e *MockCollector_Expecter) IncHTTPRequestsTotal(method interface{}, code interface{}) *MockCollector_IncHTTPRequestsTotal_Call {
-	return &MockCollector_IncHTTPRequestsTotal_Call{Call: _e.mock.On("IncHTTPRequestsTotal", method, code)}
+func (_e *MockCollector_Expecter) IncHTTPRequestsTotal(method interface{}, route interface{}, code interface{}) *MockCollector_IncHTTPRequestsTotal_Call {
+	return &MockCollector_IncHTTPRequestsTotal_Call{Call: _e.mock.On("IncHTTPRequestsTotal", method, route, code)}
 }
 
-func (_c *MockCollector_IncHTTPRequestsTotal_Call) Run(run func(method string, code string)) *MockCollector_IncHTTPRequestsTotal_Call {
+func (_c *MockCollector_IncHTTPRequestsTotal_Call) Run(run func(method string, route string, code string)) *MockCollector_IncHTTPRequestsTotal_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -63,9 +190,14 @@ func (_c *MockCollector_IncHTTPRequestsTotal_Call) Run(run func(method string, c
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -76,7 +208,7 @@ func (_c *MockCollector_IncHTTPRequestsTotal_Call) Return() *MockCollector_IncHT
 	return _c
 }
 
-func (_c *MockCollector_IncHTTPRequestsTotal_Call) RunAndReturn(run func(method string, code string)) *MockCollector_IncHTTPRequestsTotal_Call {
+func (_c *MockCollector_IncHTTPRequestsTotal_Call) RunAndReturn(run func(method string, route string, code string)) *MockCollector_IncHTTPRequestsTotal_Call {
 	_c.Run(run)
 	return _c
 }
@@ -114,6 +246,46 @@ func (_c *MockCollector_IncPVZsCreated_Call) RunAndReturn(run func()) *MockColle
 	return _c
 }
 
+// IncProductStatusChanges provides a mock function for the type MockCollector
+func (_mock *MockCollector) IncProductStatusChanges(status string) {
+	_mock.Called(status)
+	return
+}
+
+// MockCollector_IncProductStatusChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncProductStatusChanges'
+type MockCollector_IncProductStatusChanges_Call struct {
+	*mock.Call
+}
+
+// IncProductStatusChanges is a helper method to define mock.On call
+//   - status string
+func (_e *MockCollector_Expecter) IncProductStatusChanges(status interface{}) *MockCollector_IncProductStatusChanges_Call {
+	return &MockCollector_IncProductStatusChanges_Call{Call: _e.mock.On("IncProductStatusChanges", status)}
+}
+
+func (_c *MockCollector_IncProductStatusChanges_Call) Run(run func(status string)) *MockCollector_IncProductStatusChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollector_IncProductStatusChanges_Call) Return() *MockCollector_IncProductStatusChanges_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCollector_IncProductStatusChanges_Call) RunAndReturn(run func(status string)) *MockCollector_IncProductStatusChanges_Call {
+	_c.Run(run)
+	return _c
+}
+
 // IncProductsAdded provides a mock function for the type MockCollector
 func (_mock *MockCollector) IncProductsAdded() {
 	_mock.Called()
@@ -147,6 +319,46 @@ func (_c *MockCollector_IncProductsAdded_Call) RunAndReturn(run func()) *MockCol
 	return _c
 }
 
+// IncReceptionAutoCloseRuns provides a mock function for the type MockCollector
+func (_mock *MockCollector) IncReceptionAutoCloseRuns(result string) {
+	_mock.Called(result)
+	return
+}
+
+// MockCollector_IncReceptionAutoCloseRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncReceptionAutoCloseRuns'
+type MockCollector_IncReceptionAutoCloseRuns_Call struct {
+	*mock.Call
+}
+
+// IncReceptionAutoCloseRuns is a helper method to define mock.On call
+//   - result string
+func (_e *MockCollector_Expecter) IncReceptionAutoCloseRuns(result interface{}) *MockCollector_IncReceptionAutoCloseRuns_Call {
+	return &MockCollector_IncReceptionAutoCloseRuns_Call{Call: _e.mock.On("IncReceptionAutoCloseRuns", result)}
+}
+
+func (_c *MockCollector_IncReceptionAutoCloseRuns_Call) Run(run func(result string)) *MockCollector_IncReceptionAutoCloseRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollector_IncReceptionAutoCloseRuns_Call) Return() *MockCollector_IncReceptionAutoCloseRuns_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCollector_IncReceptionAutoCloseRuns_Call) RunAndReturn(run func(result string)) *MockCollector_IncReceptionAutoCloseRuns_Call {
+	_c.Run(run)
+	return _c
+}
+
 // IncReceptionsCreated provides a mock function for the type MockCollector
 func (_mock *MockCollector) IncReceptionsCreated() {
 	_mock.Called()
@@ -180,12 +392,104 @@ func (_c *MockCollector_IncReceptionsCreated_Call) RunAndReturn(run func()) *Moc
 	return _c
 }
 
-// ObserveHTTPRequestDuration provides a mock function for the type MockCollector
-func (_mock *MockCollector) ObserveHTTPRequestDuration(method string, duration float64) {
+// ObserveDBQueryDuration provides a mock function for the type MockCollector
+func (_mock *MockCollector) ObserveDBQueryDuration(operation string, duration float64) {
+	_mock.Called(operation, duration)
+	return
+}
+
+// MockCollector_ObserveDBQueryDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ObserveDBQueryDuration'
+type MockCollector_ObserveDBQueryDuration_Call struct {
+	*mock.Call
+}
+
+// ObserveDBQueryDuration is a helper method to define mock.On call
+//   - operation string
+//   - duration float64
+func (_e *MockCollector_Expecter) ObserveDBQueryDuration(operation interface{}, duration interface{}) *MockCollector_ObserveDBQueryDuration_Call {
+	return &MockCollector_ObserveDBQueryDuration_Call{Call: _e.mock.On("ObserveDBQueryDuration", operation, duration)}
+}
+
+func (_c *MockCollector_ObserveDBQueryDuration_Call) Run(run func(operation string, duration float64)) *MockCollector_ObserveDBQueryDuration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 float64
+		if args[1] != nil {
+			arg1 = args[1].(float64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollector_ObserveDBQueryDuration_Call) Return() *MockCollector_ObserveDBQueryDuration_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCollector_ObserveDBQueryDuration_Call) RunAndReturn(run func(operation string, duration float64)) *MockCollector_ObserveDBQueryDuration_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ObserveGRPCRequestDuration provides a mock function for the type MockCollector
+func (_mock *MockCollector) ObserveGRPCRequestDuration(method string, duration float64) {
 	_mock.Called(method, duration)
 	return
 }
 
+// MockCollector_ObserveGRPCRequestDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ObserveGRPCRequestDuration'
+type MockCollector_ObserveGRPCRequestDuration_Call struct {
+	*mock.Call
+}
+
+// ObserveGRPCRequestDuration is a helper method to define mock.On call
+//   - method string
+//   - duration float64
+func (_e *MockCollector_Expecter) ObserveGRPCRequestDuration(method interface{}, duration interface{}) *MockCollector_ObserveGRPCRequestDuration_Call {
+	return &MockCollector_ObserveGRPCRequestDuration_Call{Call: _e.mock.On("ObserveGRPCRequestDuration", method, duration)}
+}
+
+func (_c *MockCollector_ObserveGRPCRequestDuration_Call) Run(run func(method string, duration float64)) *MockCollector_ObserveGRPCRequestDuration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 float64
+		if args[1] != nil {
+			arg1 = args[1].(float64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollector_ObserveGRPCRequestDuration_Call) Return() *MockCollector_ObserveGRPCRequestDuration_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCollector_ObserveGRPCRequestDuration_Call) RunAndReturn(run func(method string, duration float64)) *MockCollector_ObserveGRPCRequestDuration_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ObserveHTTPRequestDuration provides a mock function for the type MockCollector
+func (_mock *MockCollector) ObserveHTTPRequestDuration(method string, route string, duration float64) {
+	_mock.Called(method, route, duration)
+	return
+}
+
 // MockCollector_ObserveHTTPRequestDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ObserveHTTPRequestDuration'
 type MockCollector_ObserveHTTPRequestDuration_Call struct {
 	*mock.Call
@@ -193,24 +497,30 @@ type MockCollector_ObserveHTTPRequestDuration_Call struct {
 
 // ObserveHTTPRequestDuration is a helper method to define mock.On call
 //   - method string
+//   - route string
 //   - duration float64
-func (_e *MockCollector_Expecter) ObserveHTTPRequestDuration(method interface{}, duration interface{}) *MockCollector_ObserveHTTPRequestDuration_Call {
-	return &MockCollector_ObserveHTTPRequestDuration_Call{Call: _e.mock.On("ObserveHTTPRequestDuration", method, duration)}
+func (_e *MockCollector_Expecter) ObserveHTTPRequestDuration(method interface{}, route interface{}, duration interface{}) *MockCollector_ObserveHTTPRequestDuration_Call {
+	return &MockCollector_ObserveHTTPRequestDuration_Call{Call: _e.mock.On("ObserveHTTPRequestDuration", method, route, duration)}
 }
 
-func (_c *MockCollector_ObserveHTTPRequestDuration_Call) Run(run func(method string, duration float64)) *MockCollector_ObserveHTTPRequestDuration_Call {
+func (_c *MockCollector_ObserveHTTPRequestDuration_Call) Run(run func(method string, route string, duration float64)) *MockCollector_ObserveHTTPRequestDuration_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
-		var arg1 float64
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(float64)
+			arg1 = args[1].(string)
+		}
+		var arg2 float64
+		if args[2] != nil {
+			arg2 = args[2].(float64)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -221,7 +531,93 @@ func (_c *MockCollector_ObserveHTTPRequestDuration_Call) Return() *MockCollector
 	return _c
 }
 
-func (_c *MockCollector_ObserveHTTPRequestDuration_Call) RunAndReturn(run func(method string, duration float64)) *MockCollector_ObserveHTTPRequestDuration_Call {
+func (_c *MockCollector_ObserveHTTPRequestDuration_Call) RunAndReturn(run func(method string, route string, duration float64)) *MockCollector_ObserveHTTPRequestDuration_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetOpenReceptions provides a mock function for the type MockCollector
+func (_mock *MockCollector) SetOpenReceptions(n int) {
+	_mock.Called(n)
+	return
+}
+
+// MockCollector_SetOpenReceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOpenReceptions'
+type MockCollector_SetOpenReceptions_Call struct {
+	*mock.Call
+}
+
+// SetOpenReceptions is a helper method to define mock.On call
+//   - n int
+func (_e *MockCollector_Expecter) SetOpenReceptions(n interface{}) *MockCollector_SetOpenReceptions_Call {
+	return &MockCollector_SetOpenReceptions_Call{Call: _e.mock.On("SetOpenReceptions", n)}
+}
+
+func (_c *MockCollector_SetOpenReceptions_Call) Run(run func(n int)) *MockCollector_SetOpenReceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollector_SetOpenReceptions_Call) Return() *MockCollector_SetOpenReceptions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCollector_SetOpenReceptions_Call) RunAndReturn(run func(n int)) *MockCollector_SetOpenReceptions_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetPvzProductsOnHand provides a mock function for the type MockCollector
+func (_mock *MockCollector) SetPvzProductsOnHand(pvzId string, n int) {
+	_mock.Called(pvzId, n)
+	return
+}
+
+// MockCollector_SetPvzProductsOnHand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPvzProductsOnHand'
+type MockCollector_SetPvzProductsOnHand_Call struct {
+	*mock.Call
+}
+
+// SetPvzProductsOnHand is a helper method to define mock.On call
+//   - pvzId string
+//   - n int
+func (_e *MockCollector_Expecter) SetPvzProductsOnHand(pvzId interface{}, n interface{}) *MockCollector_SetPvzProductsOnHand_Call {
+	return &MockCollector_SetPvzProductsOnHand_Call{Call: _e.mock.On("SetPvzProductsOnHand", pvzId, n)}
+}
+
+func (_c *MockCollector_SetPvzProductsOnHand_Call) Run(run func(pvzId string, n int)) *MockCollector_SetPvzProductsOnHand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollector_SetPvzProductsOnHand_Call) Return() *MockCollector_SetPvzProductsOnHand_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCollector_SetPvzProductsOnHand_Call) RunAndReturn(run func(pvzId string, n int)) *MockCollector_SetPvzProductsOnHand_Call {
 	_c.Run(run)
 	return _c
 }
@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit is a token bucket holding up to Requests tokens, refilled at the rate of
+// Requests per Per. The zero Limit doesn't limit anything.
+type Limit struct {
+	Requests int
+	Per      time.Duration
+}
+
+// Unlimited reports whether the limit lets every request through.
+func (l Limit) Unlimited() bool {
+	return l.Requests <= 0 || l.Per <= 0
+}
+
+// UnmarshalText parses limits like 10/s, 100/m or 1000/h. Limits of 0 or off
+// turn limiting off.
+func (l *Limit) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+	if s == "" || s == "0" || s == "off" {
+		*l = Limit{}
+		return nil
+	}
+
+	n, unit, ok := strings.Cut(s, "/")
+	if !ok {
+		return fmt.Errorf("invalid rate limit %q: expected <requests>/<s|m|h>", s)
+	}
+
+	requests, err := strconv.Atoi(n)
+	if err != nil || requests < 0 {
+		return fmt.Errorf("invalid rate limit %q: bad number of requests", s)
+	}
+
+	var per time.Duration
+	switch unit {
+	case "s":
+		per = time.Second
+	case "m":
+		per = time.Minute
+	case "h":
+		per = time.Hour
+	default:
+		return fmt.Errorf("invalid rate limit %q: unknown unit %q", s, unit)
+	}
+
+	*l = Limit{Requests: requests, Per: per}
+	return nil
+}
+
+// MarshalText formats the limit the way UnmarshalText parses it.
+func (l Limit) MarshalText() ([]byte, error) {
+	if l.Unlimited() {
+		return []byte("off"), nil
+	}
+
+	var unit string
+	switch l.Per {
+	case time.Second:
+		unit = "s"
+	case time.Minute:
+		unit = "m"
+	case time.Hour:
+		unit = "h"
+	default:
+		return nil, fmt.Errorf("rate limit period %s isn't one of s, m or h", l.Per)
+	}
+
+	return []byte(strconv.Itoa(l.Requests) + "/" + unit), nil
+}
+
+// Client is who a request is accounted to. Only one of the ids is used, the user
+// id of authenticated requests first, then a known API key and the IP at last.
+type Client struct {
+	UserID string
+	APIKey string
+	IP     string
+}
+
+// Result describes the bucket of a client after a request was counted.
+type Result struct {
+	Allowed bool
+	// Limit is the size of the bucket, zero if the request isn't limited.
+	Limit     int
+	Remaining int
+	// RetryAfter is when the next request is going to be allowed, zero if it is already.
+	RetryAfter time.Duration
+	// Reset is when the bucket is going to be full again.
+	Reset time.Duration
+}
+
+//go:generate mockery
+type Limiter interface {
+	// Take counts a request of the client to the operation, the route pattern of
+	// HTTP requests or the full method name of gRPC calls.
+	Take(ctx context.Context, operation string, client Client) (Result, error)
+}
@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimit_UnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		text    string
+		want    Limit
+		wantErr bool
+	}{
+		{text: "10/s", want: Limit{Requests: 10, Per: time.Second}},
+		{text: "100/m", want: Limit{Requests: 100, Per: time.Minute}},
+		{text: " 1000/h ", want: Limit{Requests: 1000, Per: time.Hour}},
+		{text: "off", want: Limit{}},
+		{text: "0", want: Limit{}},
+		{text: "10", wantErr: true},
+		{text: "ten/s", wantErr: true},
+		{text: "-1/s", wantErr: true},
+		{text: "10/d", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			t.Parallel()
+
+			var l Limit
+			err := l.UnmarshalText([]byte(tt.text))
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, l)
+		})
+	}
+}
+
+func TestLimit_Unlimited(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, Limit{}.Unlimited())
+	assert.False(t, Limit{Requests: 1, Per: time.Second}.Unlimited())
+}
+
+func TestLimit_MarshalText(t *testing.T) {
+	t.Parallel()
+
+	for _, text := range []string{"10/s", "100/m", "1000/h", "off"} {
+		var l Limit
+		require.NoError(t, l.UnmarshalText([]byte(text)))
+
+		got, err := l.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, text, string(got))
+	}
+
+	_, err := Limit{Requests: 1, Per: time.Millisecond}.MarshalText()
+	assert.Error(t, err)
+}
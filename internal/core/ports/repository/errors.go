@@ -12,5 +12,9 @@ const (
 	NotFound         RepoErrKind = "entity not found"
 	Conflict         RepoErrKind = "entity conflicts with existing data"
 	InvalidReference RepoErrKind = "invalid reference to another entity"
+	InvalidState     RepoErrKind = "entity is in a state that does not allow the operation"
 	TxRollbackFailed RepoErrKind = "failed to rollback transaction"
+	Locked           RepoErrKind = "lock is held by another process"
+	LimitExceeded    RepoErrKind = "entity limit exceeded"
+	VersionMismatch  RepoErrKind = "entity version does not match"
 )
@@ -6,6 +6,7 @@ package repositorymocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shrtyk/pvz-service/internal/core/domain"
@@ -40,112 +41,117 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 	return &MockRepository_Expecter{mock: &_m.Mock}
 }
 
-// CloseReceptionInPvz provides a mock function for the type MockRepository
-func (_mock *MockRepository) CloseReceptionInPvz(ctx context.Context, pvzId *uuid.UUID) error {
-	ret := _mock.Called(ctx, pvzId)
+// AllPvzsStock provides a mock function for the type MockRepository
+func (_mock *MockRepository) AllPvzsStock(ctx context.Context) ([]*domain.PvzStock, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CloseReceptionInPvz")
+		panic("no return value specified for AllPvzsStock")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, pvzId)
+	var r0 []*domain.PvzStock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.PvzStock, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.PvzStock); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.PvzStock)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockRepository_CloseReceptionInPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseReceptionInPvz'
-type MockRepository_CloseReceptionInPvz_Call struct {
+// MockRepository_AllPvzsStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllPvzsStock'
+type MockRepository_AllPvzsStock_Call struct {
 	*mock.Call
 }
 
-// CloseReceptionInPvz is a helper method to define mock.On call
+// AllPvzsStock is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pvzId *uuid.UUID
-func (_e *MockRepository_Expecter) CloseReceptionInPvz(ctx interface{}, pvzId interface{}) *MockRepository_CloseReceptionInPvz_Call {
-	return &MockRepository_CloseReceptionInPvz_Call{Call: _e.mock.On("CloseReceptionInPvz", ctx, pvzId)}
+func (_e *MockRepository_Expecter) AllPvzsStock(ctx interface{}) *MockRepository_AllPvzsStock_Call {
+	return &MockRepository_AllPvzsStock_Call{Call: _e.mock.On("AllPvzsStock", ctx)}
 }
 
-func (_c *MockRepository_CloseReceptionInPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockRepository_CloseReceptionInPvz_Call {
+func (_c *MockRepository_AllPvzsStock_Call) Run(run func(ctx context.Context)) *MockRepository_AllPvzsStock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *uuid.UUID
-		if args[1] != nil {
-			arg1 = args[1].(*uuid.UUID)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_CloseReceptionInPvz_Call) Return(err error) *MockRepository_CloseReceptionInPvz_Call {
-	_c.Call.Return(err)
+func (_c *MockRepository_AllPvzsStock_Call) Return(pvzStocks []*domain.PvzStock, err error) *MockRepository_AllPvzsStock_Call {
+	_c.Call.Return(pvzStocks, err)
 	return _c
 }
 
-func (_c *MockRepository_CloseReceptionInPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) error) *MockRepository_CloseReceptionInPvz_Call {
+func (_c *MockRepository_AllPvzsStock_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.PvzStock, error)) *MockRepository_AllPvzsStock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreatePVZ provides a mock function for the type MockRepository
-func (_mock *MockRepository) CreatePVZ(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error) {
-	ret := _mock.Called(ctx, pvz)
+// AutoCloseStaleReceptions provides a mock function for the type MockRepository
+func (_mock *MockRepository) AutoCloseStaleReceptions(ctx context.Context, idleBefore time.Time) ([]*domain.Reception, error) {
+	ret := _mock.Called(ctx, idleBefore)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreatePVZ")
+		panic("no return value specified for AutoCloseStaleReceptions")
 	}
 
-	var r0 *domain.Pvz
+	var r0 []*domain.Reception
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) (*domain.Pvz, error)); ok {
-		return returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]*domain.Reception, error)); ok {
+		return returnFunc(ctx, idleBefore)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) *domain.Pvz); ok {
-		r0 = returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []*domain.Reception); ok {
+		r0 = returnFunc(ctx, idleBefore)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Pvz)
+			r0 = ret.Get(0).([]*domain.Reception)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Pvz) error); ok {
-		r1 = returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, idleBefore)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRepository_CreatePVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePVZ'
-type MockRepository_CreatePVZ_Call struct {
+// MockRepository_AutoCloseStaleReceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoCloseStaleReceptions'
+type MockRepository_AutoCloseStaleReceptions_Call struct {
 	*mock.Call
 }
 
-// CreatePVZ is a helper method to define mock.On call
+// AutoCloseStaleReceptions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pvz *domain.Pvz
-func (_e *MockRepository_Expecter) CreatePVZ(ctx interface{}, pvz interface{}) *MockRepository_CreatePVZ_Call {
-	return &MockRepository_CreatePVZ_Call{Call: _e.mock.On("CreatePVZ", ctx, pvz)}
+//   - idleBefore time.Time
+func (_e *MockRepository_Expecter) AutoCloseStaleReceptions(ctx interface{}, idleBefore interface{}) *MockRepository_AutoCloseStaleReceptions_Call {
+	return &MockRepository_AutoCloseStaleReceptions_Call{Call: _e.mock.On("AutoCloseStaleReceptions", ctx, idleBefore)}
 }
 
-func (_c *MockRepository_CreatePVZ_Call) Run(run func(ctx context.Context, pvz *domain.Pvz)) *MockRepository_CreatePVZ_Call {
+func (_c *MockRepository_AutoCloseStaleReceptions_Call) Run(run func(ctx context.Context, idleBefore time.Time)) *MockRepository_AutoCloseStaleReceptions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Pvz
+		var arg1 time.Time
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Pvz)
+			arg1 = args[1].(time.Time)
 		}
 		run(
 			arg0,
@@ -155,258 +161,273 @@ func (_c *MockRepository_CreatePVZ_Call) Run(run func(ctx context.Context, pvz *
 	return _c
 }
 
-func (_c *MockRepository_CreatePVZ_Call) Return(pvz1 *domain.Pvz, err error) *MockRepository_CreatePVZ_Call {
-	_c.Call.Return(pvz1, err)
+func (_c *MockRepository_AutoCloseStaleReceptions_Call) Return(receptions []*domain.Reception, err error) *MockRepository_AutoCloseStaleReceptions_Call {
+	_c.Call.Return(receptions, err)
 	return _c
 }
 
-func (_c *MockRepository_CreatePVZ_Call) RunAndReturn(run func(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error)) *MockRepository_CreatePVZ_Call {
+func (_c *MockRepository_AutoCloseStaleReceptions_Call) RunAndReturn(run func(ctx context.Context, idleBefore time.Time) ([]*domain.Reception, error)) *MockRepository_AutoCloseStaleReceptions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateProduct provides a mock function for the type MockRepository
-func (_mock *MockRepository) CreateProduct(ctx context.Context, prod *domain.Product) (*domain.Product, error) {
-	ret := _mock.Called(ctx, prod)
+// CancelReceptionInPvz provides a mock function for the type MockRepository
+func (_mock *MockRepository) CancelReceptionInPvz(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, pvzId, ifVersion)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateProduct")
+		panic("no return value specified for CancelReceptionInPvz")
 	}
 
-	var r0 *domain.Product
+	var r0 *domain.Reception
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product) (*domain.Product, error)); ok {
-		return returnFunc(ctx, prod)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, pvzId, ifVersion)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product) *domain.Product); ok {
-		r0 = returnFunc(ctx, prod)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) *domain.Reception); ok {
+		r0 = returnFunc(ctx, pvzId, ifVersion)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Product)
+			r0 = ret.Get(0).(*domain.Reception)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Product) error); ok {
-		r1 = returnFunc(ctx, prod)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *int) error); ok {
+		r1 = returnFunc(ctx, pvzId, ifVersion)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRepository_CreateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProduct'
-type MockRepository_CreateProduct_Call struct {
+// MockRepository_CancelReceptionInPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelReceptionInPvz'
+type MockRepository_CancelReceptionInPvz_Call struct {
 	*mock.Call
 }
 
-// CreateProduct is a helper method to define mock.On call
+// CancelReceptionInPvz is a helper method to define mock.On call
 //   - ctx context.Context
-//   - prod *domain.Product
-func (_e *MockRepository_Expecter) CreateProduct(ctx interface{}, prod interface{}) *MockRepository_CreateProduct_Call {
-	return &MockRepository_CreateProduct_Call{Call: _e.mock.On("CreateProduct", ctx, prod)}
+//   - pvzId *uuid.UUID
+//   - ifVersion *int
+func (_e *MockRepository_Expecter) CancelReceptionInPvz(ctx interface{}, pvzId interface{}, ifVersion interface{}) *MockRepository_CancelReceptionInPvz_Call {
+	return &MockRepository_CancelReceptionInPvz_Call{Call: _e.mock.On("CancelReceptionInPvz", ctx, pvzId, ifVersion)}
 }
 
-func (_c *MockRepository_CreateProduct_Call) Run(run func(ctx context.Context, prod *domain.Product)) *MockRepository_CreateProduct_Call {
+func (_c *MockRepository_CancelReceptionInPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int)) *MockRepository_CancelReceptionInPvz_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Product
+		var arg1 *uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Product)
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *int
+		if args[2] != nil {
+			arg2 = args[2].(*int)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_CreateProduct_Call) Return(product *domain.Product, err error) *MockRepository_CreateProduct_Call {
-	_c.Call.Return(product, err)
+func (_c *MockRepository_CancelReceptionInPvz_Call) Return(reception *domain.Reception, err error) *MockRepository_CancelReceptionInPvz_Call {
+	_c.Call.Return(reception, err)
 	return _c
 }
 
-func (_c *MockRepository_CreateProduct_Call) RunAndReturn(run func(ctx context.Context, prod *domain.Product) (*domain.Product, error)) *MockRepository_CreateProduct_Call {
+func (_c *MockRepository_CancelReceptionInPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.Reception, error)) *MockRepository_CancelReceptionInPvz_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateReception provides a mock function for the type MockRepository
-func (_mock *MockRepository) CreateReception(ctx context.Context, rec *domain.Reception) (*domain.Reception, error) {
-	ret := _mock.Called(ctx, rec)
+// ClaimExportJob provides a mock function for the type MockRepository
+func (_mock *MockRepository) ClaimExportJob(ctx context.Context) (*domain.ExportJob, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateReception")
+		panic("no return value specified for ClaimExportJob")
 	}
 
-	var r0 *domain.Reception
+	var r0 *domain.ExportJob
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) (*domain.Reception, error)); ok {
-		return returnFunc(ctx, rec)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*domain.ExportJob, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) *domain.Reception); ok {
-		r0 = returnFunc(ctx, rec)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *domain.ExportJob); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Reception)
+			r0 = ret.Get(0).(*domain.ExportJob)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Reception) error); ok {
-		r1 = returnFunc(ctx, rec)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRepository_CreateReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReception'
-type MockRepository_CreateReception_Call struct {
+// MockRepository_ClaimExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimExportJob'
+type MockRepository_ClaimExportJob_Call struct {
 	*mock.Call
 }
 
-// CreateReception is a helper method to define mock.On call
+// ClaimExportJob is a helper method to define mock.On call
 //   - ctx context.Context
-//   - rec *domain.Reception
-func (_e *MockRepository_Expecter) CreateReception(ctx interface{}, rec interface{}) *MockRepository_CreateReception_Call {
-	return &MockRepository_CreateReception_Call{Call: _e.mock.On("CreateReception", ctx, rec)}
+func (_e *MockRepository_Expecter) ClaimExportJob(ctx interface{}) *MockRepository_ClaimExportJob_Call {
+	return &MockRepository_ClaimExportJob_Call{Call: _e.mock.On("ClaimExportJob", ctx)}
 }
 
-func (_c *MockRepository_CreateReception_Call) Run(run func(ctx context.Context, rec *domain.Reception)) *MockRepository_CreateReception_Call {
+func (_c *MockRepository_ClaimExportJob_Call) Run(run func(ctx context.Context)) *MockRepository_ClaimExportJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Reception
-		if args[1] != nil {
-			arg1 = args[1].(*domain.Reception)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_CreateReception_Call) Return(reception *domain.Reception, err error) *MockRepository_CreateReception_Call {
-	_c.Call.Return(reception, err)
+func (_c *MockRepository_ClaimExportJob_Call) Return(exportJob *domain.ExportJob, err error) *MockRepository_ClaimExportJob_Call {
+	_c.Call.Return(exportJob, err)
 	return _c
 }
 
-func (_c *MockRepository_CreateReception_Call) RunAndReturn(run func(ctx context.Context, rec *domain.Reception) (*domain.Reception, error)) *MockRepository_CreateReception_Call {
+func (_c *MockRepository_ClaimExportJob_Call) RunAndReturn(run func(ctx context.Context) (*domain.ExportJob, error)) *MockRepository_ClaimExportJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUser provides a mock function for the type MockRepository
-func (_mock *MockRepository) CreateUser(ctx context.Context, user *auth.User) (*auth.User, error) {
-	ret := _mock.Called(ctx, user)
+// CloseReceptionInPvz provides a mock function for the type MockRepository
+func (_mock *MockRepository) CloseReceptionInPvz(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.ReceptionCloseReport, error) {
+	ret := _mock.Called(ctx, pvzId, ifVersion)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateUser")
+		panic("no return value specified for CloseReceptionInPvz")
 	}
 
-	var r0 *auth.User
+	var r0 *domain.ReceptionCloseReport
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.User) (*auth.User, error)); ok {
-		return returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) (*domain.ReceptionCloseReport, error)); ok {
+		return returnFunc(ctx, pvzId, ifVersion)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.User) *auth.User); ok {
-		r0 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) *domain.ReceptionCloseReport); ok {
+		r0 = returnFunc(ctx, pvzId, ifVersion)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*auth.User)
+			r0 = ret.Get(0).(*domain.ReceptionCloseReport)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.User) error); ok {
-		r1 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *int) error); ok {
+		r1 = returnFunc(ctx, pvzId, ifVersion)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRepository_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type MockRepository_CreateUser_Call struct {
+// MockRepository_CloseReceptionInPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseReceptionInPvz'
+type MockRepository_CloseReceptionInPvz_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// CloseReceptionInPvz is a helper method to define mock.On call
 //   - ctx context.Context
-//   - user *auth.User
-func (_e *MockRepository_Expecter) CreateUser(ctx interface{}, user interface{}) *MockRepository_CreateUser_Call {
-	return &MockRepository_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, user)}
+//   - pvzId *uuid.UUID
+//   - ifVersion *int
+func (_e *MockRepository_Expecter) CloseReceptionInPvz(ctx interface{}, pvzId interface{}, ifVersion interface{}) *MockRepository_CloseReceptionInPvz_Call {
+	return &MockRepository_CloseReceptionInPvz_Call{Call: _e.mock.On("CloseReceptionInPvz", ctx, pvzId, ifVersion)}
 }
 
-func (_c *MockRepository_CreateUser_Call) Run(run func(ctx context.Context, user *auth.User)) *MockRepository_CreateUser_Call {
+func (_c *MockRepository_CloseReceptionInPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int)) *MockRepository_CloseReceptionInPvz_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *auth.User
+		var arg1 *uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(*auth.User)
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *int
+		if args[2] != nil {
+			arg2 = args[2].(*int)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_CreateUser_Call) Return(user1 *auth.User, err error) *MockRepository_CreateUser_Call {
-	_c.Call.Return(user1, err)
+func (_c *MockRepository_CloseReceptionInPvz_Call) Return(receptionCloseReport *domain.ReceptionCloseReport, err error) *MockRepository_CloseReceptionInPvz_Call {
+	_c.Call.Return(receptionCloseReport, err)
 	return _c
 }
 
-func (_c *MockRepository_CreateUser_Call) RunAndReturn(run func(ctx context.Context, user *auth.User) (*auth.User, error)) *MockRepository_CreateUser_Call {
+func (_c *MockRepository_CloseReceptionInPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.ReceptionCloseReport, error)) *MockRepository_CloseReceptionInPvz_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteLastProduct provides a mock function for the type MockRepository
-func (_mock *MockRepository) DeleteLastProduct(ctx context.Context, pvzId *uuid.UUID) error {
-	ret := _mock.Called(ctx, pvzId)
+// CountReceptions provides a mock function for the type MockRepository
+func (_mock *MockRepository) CountReceptions(ctx context.Context, status domain.ReceptionStatus) (int, error) {
+	ret := _mock.Called(ctx, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteLastProduct")
+		panic("no return value specified for CountReceptions")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, pvzId)
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ReceptionStatus) (int, error)); ok {
+		return returnFunc(ctx, status)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ReceptionStatus) int); ok {
+		r0 = returnFunc(ctx, status)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ReceptionStatus) error); ok {
+		r1 = returnFunc(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockRepository_DeleteLastProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLastProduct'
-type MockRepository_DeleteLastProduct_Call struct {
+// MockRepository_CountReceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountReceptions'
+type MockRepository_CountReceptions_Call struct {
 	*mock.Call
 }
 
-// DeleteLastProduct is a helper method to define mock.On call
+// CountReceptions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pvzId *uuid.UUID
-func (_e *MockRepository_Expecter) DeleteLastProduct(ctx interface{}, pvzId interface{}) *MockRepository_DeleteLastProduct_Call {
-	return &MockRepository_DeleteLastProduct_Call{Call: _e.mock.On("DeleteLastProduct", ctx, pvzId)}
+//   - status domain.ReceptionStatus
+func (_e *MockRepository_Expecter) CountReceptions(ctx interface{}, status interface{}) *MockRepository_CountReceptions_Call {
+	return &MockRepository_CountReceptions_Call{Call: _e.mock.On("CountReceptions", ctx, status)}
 }
 
-func (_c *MockRepository_DeleteLastProduct_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockRepository_DeleteLastProduct_Call {
+func (_c *MockRepository_CountReceptions_Call) Run(run func(ctx context.Context, status domain.ReceptionStatus)) *MockRepository_CountReceptions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *uuid.UUID
+		var arg1 domain.ReceptionStatus
 		if args[1] != nil {
-			arg1 = args[1].(*uuid.UUID)
+			arg1 = args[1].(domain.ReceptionStatus)
 		}
 		run(
 			arg0,
@@ -416,127 +437,133 @@ func (_c *MockRepository_DeleteLastProduct_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockRepository_DeleteLastProduct_Call) Return(err error) *MockRepository_DeleteLastProduct_Call {
-	_c.Call.Return(err)
+func (_c *MockRepository_CountReceptions_Call) Return(n int, err error) *MockRepository_CountReceptions_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockRepository_DeleteLastProduct_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) error) *MockRepository_DeleteLastProduct_Call {
+func (_c *MockRepository_CountReceptions_Call) RunAndReturn(run func(ctx context.Context, status domain.ReceptionStatus) (int, error)) *MockRepository_CountReceptions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAllPvzs provides a mock function for the type MockRepository
-func (_mock *MockRepository) GetAllPvzs(ctx context.Context) ([]*domain.Pvz, error) {
-	ret := _mock.Called(ctx)
+// CreateExportJob provides a mock function for the type MockRepository
+func (_mock *MockRepository) CreateExportJob(ctx context.Context, job *domain.ExportJob) (*domain.ExportJob, error) {
+	ret := _mock.Called(ctx, job)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllPvzs")
+		panic("no return value specified for CreateExportJob")
 	}
 
-	var r0 []*domain.Pvz
+	var r0 *domain.ExportJob
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Pvz, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ExportJob) (*domain.ExportJob, error)); ok {
+		return returnFunc(ctx, job)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Pvz); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ExportJob) *domain.ExportJob); ok {
+		r0 = returnFunc(ctx, job)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Pvz)
+			r0 = ret.Get(0).(*domain.ExportJob)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ExportJob) error); ok {
+		r1 = returnFunc(ctx, job)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRepository_GetAllPvzs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllPvzs'
-type MockRepository_GetAllPvzs_Call struct {
+// MockRepository_CreateExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExportJob'
+type MockRepository_CreateExportJob_Call struct {
 	*mock.Call
 }
 
-// GetAllPvzs is a helper method to define mock.On call
+// CreateExportJob is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockRepository_Expecter) GetAllPvzs(ctx interface{}) *MockRepository_GetAllPvzs_Call {
-	return &MockRepository_GetAllPvzs_Call{Call: _e.mock.On("GetAllPvzs", ctx)}
+//   - job *domain.ExportJob
+func (_e *MockRepository_Expecter) CreateExportJob(ctx interface{}, job interface{}) *MockRepository_CreateExportJob_Call {
+	return &MockRepository_CreateExportJob_Call{Call: _e.mock.On("CreateExportJob", ctx, job)}
 }
 
-func (_c *MockRepository_GetAllPvzs_Call) Run(run func(ctx context.Context)) *MockRepository_GetAllPvzs_Call {
+func (_c *MockRepository_CreateExportJob_Call) Run(run func(ctx context.Context, job *domain.ExportJob)) *MockRepository_CreateExportJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 *domain.ExportJob
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ExportJob)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_GetAllPvzs_Call) Return(pvzs []*domain.Pvz, err error) *MockRepository_GetAllPvzs_Call {
-	_c.Call.Return(pvzs, err)
+func (_c *MockRepository_CreateExportJob_Call) Return(exportJob *domain.ExportJob, err error) *MockRepository_CreateExportJob_Call {
+	_c.Call.Return(exportJob, err)
 	return _c
 }
 
-func (_c *MockRepository_GetAllPvzs_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Pvz, error)) *MockRepository_GetAllPvzs_Call {
+func (_c *MockRepository_CreateExportJob_Call) RunAndReturn(run func(ctx context.Context, job *domain.ExportJob) (*domain.ExportJob, error)) *MockRepository_CreateExportJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPvzsData provides a mock function for the type MockRepository
-func (_mock *MockRepository) GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) ([]*domain.PvzReceptions, error) {
-	ret := _mock.Called(ctx, params)
+// CreatePVZ provides a mock function for the type MockRepository
+func (_mock *MockRepository) CreatePVZ(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error) {
+	ret := _mock.Called(ctx, pvz)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPvzsData")
+		panic("no return value specified for CreatePVZ")
 	}
 
-	var r0 []*domain.PvzReceptions
+	var r0 *domain.Pvz
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) ([]*domain.PvzReceptions, error)); ok {
-		return returnFunc(ctx, params)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) (*domain.Pvz, error)); ok {
+		return returnFunc(ctx, pvz)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) []*domain.PvzReceptions); ok {
-		r0 = returnFunc(ctx, params)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) *domain.Pvz); ok {
+		r0 = returnFunc(ctx, pvz)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.PvzReceptions)
+			r0 = ret.Get(0).(*domain.Pvz)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PvzsReadParams) error); ok {
-		r1 = returnFunc(ctx, params)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Pvz) error); ok {
+		r1 = returnFunc(ctx, pvz)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRepository_GetPvzsData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvzsData'
-type MockRepository_GetPvzsData_Call struct {
+// MockRepository_CreatePVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePVZ'
+type MockRepository_CreatePVZ_Call struct {
 	*mock.Call
 }
 
-// GetPvzsData is a helper method to define mock.On call
+// CreatePVZ is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params *domain.PvzsReadParams
-func (_e *MockRepository_Expecter) GetPvzsData(ctx interface{}, params interface{}) *MockRepository_GetPvzsData_Call {
-	return &MockRepository_GetPvzsData_Call{Call: _e.mock.On("GetPvzsData", ctx, params)}
+//   - pvz *domain.Pvz
+func (_e *MockRepository_Expecter) CreatePVZ(ctx interface{}, pvz interface{}) *MockRepository_CreatePVZ_Call {
+	return &MockRepository_CreatePVZ_Call{Call: _e.mock.On("CreatePVZ", ctx, pvz)}
 }
 
-func (_c *MockRepository_GetPvzsData_Call) Run(run func(ctx context.Context, params *domain.PvzsReadParams)) *MockRepository_GetPvzsData_Call {
+func (_c *MockRepository_CreatePVZ_Call) Run(run func(ctx context.Context, pvz *domain.Pvz)) *MockRepository_CreatePVZ_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.PvzsReadParams
+		var arg1 *domain.Pvz
 		if args[1] != nil {
-			arg1 = args[1].(*domain.PvzsReadParams)
+			arg1 = args[1].(*domain.Pvz)
 		}
 		run(
 			arg0,
@@ -546,116 +573,4213 @@ func (_c *MockRepository_GetPvzsData_Call) Run(run func(ctx context.Context, par
 	return _c
 }
 
-func (_c *MockRepository_GetPvzsData_Call) Return(pvzReceptionss []*domain.PvzReceptions, err error) *MockRepository_GetPvzsData_Call {
-	_c.Call.Return(pvzReceptionss, err)
+func (_c *MockRepository_CreatePVZ_Call) Return(pvz1 *domain.Pvz, err error) *MockRepository_CreatePVZ_Call {
+	_c.Call.Return(pvz1, err)
 	return _c
 }
 
-func (_c *MockRepository_GetPvzsData_Call) RunAndReturn(run func(ctx context.Context, params *domain.PvzsReadParams) ([]*domain.PvzReceptions, error)) *MockRepository_GetPvzsData_Call {
+func (_c *MockRepository_CreatePVZ_Call) RunAndReturn(run func(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error)) *MockRepository_CreatePVZ_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SaveRefreshToken provides a mock function for the type MockRepository
-func (_mock *MockRepository) SaveRefreshToken(ctx context.Context, rToken *auth.RefreshToken) error {
-	ret := _mock.Called(ctx, rToken)
+// CreateProduct provides a mock function for the type MockRepository
+func (_mock *MockRepository) CreateProduct(ctx context.Context, prod *domain.Product, capacity int, uniqueBarcode bool) (*domain.Product, error) {
+	ret := _mock.Called(ctx, prod, capacity, uniqueBarcode)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SaveRefreshToken")
+		panic("no return value specified for CreateProduct")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RefreshToken) error); ok {
-		r0 = returnFunc(ctx, rToken)
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product, int, bool) (*domain.Product, error)); ok {
+		return returnFunc(ctx, prod, capacity, uniqueBarcode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product, int, bool) *domain.Product); ok {
+		r0 = returnFunc(ctx, prod, capacity, uniqueBarcode)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Product, int, bool) error); ok {
+		r1 = returnFunc(ctx, prod, capacity, uniqueBarcode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockRepository_SaveRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRefreshToken'
-type MockRepository_SaveRefreshToken_Call struct {
+// MockRepository_CreateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProduct'
+type MockRepository_CreateProduct_Call struct {
 	*mock.Call
 }
 
-// SaveRefreshToken is a helper method to define mock.On call
+// CreateProduct is a helper method to define mock.On call
 //   - ctx context.Context
-//   - rToken *auth.RefreshToken
-func (_e *MockRepository_Expecter) SaveRefreshToken(ctx interface{}, rToken interface{}) *MockRepository_SaveRefreshToken_Call {
-	return &MockRepository_SaveRefreshToken_Call{Call: _e.mock.On("SaveRefreshToken", ctx, rToken)}
+//   - prod *domain.Product
+//   - capacity int
+//   - uniqueBarcode bool
+func (_e *MockRepository_Expecter) CreateProduct(ctx interface{}, prod interface{}, capacity interface{}, uniqueBarcode interface{}) *MockRepository_CreateProduct_Call {
+	return &MockRepository_CreateProduct_Call{Call: _e.mock.On("CreateProduct", ctx, prod, capacity, uniqueBarcode)}
 }
 
-func (_c *MockRepository_SaveRefreshToken_Call) Run(run func(ctx context.Context, rToken *auth.RefreshToken)) *MockRepository_SaveRefreshToken_Call {
+func (_c *MockRepository_CreateProduct_Call) Run(run func(ctx context.Context, prod *domain.Product, capacity int, uniqueBarcode bool)) *MockRepository_CreateProduct_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *auth.RefreshToken
+		var arg1 *domain.Product
 		if args[1] != nil {
-			arg1 = args[1].(*auth.RefreshToken)
+			arg1 = args[1].(*domain.Product)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateProduct_Call) Return(product *domain.Product, err error) *MockRepository_CreateProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockRepository_CreateProduct_Call) RunAndReturn(run func(ctx context.Context, prod *domain.Product, capacity int, uniqueBarcode bool) (*domain.Product, error)) *MockRepository_CreateProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateProductsBatch provides a mock function for the type MockRepository
+func (_mock *MockRepository) CreateProductsBatch(ctx context.Context, batch *domain.ProductsBatch) (*domain.ProductsBatchResult, error) {
+	ret := _mock.Called(ctx, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProductsBatch")
+	}
+
+	var r0 *domain.ProductsBatchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductsBatch) (*domain.ProductsBatchResult, error)); ok {
+		return returnFunc(ctx, batch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductsBatch) *domain.ProductsBatchResult); ok {
+		r0 = returnFunc(ctx, batch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProductsBatchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ProductsBatch) error); ok {
+		r1 = returnFunc(ctx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_CreateProductsBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProductsBatch'
+type MockRepository_CreateProductsBatch_Call struct {
+	*mock.Call
+}
+
+// CreateProductsBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batch *domain.ProductsBatch
+func (_e *MockRepository_Expecter) CreateProductsBatch(ctx interface{}, batch interface{}) *MockRepository_CreateProductsBatch_Call {
+	return &MockRepository_CreateProductsBatch_Call{Call: _e.mock.On("CreateProductsBatch", ctx, batch)}
+}
+
+func (_c *MockRepository_CreateProductsBatch_Call) Run(run func(ctx context.Context, batch *domain.ProductsBatch)) *MockRepository_CreateProductsBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ProductsBatch
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ProductsBatch)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateProductsBatch_Call) Return(productsBatchResult *domain.ProductsBatchResult, err error) *MockRepository_CreateProductsBatch_Call {
+	_c.Call.Return(productsBatchResult, err)
+	return _c
+}
+
+func (_c *MockRepository_CreateProductsBatch_Call) RunAndReturn(run func(ctx context.Context, batch *domain.ProductsBatch) (*domain.ProductsBatchResult, error)) *MockRepository_CreateProductsBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReception provides a mock function for the type MockRepository
+func (_mock *MockRepository) CreateReception(ctx context.Context, rec *domain.Reception) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, rec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReception")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, rec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) *domain.Reception); ok {
+		r0 = returnFunc(ctx, rec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Reception) error); ok {
+		r1 = returnFunc(ctx, rec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_CreateReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReception'
+type MockRepository_CreateReception_Call struct {
+	*mock.Call
+}
+
+// CreateReception is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rec *domain.Reception
+func (_e *MockRepository_Expecter) CreateReception(ctx interface{}, rec interface{}) *MockRepository_CreateReception_Call {
+	return &MockRepository_CreateReception_Call{Call: _e.mock.On("CreateReception", ctx, rec)}
+}
+
+func (_c *MockRepository_CreateReception_Call) Run(run func(ctx context.Context, rec *domain.Reception)) *MockRepository_CreateReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Reception
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Reception)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateReception_Call) Return(reception *domain.Reception, err error) *MockRepository_CreateReception_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockRepository_CreateReception_Call) RunAndReturn(run func(ctx context.Context, rec *domain.Reception) (*domain.Reception, error)) *MockRepository_CreateReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function for the type MockRepository
+func (_mock *MockRepository) CreateUser(ctx context.Context, user *auth.User) (*auth.User, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 *auth.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.User) (*auth.User, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.User) *auth.User); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.User) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockRepository_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *auth.User
+func (_e *MockRepository_Expecter) CreateUser(ctx interface{}, user interface{}) *MockRepository_CreateUser_Call {
+	return &MockRepository_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, user)}
+}
+
+func (_c *MockRepository_CreateUser_Call) Run(run func(ctx context.Context, user *auth.User)) *MockRepository_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.User
+		if args[1] != nil {
+			arg1 = args[1].(*auth.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateUser_Call) Return(user1 *auth.User, err error) *MockRepository_CreateUser_Call {
+	_c.Call.Return(user1, err)
+	return _c
+}
+
+func (_c *MockRepository_CreateUser_Call) RunAndReturn(run func(ctx context.Context, user *auth.User) (*auth.User, error)) *MockRepository_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteIdempotencyKey provides a mock function for the type MockRepository
+func (_mock *MockRepository) DeleteIdempotencyKey(ctx context.Context, req *domain.IdempotentRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteIdempotencyKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotentRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_DeleteIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteIdempotencyKey'
+type MockRepository_DeleteIdempotencyKey_Call struct {
+	*mock.Call
+}
+
+// DeleteIdempotencyKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.IdempotentRequest
+func (_e *MockRepository_Expecter) DeleteIdempotencyKey(ctx interface{}, req interface{}) *MockRepository_DeleteIdempotencyKey_Call {
+	return &MockRepository_DeleteIdempotencyKey_Call{Call: _e.mock.On("DeleteIdempotencyKey", ctx, req)}
+}
+
+func (_c *MockRepository_DeleteIdempotencyKey_Call) Run(run func(ctx context.Context, req *domain.IdempotentRequest)) *MockRepository_DeleteIdempotencyKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.IdempotentRequest
+		if args[1] != nil {
+			arg1 = args[1].(*domain.IdempotentRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteIdempotencyKey_Call) Return(err error) *MockRepository_DeleteIdempotencyKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_DeleteIdempotencyKey_Call) RunAndReturn(run func(ctx context.Context, req *domain.IdempotentRequest) error) *MockRepository_DeleteIdempotencyKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLastProduct provides a mock function for the type MockRepository
+func (_mock *MockRepository) DeleteLastProduct(ctx context.Context, pvzId *uuid.UUID, actorId *uuid.UUID) error {
+	ret := _mock.Called(ctx, pvzId, actorId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLastProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, pvzId, actorId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_DeleteLastProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLastProduct'
+type MockRepository_DeleteLastProduct_Call struct {
+	*mock.Call
+}
+
+// DeleteLastProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+//   - actorId *uuid.UUID
+func (_e *MockRepository_Expecter) DeleteLastProduct(ctx interface{}, pvzId interface{}, actorId interface{}) *MockRepository_DeleteLastProduct_Call {
+	return &MockRepository_DeleteLastProduct_Call{Call: _e.mock.On("DeleteLastProduct", ctx, pvzId, actorId)}
+}
+
+func (_c *MockRepository_DeleteLastProduct_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, actorId *uuid.UUID)) *MockRepository_DeleteLastProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteLastProduct_Call) Return(err error) *MockRepository_DeleteLastProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_DeleteLastProduct_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, actorId *uuid.UUID) error) *MockRepository_DeleteLastProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProduct provides a mock function for the type MockRepository
+func (_mock *MockRepository) DeleteProduct(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID) error {
+	ret := _mock.Called(ctx, productId, actorId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, productId, actorId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_DeleteProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProduct'
+type MockRepository_DeleteProduct_Call struct {
+	*mock.Call
+}
+
+// DeleteProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+//   - actorId *uuid.UUID
+func (_e *MockRepository_Expecter) DeleteProduct(ctx interface{}, productId interface{}, actorId interface{}) *MockRepository_DeleteProduct_Call {
+	return &MockRepository_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", ctx, productId, actorId)}
+}
+
+func (_c *MockRepository_DeleteProduct_Call) Run(run func(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID)) *MockRepository_DeleteProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteProduct_Call) Return(err error) *MockRepository_DeleteProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_DeleteProduct_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID) error) *MockRepository_DeleteProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportJob provides a mock function for the type MockRepository
+func (_mock *MockRepository) ExportJob(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, error) {
+	ret := _mock.Called(ctx, jobId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportJob")
+	}
+
+	var r0 *domain.ExportJob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.ExportJob, error)); ok {
+		return returnFunc(ctx, jobId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.ExportJob); ok {
+		r0 = returnFunc(ctx, jobId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExportJob)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, jobId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportJob'
+type MockRepository_ExportJob_Call struct {
+	*mock.Call
+}
+
+// ExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobId *uuid.UUID
+func (_e *MockRepository_Expecter) ExportJob(ctx interface{}, jobId interface{}) *MockRepository_ExportJob_Call {
+	return &MockRepository_ExportJob_Call{Call: _e.mock.On("ExportJob", ctx, jobId)}
+}
+
+func (_c *MockRepository_ExportJob_Call) Run(run func(ctx context.Context, jobId *uuid.UUID)) *MockRepository_ExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ExportJob_Call) Return(exportJob *domain.ExportJob, err error) *MockRepository_ExportJob_Call {
+	_c.Call.Return(exportJob, err)
+	return _c
+}
+
+func (_c *MockRepository_ExportJob_Call) RunAndReturn(run func(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, error)) *MockRepository_ExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishExportJob provides a mock function for the type MockRepository
+func (_mock *MockRepository) FinishExportJob(ctx context.Context, jobId *uuid.UUID, status domain.ExportJobStatus, errMsg *string) error {
+	ret := _mock.Called(ctx, jobId, status, errMsg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishExportJob")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, domain.ExportJobStatus, *string) error); ok {
+		r0 = returnFunc(ctx, jobId, status, errMsg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_FinishExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishExportJob'
+type MockRepository_FinishExportJob_Call struct {
+	*mock.Call
+}
+
+// FinishExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobId *uuid.UUID
+//   - status domain.ExportJobStatus
+//   - errMsg *string
+func (_e *MockRepository_Expecter) FinishExportJob(ctx interface{}, jobId interface{}, status interface{}, errMsg interface{}) *MockRepository_FinishExportJob_Call {
+	return &MockRepository_FinishExportJob_Call{Call: _e.mock.On("FinishExportJob", ctx, jobId, status, errMsg)}
+}
+
+func (_c *MockRepository_FinishExportJob_Call) Run(run func(ctx context.Context, jobId *uuid.UUID, status domain.ExportJobStatus, errMsg *string)) *MockRepository_FinishExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 domain.ExportJobStatus
+		if args[2] != nil {
+			arg2 = args[2].(domain.ExportJobStatus)
+		}
+		var arg3 *string
+		if args[3] != nil {
+			arg3 = args[3].(*string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FinishExportJob_Call) Return(err error) *MockRepository_FinishExportJob_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_FinishExportJob_Call) RunAndReturn(run func(ctx context.Context, jobId *uuid.UUID, status domain.ExportJobStatus, errMsg *string) error) *MockRepository_FinishExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllPvzs provides a mock function for the type MockRepository
+func (_mock *MockRepository) GetAllPvzs(ctx context.Context, filter *domain.PvzsFilter, sort domain.PvzsSort) ([]*domain.Pvz, error) {
+	ret := _mock.Called(ctx, filter, sort)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPvzs")
+	}
+
+	var r0 []*domain.Pvz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsFilter, domain.PvzsSort) ([]*domain.Pvz, error)); ok {
+		return returnFunc(ctx, filter, sort)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsFilter, domain.PvzsSort) []*domain.Pvz); ok {
+		r0 = returnFunc(ctx, filter, sort)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Pvz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PvzsFilter, domain.PvzsSort) error); ok {
+		r1 = returnFunc(ctx, filter, sort)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_GetAllPvzs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllPvzs'
+type MockRepository_GetAllPvzs_Call struct {
+	*mock.Call
+}
+
+// GetAllPvzs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *domain.PvzsFilter
+//   - sort domain.PvzsSort
+func (_e *MockRepository_Expecter) GetAllPvzs(ctx interface{}, filter interface{}, sort interface{}) *MockRepository_GetAllPvzs_Call {
+	return &MockRepository_GetAllPvzs_Call{Call: _e.mock.On("GetAllPvzs", ctx, filter, sort)}
+}
+
+func (_c *MockRepository_GetAllPvzs_Call) Run(run func(ctx context.Context, filter *domain.PvzsFilter, sort domain.PvzsSort)) *MockRepository_GetAllPvzs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PvzsFilter
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PvzsFilter)
+		}
+		var arg2 domain.PvzsSort
+		if args[2] != nil {
+			arg2 = args[2].(domain.PvzsSort)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetAllPvzs_Call) Return(pvzs []*domain.Pvz, err error) *MockRepository_GetAllPvzs_Call {
+	_c.Call.Return(pvzs, err)
+	return _c
+}
+
+func (_c *MockRepository_GetAllPvzs_Call) RunAndReturn(run func(ctx context.Context, filter *domain.PvzsFilter, sort domain.PvzsSort) ([]*domain.Pvz, error)) *MockRepository_GetAllPvzs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPvzsData provides a mock function for the type MockRepository
+func (_mock *MockRepository) GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) (*domain.PvzsPage, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPvzsData")
+	}
+
+	var r0 *domain.PvzsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) (*domain.PvzsPage, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) *domain.PvzsPage); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PvzsPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PvzsReadParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_GetPvzsData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvzsData'
+type MockRepository_GetPvzsData_Call struct {
+	*mock.Call
+}
+
+// GetPvzsData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *domain.PvzsReadParams
+func (_e *MockRepository_Expecter) GetPvzsData(ctx interface{}, params interface{}) *MockRepository_GetPvzsData_Call {
+	return &MockRepository_GetPvzsData_Call{Call: _e.mock.On("GetPvzsData", ctx, params)}
+}
+
+func (_c *MockRepository_GetPvzsData_Call) Run(run func(ctx context.Context, params *domain.PvzsReadParams)) *MockRepository_GetPvzsData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PvzsReadParams
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PvzsReadParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetPvzsData_Call) Return(pvzsPage *domain.PvzsPage, err error) *MockRepository_GetPvzsData_Call {
+	_c.Call.Return(pvzsPage, err)
+	return _c
+}
+
+func (_c *MockRepository_GetPvzsData_Call) RunAndReturn(run func(ctx context.Context, params *domain.PvzsReadParams) (*domain.PvzsPage, error)) *MockRepository_GetPvzsData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductDeletions provides a mock function for the type MockRepository
+func (_mock *MockRepository) ProductDeletions(ctx context.Context, receptionId *uuid.UUID) ([]*domain.ProductDeletion, error) {
+	ret := _mock.Called(ctx, receptionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductDeletions")
+	}
+
+	var r0 []*domain.ProductDeletion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) ([]*domain.ProductDeletion, error)); ok {
+		return returnFunc(ctx, receptionId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) []*domain.ProductDeletion); ok {
+		r0 = returnFunc(ctx, receptionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductDeletion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, receptionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ProductDeletions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductDeletions'
+type MockRepository_ProductDeletions_Call struct {
+	*mock.Call
+}
+
+// ProductDeletions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receptionId *uuid.UUID
+func (_e *MockRepository_Expecter) ProductDeletions(ctx interface{}, receptionId interface{}) *MockRepository_ProductDeletions_Call {
+	return &MockRepository_ProductDeletions_Call{Call: _e.mock.On("ProductDeletions", ctx, receptionId)}
+}
+
+func (_c *MockRepository_ProductDeletions_Call) Run(run func(ctx context.Context, receptionId *uuid.UUID)) *MockRepository_ProductDeletions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ProductDeletions_Call) Return(productDeletions []*domain.ProductDeletion, err error) *MockRepository_ProductDeletions_Call {
+	_c.Call.Return(productDeletions, err)
+	return _c
+}
+
+func (_c *MockRepository_ProductDeletions_Call) RunAndReturn(run func(ctx context.Context, receptionId *uuid.UUID) ([]*domain.ProductDeletion, error)) *MockRepository_ProductDeletions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductStatusHistory provides a mock function for the type MockRepository
+func (_mock *MockRepository) ProductStatusHistory(ctx context.Context, productId *uuid.UUID) ([]*domain.ProductStatusChange, error) {
+	ret := _mock.Called(ctx, productId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductStatusHistory")
+	}
+
+	var r0 []*domain.ProductStatusChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) ([]*domain.ProductStatusChange, error)); ok {
+		return returnFunc(ctx, productId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) []*domain.ProductStatusChange); ok {
+		r0 = returnFunc(ctx, productId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductStatusChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ProductStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductStatusHistory'
+type MockRepository_ProductStatusHistory_Call struct {
+	*mock.Call
+}
+
+// ProductStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+func (_e *MockRepository_Expecter) ProductStatusHistory(ctx interface{}, productId interface{}) *MockRepository_ProductStatusHistory_Call {
+	return &MockRepository_ProductStatusHistory_Call{Call: _e.mock.On("ProductStatusHistory", ctx, productId)}
+}
+
+func (_c *MockRepository_ProductStatusHistory_Call) Run(run func(ctx context.Context, productId *uuid.UUID)) *MockRepository_ProductStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ProductStatusHistory_Call) Return(productStatusChanges []*domain.ProductStatusChange, err error) *MockRepository_ProductStatusHistory_Call {
+	_c.Call.Return(productStatusChanges, err)
+	return _c
+}
+
+func (_c *MockRepository_ProductStatusHistory_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID) ([]*domain.ProductStatusChange, error)) *MockRepository_ProductStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductStorageState provides a mock function for the type MockRepository
+func (_mock *MockRepository) ProductStorageState(ctx context.Context, productId *uuid.UUID) (*domain.ProductStorageState, error) {
+	ret := _mock.Called(ctx, productId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductStorageState")
+	}
+
+	var r0 *domain.ProductStorageState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.ProductStorageState, error)); ok {
+		return returnFunc(ctx, productId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.ProductStorageState); ok {
+		r0 = returnFunc(ctx, productId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProductStorageState)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ProductStorageState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductStorageState'
+type MockRepository_ProductStorageState_Call struct {
+	*mock.Call
+}
+
+// ProductStorageState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+func (_e *MockRepository_Expecter) ProductStorageState(ctx interface{}, productId interface{}) *MockRepository_ProductStorageState_Call {
+	return &MockRepository_ProductStorageState_Call{Call: _e.mock.On("ProductStorageState", ctx, productId)}
+}
+
+func (_c *MockRepository_ProductStorageState_Call) Run(run func(ctx context.Context, productId *uuid.UUID)) *MockRepository_ProductStorageState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ProductStorageState_Call) Return(productStorageState *domain.ProductStorageState, err error) *MockRepository_ProductStorageState_Call {
+	_c.Call.Return(productStorageState, err)
+	return _c
+}
+
+func (_c *MockRepository_ProductStorageState_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID) (*domain.ProductStorageState, error)) *MockRepository_ProductStorageState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductsByBarcode provides a mock function for the type MockRepository
+func (_mock *MockRepository) ProductsByBarcode(ctx context.Context, barcode string) ([]*domain.ProductInfo, error) {
+	ret := _mock.Called(ctx, barcode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductsByBarcode")
+	}
+
+	var r0 []*domain.ProductInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.ProductInfo, error)); ok {
+		return returnFunc(ctx, barcode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.ProductInfo); ok {
+		r0 = returnFunc(ctx, barcode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductInfo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, barcode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ProductsByBarcode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductsByBarcode'
+type MockRepository_ProductsByBarcode_Call struct {
+	*mock.Call
+}
+
+// ProductsByBarcode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - barcode string
+func (_e *MockRepository_Expecter) ProductsByBarcode(ctx interface{}, barcode interface{}) *MockRepository_ProductsByBarcode_Call {
+	return &MockRepository_ProductsByBarcode_Call{Call: _e.mock.On("ProductsByBarcode", ctx, barcode)}
+}
+
+func (_c *MockRepository_ProductsByBarcode_Call) Run(run func(ctx context.Context, barcode string)) *MockRepository_ProductsByBarcode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ProductsByBarcode_Call) Return(productInfos []*domain.ProductInfo, err error) *MockRepository_ProductsByBarcode_Call {
+	_c.Call.Return(productInfos, err)
+	return _c
+}
+
+func (_c *MockRepository_ProductsByBarcode_Call) RunAndReturn(run func(ctx context.Context, barcode string) ([]*domain.ProductInfo, error)) *MockRepository_ProductsByBarcode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductsThroughput provides a mock function for the type MockRepository
+func (_mock *MockRepository) ProductsThroughput(ctx context.Context, params *domain.ThroughputParams) ([]*domain.ThroughputPoint, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductsThroughput")
+	}
+
+	var r0 []*domain.ThroughputPoint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ThroughputParams) ([]*domain.ThroughputPoint, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ThroughputParams) []*domain.ThroughputPoint); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ThroughputPoint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ThroughputParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ProductsThroughput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductsThroughput'
+type MockRepository_ProductsThroughput_Call struct {
+	*mock.Call
+}
+
+// ProductsThroughput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *domain.ThroughputParams
+func (_e *MockRepository_Expecter) ProductsThroughput(ctx interface{}, params interface{}) *MockRepository_ProductsThroughput_Call {
+	return &MockRepository_ProductsThroughput_Call{Call: _e.mock.On("ProductsThroughput", ctx, params)}
+}
+
+func (_c *MockRepository_ProductsThroughput_Call) Run(run func(ctx context.Context, params *domain.ThroughputParams)) *MockRepository_ProductsThroughput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ThroughputParams
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ThroughputParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ProductsThroughput_Call) Return(throughputPoints []*domain.ThroughputPoint, err error) *MockRepository_ProductsThroughput_Call {
+	_c.Call.Return(throughputPoints, err)
+	return _c
+}
+
+func (_c *MockRepository_ProductsThroughput_Call) RunAndReturn(run func(ctx context.Context, params *domain.ThroughputParams) ([]*domain.ThroughputPoint, error)) *MockRepository_ProductsThroughput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pvz provides a mock function for the type MockRepository
+func (_mock *MockRepository) Pvz(ctx context.Context, pvzId *uuid.UUID) (*domain.Pvz, error) {
+	ret := _mock.Called(ctx, pvzId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pvz")
+	}
+
+	var r0 *domain.Pvz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.Pvz, error)); ok {
+		return returnFunc(ctx, pvzId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.Pvz); ok {
+		r0 = returnFunc(ctx, pvzId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Pvz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvzId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_Pvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pvz'
+type MockRepository_Pvz_Call struct {
+	*mock.Call
+}
+
+// Pvz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+func (_e *MockRepository_Expecter) Pvz(ctx interface{}, pvzId interface{}) *MockRepository_Pvz_Call {
+	return &MockRepository_Pvz_Call{Call: _e.mock.On("Pvz", ctx, pvzId)}
+}
+
+func (_c *MockRepository_Pvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockRepository_Pvz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Pvz_Call) Return(pvz *domain.Pvz, err error) *MockRepository_Pvz_Call {
+	_c.Call.Return(pvz, err)
+	return _c
+}
+
+func (_c *MockRepository_Pvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) (*domain.Pvz, error)) *MockRepository_Pvz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PvzStock provides a mock function for the type MockRepository
+func (_mock *MockRepository) PvzStock(ctx context.Context, pvzId *uuid.UUID) (*domain.PvzStock, error) {
+	ret := _mock.Called(ctx, pvzId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PvzStock")
+	}
+
+	var r0 *domain.PvzStock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.PvzStock, error)); ok {
+		return returnFunc(ctx, pvzId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.PvzStock); ok {
+		r0 = returnFunc(ctx, pvzId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PvzStock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvzId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_PvzStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PvzStock'
+type MockRepository_PvzStock_Call struct {
+	*mock.Call
+}
+
+// PvzStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+func (_e *MockRepository_Expecter) PvzStock(ctx interface{}, pvzId interface{}) *MockRepository_PvzStock_Call {
+	return &MockRepository_PvzStock_Call{Call: _e.mock.On("PvzStock", ctx, pvzId)}
+}
+
+func (_c *MockRepository_PvzStock_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockRepository_PvzStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_PvzStock_Call) Return(pvzStock *domain.PvzStock, err error) *MockRepository_PvzStock_Call {
+	_c.Call.Return(pvzStock, err)
+	return _c
+}
+
+func (_c *MockRepository_PvzStock_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) (*domain.PvzStock, error)) *MockRepository_PvzStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reception provides a mock function for the type MockRepository
+func (_mock *MockRepository) Reception(ctx context.Context, receptionId *uuid.UUID) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, receptionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reception")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, receptionId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.Reception); ok {
+		r0 = returnFunc(ctx, receptionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, receptionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_Reception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reception'
+type MockRepository_Reception_Call struct {
+	*mock.Call
+}
+
+// Reception is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receptionId *uuid.UUID
+func (_e *MockRepository_Expecter) Reception(ctx interface{}, receptionId interface{}) *MockRepository_Reception_Call {
+	return &MockRepository_Reception_Call{Call: _e.mock.On("Reception", ctx, receptionId)}
+}
+
+func (_c *MockRepository_Reception_Call) Run(run func(ctx context.Context, receptionId *uuid.UUID)) *MockRepository_Reception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Reception_Call) Return(reception *domain.Reception, err error) *MockRepository_Reception_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockRepository_Reception_Call) RunAndReturn(run func(ctx context.Context, receptionId *uuid.UUID) (*domain.Reception, error)) *MockRepository_Reception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceptionStats provides a mock function for the type MockRepository
+func (_mock *MockRepository) ReceptionStats(ctx context.Context, params *domain.ReceptionStatsParams) ([]*domain.ReceptionStats, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceptionStats")
+	}
+
+	var r0 []*domain.ReceptionStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionStatsParams) ([]*domain.ReceptionStats, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionStatsParams) []*domain.ReceptionStats); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ReceptionStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ReceptionStatsParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ReceptionStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceptionStats'
+type MockRepository_ReceptionStats_Call struct {
+	*mock.Call
+}
+
+// ReceptionStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *domain.ReceptionStatsParams
+func (_e *MockRepository_Expecter) ReceptionStats(ctx interface{}, params interface{}) *MockRepository_ReceptionStats_Call {
+	return &MockRepository_ReceptionStats_Call{Call: _e.mock.On("ReceptionStats", ctx, params)}
+}
+
+func (_c *MockRepository_ReceptionStats_Call) Run(run func(ctx context.Context, params *domain.ReceptionStatsParams)) *MockRepository_ReceptionStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ReceptionStatsParams
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ReceptionStatsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReceptionStats_Call) Return(receptionStatss []*domain.ReceptionStats, err error) *MockRepository_ReceptionStats_Call {
+	_c.Call.Return(receptionStatss, err)
+	return _c
+}
+
+func (_c *MockRepository_ReceptionStats_Call) RunAndReturn(run func(ctx context.Context, params *domain.ReceptionStatsParams) ([]*domain.ReceptionStats, error)) *MockRepository_ReceptionStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshDailyStats provides a mock function for the type MockRepository
+func (_mock *MockRepository) RefreshDailyStats(ctx context.Context, from time.Time, to time.Time) error {
+	ret := _mock.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshDailyStats")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) error); ok {
+		r0 = returnFunc(ctx, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_RefreshDailyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshDailyStats'
+type MockRepository_RefreshDailyStats_Call struct {
+	*mock.Call
+}
+
+// RefreshDailyStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *MockRepository_Expecter) RefreshDailyStats(ctx interface{}, from interface{}, to interface{}) *MockRepository_RefreshDailyStats_Call {
+	return &MockRepository_RefreshDailyStats_Call{Call: _e.mock.On("RefreshDailyStats", ctx, from, to)}
+}
+
+func (_c *MockRepository_RefreshDailyStats_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *MockRepository_RefreshDailyStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_RefreshDailyStats_Call) Return(err error) *MockRepository_RefreshDailyStats_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_RefreshDailyStats_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time) error) *MockRepository_RefreshDailyStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenReception provides a mock function for the type MockRepository
+func (_mock *MockRepository) ReopenReception(ctx context.Context, receptionId *uuid.UUID, closedAfter time.Time, ifVersion *int) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, receptionId, closedAfter, ifVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReopenReception")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, time.Time, *int) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, receptionId, closedAfter, ifVersion)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, time.Time, *int) *domain.Reception); ok {
+		r0 = returnFunc(ctx, receptionId, closedAfter, ifVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, time.Time, *int) error); ok {
+		r1 = returnFunc(ctx, receptionId, closedAfter, ifVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ReopenReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenReception'
+type MockRepository_ReopenReception_Call struct {
+	*mock.Call
+}
+
+// ReopenReception is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receptionId *uuid.UUID
+//   - closedAfter time.Time
+//   - ifVersion *int
+func (_e *MockRepository_Expecter) ReopenReception(ctx interface{}, receptionId interface{}, closedAfter interface{}, ifVersion interface{}) *MockRepository_ReopenReception_Call {
+	return &MockRepository_ReopenReception_Call{Call: _e.mock.On("ReopenReception", ctx, receptionId, closedAfter, ifVersion)}
+}
+
+func (_c *MockRepository_ReopenReception_Call) Run(run func(ctx context.Context, receptionId *uuid.UUID, closedAfter time.Time, ifVersion *int)) *MockRepository_ReopenReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 *int
+		if args[3] != nil {
+			arg3 = args[3].(*int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReopenReception_Call) Return(reception *domain.Reception, err error) *MockRepository_ReopenReception_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockRepository_ReopenReception_Call) RunAndReturn(run func(ctx context.Context, receptionId *uuid.UUID, closedAfter time.Time, ifVersion *int) (*domain.Reception, error)) *MockRepository_ReopenReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveIdempotencyKey provides a mock function for the type MockRepository
+func (_mock *MockRepository) ReserveIdempotencyKey(ctx context.Context, rec *domain.IdempotencyRecord, staleBefore time.Time) (*domain.IdempotencyRecord, error) {
+	ret := _mock.Called(ctx, rec, staleBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveIdempotencyKey")
+	}
+
+	var r0 *domain.IdempotencyRecord
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotencyRecord, time.Time) (*domain.IdempotencyRecord, error)); ok {
+		return returnFunc(ctx, rec, staleBefore)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotencyRecord, time.Time) *domain.IdempotencyRecord); ok {
+		r0 = returnFunc(ctx, rec, staleBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.IdempotencyRecord)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.IdempotencyRecord, time.Time) error); ok {
+		r1 = returnFunc(ctx, rec, staleBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ReserveIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveIdempotencyKey'
+type MockRepository_ReserveIdempotencyKey_Call struct {
+	*mock.Call
+}
+
+// ReserveIdempotencyKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rec *domain.IdempotencyRecord
+//   - staleBefore time.Time
+func (_e *MockRepository_Expecter) ReserveIdempotencyKey(ctx interface{}, rec interface{}, staleBefore interface{}) *MockRepository_ReserveIdempotencyKey_Call {
+	return &MockRepository_ReserveIdempotencyKey_Call{Call: _e.mock.On("ReserveIdempotencyKey", ctx, rec, staleBefore)}
+}
+
+func (_c *MockRepository_ReserveIdempotencyKey_Call) Run(run func(ctx context.Context, rec *domain.IdempotencyRecord, staleBefore time.Time)) *MockRepository_ReserveIdempotencyKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.IdempotencyRecord
+		if args[1] != nil {
+			arg1 = args[1].(*domain.IdempotencyRecord)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReserveIdempotencyKey_Call) Return(idempotencyRecord *domain.IdempotencyRecord, err error) *MockRepository_ReserveIdempotencyKey_Call {
+	_c.Call.Return(idempotencyRecord, err)
+	return _c
+}
+
+func (_c *MockRepository_ReserveIdempotencyKey_Call) RunAndReturn(run func(ctx context.Context, rec *domain.IdempotencyRecord, staleBefore time.Time) (*domain.IdempotencyRecord, error)) *MockRepository_ReserveIdempotencyKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreProduct provides a mock function for the type MockRepository
+func (_mock *MockRepository) RestoreProduct(ctx context.Context, productId *uuid.UUID, capacity int) (*domain.Product, error) {
+	ret := _mock.Called(ctx, productId, capacity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreProduct")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) (*domain.Product, error)); ok {
+		return returnFunc(ctx, productId, capacity)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) *domain.Product); ok {
+		r0 = returnFunc(ctx, productId, capacity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, productId, capacity)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_RestoreProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreProduct'
+type MockRepository_RestoreProduct_Call struct {
+	*mock.Call
+}
+
+// RestoreProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+//   - capacity int
+func (_e *MockRepository_Expecter) RestoreProduct(ctx interface{}, productId interface{}, capacity interface{}) *MockRepository_RestoreProduct_Call {
+	return &MockRepository_RestoreProduct_Call{Call: _e.mock.On("RestoreProduct", ctx, productId, capacity)}
+}
+
+func (_c *MockRepository_RestoreProduct_Call) Run(run func(ctx context.Context, productId *uuid.UUID, capacity int)) *MockRepository_RestoreProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_RestoreProduct_Call) Return(product *domain.Product, err error) *MockRepository_RestoreProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockRepository_RestoreProduct_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID, capacity int) (*domain.Product, error)) *MockRepository_RestoreProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveIdempotentResponse provides a mock function for the type MockRepository
+func (_mock *MockRepository) SaveIdempotentResponse(ctx context.Context, req *domain.IdempotentRequest, resp *domain.IdempotentResponse) error {
+	ret := _mock.Called(ctx, req, resp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveIdempotentResponse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotentRequest, *domain.IdempotentResponse) error); ok {
+		r0 = returnFunc(ctx, req, resp)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_SaveIdempotentResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveIdempotentResponse'
+type MockRepository_SaveIdempotentResponse_Call struct {
+	*mock.Call
+}
+
+// SaveIdempotentResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.IdempotentRequest
+//   - resp *domain.IdempotentResponse
+func (_e *MockRepository_Expecter) SaveIdempotentResponse(ctx interface{}, req interface{}, resp interface{}) *MockRepository_SaveIdempotentResponse_Call {
+	return &MockRepository_SaveIdempotentResponse_Call{Call: _e.mock.On("SaveIdempotentResponse", ctx, req, resp)}
+}
+
+func (_c *MockRepository_SaveIdempotentResponse_Call) Run(run func(ctx context.Context, req *domain.IdempotentRequest, resp *domain.IdempotentResponse)) *MockRepository_SaveIdempotentResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.IdempotentRequest
+		if args[1] != nil {
+			arg1 = args[1].(*domain.IdempotentRequest)
+		}
+		var arg2 *domain.IdempotentResponse
+		if args[2] != nil {
+			arg2 = args[2].(*domain.IdempotentResponse)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveIdempotentResponse_Call) Return(err error) *MockRepository_SaveIdempotentResponse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_SaveIdempotentResponse_Call) RunAndReturn(run func(ctx context.Context, req *domain.IdempotentRequest, resp *domain.IdempotentResponse) error) *MockRepository_SaveIdempotentResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveRefreshToken provides a mock function for the type MockRepository
+func (_mock *MockRepository) SaveRefreshToken(ctx context.Context, rToken *auth.RefreshToken) error {
+	ret := _mock.Called(ctx, rToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveRefreshToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RefreshToken) error); ok {
+		r0 = returnFunc(ctx, rToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_SaveRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRefreshToken'
+type MockRepository_SaveRefreshToken_Call struct {
+	*mock.Call
+}
+
+// SaveRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rToken *auth.RefreshToken
+func (_e *MockRepository_Expecter) SaveRefreshToken(ctx interface{}, rToken interface{}) *MockRepository_SaveRefreshToken_Call {
+	return &MockRepository_SaveRefreshToken_Call{Call: _e.mock.On("SaveRefreshToken", ctx, rToken)}
+}
+
+func (_c *MockRepository_SaveRefreshToken_Call) Run(run func(ctx context.Context, rToken *auth.RefreshToken)) *MockRepository_SaveRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.RefreshToken
+		if args[1] != nil {
+			arg1 = args[1].(*auth.RefreshToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveRefreshToken_Call) Return(err error) *MockRepository_SaveRefreshToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_SaveRefreshToken_Call) RunAndReturn(run func(ctx context.Context, rToken *auth.RefreshToken) error) *MockRepository_SaveRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetProductPickupCode provides a mock function for the type MockRepository
+func (_mock *MockRepository) SetProductPickupCode(ctx context.Context, productId *uuid.UUID, codeHash []byte, expiresAt *time.Time) error {
+	ret := _mock.Called(ctx, productId, codeHash, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetProductPickupCode")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, []byte, *time.Time) error); ok {
+		r0 = returnFunc(ctx, productId, codeHash, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_SetProductPickupCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProductPickupCode'
+type MockRepository_SetProductPickupCode_Call struct {
+	*mock.Call
+}
+
+// SetProductPickupCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+//   - codeHash []byte
+//   - expiresAt *time.Time
+func (_e *MockRepository_Expecter) SetProductPickupCode(ctx interface{}, productId interface{}, codeHash interface{}, expiresAt interface{}) *MockRepository_SetProductPickupCode_Call {
+	return &MockRepository_SetProductPickupCode_Call{Call: _e.mock.On("SetProductPickupCode", ctx, productId, codeHash, expiresAt)}
+}
+
+func (_c *MockRepository_SetProductPickupCode_Call) Run(run func(ctx context.Context, productId *uuid.UUID, codeHash []byte, expiresAt *time.Time)) *MockRepository_SetProductPickupCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		var arg3 *time.Time
+		if args[3] != nil {
+			arg3 = args[3].(*time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_SetProductPickupCode_Call) Return(err error) *MockRepository_SetProductPickupCode_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_SetProductPickupCode_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID, codeHash []byte, expiresAt *time.Time) error) *MockRepository_SetProductPickupCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetReceptionManifest provides a mock function for the type MockRepository
+func (_mock *MockRepository) SetReceptionManifest(ctx context.Context, manifest *domain.ReceptionManifest) error {
+	ret := _mock.Called(ctx, manifest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetReceptionManifest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionManifest) error); ok {
+		r0 = returnFunc(ctx, manifest)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_SetReceptionManifest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetReceptionManifest'
+type MockRepository_SetReceptionManifest_Call struct {
+	*mock.Call
+}
+
+// SetReceptionManifest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - manifest *domain.ReceptionManifest
+func (_e *MockRepository_Expecter) SetReceptionManifest(ctx interface{}, manifest interface{}) *MockRepository_SetReceptionManifest_Call {
+	return &MockRepository_SetReceptionManifest_Call{Call: _e.mock.On("SetReceptionManifest", ctx, manifest)}
+}
+
+func (_c *MockRepository_SetReceptionManifest_Call) Run(run func(ctx context.Context, manifest *domain.ReceptionManifest)) *MockRepository_SetReceptionManifest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ReceptionManifest
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ReceptionManifest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_SetReceptionManifest_Call) Return(err error) *MockRepository_SetReceptionManifest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_SetReceptionManifest_Call) RunAndReturn(run func(ctx context.Context, manifest *domain.ReceptionManifest) error) *MockRepository_SetReceptionManifest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamPvzsData provides a mock function for the type MockRepository
+func (_mock *MockRepository) StreamPvzsData(ctx context.Context, params *domain.PvzsReadParams, yield func(*domain.PvzReceptions) error) (*domain.PvzsPage, error) {
+	ret := _mock.Called(ctx, params, yield)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPvzsData")
+	}
+
+	var r0 *domain.PvzsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams, func(*domain.PvzReceptions) error) (*domain.PvzsPage, error)); ok {
+		return returnFunc(ctx, params, yield)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams, func(*domain.PvzReceptions) error) *domain.PvzsPage); ok {
+		r0 = returnFunc(ctx, params, yield)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PvzsPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PvzsReadParams, func(*domain.PvzReceptions) error) error); ok {
+		r1 = returnFunc(ctx, params, yield)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_StreamPvzsData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamPvzsData'
+type MockRepository_StreamPvzsData_Call struct {
+	*mock.Call
+}
+
+// StreamPvzsData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *domain.PvzsReadParams
+//   - yield func(*domain.PvzReceptions) error
+func (_e *MockRepository_Expecter) StreamPvzsData(ctx interface{}, params interface{}, yield interface{}) *MockRepository_StreamPvzsData_Call {
+	return &MockRepository_StreamPvzsData_Call{Call: _e.mock.On("StreamPvzsData", ctx, params, yield)}
+}
+
+func (_c *MockRepository_StreamPvzsData_Call) Run(run func(ctx context.Context, params *domain.PvzsReadParams, yield func(*domain.PvzReceptions) error)) *MockRepository_StreamPvzsData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PvzsReadParams
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PvzsReadParams)
+		}
+		var arg2 func(*domain.PvzReceptions) error
+		if args[2] != nil {
+			arg2 = args[2].(func(*domain.PvzReceptions) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_StreamPvzsData_Call) Return(pvzsPage *domain.PvzsPage, err error) *MockRepository_StreamPvzsData_Call {
+	_c.Call.Return(pvzsPage, err)
+	return _c
+}
+
+func (_c *MockRepository_StreamPvzsData_Call) RunAndReturn(run func(ctx context.Context, params *domain.PvzsReadParams, yield func(*domain.PvzReceptions) error) (*domain.PvzsPage, error)) *MockRepository_StreamPvzsData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TakePickupCodeAttempt provides a mock function for the type MockRepository
+func (_mock *MockRepository) TakePickupCodeAttempt(ctx context.Context, productId *uuid.UUID, maxAttempts int) ([]byte, error) {
+	ret := _mock.Called(ctx, productId, maxAttempts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TakePickupCodeAttempt")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) ([]byte, error)); ok {
+		return returnFunc(ctx, productId, maxAttempts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) []byte); ok {
+		r0 = returnFunc(ctx, productId, maxAttempts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, productId, maxAttempts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_TakePickupCodeAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakePickupCodeAttempt'
+type MockRepository_TakePickupCodeAttempt_Call struct {
+	*mock.Call
+}
+
+// TakePickupCodeAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+//   - maxAttempts int
+func (_e *MockRepository_Expecter) TakePickupCodeAttempt(ctx interface{}, productId interface{}, maxAttempts interface{}) *MockRepository_TakePickupCodeAttempt_Call {
+	return &MockRepository_TakePickupCodeAttempt_Call{Call: _e.mock.On("TakePickupCodeAttempt", ctx, productId, maxAttempts)}
+}
+
+func (_c *MockRepository_TakePickupCodeAttempt_Call) Run(run func(ctx context.Context, productId *uuid.UUID, maxAttempts int)) *MockRepository_TakePickupCodeAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_TakePickupCodeAttempt_Call) Return(bytes []byte, err error) *MockRepository_TakePickupCodeAttempt_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockRepository_TakePickupCodeAttempt_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID, maxAttempts int) ([]byte, error)) *MockRepository_TakePickupCodeAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProductStatus provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateProductStatus(ctx context.Context, upd *domain.ProductStatusUpdate) (*domain.Product, error) {
+	ret := _mock.Called(ctx, upd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProductStatus")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductStatusUpdate) (*domain.Product, error)); ok {
+		return returnFunc(ctx, upd)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductStatusUpdate) *domain.Product); ok {
+		r0 = returnFunc(ctx, upd)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ProductStatusUpdate) error); ok {
+		r1 = returnFunc(ctx, upd)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_UpdateProductStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProductStatus'
+type MockRepository_UpdateProductStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateProductStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - upd *domain.ProductStatusUpdate
+func (_e *MockRepository_Expecter) UpdateProductStatus(ctx interface{}, upd interface{}) *MockRepository_UpdateProductStatus_Call {
+	return &MockRepository_UpdateProductStatus_Call{Call: _e.mock.On("UpdateProductStatus", ctx, upd)}
+}
+
+func (_c *MockRepository_UpdateProductStatus_Call) Run(run func(ctx context.Context, upd *domain.ProductStatusUpdate)) *MockRepository_UpdateProductStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ProductStatusUpdate
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ProductStatusUpdate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateProductStatus_Call) Return(product *domain.Product, err error) *MockRepository_UpdateProductStatus_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockRepository_UpdateProductStatus_Call) RunAndReturn(run func(ctx context.Context, upd *domain.ProductStatusUpdate) (*domain.Product, error)) *MockRepository_UpdateProductStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePvz provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdatePvz(ctx context.Context, pvzId *uuid.UUID, city domain.PVZCity, ifVersion *int) (*domain.Pvz, error) {
+	ret := _mock.Called(ctx, pvzId, city, ifVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePvz")
+	}
+
+	var r0 *domain.Pvz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, domain.PVZCity, *int) (*domain.Pvz, error)); ok {
+		return returnFunc(ctx, pvzId, city, ifVersion)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, domain.PVZCity, *int) *domain.Pvz); ok {
+		r0 = returnFunc(ctx, pvzId, city, ifVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Pvz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, domain.PVZCity, *int) error); ok {
+		r1 = returnFunc(ctx, pvzId, city, ifVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_UpdatePvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePvz'
+type MockRepository_UpdatePvz_Call struct {
+	*mock.Call
+}
+
+// UpdatePvz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+//   - city domain.PVZCity
+//   - ifVersion *int
+func (_e *MockRepository_Expecter) UpdatePvz(ctx interface{}, pvzId interface{}, city interface{}, ifVersion interface{}) *MockRepository_UpdatePvz_Call {
+	return &MockRepository_UpdatePvz_Call{Call: _e.mock.On("UpdatePvz", ctx, pvzId, city, ifVersion)}
+}
+
+func (_c *MockRepository_UpdatePvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, city domain.PVZCity, ifVersion *int)) *MockRepository_UpdatePvz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 domain.PVZCity
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZCity)
+		}
+		var arg3 *int
+		if args[3] != nil {
+			arg3 = args[3].(*int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdatePvz_Call) Return(pvz *domain.Pvz, err error) *MockRepository_UpdatePvz_Call {
+	_c.Call.Return(pvz, err)
+	return _c
+}
+
+func (_c *MockRepository_UpdatePvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, city domain.PVZCity, ifVersion *int) (*domain.Pvz, error)) *MockRepository_UpdatePvz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserRefreshToken provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateUserRefreshToken(ctx context.Context, usedHash []byte, newToken *auth.RefreshToken) error {
+	ret := _mock.Called(ctx, usedHash, newToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserRefreshToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte, *auth.RefreshToken) error); ok {
+		r0 = returnFunc(ctx, usedHash, newToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_UpdateUserRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserRefreshToken'
+type MockRepository_UpdateUserRefreshToken_Call struct {
+	*mock.Call
+}
+
+// UpdateUserRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - usedHash []byte
+//   - newToken *auth.RefreshToken
+func (_e *MockRepository_Expecter) UpdateUserRefreshToken(ctx interface{}, usedHash interface{}, newToken interface{}) *MockRepository_UpdateUserRefreshToken_Call {
+	return &MockRepository_UpdateUserRefreshToken_Call{Call: _e.mock.On("UpdateUserRefreshToken", ctx, usedHash, newToken)}
+}
+
+func (_c *MockRepository_UpdateUserRefreshToken_Call) Run(run func(ctx context.Context, usedHash []byte, newToken *auth.RefreshToken)) *MockRepository_UpdateUserRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []byte
+		if args[1] != nil {
+			arg1 = args[1].([]byte)
+		}
+		var arg2 *auth.RefreshToken
+		if args[2] != nil {
+			arg2 = args[2].(*auth.RefreshToken)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateUserRefreshToken_Call) Return(err error) *MockRepository_UpdateUserRefreshToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_UpdateUserRefreshToken_Call) RunAndReturn(run func(ctx context.Context, usedHash []byte, newToken *auth.RefreshToken) error) *MockRepository_UpdateUserRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserByEmail provides a mock function for the type MockRepository
+func (_mock *MockRepository) UserByEmail(ctx context.Context, email string) (*auth.User, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserByEmail")
+	}
+
+	var r0 *auth.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*auth.User, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *auth.User); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_UserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserByEmail'
+type MockRepository_UserByEmail_Call struct {
+	*mock.Call
+}
+
+// UserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockRepository_Expecter) UserByEmail(ctx interface{}, email interface{}) *MockRepository_UserByEmail_Call {
+	return &MockRepository_UserByEmail_Call{Call: _e.mock.On("UserByEmail", ctx, email)}
+}
+
+func (_c *MockRepository_UserByEmail_Call) Run(run func(ctx context.Context, email string)) *MockRepository_UserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_UserByEmail_Call) Return(user *auth.User, err error) *MockRepository_UserByEmail_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockRepository_UserByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*auth.User, error)) *MockRepository_UserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserRoleAndRefreshToken provides a mock function for the type MockRepository
+func (_mock *MockRepository) UserRoleAndRefreshToken(ctx context.Context, tokenHash []byte) (*auth.UserRoleAndRToken, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserRoleAndRefreshToken")
+	}
+
+	var r0 *auth.UserRoleAndRToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte) (*auth.UserRoleAndRToken, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte) *auth.UserRoleAndRToken); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.UserRoleAndRToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_UserRoleAndRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserRoleAndRefreshToken'
+type MockRepository_UserRoleAndRefreshToken_Call struct {
+	*mock.Call
+}
+
+// UserRoleAndRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash []byte
+func (_e *MockRepository_Expecter) UserRoleAndRefreshToken(ctx interface{}, tokenHash interface{}) *MockRepository_UserRoleAndRefreshToken_Call {
+	return &MockRepository_UserRoleAndRefreshToken_Call{Call: _e.mock.On("UserRoleAndRefreshToken", ctx, tokenHash)}
+}
+
+func (_c *MockRepository_UserRoleAndRefreshToken_Call) Run(run func(ctx context.Context, tokenHash []byte)) *MockRepository_UserRoleAndRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []byte
+		if args[1] != nil {
+			arg1 = args[1].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_UserRoleAndRefreshToken_Call) Return(userRoleAndRToken *auth.UserRoleAndRToken, err error) *MockRepository_UserRoleAndRefreshToken_Call {
+	_c.Call.Return(userRoleAndRToken, err)
+	return _c
+}
+
+func (_c *MockRepository_UserRoleAndRefreshToken_Call) RunAndReturn(run func(ctx context.Context, tokenHash []byte) (*auth.UserRoleAndRToken, error)) *MockRepository_UserRoleAndRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPvzsRepo creates a new instance of MockPvzsRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPvzsRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPvzsRepo {
+	mock := &MockPvzsRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPvzsRepo is an autogenerated mock type for the PvzsRepo type
+type MockPvzsRepo struct {
+	mock.Mock
+}
+
+type MockPvzsRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPvzsRepo) EXPECT() *MockPvzsRepo_Expecter {
+	return &MockPvzsRepo_Expecter{mock: &_m.Mock}
+}
+
+// AllPvzsStock provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) AllPvzsStock(ctx context.Context) ([]*domain.PvzStock, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllPvzsStock")
+	}
+
+	var r0 []*domain.PvzStock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.PvzStock, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.PvzStock); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.PvzStock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_AllPvzsStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllPvzsStock'
+type MockPvzsRepo_AllPvzsStock_Call struct {
+	*mock.Call
+}
+
+// AllPvzsStock is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPvzsRepo_Expecter) AllPvzsStock(ctx interface{}) *MockPvzsRepo_AllPvzsStock_Call {
+	return &MockPvzsRepo_AllPvzsStock_Call{Call: _e.mock.On("AllPvzsStock", ctx)}
+}
+
+func (_c *MockPvzsRepo_AllPvzsStock_Call) Run(run func(ctx context.Context)) *MockPvzsRepo_AllPvzsStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_AllPvzsStock_Call) Return(pvzStocks []*domain.PvzStock, err error) *MockPvzsRepo_AllPvzsStock_Call {
+	_c.Call.Return(pvzStocks, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_AllPvzsStock_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.PvzStock, error)) *MockPvzsRepo_AllPvzsStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AutoCloseStaleReceptions provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) AutoCloseStaleReceptions(ctx context.Context, idleBefore time.Time) ([]*domain.Reception, error) {
+	ret := _mock.Called(ctx, idleBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AutoCloseStaleReceptions")
+	}
+
+	var r0 []*domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]*domain.Reception, error)); ok {
+		return returnFunc(ctx, idleBefore)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []*domain.Reception); ok {
+		r0 = returnFunc(ctx, idleBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, idleBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_AutoCloseStaleReceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoCloseStaleReceptions'
+type MockPvzsRepo_AutoCloseStaleReceptions_Call struct {
+	*mock.Call
+}
+
+// AutoCloseStaleReceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - idleBefore time.Time
+func (_e *MockPvzsRepo_Expecter) AutoCloseStaleReceptions(ctx interface{}, idleBefore interface{}) *MockPvzsRepo_AutoCloseStaleReceptions_Call {
+	return &MockPvzsRepo_AutoCloseStaleReceptions_Call{Call: _e.mock.On("AutoCloseStaleReceptions", ctx, idleBefore)}
+}
+
+func (_c *MockPvzsRepo_AutoCloseStaleReceptions_Call) Run(run func(ctx context.Context, idleBefore time.Time)) *MockPvzsRepo_AutoCloseStaleReceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_AutoCloseStaleReceptions_Call) Return(receptions []*domain.Reception, err error) *MockPvzsRepo_AutoCloseStaleReceptions_Call {
+	_c.Call.Return(receptions, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_AutoCloseStaleReceptions_Call) RunAndReturn(run func(ctx context.Context, idleBefore time.Time) ([]*domain.Reception, error)) *MockPvzsRepo_AutoCloseStaleReceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelReceptionInPvz provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) CancelReceptionInPvz(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, pvzId, ifVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelReceptionInPvz")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, pvzId, ifVersion)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) *domain.Reception); ok {
+		r0 = returnFunc(ctx, pvzId, ifVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *int) error); ok {
+		r1 = returnFunc(ctx, pvzId, ifVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_CancelReceptionInPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelReceptionInPvz'
+type MockPvzsRepo_CancelReceptionInPvz_Call struct {
+	*mock.Call
+}
+
+// CancelReceptionInPvz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+//   - ifVersion *int
+func (_e *MockPvzsRepo_Expecter) CancelReceptionInPvz(ctx interface{}, pvzId interface{}, ifVersion interface{}) *MockPvzsRepo_CancelReceptionInPvz_Call {
+	return &MockPvzsRepo_CancelReceptionInPvz_Call{Call: _e.mock.On("CancelReceptionInPvz", ctx, pvzId, ifVersion)}
+}
+
+func (_c *MockPvzsRepo_CancelReceptionInPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int)) *MockPvzsRepo_CancelReceptionInPvz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *int
+		if args[2] != nil {
+			arg2 = args[2].(*int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_CancelReceptionInPvz_Call) Return(reception *domain.Reception, err error) *MockPvzsRepo_CancelReceptionInPvz_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_CancelReceptionInPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.Reception, error)) *MockPvzsRepo_CancelReceptionInPvz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloseReceptionInPvz provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) CloseReceptionInPvz(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.ReceptionCloseReport, error) {
+	ret := _mock.Called(ctx, pvzId, ifVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseReceptionInPvz")
+	}
+
+	var r0 *domain.ReceptionCloseReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) (*domain.ReceptionCloseReport, error)); ok {
+		return returnFunc(ctx, pvzId, ifVersion)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) *domain.ReceptionCloseReport); ok {
+		r0 = returnFunc(ctx, pvzId, ifVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ReceptionCloseReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *int) error); ok {
+		r1 = returnFunc(ctx, pvzId, ifVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_CloseReceptionInPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseReceptionInPvz'
+type MockPvzsRepo_CloseReceptionInPvz_Call struct {
+	*mock.Call
+}
+
+// CloseReceptionInPvz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+//   - ifVersion *int
+func (_e *MockPvzsRepo_Expecter) CloseReceptionInPvz(ctx interface{}, pvzId interface{}, ifVersion interface{}) *MockPvzsRepo_CloseReceptionInPvz_Call {
+	return &MockPvzsRepo_CloseReceptionInPvz_Call{Call: _e.mock.On("CloseReceptionInPvz", ctx, pvzId, ifVersion)}
+}
+
+func (_c *MockPvzsRepo_CloseReceptionInPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int)) *MockPvzsRepo_CloseReceptionInPvz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *int
+		if args[2] != nil {
+			arg2 = args[2].(*int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_CloseReceptionInPvz_Call) Return(receptionCloseReport *domain.ReceptionCloseReport, err error) *MockPvzsRepo_CloseReceptionInPvz_Call {
+	_c.Call.Return(receptionCloseReport, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_CloseReceptionInPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.ReceptionCloseReport, error)) *MockPvzsRepo_CloseReceptionInPvz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountReceptions provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) CountReceptions(ctx context.Context, status domain.ReceptionStatus) (int, error) {
+	ret := _mock.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountReceptions")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ReceptionStatus) (int, error)); ok {
+		return returnFunc(ctx, status)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ReceptionStatus) int); ok {
+		r0 = returnFunc(ctx, status)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ReceptionStatus) error); ok {
+		r1 = returnFunc(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_CountReceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountReceptions'
+type MockPvzsRepo_CountReceptions_Call struct {
+	*mock.Call
+}
+
+// CountReceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status domain.ReceptionStatus
+func (_e *MockPvzsRepo_Expecter) CountReceptions(ctx interface{}, status interface{}) *MockPvzsRepo_CountReceptions_Call {
+	return &MockPvzsRepo_CountReceptions_Call{Call: _e.mock.On("CountReceptions", ctx, status)}
+}
+
+func (_c *MockPvzsRepo_CountReceptions_Call) Run(run func(ctx context.Context, status domain.ReceptionStatus)) *MockPvzsRepo_CountReceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ReceptionStatus
+		if args[1] != nil {
+			arg1 = args[1].(domain.ReceptionStatus)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_CountReceptions_Call) Return(n int, err error) *MockPvzsRepo_CountReceptions_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_CountReceptions_Call) RunAndReturn(run func(ctx context.Context, status domain.ReceptionStatus) (int, error)) *MockPvzsRepo_CountReceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePVZ provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) CreatePVZ(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error) {
+	ret := _mock.Called(ctx, pvz)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePVZ")
+	}
+
+	var r0 *domain.Pvz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) (*domain.Pvz, error)); ok {
+		return returnFunc(ctx, pvz)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) *domain.Pvz); ok {
+		r0 = returnFunc(ctx, pvz)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Pvz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Pvz) error); ok {
+		r1 = returnFunc(ctx, pvz)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_CreatePVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePVZ'
+type MockPvzsRepo_CreatePVZ_Call struct {
+	*mock.Call
+}
+
+// CreatePVZ is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvz *domain.Pvz
+func (_e *MockPvzsRepo_Expecter) CreatePVZ(ctx interface{}, pvz interface{}) *MockPvzsRepo_CreatePVZ_Call {
+	return &MockPvzsRepo_CreatePVZ_Call{Call: _e.mock.On("CreatePVZ", ctx, pvz)}
+}
+
+func (_c *MockPvzsRepo_CreatePVZ_Call) Run(run func(ctx context.Context, pvz *domain.Pvz)) *MockPvzsRepo_CreatePVZ_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Pvz
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Pvz)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_CreatePVZ_Call) Return(pvz1 *domain.Pvz, err error) *MockPvzsRepo_CreatePVZ_Call {
+	_c.Call.Return(pvz1, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_CreatePVZ_Call) RunAndReturn(run func(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error)) *MockPvzsRepo_CreatePVZ_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateProduct provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) CreateProduct(ctx context.Context, prod *domain.Product, capacity int, uniqueBarcode bool) (*domain.Product, error) {
+	ret := _mock.Called(ctx, prod, capacity, uniqueBarcode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProduct")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product, int, bool) (*domain.Product, error)); ok {
+		return returnFunc(ctx, prod, capacity, uniqueBarcode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product, int, bool) *domain.Product); ok {
+		r0 = returnFunc(ctx, prod, capacity, uniqueBarcode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Product, int, bool) error); ok {
+		r1 = returnFunc(ctx, prod, capacity, uniqueBarcode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_CreateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProduct'
+type MockPvzsRepo_CreateProduct_Call struct {
+	*mock.Call
+}
+
+// CreateProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prod *domain.Product
+//   - capacity int
+//   - uniqueBarcode bool
+func (_e *MockPvzsRepo_Expecter) CreateProduct(ctx interface{}, prod interface{}, capacity interface{}, uniqueBarcode interface{}) *MockPvzsRepo_CreateProduct_Call {
+	return &MockPvzsRepo_CreateProduct_Call{Call: _e.mock.On("CreateProduct", ctx, prod, capacity, uniqueBarcode)}
+}
+
+func (_c *MockPvzsRepo_CreateProduct_Call) Run(run func(ctx context.Context, prod *domain.Product, capacity int, uniqueBarcode bool)) *MockPvzsRepo_CreateProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Product
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Product)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_CreateProduct_Call) Return(product *domain.Product, err error) *MockPvzsRepo_CreateProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_CreateProduct_Call) RunAndReturn(run func(ctx context.Context, prod *domain.Product, capacity int, uniqueBarcode bool) (*domain.Product, error)) *MockPvzsRepo_CreateProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateProductsBatch provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) CreateProductsBatch(ctx context.Context, batch *domain.ProductsBatch) (*domain.ProductsBatchResult, error) {
+	ret := _mock.Called(ctx, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProductsBatch")
+	}
+
+	var r0 *domain.ProductsBatchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductsBatch) (*domain.ProductsBatchResult, error)); ok {
+		return returnFunc(ctx, batch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductsBatch) *domain.ProductsBatchResult); ok {
+		r0 = returnFunc(ctx, batch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProductsBatchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ProductsBatch) error); ok {
+		r1 = returnFunc(ctx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_CreateProductsBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProductsBatch'
+type MockPvzsRepo_CreateProductsBatch_Call struct {
+	*mock.Call
+}
+
+// CreateProductsBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batch *domain.ProductsBatch
+func (_e *MockPvzsRepo_Expecter) CreateProductsBatch(ctx interface{}, batch interface{}) *MockPvzsRepo_CreateProductsBatch_Call {
+	return &MockPvzsRepo_CreateProductsBatch_Call{Call: _e.mock.On("CreateProductsBatch", ctx, batch)}
+}
+
+func (_c *MockPvzsRepo_CreateProductsBatch_Call) Run(run func(ctx context.Context, batch *domain.ProductsBatch)) *MockPvzsRepo_CreateProductsBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ProductsBatch
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ProductsBatch)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_CreateProductsBatch_Call) Return(productsBatchResult *domain.ProductsBatchResult, err error) *MockPvzsRepo_CreateProductsBatch_Call {
+	_c.Call.Return(productsBatchResult, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_CreateProductsBatch_Call) RunAndReturn(run func(ctx context.Context, batch *domain.ProductsBatch) (*domain.ProductsBatchResult, error)) *MockPvzsRepo_CreateProductsBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReception provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) CreateReception(ctx context.Context, rec *domain.Reception) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, rec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReception")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, rec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) *domain.Reception); ok {
+		r0 = returnFunc(ctx, rec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Reception) error); ok {
+		r1 = returnFunc(ctx, rec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_CreateReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReception'
+type MockPvzsRepo_CreateReception_Call struct {
+	*mock.Call
+}
+
+// CreateReception is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rec *domain.Reception
+func (_e *MockPvzsRepo_Expecter) CreateReception(ctx interface{}, rec interface{}) *MockPvzsRepo_CreateReception_Call {
+	return &MockPvzsRepo_CreateReception_Call{Call: _e.mock.On("CreateReception", ctx, rec)}
+}
+
+func (_c *MockPvzsRepo_CreateReception_Call) Run(run func(ctx context.Context, rec *domain.Reception)) *MockPvzsRepo_CreateReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Reception
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Reception)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_CreateReception_Call) Return(reception *domain.Reception, err error) *MockPvzsRepo_CreateReception_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_CreateReception_Call) RunAndReturn(run func(ctx context.Context, rec *domain.Reception) (*domain.Reception, error)) *MockPvzsRepo_CreateReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLastProduct provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) DeleteLastProduct(ctx context.Context, pvzId *uuid.UUID, actorId *uuid.UUID) error {
+	ret := _mock.Called(ctx, pvzId, actorId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLastProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, pvzId, actorId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPvzsRepo_DeleteLastProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLastProduct'
+type MockPvzsRepo_DeleteLastProduct_Call struct {
+	*mock.Call
+}
+
+// DeleteLastProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+//   - actorId *uuid.UUID
+func (_e *MockPvzsRepo_Expecter) DeleteLastProduct(ctx interface{}, pvzId interface{}, actorId interface{}) *MockPvzsRepo_DeleteLastProduct_Call {
+	return &MockPvzsRepo_DeleteLastProduct_Call{Call: _e.mock.On("DeleteLastProduct", ctx, pvzId, actorId)}
+}
+
+func (_c *MockPvzsRepo_DeleteLastProduct_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, actorId *uuid.UUID)) *MockPvzsRepo_DeleteLastProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_DeleteLastProduct_Call) Return(err error) *MockPvzsRepo_DeleteLastProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_DeleteLastProduct_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, actorId *uuid.UUID) error) *MockPvzsRepo_DeleteLastProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProduct provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) DeleteProduct(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID) error {
+	ret := _mock.Called(ctx, productId, actorId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, productId, actorId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPvzsRepo_DeleteProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProduct'
+type MockPvzsRepo_DeleteProduct_Call struct {
+	*mock.Call
+}
+
+// DeleteProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+//   - actorId *uuid.UUID
+func (_e *MockPvzsRepo_Expecter) DeleteProduct(ctx interface{}, productId interface{}, actorId interface{}) *MockPvzsRepo_DeleteProduct_Call {
+	return &MockPvzsRepo_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", ctx, productId, actorId)}
+}
+
+func (_c *MockPvzsRepo_DeleteProduct_Call) Run(run func(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID)) *MockPvzsRepo_DeleteProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_DeleteProduct_Call) Return(err error) *MockPvzsRepo_DeleteProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_DeleteProduct_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID) error) *MockPvzsRepo_DeleteProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllPvzs provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) GetAllPvzs(ctx context.Context, filter *domain.PvzsFilter, sort domain.PvzsSort) ([]*domain.Pvz, error) {
+	ret := _mock.Called(ctx, filter, sort)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPvzs")
+	}
+
+	var r0 []*domain.Pvz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsFilter, domain.PvzsSort) ([]*domain.Pvz, error)); ok {
+		return returnFunc(ctx, filter, sort)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsFilter, domain.PvzsSort) []*domain.Pvz); ok {
+		r0 = returnFunc(ctx, filter, sort)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Pvz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PvzsFilter, domain.PvzsSort) error); ok {
+		r1 = returnFunc(ctx, filter, sort)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_GetAllPvzs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllPvzs'
+type MockPvzsRepo_GetAllPvzs_Call struct {
+	*mock.Call
+}
+
+// GetAllPvzs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *domain.PvzsFilter
+//   - sort domain.PvzsSort
+func (_e *MockPvzsRepo_Expecter) GetAllPvzs(ctx interface{}, filter interface{}, sort interface{}) *MockPvzsRepo_GetAllPvzs_Call {
+	return &MockPvzsRepo_GetAllPvzs_Call{Call: _e.mock.On("GetAllPvzs", ctx, filter, sort)}
+}
+
+func (_c *MockPvzsRepo_GetAllPvzs_Call) Run(run func(ctx context.Context, filter *domain.PvzsFilter, sort domain.PvzsSort)) *MockPvzsRepo_GetAllPvzs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PvzsFilter
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PvzsFilter)
+		}
+		var arg2 domain.PvzsSort
+		if args[2] != nil {
+			arg2 = args[2].(domain.PvzsSort)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_GetAllPvzs_Call) Return(pvzs []*domain.Pvz, err error) *MockPvzsRepo_GetAllPvzs_Call {
+	_c.Call.Return(pvzs, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_GetAllPvzs_Call) RunAndReturn(run func(ctx context.Context, filter *domain.PvzsFilter, sort domain.PvzsSort) ([]*domain.Pvz, error)) *MockPvzsRepo_GetAllPvzs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPvzsData provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) (*domain.PvzsPage, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPvzsData")
+	}
+
+	var r0 *domain.PvzsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) (*domain.PvzsPage, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) *domain.PvzsPage); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PvzsPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PvzsReadParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_GetPvzsData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvzsData'
+type MockPvzsRepo_GetPvzsData_Call struct {
+	*mock.Call
+}
+
+// GetPvzsData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *domain.PvzsReadParams
+func (_e *MockPvzsRepo_Expecter) GetPvzsData(ctx interface{}, params interface{}) *MockPvzsRepo_GetPvzsData_Call {
+	return &MockPvzsRepo_GetPvzsData_Call{Call: _e.mock.On("GetPvzsData", ctx, params)}
+}
+
+func (_c *MockPvzsRepo_GetPvzsData_Call) Run(run func(ctx context.Context, params *domain.PvzsReadParams)) *MockPvzsRepo_GetPvzsData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PvzsReadParams
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PvzsReadParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_GetPvzsData_Call) Return(pvzsPage *domain.PvzsPage, err error) *MockPvzsRepo_GetPvzsData_Call {
+	_c.Call.Return(pvzsPage, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_GetPvzsData_Call) RunAndReturn(run func(ctx context.Context, params *domain.PvzsReadParams) (*domain.PvzsPage, error)) *MockPvzsRepo_GetPvzsData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductDeletions provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) ProductDeletions(ctx context.Context, receptionId *uuid.UUID) ([]*domain.ProductDeletion, error) {
+	ret := _mock.Called(ctx, receptionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductDeletions")
+	}
+
+	var r0 []*domain.ProductDeletion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) ([]*domain.ProductDeletion, error)); ok {
+		return returnFunc(ctx, receptionId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) []*domain.ProductDeletion); ok {
+		r0 = returnFunc(ctx, receptionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductDeletion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, receptionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_ProductDeletions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductDeletions'
+type MockPvzsRepo_ProductDeletions_Call struct {
+	*mock.Call
+}
+
+// ProductDeletions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receptionId *uuid.UUID
+func (_e *MockPvzsRepo_Expecter) ProductDeletions(ctx interface{}, receptionId interface{}) *MockPvzsRepo_ProductDeletions_Call {
+	return &MockPvzsRepo_ProductDeletions_Call{Call: _e.mock.On("ProductDeletions", ctx, receptionId)}
+}
+
+func (_c *MockPvzsRepo_ProductDeletions_Call) Run(run func(ctx context.Context, receptionId *uuid.UUID)) *MockPvzsRepo_ProductDeletions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_ProductDeletions_Call) Return(productDeletions []*domain.ProductDeletion, err error) *MockPvzsRepo_ProductDeletions_Call {
+	_c.Call.Return(productDeletions, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_ProductDeletions_Call) RunAndReturn(run func(ctx context.Context, receptionId *uuid.UUID) ([]*domain.ProductDeletion, error)) *MockPvzsRepo_ProductDeletions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductStatusHistory provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) ProductStatusHistory(ctx context.Context, productId *uuid.UUID) ([]*domain.ProductStatusChange, error) {
+	ret := _mock.Called(ctx, productId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductStatusHistory")
+	}
+
+	var r0 []*domain.ProductStatusChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) ([]*domain.ProductStatusChange, error)); ok {
+		return returnFunc(ctx, productId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) []*domain.ProductStatusChange); ok {
+		r0 = returnFunc(ctx, productId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductStatusChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_ProductStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductStatusHistory'
+type MockPvzsRepo_ProductStatusHistory_Call struct {
+	*mock.Call
+}
+
+// ProductStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+func (_e *MockPvzsRepo_Expecter) ProductStatusHistory(ctx interface{}, productId interface{}) *MockPvzsRepo_ProductStatusHistory_Call {
+	return &MockPvzsRepo_ProductStatusHistory_Call{Call: _e.mock.On("ProductStatusHistory", ctx, productId)}
+}
+
+func (_c *MockPvzsRepo_ProductStatusHistory_Call) Run(run func(ctx context.Context, productId *uuid.UUID)) *MockPvzsRepo_ProductStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_ProductStatusHistory_Call) Return(productStatusChanges []*domain.ProductStatusChange, err error) *MockPvzsRepo_ProductStatusHistory_Call {
+	_c.Call.Return(productStatusChanges, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_ProductStatusHistory_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID) ([]*domain.ProductStatusChange, error)) *MockPvzsRepo_ProductStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductStorageState provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) ProductStorageState(ctx context.Context, productId *uuid.UUID) (*domain.ProductStorageState, error) {
+	ret := _mock.Called(ctx, productId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductStorageState")
+	}
+
+	var r0 *domain.ProductStorageState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.ProductStorageState, error)); ok {
+		return returnFunc(ctx, productId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.ProductStorageState); ok {
+		r0 = returnFunc(ctx, productId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProductStorageState)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_ProductStorageState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductStorageState'
+type MockPvzsRepo_ProductStorageState_Call struct {
+	*mock.Call
+}
+
+// ProductStorageState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+func (_e *MockPvzsRepo_Expecter) ProductStorageState(ctx interface{}, productId interface{}) *MockPvzsRepo_ProductStorageState_Call {
+	return &MockPvzsRepo_ProductStorageState_Call{Call: _e.mock.On("ProductStorageState", ctx, productId)}
+}
+
+func (_c *MockPvzsRepo_ProductStorageState_Call) Run(run func(ctx context.Context, productId *uuid.UUID)) *MockPvzsRepo_ProductStorageState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_ProductStorageState_Call) Return(productStorageState *domain.ProductStorageState, err error) *MockPvzsRepo_ProductStorageState_Call {
+	_c.Call.Return(productStorageState, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_ProductStorageState_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID) (*domain.ProductStorageState, error)) *MockPvzsRepo_ProductStorageState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductsByBarcode provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) ProductsByBarcode(ctx context.Context, barcode string) ([]*domain.ProductInfo, error) {
+	ret := _mock.Called(ctx, barcode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductsByBarcode")
+	}
+
+	var r0 []*domain.ProductInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.ProductInfo, error)); ok {
+		return returnFunc(ctx, barcode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.ProductInfo); ok {
+		r0 = returnFunc(ctx, barcode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductInfo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, barcode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_ProductsByBarcode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductsByBarcode'
+type MockPvzsRepo_ProductsByBarcode_Call struct {
+	*mock.Call
+}
+
+// ProductsByBarcode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - barcode string
+func (_e *MockPvzsRepo_Expecter) ProductsByBarcode(ctx interface{}, barcode interface{}) *MockPvzsRepo_ProductsByBarcode_Call {
+	return &MockPvzsRepo_ProductsByBarcode_Call{Call: _e.mock.On("ProductsByBarcode", ctx, barcode)}
+}
+
+func (_c *MockPvzsRepo_ProductsByBarcode_Call) Run(run func(ctx context.Context, barcode string)) *MockPvzsRepo_ProductsByBarcode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_ProductsByBarcode_Call) Return(productInfos []*domain.ProductInfo, err error) *MockPvzsRepo_ProductsByBarcode_Call {
+	_c.Call.Return(productInfos, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_ProductsByBarcode_Call) RunAndReturn(run func(ctx context.Context, barcode string) ([]*domain.ProductInfo, error)) *MockPvzsRepo_ProductsByBarcode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pvz provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) Pvz(ctx context.Context, pvzId *uuid.UUID) (*domain.Pvz, error) {
+	ret := _mock.Called(ctx, pvzId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pvz")
+	}
+
+	var r0 *domain.Pvz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.Pvz, error)); ok {
+		return returnFunc(ctx, pvzId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.Pvz); ok {
+		r0 = returnFunc(ctx, pvzId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Pvz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvzId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_Pvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pvz'
+type MockPvzsRepo_Pvz_Call struct {
+	*mock.Call
+}
+
+// Pvz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+func (_e *MockPvzsRepo_Expecter) Pvz(ctx interface{}, pvzId interface{}) *MockPvzsRepo_Pvz_Call {
+	return &MockPvzsRepo_Pvz_Call{Call: _e.mock.On("Pvz", ctx, pvzId)}
+}
+
+func (_c *MockPvzsRepo_Pvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockPvzsRepo_Pvz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_Pvz_Call) Return(pvz *domain.Pvz, err error) *MockPvzsRepo_Pvz_Call {
+	_c.Call.Return(pvz, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_Pvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) (*domain.Pvz, error)) *MockPvzsRepo_Pvz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PvzStock provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) PvzStock(ctx context.Context, pvzId *uuid.UUID) (*domain.PvzStock, error) {
+	ret := _mock.Called(ctx, pvzId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PvzStock")
+	}
+
+	var r0 *domain.PvzStock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.PvzStock, error)); ok {
+		return returnFunc(ctx, pvzId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.PvzStock); ok {
+		r0 = returnFunc(ctx, pvzId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PvzStock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvzId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_PvzStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PvzStock'
+type MockPvzsRepo_PvzStock_Call struct {
+	*mock.Call
+}
+
+// PvzStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+func (_e *MockPvzsRepo_Expecter) PvzStock(ctx interface{}, pvzId interface{}) *MockPvzsRepo_PvzStock_Call {
+	return &MockPvzsRepo_PvzStock_Call{Call: _e.mock.On("PvzStock", ctx, pvzId)}
+}
+
+func (_c *MockPvzsRepo_PvzStock_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockPvzsRepo_PvzStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_PvzStock_Call) Return(pvzStock *domain.PvzStock, err error) *MockPvzsRepo_PvzStock_Call {
+	_c.Call.Return(pvzStock, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_PvzStock_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) (*domain.PvzStock, error)) *MockPvzsRepo_PvzStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reception provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) Reception(ctx context.Context, receptionId *uuid.UUID) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, receptionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reception")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, receptionId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.Reception); ok {
+		r0 = returnFunc(ctx, receptionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, receptionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_Reception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reception'
+type MockPvzsRepo_Reception_Call struct {
+	*mock.Call
+}
+
+// Reception is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receptionId *uuid.UUID
+func (_e *MockPvzsRepo_Expecter) Reception(ctx interface{}, receptionId interface{}) *MockPvzsRepo_Reception_Call {
+	return &MockPvzsRepo_Reception_Call{Call: _e.mock.On("Reception", ctx, receptionId)}
+}
+
+func (_c *MockPvzsRepo_Reception_Call) Run(run func(ctx context.Context, receptionId *uuid.UUID)) *MockPvzsRepo_Reception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_Reception_Call) Return(reception *domain.Reception, err error) *MockPvzsRepo_Reception_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_Reception_Call) RunAndReturn(run func(ctx context.Context, receptionId *uuid.UUID) (*domain.Reception, error)) *MockPvzsRepo_Reception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenReception provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) ReopenReception(ctx context.Context, receptionId *uuid.UUID, closedAfter time.Time, ifVersion *int) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, receptionId, closedAfter, ifVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReopenReception")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, time.Time, *int) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, receptionId, closedAfter, ifVersion)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, time.Time, *int) *domain.Reception); ok {
+		r0 = returnFunc(ctx, receptionId, closedAfter, ifVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, time.Time, *int) error); ok {
+		r1 = returnFunc(ctx, receptionId, closedAfter, ifVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_ReopenReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenReception'
+type MockPvzsRepo_ReopenReception_Call struct {
+	*mock.Call
+}
+
+// ReopenReception is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receptionId *uuid.UUID
+//   - closedAfter time.Time
+//   - ifVersion *int
+func (_e *MockPvzsRepo_Expecter) ReopenReception(ctx interface{}, receptionId interface{}, closedAfter interface{}, ifVersion interface{}) *MockPvzsRepo_ReopenReception_Call {
+	return &MockPvzsRepo_ReopenReception_Call{Call: _e.mock.On("ReopenReception", ctx, receptionId, closedAfter, ifVersion)}
+}
+
+func (_c *MockPvzsRepo_ReopenReception_Call) Run(run func(ctx context.Context, receptionId *uuid.UUID, closedAfter time.Time, ifVersion *int)) *MockPvzsRepo_ReopenReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 *int
+		if args[3] != nil {
+			arg3 = args[3].(*int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_ReopenReception_Call) Return(reception *domain.Reception, err error) *MockPvzsRepo_ReopenReception_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_ReopenReception_Call) RunAndReturn(run func(ctx context.Context, receptionId *uuid.UUID, closedAfter time.Time, ifVersion *int) (*domain.Reception, error)) *MockPvzsRepo_ReopenReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreProduct provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) RestoreProduct(ctx context.Context, productId *uuid.UUID, capacity int) (*domain.Product, error) {
+	ret := _mock.Called(ctx, productId, capacity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreProduct")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) (*domain.Product, error)); ok {
+		return returnFunc(ctx, productId, capacity)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) *domain.Product); ok {
+		r0 = returnFunc(ctx, productId, capacity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, productId, capacity)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_RestoreProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreProduct'
+type MockPvzsRepo_RestoreProduct_Call struct {
+	*mock.Call
+}
+
+// RestoreProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+//   - capacity int
+func (_e *MockPvzsRepo_Expecter) RestoreProduct(ctx interface{}, productId interface{}, capacity interface{}) *MockPvzsRepo_RestoreProduct_Call {
+	return &MockPvzsRepo_RestoreProduct_Call{Call: _e.mock.On("RestoreProduct", ctx, productId, capacity)}
+}
+
+func (_c *MockPvzsRepo_RestoreProduct_Call) Run(run func(ctx context.Context, productId *uuid.UUID, capacity int)) *MockPvzsRepo_RestoreProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_RestoreProduct_Call) Return(product *domain.Product, err error) *MockPvzsRepo_RestoreProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_RestoreProduct_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID, capacity int) (*domain.Product, error)) *MockPvzsRepo_RestoreProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetProductPickupCode provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) SetProductPickupCode(ctx context.Context, productId *uuid.UUID, codeHash []byte, expiresAt *time.Time) error {
+	ret := _mock.Called(ctx, productId, codeHash, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetProductPickupCode")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, []byte, *time.Time) error); ok {
+		r0 = returnFunc(ctx, productId, codeHash, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPvzsRepo_SetProductPickupCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProductPickupCode'
+type MockPvzsRepo_SetProductPickupCode_Call struct {
+	*mock.Call
+}
+
+// SetProductPickupCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+//   - codeHash []byte
+//   - expiresAt *time.Time
+func (_e *MockPvzsRepo_Expecter) SetProductPickupCode(ctx interface{}, productId interface{}, codeHash interface{}, expiresAt interface{}) *MockPvzsRepo_SetProductPickupCode_Call {
+	return &MockPvzsRepo_SetProductPickupCode_Call{Call: _e.mock.On("SetProductPickupCode", ctx, productId, codeHash, expiresAt)}
+}
+
+func (_c *MockPvzsRepo_SetProductPickupCode_Call) Run(run func(ctx context.Context, productId *uuid.UUID, codeHash []byte, expiresAt *time.Time)) *MockPvzsRepo_SetProductPickupCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		var arg3 *time.Time
+		if args[3] != nil {
+			arg3 = args[3].(*time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_SetProductPickupCode_Call) Return(err error) *MockPvzsRepo_SetProductPickupCode_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_SetProductPickupCode_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID, codeHash []byte, expiresAt *time.Time) error) *MockPvzsRepo_SetProductPickupCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetReceptionManifest provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) SetReceptionManifest(ctx context.Context, manifest *domain.ReceptionManifest) error {
+	ret := _mock.Called(ctx, manifest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetReceptionManifest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionManifest) error); ok {
+		r0 = returnFunc(ctx, manifest)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPvzsRepo_SetReceptionManifest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetReceptionManifest'
+type MockPvzsRepo_SetReceptionManifest_Call struct {
+	*mock.Call
+}
+
+// SetReceptionManifest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - manifest *domain.ReceptionManifest
+func (_e *MockPvzsRepo_Expecter) SetReceptionManifest(ctx interface{}, manifest interface{}) *MockPvzsRepo_SetReceptionManifest_Call {
+	return &MockPvzsRepo_SetReceptionManifest_Call{Call: _e.mock.On("SetReceptionManifest", ctx, manifest)}
+}
+
+func (_c *MockPvzsRepo_SetReceptionManifest_Call) Run(run func(ctx context.Context, manifest *domain.ReceptionManifest)) *MockPvzsRepo_SetReceptionManifest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ReceptionManifest
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ReceptionManifest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_SetReceptionManifest_Call) Return(err error) *MockPvzsRepo_SetReceptionManifest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_SetReceptionManifest_Call) RunAndReturn(run func(ctx context.Context, manifest *domain.ReceptionManifest) error) *MockPvzsRepo_SetReceptionManifest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamPvzsData provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) StreamPvzsData(ctx context.Context, params *domain.PvzsReadParams, yield func(*domain.PvzReceptions) error) (*domain.PvzsPage, error) {
+	ret := _mock.Called(ctx, params, yield)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPvzsData")
+	}
+
+	var r0 *domain.PvzsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams, func(*domain.PvzReceptions) error) (*domain.PvzsPage, error)); ok {
+		return returnFunc(ctx, params, yield)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams, func(*domain.PvzReceptions) error) *domain.PvzsPage); ok {
+		r0 = returnFunc(ctx, params, yield)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PvzsPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PvzsReadParams, func(*domain.PvzReceptions) error) error); ok {
+		r1 = returnFunc(ctx, params, yield)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_StreamPvzsData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamPvzsData'
+type MockPvzsRepo_StreamPvzsData_Call struct {
+	*mock.Call
+}
+
+// StreamPvzsData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *domain.PvzsReadParams
+//   - yield func(*domain.PvzReceptions) error
+func (_e *MockPvzsRepo_Expecter) StreamPvzsData(ctx interface{}, params interface{}, yield interface{}) *MockPvzsRepo_StreamPvzsData_Call {
+	return &MockPvzsRepo_StreamPvzsData_Call{Call: _e.mock.On("StreamPvzsData", ctx, params, yield)}
+}
+
+func (_c *MockPvzsRepo_StreamPvzsData_Call) Run(run func(ctx context.Context, params *domain.PvzsReadParams, yield func(*domain.PvzReceptions) error)) *MockPvzsRepo_StreamPvzsData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PvzsReadParams
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PvzsReadParams)
+		}
+		var arg2 func(*domain.PvzReceptions) error
+		if args[2] != nil {
+			arg2 = args[2].(func(*domain.PvzReceptions) error)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_SaveRefreshToken_Call) Return(err error) *MockRepository_SaveRefreshToken_Call {
-	_c.Call.Return(err)
+func (_c *MockPvzsRepo_StreamPvzsData_Call) Return(pvzsPage *domain.PvzsPage, err error) *MockPvzsRepo_StreamPvzsData_Call {
+	_c.Call.Return(pvzsPage, err)
 	return _c
 }
 
-func (_c *MockRepository_SaveRefreshToken_Call) RunAndReturn(run func(ctx context.Context, rToken *auth.RefreshToken) error) *MockRepository_SaveRefreshToken_Call {
+func (_c *MockPvzsRepo_StreamPvzsData_Call) RunAndReturn(run func(ctx context.Context, params *domain.PvzsReadParams, yield func(*domain.PvzReceptions) error) (*domain.PvzsPage, error)) *MockPvzsRepo_StreamPvzsData_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUserRefreshToken provides a mock function for the type MockRepository
-func (_mock *MockRepository) UpdateUserRefreshToken(ctx context.Context, usedHash []byte, newToken *auth.RefreshToken) error {
-	ret := _mock.Called(ctx, usedHash, newToken)
+// TakePickupCodeAttempt provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) TakePickupCodeAttempt(ctx context.Context, productId *uuid.UUID, maxAttempts int) ([]byte, error) {
+	ret := _mock.Called(ctx, productId, maxAttempts)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUserRefreshToken")
+		panic("no return value specified for TakePickupCodeAttempt")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte, *auth.RefreshToken) error); ok {
-		r0 = returnFunc(ctx, usedHash, newToken)
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) ([]byte, error)); ok {
+		return returnFunc(ctx, productId, maxAttempts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, int) []byte); ok {
+		r0 = returnFunc(ctx, productId, maxAttempts)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, productId, maxAttempts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockRepository_UpdateUserRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserRefreshToken'
-type MockRepository_UpdateUserRefreshToken_Call struct {
+// MockPvzsRepo_TakePickupCodeAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakePickupCodeAttempt'
+type MockPvzsRepo_TakePickupCodeAttempt_Call struct {
 	*mock.Call
 }
 
-// UpdateUserRefreshToken is a helper method to define mock.On call
+// TakePickupCodeAttempt is a helper method to define mock.On call
 //   - ctx context.Context
-//   - usedHash []byte
-//   - newToken *auth.RefreshToken
-func (_e *MockRepository_Expecter) UpdateUserRefreshToken(ctx interface{}, usedHash interface{}, newToken interface{}) *MockRepository_UpdateUserRefreshToken_Call {
-	return &MockRepository_UpdateUserRefreshToken_Call{Call: _e.mock.On("UpdateUserRefreshToken", ctx, usedHash, newToken)}
+//   - productId *uuid.UUID
+//   - maxAttempts int
+func (_e *MockPvzsRepo_Expecter) TakePickupCodeAttempt(ctx interface{}, productId interface{}, maxAttempts interface{}) *MockPvzsRepo_TakePickupCodeAttempt_Call {
+	return &MockPvzsRepo_TakePickupCodeAttempt_Call{Call: _e.mock.On("TakePickupCodeAttempt", ctx, productId, maxAttempts)}
 }
 
-func (_c *MockRepository_UpdateUserRefreshToken_Call) Run(run func(ctx context.Context, usedHash []byte, newToken *auth.RefreshToken)) *MockRepository_UpdateUserRefreshToken_Call {
+func (_c *MockPvzsRepo_TakePickupCodeAttempt_Call) Run(run func(ctx context.Context, productId *uuid.UUID, maxAttempts int)) *MockPvzsRepo_TakePickupCodeAttempt_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 []byte
+		var arg1 *uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].([]byte)
+			arg1 = args[1].(*uuid.UUID)
 		}
-		var arg2 *auth.RefreshToken
+		var arg2 int
 		if args[2] != nil {
-			arg2 = args[2].(*auth.RefreshToken)
+			arg2 = args[2].(int)
 		}
 		run(
 			arg0,
@@ -666,65 +4790,302 @@ func (_c *MockRepository_UpdateUserRefreshToken_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *MockRepository_UpdateUserRefreshToken_Call) Return(err error) *MockRepository_UpdateUserRefreshToken_Call {
-	_c.Call.Return(err)
+func (_c *MockPvzsRepo_TakePickupCodeAttempt_Call) Return(bytes []byte, err error) *MockPvzsRepo_TakePickupCodeAttempt_Call {
+	_c.Call.Return(bytes, err)
 	return _c
 }
 
-func (_c *MockRepository_UpdateUserRefreshToken_Call) RunAndReturn(run func(ctx context.Context, usedHash []byte, newToken *auth.RefreshToken) error) *MockRepository_UpdateUserRefreshToken_Call {
+func (_c *MockPvzsRepo_TakePickupCodeAttempt_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID, maxAttempts int) ([]byte, error)) *MockPvzsRepo_TakePickupCodeAttempt_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UserByEmail provides a mock function for the type MockRepository
-func (_mock *MockRepository) UserByEmail(ctx context.Context, email string) (*auth.User, error) {
-	ret := _mock.Called(ctx, email)
+// UpdateProductStatus provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) UpdateProductStatus(ctx context.Context, upd *domain.ProductStatusUpdate) (*domain.Product, error) {
+	ret := _mock.Called(ctx, upd)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UserByEmail")
+		panic("no return value specified for UpdateProductStatus")
 	}
 
-	var r0 *auth.User
+	var r0 *domain.Product
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*auth.User, error)); ok {
-		return returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductStatusUpdate) (*domain.Product, error)); ok {
+		return returnFunc(ctx, upd)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *auth.User); ok {
-		r0 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductStatusUpdate) *domain.Product); ok {
+		r0 = returnFunc(ctx, upd)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*auth.User)
+			r0 = ret.Get(0).(*domain.Product)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ProductStatusUpdate) error); ok {
+		r1 = returnFunc(ctx, upd)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRepository_UserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserByEmail'
-type MockRepository_UserByEmail_Call struct {
+// MockPvzsRepo_UpdateProductStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProductStatus'
+type MockPvzsRepo_UpdateProductStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateProductStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - upd *domain.ProductStatusUpdate
+func (_e *MockPvzsRepo_Expecter) UpdateProductStatus(ctx interface{}, upd interface{}) *MockPvzsRepo_UpdateProductStatus_Call {
+	return &MockPvzsRepo_UpdateProductStatus_Call{Call: _e.mock.On("UpdateProductStatus", ctx, upd)}
+}
+
+func (_c *MockPvzsRepo_UpdateProductStatus_Call) Run(run func(ctx context.Context, upd *domain.ProductStatusUpdate)) *MockPvzsRepo_UpdateProductStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ProductStatusUpdate
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ProductStatusUpdate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_UpdateProductStatus_Call) Return(product *domain.Product, err error) *MockPvzsRepo_UpdateProductStatus_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_UpdateProductStatus_Call) RunAndReturn(run func(ctx context.Context, upd *domain.ProductStatusUpdate) (*domain.Product, error)) *MockPvzsRepo_UpdateProductStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePvz provides a mock function for the type MockPvzsRepo
+func (_mock *MockPvzsRepo) UpdatePvz(ctx context.Context, pvzId *uuid.UUID, city domain.PVZCity, ifVersion *int) (*domain.Pvz, error) {
+	ret := _mock.Called(ctx, pvzId, city, ifVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePvz")
+	}
+
+	var r0 *domain.Pvz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, domain.PVZCity, *int) (*domain.Pvz, error)); ok {
+		return returnFunc(ctx, pvzId, city, ifVersion)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, domain.PVZCity, *int) *domain.Pvz); ok {
+		r0 = returnFunc(ctx, pvzId, city, ifVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Pvz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, domain.PVZCity, *int) error); ok {
+		r1 = returnFunc(ctx, pvzId, city, ifVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsRepo_UpdatePvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePvz'
+type MockPvzsRepo_UpdatePvz_Call struct {
+	*mock.Call
+}
+
+// UpdatePvz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+//   - city domain.PVZCity
+//   - ifVersion *int
+func (_e *MockPvzsRepo_Expecter) UpdatePvz(ctx interface{}, pvzId interface{}, city interface{}, ifVersion interface{}) *MockPvzsRepo_UpdatePvz_Call {
+	return &MockPvzsRepo_UpdatePvz_Call{Call: _e.mock.On("UpdatePvz", ctx, pvzId, city, ifVersion)}
+}
+
+func (_c *MockPvzsRepo_UpdatePvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, city domain.PVZCity, ifVersion *int)) *MockPvzsRepo_UpdatePvz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 domain.PVZCity
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZCity)
+		}
+		var arg3 *int
+		if args[3] != nil {
+			arg3 = args[3].(*int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsRepo_UpdatePvz_Call) Return(pvz *domain.Pvz, err error) *MockPvzsRepo_UpdatePvz_Call {
+	_c.Call.Return(pvz, err)
+	return _c
+}
+
+func (_c *MockPvzsRepo_UpdatePvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, city domain.PVZCity, ifVersion *int) (*domain.Pvz, error)) *MockPvzsRepo_UpdatePvz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExportsRepo creates a new instance of MockExportsRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExportsRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExportsRepo {
+	mock := &MockExportsRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExportsRepo is an autogenerated mock type for the ExportsRepo type
+type MockExportsRepo struct {
+	mock.Mock
+}
+
+type MockExportsRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExportsRepo) EXPECT() *MockExportsRepo_Expecter {
+	return &MockExportsRepo_Expecter{mock: &_m.Mock}
+}
+
+// ClaimExportJob provides a mock function for the type MockExportsRepo
+func (_mock *MockExportsRepo) ClaimExportJob(ctx context.Context) (*domain.ExportJob, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimExportJob")
+	}
+
+	var r0 *domain.ExportJob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*domain.ExportJob, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *domain.ExportJob); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExportJob)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExportsRepo_ClaimExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimExportJob'
+type MockExportsRepo_ClaimExportJob_Call struct {
+	*mock.Call
+}
+
+// ClaimExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockExportsRepo_Expecter) ClaimExportJob(ctx interface{}) *MockExportsRepo_ClaimExportJob_Call {
+	return &MockExportsRepo_ClaimExportJob_Call{Call: _e.mock.On("ClaimExportJob", ctx)}
+}
+
+func (_c *MockExportsRepo_ClaimExportJob_Call) Run(run func(ctx context.Context)) *MockExportsRepo_ClaimExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportsRepo_ClaimExportJob_Call) Return(exportJob *domain.ExportJob, err error) *MockExportsRepo_ClaimExportJob_Call {
+	_c.Call.Return(exportJob, err)
+	return _c
+}
+
+func (_c *MockExportsRepo_ClaimExportJob_Call) RunAndReturn(run func(ctx context.Context) (*domain.ExportJob, error)) *MockExportsRepo_ClaimExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateExportJob provides a mock function for the type MockExportsRepo
+func (_mock *MockExportsRepo) CreateExportJob(ctx context.Context, job *domain.ExportJob) (*domain.ExportJob, error) {
+	ret := _mock.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExportJob")
+	}
+
+	var r0 *domain.ExportJob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ExportJob) (*domain.ExportJob, error)); ok {
+		return returnFunc(ctx, job)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ExportJob) *domain.ExportJob); ok {
+		r0 = returnFunc(ctx, job)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExportJob)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ExportJob) error); ok {
+		r1 = returnFunc(ctx, job)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExportsRepo_CreateExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExportJob'
+type MockExportsRepo_CreateExportJob_Call struct {
 	*mock.Call
 }
 
-// UserByEmail is a helper method to define mock.On call
+// CreateExportJob is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-func (_e *MockRepository_Expecter) UserByEmail(ctx interface{}, email interface{}) *MockRepository_UserByEmail_Call {
-	return &MockRepository_UserByEmail_Call{Call: _e.mock.On("UserByEmail", ctx, email)}
+//   - job *domain.ExportJob
+func (_e *MockExportsRepo_Expecter) CreateExportJob(ctx interface{}, job interface{}) *MockExportsRepo_CreateExportJob_Call {
+	return &MockExportsRepo_CreateExportJob_Call{Call: _e.mock.On("CreateExportJob", ctx, job)}
 }
 
-func (_c *MockRepository_UserByEmail_Call) Run(run func(ctx context.Context, email string)) *MockRepository_UserByEmail_Call {
+func (_c *MockExportsRepo_CreateExportJob_Call) Run(run func(ctx context.Context, job *domain.ExportJob)) *MockExportsRepo_CreateExportJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *domain.ExportJob
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(*domain.ExportJob)
 		}
 		run(
 			arg0,
@@ -734,65 +5095,65 @@ func (_c *MockRepository_UserByEmail_Call) Run(run func(ctx context.Context, ema
 	return _c
 }
 
-func (_c *MockRepository_UserByEmail_Call) Return(user *auth.User, err error) *MockRepository_UserByEmail_Call {
-	_c.Call.Return(user, err)
+func (_c *MockExportsRepo_CreateExportJob_Call) Return(exportJob *domain.ExportJob, err error) *MockExportsRepo_CreateExportJob_Call {
+	_c.Call.Return(exportJob, err)
 	return _c
 }
 
-func (_c *MockRepository_UserByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*auth.User, error)) *MockRepository_UserByEmail_Call {
+func (_c *MockExportsRepo_CreateExportJob_Call) RunAndReturn(run func(ctx context.Context, job *domain.ExportJob) (*domain.ExportJob, error)) *MockExportsRepo_CreateExportJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UserRoleAndRefreshToken provides a mock function for the type MockRepository
-func (_mock *MockRepository) UserRoleAndRefreshToken(ctx context.Context, tokenHash []byte) (*auth.UserRoleAndRToken, error) {
-	ret := _mock.Called(ctx, tokenHash)
+// ExportJob provides a mock function for the type MockExportsRepo
+func (_mock *MockExportsRepo) ExportJob(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, error) {
+	ret := _mock.Called(ctx, jobId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UserRoleAndRefreshToken")
+		panic("no return value specified for ExportJob")
 	}
 
-	var r0 *auth.UserRoleAndRToken
+	var r0 *domain.ExportJob
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte) (*auth.UserRoleAndRToken, error)); ok {
-		return returnFunc(ctx, tokenHash)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.ExportJob, error)); ok {
+		return returnFunc(ctx, jobId)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte) *auth.UserRoleAndRToken); ok {
-		r0 = returnFunc(ctx, tokenHash)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.ExportJob); ok {
+		r0 = returnFunc(ctx, jobId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*auth.UserRoleAndRToken)
+			r0 = ret.Get(0).(*domain.ExportJob)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
-		r1 = returnFunc(ctx, tokenHash)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, jobId)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRepository_UserRoleAndRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserRoleAndRefreshToken'
-type MockRepository_UserRoleAndRefreshToken_Call struct {
+// MockExportsRepo_ExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportJob'
+type MockExportsRepo_ExportJob_Call struct {
 	*mock.Call
 }
 
-// UserRoleAndRefreshToken is a helper method to define mock.On call
+// ExportJob is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tokenHash []byte
-func (_e *MockRepository_Expecter) UserRoleAndRefreshToken(ctx interface{}, tokenHash interface{}) *MockRepository_UserRoleAndRefreshToken_Call {
-	return &MockRepository_UserRoleAndRefreshToken_Call{Call: _e.mock.On("UserRoleAndRefreshToken", ctx, tokenHash)}
+//   - jobId *uuid.UUID
+func (_e *MockExportsRepo_Expecter) ExportJob(ctx interface{}, jobId interface{}) *MockExportsRepo_ExportJob_Call {
+	return &MockExportsRepo_ExportJob_Call{Call: _e.mock.On("ExportJob", ctx, jobId)}
 }
 
-func (_c *MockRepository_UserRoleAndRefreshToken_Call) Run(run func(ctx context.Context, tokenHash []byte)) *MockRepository_UserRoleAndRefreshToken_Call {
+func (_c *MockExportsRepo_ExportJob_Call) Run(run func(ctx context.Context, jobId *uuid.UUID)) *MockExportsRepo_ExportJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 []byte
+		var arg1 *uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].([]byte)
+			arg1 = args[1].(*uuid.UUID)
 		}
 		run(
 			arg0,
@@ -802,73 +5163,48 @@ func (_c *MockRepository_UserRoleAndRefreshToken_Call) Run(run func(ctx context.
 	return _c
 }
 
-func (_c *MockRepository_UserRoleAndRefreshToken_Call) Return(userRoleAndRToken *auth.UserRoleAndRToken, err error) *MockRepository_UserRoleAndRefreshToken_Call {
-	_c.Call.Return(userRoleAndRToken, err)
+func (_c *MockExportsRepo_ExportJob_Call) Return(exportJob *domain.ExportJob, err error) *MockExportsRepo_ExportJob_Call {
+	_c.Call.Return(exportJob, err)
 	return _c
 }
 
-func (_c *MockRepository_UserRoleAndRefreshToken_Call) RunAndReturn(run func(ctx context.Context, tokenHash []byte) (*auth.UserRoleAndRToken, error)) *MockRepository_UserRoleAndRefreshToken_Call {
+func (_c *MockExportsRepo_ExportJob_Call) RunAndReturn(run func(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, error)) *MockExportsRepo_ExportJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockPvzsRepo creates a new instance of MockPvzsRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPvzsRepo(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPvzsRepo {
-	mock := &MockPvzsRepo{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockPvzsRepo is an autogenerated mock type for the PvzsRepo type
-type MockPvzsRepo struct {
-	mock.Mock
-}
-
-type MockPvzsRepo_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockPvzsRepo) EXPECT() *MockPvzsRepo_Expecter {
-	return &MockPvzsRepo_Expecter{mock: &_m.Mock}
-}
-
-// CloseReceptionInPvz provides a mock function for the type MockPvzsRepo
-func (_mock *MockPvzsRepo) CloseReceptionInPvz(ctx context.Context, pvzId *uuid.UUID) error {
-	ret := _mock.Called(ctx, pvzId)
+// FinishExportJob provides a mock function for the type MockExportsRepo
+func (_mock *MockExportsRepo) FinishExportJob(ctx context.Context, jobId *uuid.UUID, status domain.ExportJobStatus, errMsg *string) error {
+	ret := _mock.Called(ctx, jobId, status, errMsg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CloseReceptionInPvz")
+		panic("no return value specified for FinishExportJob")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, pvzId)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, domain.ExportJobStatus, *string) error); ok {
+		r0 = returnFunc(ctx, jobId, status, errMsg)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockPvzsRepo_CloseReceptionInPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseReceptionInPvz'
-type MockPvzsRepo_CloseReceptionInPvz_Call struct {
+// MockExportsRepo_FinishExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishExportJob'
+type MockExportsRepo_FinishExportJob_Call struct {
 	*mock.Call
 }
 
-// CloseReceptionInPvz is a helper method to define mock.On call
+// FinishExportJob is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pvzId *uuid.UUID
-func (_e *MockPvzsRepo_Expecter) CloseReceptionInPvz(ctx interface{}, pvzId interface{}) *MockPvzsRepo_CloseReceptionInPvz_Call {
-	return &MockPvzsRepo_CloseReceptionInPvz_Call{Call: _e.mock.On("CloseReceptionInPvz", ctx, pvzId)}
+//   - jobId *uuid.UUID
+//   - status domain.ExportJobStatus
+//   - errMsg *string
+func (_e *MockExportsRepo_Expecter) FinishExportJob(ctx interface{}, jobId interface{}, status interface{}, errMsg interface{}) *MockExportsRepo_FinishExportJob_Call {
+	return &MockExportsRepo_FinishExportJob_Call{Call: _e.mock.On("FinishExportJob", ctx, jobId, status, errMsg)}
 }
 
-func (_c *MockPvzsRepo_CloseReceptionInPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockPvzsRepo_CloseReceptionInPvz_Call {
+func (_c *MockExportsRepo_FinishExportJob_Call) Run(run func(ctx context.Context, jobId *uuid.UUID, status domain.ExportJobStatus, errMsg *string)) *MockExportsRepo_FinishExportJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -878,73 +5214,110 @@ func (_c *MockPvzsRepo_CloseReceptionInPvz_Call) Run(run func(ctx context.Contex
 		if args[1] != nil {
 			arg1 = args[1].(*uuid.UUID)
 		}
+		var arg2 domain.ExportJobStatus
+		if args[2] != nil {
+			arg2 = args[2].(domain.ExportJobStatus)
+		}
+		var arg3 *string
+		if args[3] != nil {
+			arg3 = args[3].(*string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPvzsRepo_CloseReceptionInPvz_Call) Return(err error) *MockPvzsRepo_CloseReceptionInPvz_Call {
+func (_c *MockExportsRepo_FinishExportJob_Call) Return(err error) *MockExportsRepo_FinishExportJob_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPvzsRepo_CloseReceptionInPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) error) *MockPvzsRepo_CloseReceptionInPvz_Call {
+func (_c *MockExportsRepo_FinishExportJob_Call) RunAndReturn(run func(ctx context.Context, jobId *uuid.UUID, status domain.ExportJobStatus, errMsg *string) error) *MockExportsRepo_FinishExportJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreatePVZ provides a mock function for the type MockPvzsRepo
-func (_mock *MockPvzsRepo) CreatePVZ(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error) {
-	ret := _mock.Called(ctx, pvz)
+// NewMockAnalyticsRepo creates a new instance of MockAnalyticsRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAnalyticsRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAnalyticsRepo {
+	mock := &MockAnalyticsRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAnalyticsRepo is an autogenerated mock type for the AnalyticsRepo type
+type MockAnalyticsRepo struct {
+	mock.Mock
+}
+
+type MockAnalyticsRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAnalyticsRepo) EXPECT() *MockAnalyticsRepo_Expecter {
+	return &MockAnalyticsRepo_Expecter{mock: &_m.Mock}
+}
+
+// ProductsThroughput provides a mock function for the type MockAnalyticsRepo
+func (_mock *MockAnalyticsRepo) ProductsThroughput(ctx context.Context, params *domain.ThroughputParams) ([]*domain.ThroughputPoint, error) {
+	ret := _mock.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreatePVZ")
+		panic("no return value specified for ProductsThroughput")
 	}
 
-	var r0 *domain.Pvz
+	var r0 []*domain.ThroughputPoint
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) (*domain.Pvz, error)); ok {
-		return returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ThroughputParams) ([]*domain.ThroughputPoint, error)); ok {
+		return returnFunc(ctx, params)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) *domain.Pvz); ok {
-		r0 = returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ThroughputParams) []*domain.ThroughputPoint); ok {
+		r0 = returnFunc(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Pvz)
+			r0 = ret.Get(0).([]*domain.ThroughputPoint)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Pvz) error); ok {
-		r1 = returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ThroughputParams) error); ok {
+		r1 = returnFunc(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPvzsRepo_CreatePVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePVZ'
-type MockPvzsRepo_CreatePVZ_Call struct {
+// MockAnalyticsRepo_ProductsThroughput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductsThroughput'
+type MockAnalyticsRepo_ProductsThroughput_Call struct {
 	*mock.Call
 }
 
-// CreatePVZ is a helper method to define mock.On call
+// ProductsThroughput is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pvz *domain.Pvz
-func (_e *MockPvzsRepo_Expecter) CreatePVZ(ctx interface{}, pvz interface{}) *MockPvzsRepo_CreatePVZ_Call {
-	return &MockPvzsRepo_CreatePVZ_Call{Call: _e.mock.On("CreatePVZ", ctx, pvz)}
+//   - params *domain.ThroughputParams
+func (_e *MockAnalyticsRepo_Expecter) ProductsThroughput(ctx interface{}, params interface{}) *MockAnalyticsRepo_ProductsThroughput_Call {
+	return &MockAnalyticsRepo_ProductsThroughput_Call{Call: _e.mock.On("ProductsThroughput", ctx, params)}
 }
 
-func (_c *MockPvzsRepo_CreatePVZ_Call) Run(run func(ctx context.Context, pvz *domain.Pvz)) *MockPvzsRepo_CreatePVZ_Call {
+func (_c *MockAnalyticsRepo_ProductsThroughput_Call) Run(run func(ctx context.Context, params *domain.ThroughputParams)) *MockAnalyticsRepo_ProductsThroughput_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Pvz
+		var arg1 *domain.ThroughputParams
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Pvz)
+			arg1 = args[1].(*domain.ThroughputParams)
 		}
 		run(
 			arg0,
@@ -954,65 +5327,65 @@ func (_c *MockPvzsRepo_CreatePVZ_Call) Run(run func(ctx context.Context, pvz *do
 	return _c
 }
 
-func (_c *MockPvzsRepo_CreatePVZ_Call) Return(pvz1 *domain.Pvz, err error) *MockPvzsRepo_CreatePVZ_Call {
-	_c.Call.Return(pvz1, err)
+func (_c *MockAnalyticsRepo_ProductsThroughput_Call) Return(throughputPoints []*domain.ThroughputPoint, err error) *MockAnalyticsRepo_ProductsThroughput_Call {
+	_c.Call.Return(throughputPoints, err)
 	return _c
 }
 
-func (_c *MockPvzsRepo_CreatePVZ_Call) RunAndReturn(run func(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error)) *MockPvzsRepo_CreatePVZ_Call {
+func (_c *MockAnalyticsRepo_ProductsThroughput_Call) RunAndReturn(run func(ctx context.Context, params *domain.ThroughputParams) ([]*domain.ThroughputPoint, error)) *MockAnalyticsRepo_ProductsThroughput_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateProduct provides a mock function for the type MockPvzsRepo
-func (_mock *MockPvzsRepo) CreateProduct(ctx context.Context, prod *domain.Product) (*domain.Product, error) {
-	ret := _mock.Called(ctx, prod)
+// ReceptionStats provides a mock function for the type MockAnalyticsRepo
+func (_mock *MockAnalyticsRepo) ReceptionStats(ctx context.Context, params *domain.ReceptionStatsParams) ([]*domain.ReceptionStats, error) {
+	ret := _mock.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateProduct")
+		panic("no return value specified for ReceptionStats")
 	}
 
-	var r0 *domain.Product
+	var r0 []*domain.ReceptionStats
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product) (*domain.Product, error)); ok {
-		return returnFunc(ctx, prod)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionStatsParams) ([]*domain.ReceptionStats, error)); ok {
+		return returnFunc(ctx, params)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product) *domain.Product); ok {
-		r0 = returnFunc(ctx, prod)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionStatsParams) []*domain.ReceptionStats); ok {
+		r0 = returnFunc(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Product)
+			r0 = ret.Get(0).([]*domain.ReceptionStats)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Product) error); ok {
-		r1 = returnFunc(ctx, prod)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ReceptionStatsParams) error); ok {
+		r1 = returnFunc(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPvzsRepo_CreateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProduct'
-type MockPvzsRepo_CreateProduct_Call struct {
+// MockAnalyticsRepo_ReceptionStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceptionStats'
+type MockAnalyticsRepo_ReceptionStats_Call struct {
 	*mock.Call
 }
 
-// CreateProduct is a helper method to define mock.On call
+// ReceptionStats is a helper method to define mock.On call
 //   - ctx context.Context
-//   - prod *domain.Product
-func (_e *MockPvzsRepo_Expecter) CreateProduct(ctx interface{}, prod interface{}) *MockPvzsRepo_CreateProduct_Call {
-	return &MockPvzsRepo_CreateProduct_Call{Call: _e.mock.On("CreateProduct", ctx, prod)}
+//   - params *domain.ReceptionStatsParams
+func (_e *MockAnalyticsRepo_Expecter) ReceptionStats(ctx interface{}, params interface{}) *MockAnalyticsRepo_ReceptionStats_Call {
+	return &MockAnalyticsRepo_ReceptionStats_Call{Call: _e.mock.On("ReceptionStats", ctx, params)}
 }
 
-func (_c *MockPvzsRepo_CreateProduct_Call) Run(run func(ctx context.Context, prod *domain.Product)) *MockPvzsRepo_CreateProduct_Call {
+func (_c *MockAnalyticsRepo_ReceptionStats_Call) Run(run func(ctx context.Context, params *domain.ReceptionStatsParams)) *MockAnalyticsRepo_ReceptionStats_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Product
+		var arg1 *domain.ReceptionStatsParams
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Product)
+			arg1 = args[1].(*domain.ReceptionStatsParams)
 		}
 		run(
 			arg0,
@@ -1022,122 +5395,144 @@ func (_c *MockPvzsRepo_CreateProduct_Call) Run(run func(ctx context.Context, pro
 	return _c
 }
 
-func (_c *MockPvzsRepo_CreateProduct_Call) Return(product *domain.Product, err error) *MockPvzsRepo_CreateProduct_Call {
-	_c.Call.Return(product, err)
+func (_c *MockAnalyticsRepo_ReceptionStats_Call) Return(receptionStatss []*domain.ReceptionStats, err error) *MockAnalyticsRepo_ReceptionStats_Call {
+	_c.Call.Return(receptionStatss, err)
 	return _c
 }
 
-func (_c *MockPvzsRepo_CreateProduct_Call) RunAndReturn(run func(ctx context.Context, prod *domain.Product) (*domain.Product, error)) *MockPvzsRepo_CreateProduct_Call {
+func (_c *MockAnalyticsRepo_ReceptionStats_Call) RunAndReturn(run func(ctx context.Context, params *domain.ReceptionStatsParams) ([]*domain.ReceptionStats, error)) *MockAnalyticsRepo_ReceptionStats_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateReception provides a mock function for the type MockPvzsRepo
-func (_mock *MockPvzsRepo) CreateReception(ctx context.Context, rec *domain.Reception) (*domain.Reception, error) {
-	ret := _mock.Called(ctx, rec)
+// RefreshDailyStats provides a mock function for the type MockAnalyticsRepo
+func (_mock *MockAnalyticsRepo) RefreshDailyStats(ctx context.Context, from time.Time, to time.Time) error {
+	ret := _mock.Called(ctx, from, to)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateReception")
+		panic("no return value specified for RefreshDailyStats")
 	}
 
-	var r0 *domain.Reception
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) (*domain.Reception, error)); ok {
-		return returnFunc(ctx, rec)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) *domain.Reception); ok {
-		r0 = returnFunc(ctx, rec)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Reception)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Reception) error); ok {
-		r1 = returnFunc(ctx, rec)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) error); ok {
+		r0 = returnFunc(ctx, from, to)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockPvzsRepo_CreateReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReception'
-type MockPvzsRepo_CreateReception_Call struct {
+// MockAnalyticsRepo_RefreshDailyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshDailyStats'
+type MockAnalyticsRepo_RefreshDailyStats_Call struct {
 	*mock.Call
 }
 
-// CreateReception is a helper method to define mock.On call
+// RefreshDailyStats is a helper method to define mock.On call
 //   - ctx context.Context
-//   - rec *domain.Reception
-func (_e *MockPvzsRepo_Expecter) CreateReception(ctx interface{}, rec interface{}) *MockPvzsRepo_CreateReception_Call {
-	return &MockPvzsRepo_CreateReception_Call{Call: _e.mock.On("CreateReception", ctx, rec)}
+//   - from time.Time
+//   - to time.Time
+func (_e *MockAnalyticsRepo_Expecter) RefreshDailyStats(ctx interface{}, from interface{}, to interface{}) *MockAnalyticsRepo_RefreshDailyStats_Call {
+	return &MockAnalyticsRepo_RefreshDailyStats_Call{Call: _e.mock.On("RefreshDailyStats", ctx, from, to)}
 }
 
-func (_c *MockPvzsRepo_CreateReception_Call) Run(run func(ctx context.Context, rec *domain.Reception)) *MockPvzsRepo_CreateReception_Call {
+func (_c *MockAnalyticsRepo_RefreshDailyStats_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *MockAnalyticsRepo_RefreshDailyStats_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Reception
+		var arg1 time.Time
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Reception)
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPvzsRepo_CreateReception_Call) Return(reception *domain.Reception, err error) *MockPvzsRepo_CreateReception_Call {
-	_c.Call.Return(reception, err)
+func (_c *MockAnalyticsRepo_RefreshDailyStats_Call) Return(err error) *MockAnalyticsRepo_RefreshDailyStats_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPvzsRepo_CreateReception_Call) RunAndReturn(run func(ctx context.Context, rec *domain.Reception) (*domain.Reception, error)) *MockPvzsRepo_CreateReception_Call {
+func (_c *MockAnalyticsRepo_RefreshDailyStats_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time) error) *MockAnalyticsRepo_RefreshDailyStats_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteLastProduct provides a mock function for the type MockPvzsRepo
-func (_mock *MockPvzsRepo) DeleteLastProduct(ctx context.Context, pvzId *uuid.UUID) error {
-	ret := _mock.Called(ctx, pvzId)
+// NewMockIdempotencyRepo creates a new instance of MockIdempotencyRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdempotencyRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdempotencyRepo {
+	mock := &MockIdempotencyRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIdempotencyRepo is an autogenerated mock type for the IdempotencyRepo type
+type MockIdempotencyRepo struct {
+	mock.Mock
+}
+
+type MockIdempotencyRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdempotencyRepo) EXPECT() *MockIdempotencyRepo_Expecter {
+	return &MockIdempotencyRepo_Expecter{mock: &_m.Mock}
+}
+
+// DeleteIdempotencyKey provides a mock function for the type MockIdempotencyRepo
+func (_mock *MockIdempotencyRepo) DeleteIdempotencyKey(ctx context.Context, req *domain.IdempotentRequest) error {
+	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteLastProduct")
+		panic("no return value specified for DeleteIdempotencyKey")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, pvzId)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotentRequest) error); ok {
+		r0 = returnFunc(ctx, req)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockPvzsRepo_DeleteLastProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLastProduct'
-type MockPvzsRepo_DeleteLastProduct_Call struct {
+// MockIdempotencyRepo_DeleteIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteIdempotencyKey'
+type MockIdempotencyRepo_DeleteIdempotencyKey_Call struct {
 	*mock.Call
 }
 
-// DeleteLastProduct is a helper method to define mock.On call
+// DeleteIdempotencyKey is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pvzId *uuid.UUID
-func (_e *MockPvzsRepo_Expecter) DeleteLastProduct(ctx interface{}, pvzId interface{}) *MockPvzsRepo_DeleteLastProduct_Call {
-	return &MockPvzsRepo_DeleteLastProduct_Call{Call: _e.mock.On("DeleteLastProduct", ctx, pvzId)}
+//   - req *domain.IdempotentRequest
+func (_e *MockIdempotencyRepo_Expecter) DeleteIdempotencyKey(ctx interface{}, req interface{}) *MockIdempotencyRepo_DeleteIdempotencyKey_Call {
+	return &MockIdempotencyRepo_DeleteIdempotencyKey_Call{Call: _e.mock.On("DeleteIdempotencyKey", ctx, req)}
 }
 
-func (_c *MockPvzsRepo_DeleteLastProduct_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockPvzsRepo_DeleteLastProduct_Call {
+func (_c *MockIdempotencyRepo_DeleteIdempotencyKey_Call) Run(run func(ctx context.Context, req *domain.IdempotentRequest)) *MockIdempotencyRepo_DeleteIdempotencyKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *uuid.UUID
+		var arg1 *domain.IdempotentRequest
 		if args[1] != nil {
-			arg1 = args[1].(*uuid.UUID)
+			arg1 = args[1].(*domain.IdempotentRequest)
 		}
 		run(
 			arg0,
@@ -1147,142 +5542,149 @@ func (_c *MockPvzsRepo_DeleteLastProduct_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockPvzsRepo_DeleteLastProduct_Call) Return(err error) *MockPvzsRepo_DeleteLastProduct_Call {
+func (_c *MockIdempotencyRepo_DeleteIdempotencyKey_Call) Return(err error) *MockIdempotencyRepo_DeleteIdempotencyKey_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPvzsRepo_DeleteLastProduct_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) error) *MockPvzsRepo_DeleteLastProduct_Call {
+func (_c *MockIdempotencyRepo_DeleteIdempotencyKey_Call) RunAndReturn(run func(ctx context.Context, req *domain.IdempotentRequest) error) *MockIdempotencyRepo_DeleteIdempotencyKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAllPvzs provides a mock function for the type MockPvzsRepo
-func (_mock *MockPvzsRepo) GetAllPvzs(ctx context.Context) ([]*domain.Pvz, error) {
-	ret := _mock.Called(ctx)
+// ReserveIdempotencyKey provides a mock function for the type MockIdempotencyRepo
+func (_mock *MockIdempotencyRepo) ReserveIdempotencyKey(ctx context.Context, rec *domain.IdempotencyRecord, staleBefore time.Time) (*domain.IdempotencyRecord, error) {
+	ret := _mock.Called(ctx, rec, staleBefore)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllPvzs")
+		panic("no return value specified for ReserveIdempotencyKey")
 	}
 
-	var r0 []*domain.Pvz
+	var r0 *domain.IdempotencyRecord
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Pvz, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotencyRecord, time.Time) (*domain.IdempotencyRecord, error)); ok {
+		return returnFunc(ctx, rec, staleBefore)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Pvz); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotencyRecord, time.Time) *domain.IdempotencyRecord); ok {
+		r0 = returnFunc(ctx, rec, staleBefore)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Pvz)
+			r0 = ret.Get(0).(*domain.IdempotencyRecord)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.IdempotencyRecord, time.Time) error); ok {
+		r1 = returnFunc(ctx, rec, staleBefore)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPvzsRepo_GetAllPvzs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllPvzs'
-type MockPvzsRepo_GetAllPvzs_Call struct {
+// MockIdempotencyRepo_ReserveIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveIdempotencyKey'
+type MockIdempotencyRepo_ReserveIdempotencyKey_Call struct {
 	*mock.Call
 }
 
-// GetAllPvzs is a helper method to define mock.On call
+// ReserveIdempotencyKey is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockPvzsRepo_Expecter) GetAllPvzs(ctx interface{}) *MockPvzsRepo_GetAllPvzs_Call {
-	return &MockPvzsRepo_GetAllPvzs_Call{Call: _e.mock.On("GetAllPvzs", ctx)}
+//   - rec *domain.IdempotencyRecord
+//   - staleBefore time.Time
+func (_e *MockIdempotencyRepo_Expecter) ReserveIdempotencyKey(ctx interface{}, rec interface{}, staleBefore interface{}) *MockIdempotencyRepo_ReserveIdempotencyKey_Call {
+	return &MockIdempotencyRepo_ReserveIdempotencyKey_Call{Call: _e.mock.On("ReserveIdempotencyKey", ctx, rec, staleBefore)}
 }
 
-func (_c *MockPvzsRepo_GetAllPvzs_Call) Run(run func(ctx context.Context)) *MockPvzsRepo_GetAllPvzs_Call {
+func (_c *MockIdempotencyRepo_ReserveIdempotencyKey_Call) Run(run func(ctx context.Context, rec *domain.IdempotencyRecord, staleBefore time.Time)) *MockIdempotencyRepo_ReserveIdempotencyKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 *domain.IdempotencyRecord
+		if args[1] != nil {
+			arg1 = args[1].(*domain.IdempotencyRecord)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPvzsRepo_GetAllPvzs_Call) Return(pvzs []*domain.Pvz, err error) *MockPvzsRepo_GetAllPvzs_Call {
-	_c.Call.Return(pvzs, err)
+func (_c *MockIdempotencyRepo_ReserveIdempotencyKey_Call) Return(idempotencyRecord *domain.IdempotencyRecord, err error) *MockIdempotencyRepo_ReserveIdempotencyKey_Call {
+	_c.Call.Return(idempotencyRecord, err)
 	return _c
 }
 
-func (_c *MockPvzsRepo_GetAllPvzs_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Pvz, error)) *MockPvzsRepo_GetAllPvzs_Call {
+func (_c *MockIdempotencyRepo_ReserveIdempotencyKey_Call) RunAndReturn(run func(ctx context.Context, rec *domain.IdempotencyRecord, staleBefore time.Time) (*domain.IdempotencyRecord, error)) *MockIdempotencyRepo_ReserveIdempotencyKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPvzsData provides a mock function for the type MockPvzsRepo
-func (_mock *MockPvzsRepo) GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) ([]*domain.PvzReceptions, error) {
-	ret := _mock.Called(ctx, params)
+// SaveIdempotentResponse provides a mock function for the type MockIdempotencyRepo
+func (_mock *MockIdempotencyRepo) SaveIdempotentResponse(ctx context.Context, req *domain.IdempotentRequest, resp *domain.IdempotentResponse) error {
+	ret := _mock.Called(ctx, req, resp)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPvzsData")
+		panic("no return value specified for SaveIdempotentResponse")
 	}
 
-	var r0 []*domain.PvzReceptions
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) ([]*domain.PvzReceptions, error)); ok {
-		return returnFunc(ctx, params)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) []*domain.PvzReceptions); ok {
-		r0 = returnFunc(ctx, params)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.PvzReceptions)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PvzsReadParams) error); ok {
-		r1 = returnFunc(ctx, params)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotentRequest, *domain.IdempotentResponse) error); ok {
+		r0 = returnFunc(ctx, req, resp)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockPvzsRepo_GetPvzsData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvzsData'
-type MockPvzsRepo_GetPvzsData_Call struct {
+// MockIdempotencyRepo_SaveIdempotentResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveIdempotentResponse'
+type MockIdempotencyRepo_SaveIdempotentResponse_Call struct {
 	*mock.Call
 }
 
-// GetPvzsData is a helper method to define mock.On call
+// SaveIdempotentResponse is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params *domain.PvzsReadParams
-func (_e *MockPvzsRepo_Expecter) GetPvzsData(ctx interface{}, params interface{}) *MockPvzsRepo_GetPvzsData_Call {
-	return &MockPvzsRepo_GetPvzsData_Call{Call: _e.mock.On("GetPvzsData", ctx, params)}
+//   - req *domain.IdempotentRequest
+//   - resp *domain.IdempotentResponse
+func (_e *MockIdempotencyRepo_Expecter) SaveIdempotentResponse(ctx interface{}, req interface{}, resp interface{}) *MockIdempotencyRepo_SaveIdempotentResponse_Call {
+	return &MockIdempotencyRepo_SaveIdempotentResponse_Call{Call: _e.mock.On("SaveIdempotentResponse", ctx, req, resp)}
 }
 
-func (_c *MockPvzsRepo_GetPvzsData_Call) Run(run func(ctx context.Context, params *domain.PvzsReadParams)) *MockPvzsRepo_GetPvzsData_Call {
+func (_c *MockIdempotencyRepo_SaveIdempotentResponse_Call) Run(run func(ctx context.Context, req *domain.IdempotentRequest, resp *domain.IdempotentResponse)) *MockIdempotencyRepo_SaveIdempotentResponse_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.PvzsReadParams
+		var arg1 *domain.IdempotentRequest
 		if args[1] != nil {
-			arg1 = args[1].(*domain.PvzsReadParams)
+			arg1 = args[1].(*domain.IdempotentRequest)
+		}
+		var arg2 *domain.IdempotentResponse
+		if args[2] != nil {
+			arg2 = args[2].(*domain.IdempotentResponse)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPvzsRepo_GetPvzsData_Call) Return(pvzReceptionss []*domain.PvzReceptions, err error) *MockPvzsRepo_GetPvzsData_Call {
-	_c.Call.Return(pvzReceptionss, err)
+func (_c *MockIdempotencyRepo_SaveIdempotentResponse_Call) Return(err error) *MockIdempotencyRepo_SaveIdempotentResponse_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPvzsRepo_GetPvzsData_Call) RunAndReturn(run func(ctx context.Context, params *domain.PvzsReadParams) ([]*domain.PvzReceptions, error)) *MockPvzsRepo_GetPvzsData_Call {
+func (_c *MockIdempotencyRepo_SaveIdempotentResponse_Call) RunAndReturn(run func(ctx context.Context, req *domain.IdempotentRequest, resp *domain.IdempotentResponse) error) *MockIdempotencyRepo_SaveIdempotentResponse_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shrtyk/pvz-service/internal/core/domain"
@@ -11,17 +12,88 @@ import (
 //go:generate mockery
 type Repository interface {
 	PvzsRepo
+	ExportsRepo
+	AnalyticsRepo
+	IdempotencyRepo
 	AuthRepo
 }
 
 type PvzsRepo interface {
 	CreatePVZ(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error)
 	CreateReception(ctx context.Context, rec *domain.Reception) (*domain.Reception, error)
-	CreateProduct(ctx context.Context, prod *domain.Product) (*domain.Product, error)
-	DeleteLastProduct(ctx context.Context, pvzId *uuid.UUID) error
-	CloseReceptionInPvz(ctx context.Context, pvzId *uuid.UUID) error
-	GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) ([]*domain.PvzReceptions, error)
-	GetAllPvzs(ctx context.Context) ([]*domain.Pvz, error)
+	Pvz(ctx context.Context, pvzId *uuid.UUID) (*domain.Pvz, error)
+	UpdatePvz(ctx context.Context, pvzId *uuid.UUID, city domain.PVZCity, ifVersion *int) (*domain.Pvz, error)
+	Reception(ctx context.Context, receptionId *uuid.UUID) (*domain.Reception, error)
+	// CreateProduct adds the product to the active reception. With uniqueBarcode its
+	// barcode is kept unique across all receptions by the database.
+	CreateProduct(ctx context.Context, prod *domain.Product, capacity int, uniqueBarcode bool) (*domain.Product, error)
+	CreateProductsBatch(ctx context.Context, batch *domain.ProductsBatch) (*domain.ProductsBatchResult, error)
+	// ProductsByBarcode returns every product with the barcode, newest first.
+	ProductsByBarcode(ctx context.Context, barcode string) ([]*domain.ProductInfo, error)
+	DeleteLastProduct(ctx context.Context, pvzId, actorId *uuid.UUID) error
+	DeleteProduct(ctx context.Context, productId, actorId *uuid.UUID) error
+	RestoreProduct(ctx context.Context, productId *uuid.UUID, capacity int) (*domain.Product, error)
+	ProductDeletions(ctx context.Context, receptionId *uuid.UUID) ([]*domain.ProductDeletion, error)
+	ProductStorageState(ctx context.Context, productId *uuid.UUID) (*domain.ProductStorageState, error)
+	SetProductPickupCode(ctx context.Context, productId *uuid.UUID, codeHash []byte, expiresAt *time.Time) error
+	// TakePickupCodeAttempt counts an attempt to enter the pickup code and returns its hash.
+	// It fails with InvalidState once the code has expired or used up maxAttempts.
+	TakePickupCodeAttempt(ctx context.Context, productId *uuid.UUID, maxAttempts int) ([]byte, error)
+	UpdateProductStatus(ctx context.Context, upd *domain.ProductStatusUpdate) (*domain.Product, error)
+	ProductStatusHistory(ctx context.Context, productId *uuid.UUID) ([]*domain.ProductStatusChange, error)
+	// Reception changing methods take ifVersion, when it is not nil the reception must
+	// have that version, otherwise they fail with VersionMismatch.
+	CloseReceptionInPvz(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.ReceptionCloseReport, error)
+	CancelReceptionInPvz(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.Reception, error)
+	ReopenReception(
+		ctx context.Context,
+		receptionId *uuid.UUID,
+		closedAfter time.Time,
+		ifVersion *int,
+	) (*domain.Reception, error)
+	// SetReceptionManifest checks manifest.IfVersion and sets manifest.Version.
+	SetReceptionManifest(ctx context.Context, manifest *domain.ReceptionManifest) error
+	AutoCloseStaleReceptions(ctx context.Context, idleBefore time.Time) ([]*domain.Reception, error)
+	GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) (*domain.PvzsPage, error)
+	// StreamPvzsData passes every PVZ of the page to yield as soon as it is read.
+	// The returned page carries only the next cursor and the total.
+	StreamPvzsData(
+		ctx context.Context,
+		params *domain.PvzsReadParams,
+		yield func(*domain.PvzReceptions) error,
+	) (*domain.PvzsPage, error)
+	GetAllPvzs(ctx context.Context, filter *domain.PvzsFilter, sort domain.PvzsSort) ([]*domain.Pvz, error)
+	PvzStock(ctx context.Context, pvzId *uuid.UUID) (*domain.PvzStock, error)
+	AllPvzsStock(ctx context.Context) ([]*domain.PvzStock, error)
+	CountReceptions(ctx context.Context, status domain.ReceptionStatus) (int, error)
+}
+
+type ExportsRepo interface {
+	CreateExportJob(ctx context.Context, job *domain.ExportJob) (*domain.ExportJob, error)
+	ExportJob(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, error)
+	// ClaimExportJob marks the oldest pending job as running and returns it.
+	ClaimExportJob(ctx context.Context) (*domain.ExportJob, error)
+	FinishExportJob(ctx context.Context, jobId *uuid.UUID, status domain.ExportJobStatus, errMsg *string) error
+}
+
+type AnalyticsRepo interface {
+	ProductsThroughput(ctx context.Context, params *domain.ThroughputParams) ([]*domain.ThroughputPoint, error)
+	ReceptionStats(ctx context.Context, params *domain.ReceptionStatsParams) ([]*domain.ReceptionStats, error)
+	// RefreshDailyStats recomputes rollups of the UTC days within [from, to).
+	RefreshDailyStats(ctx context.Context, from, to time.Time) error
+}
+
+type IdempotencyRepo interface {
+	// ReserveIdempotencyKey stores the key of rec unless it is already taken. It returns
+	// nil once the key is reserved and the already stored record otherwise. Expired keys
+	// and keys left without a response since before staleBefore are taken over.
+	ReserveIdempotencyKey(
+		ctx context.Context,
+		rec *domain.IdempotencyRecord,
+		staleBefore time.Time,
+	) (*domain.IdempotencyRecord, error)
+	SaveIdempotentResponse(ctx context.Context, req *domain.IdempotentRequest, resp *domain.IdempotentResponse) error
+	DeleteIdempotencyKey(ctx context.Context, req *domain.IdempotentRequest) error
 }
 
 type AuthRepo interface {
@@ -14,6 +14,26 @@ const (
 	NoActiveReception       ServiceErrKind = "no opened reception"
 	NoProdOrActiveReception ServiceErrKind = "no product to delete or active reception"
 	FailedToCloseReception  ServiceErrKind = "failed to close reception"
+	ProductAlreadyExists    ServiceErrKind = "product with such barcode already exists"
+	ProductNotFound         ServiceErrKind = "product not found"
+	DeletedProductNotFound  ServiceErrKind = "deleted product not found"
+	ReceptionNotInProgress  ServiceErrKind = "reception is not in progress"
+	ReceptionNotFound       ServiceErrKind = "reception not found"
+	ReceptionNotReopenable  ServiceErrKind = "reception can not be reopened"
+	AutoCloseBusy           ServiceErrKind = "auto close is running on another instance"
+	ProductNotInStorage     ServiceErrKind = "product is not in storage"
+	ReceptionNotClosed      ServiceErrKind = "reception is not closed"
+	PickupCodeNotIssued     ServiceErrKind = "pickup code is not issued"
+	WrongPickupCode         ServiceErrKind = "wrong pickup code"
+	PickupCodeExpired       ServiceErrKind = "pickup code has expired"
+	PickupCodeLocked        ServiceErrKind = "pickup code is locked after too many wrong attempts"
+	StorageNotExpired       ServiceErrKind = "storage period is not over yet"
+	PvzCapacityExceeded     ServiceErrKind = "pvz capacity exceeded"
+	ExportJobNotFound       ServiceErrKind = "export job not found"
+	ExportNotReady          ServiceErrKind = "export is not ready"
+	IdempotencyKeyReused    ServiceErrKind = "idempotency key is already used for another request"
+	IdempotentReqInProgress ServiceErrKind = "request with this idempotency key is in progress"
+	VersionMismatch         ServiceErrKind = "resource version does not match"
 
 	EmailAlreadyExists ServiceErrKind = "email already exists"
 	WrongCredentials   ServiceErrKind = "wrong credentials"
@@ -6,6 +6,8 @@ package servicemocks
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shrtyk/pvz-service/internal/core/domain"
@@ -40,6 +42,63 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
+// AbortIdempotentRequest provides a mock function for the type MockService
+func (_mock *MockService) AbortIdempotentRequest(ctx context.Context, req *domain.IdempotentRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AbortIdempotentRequest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotentRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_AbortIdempotentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AbortIdempotentRequest'
+type MockService_AbortIdempotentRequest_Call struct {
+	*mock.Call
+}
+
+// AbortIdempotentRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.IdempotentRequest
+func (_e *MockService_Expecter) AbortIdempotentRequest(ctx interface{}, req interface{}) *MockService_AbortIdempotentRequest_Call {
+	return &MockService_AbortIdempotentRequest_Call{Call: _e.mock.On("AbortIdempotentRequest", ctx, req)}
+}
+
+func (_c *MockService_AbortIdempotentRequest_Call) Run(run func(ctx context.Context, req *domain.IdempotentRequest)) *MockService_AbortIdempotentRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.IdempotentRequest
+		if args[1] != nil {
+			arg1 = args[1].(*domain.IdempotentRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_AbortIdempotentRequest_Call) Return(err error) *MockService_AbortIdempotentRequest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_AbortIdempotentRequest_Call) RunAndReturn(run func(ctx context.Context, req *domain.IdempotentRequest) error) *MockService_AbortIdempotentRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddProductPVZ provides a mock function for the type MockService
 func (_mock *MockService) AddProductPVZ(ctx context.Context, prod *domain.Product) (*domain.Product, error) {
 	ret := _mock.Called(ctx, prod)
@@ -108,44 +167,55 @@ func (_c *MockService_AddProductPVZ_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
-// CloseReceptionInPvz provides a mock function for the type MockService
-func (_mock *MockService) CloseReceptionInPvz(ctx context.Context, pvzId *uuid.UUID) error {
-	ret := _mock.Called(ctx, pvzId)
+// AddProductsBatch provides a mock function for the type MockService
+func (_mock *MockService) AddProductsBatch(ctx context.Context, batch *domain.ProductsBatch) (*domain.ProductsBatchResult, error) {
+	ret := _mock.Called(ctx, batch)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CloseReceptionInPvz")
+		panic("no return value specified for AddProductsBatch")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, pvzId)
+	var r0 *domain.ProductsBatchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductsBatch) (*domain.ProductsBatchResult, error)); ok {
+		return returnFunc(ctx, batch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductsBatch) *domain.ProductsBatchResult); ok {
+		r0 = returnFunc(ctx, batch)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProductsBatchResult)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ProductsBatch) error); ok {
+		r1 = returnFunc(ctx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockService_CloseReceptionInPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseReceptionInPvz'
-type MockService_CloseReceptionInPvz_Call struct {
+// MockService_AddProductsBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProductsBatch'
+type MockService_AddProductsBatch_Call struct {
 	*mock.Call
 }
 
-// CloseReceptionInPvz is a helper method to define mock.On call
+// AddProductsBatch is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pvzId *uuid.UUID
-func (_e *MockService_Expecter) CloseReceptionInPvz(ctx interface{}, pvzId interface{}) *MockService_CloseReceptionInPvz_Call {
-	return &MockService_CloseReceptionInPvz_Call{Call: _e.mock.On("CloseReceptionInPvz", ctx, pvzId)}
+//   - batch *domain.ProductsBatch
+func (_e *MockService_Expecter) AddProductsBatch(ctx interface{}, batch interface{}) *MockService_AddProductsBatch_Call {
+	return &MockService_AddProductsBatch_Call{Call: _e.mock.On("AddProductsBatch", ctx, batch)}
 }
 
-func (_c *MockService_CloseReceptionInPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockService_CloseReceptionInPvz_Call {
+func (_c *MockService_AddProductsBatch_Call) Run(run func(ctx context.Context, batch *domain.ProductsBatch)) *MockService_AddProductsBatch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *uuid.UUID
+		var arg1 *domain.ProductsBatch
 		if args[1] != nil {
-			arg1 = args[1].(*uuid.UUID)
+			arg1 = args[1].(*domain.ProductsBatch)
 		}
 		run(
 			arg0,
@@ -155,184 +225,195 @@ func (_c *MockService_CloseReceptionInPvz_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockService_CloseReceptionInPvz_Call) Return(err error) *MockService_CloseReceptionInPvz_Call {
-	_c.Call.Return(err)
+func (_c *MockService_AddProductsBatch_Call) Return(productsBatchResult *domain.ProductsBatchResult, err error) *MockService_AddProductsBatch_Call {
+	_c.Call.Return(productsBatchResult, err)
 	return _c
 }
 
-func (_c *MockService_CloseReceptionInPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) error) *MockService_CloseReceptionInPvz_Call {
+func (_c *MockService_AddProductsBatch_Call) RunAndReturn(run func(ctx context.Context, batch *domain.ProductsBatch) (*domain.ProductsBatchResult, error)) *MockService_AddProductsBatch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteLastProductPvz provides a mock function for the type MockService
-func (_mock *MockService) DeleteLastProductPvz(ctx context.Context, pvzId *uuid.UUID) error {
-	ret := _mock.Called(ctx, pvzId)
+// AllPvzsStock provides a mock function for the type MockService
+func (_mock *MockService) AllPvzsStock(ctx context.Context) ([]*domain.PvzStock, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteLastProductPvz")
+		panic("no return value specified for AllPvzsStock")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, pvzId)
+	var r0 []*domain.PvzStock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.PvzStock, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.PvzStock); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.PvzStock)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockService_DeleteLastProductPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLastProductPvz'
-type MockService_DeleteLastProductPvz_Call struct {
+// MockService_AllPvzsStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllPvzsStock'
+type MockService_AllPvzsStock_Call struct {
 	*mock.Call
 }
 
-// DeleteLastProductPvz is a helper method to define mock.On call
+// AllPvzsStock is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pvzId *uuid.UUID
-func (_e *MockService_Expecter) DeleteLastProductPvz(ctx interface{}, pvzId interface{}) *MockService_DeleteLastProductPvz_Call {
-	return &MockService_DeleteLastProductPvz_Call{Call: _e.mock.On("DeleteLastProductPvz", ctx, pvzId)}
+func (_e *MockService_Expecter) AllPvzsStock(ctx interface{}) *MockService_AllPvzsStock_Call {
+	return &MockService_AllPvzsStock_Call{Call: _e.mock.On("AllPvzsStock", ctx)}
 }
 
-func (_c *MockService_DeleteLastProductPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockService_DeleteLastProductPvz_Call {
+func (_c *MockService_AllPvzsStock_Call) Run(run func(ctx context.Context)) *MockService_AllPvzsStock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *uuid.UUID
-		if args[1] != nil {
-			arg1 = args[1].(*uuid.UUID)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockService_DeleteLastProductPvz_Call) Return(err error) *MockService_DeleteLastProductPvz_Call {
-	_c.Call.Return(err)
+func (_c *MockService_AllPvzsStock_Call) Return(pvzStocks []*domain.PvzStock, err error) *MockService_AllPvzsStock_Call {
+	_c.Call.Return(pvzStocks, err)
 	return _c
 }
 
-func (_c *MockService_DeleteLastProductPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) error) *MockService_DeleteLastProductPvz_Call {
+func (_c *MockService_AllPvzsStock_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.PvzStock, error)) *MockService_AllPvzsStock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAllPvzs provides a mock function for the type MockService
-func (_mock *MockService) GetAllPvzs(ctx context.Context) ([]*domain.Pvz, error) {
-	ret := _mock.Called(ctx)
+// AutoCloseStaleReceptions provides a mock function for the type MockService
+func (_mock *MockService) AutoCloseStaleReceptions(ctx context.Context, idleFor time.Duration) ([]*domain.Reception, error) {
+	ret := _mock.Called(ctx, idleFor)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllPvzs")
+		panic("no return value specified for AutoCloseStaleReceptions")
 	}
 
-	var r0 []*domain.Pvz
+	var r0 []*domain.Reception
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Pvz, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration) ([]*domain.Reception, error)); ok {
+		return returnFunc(ctx, idleFor)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Pvz); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration) []*domain.Reception); ok {
+		r0 = returnFunc(ctx, idleFor)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Pvz)
+			r0 = ret.Get(0).([]*domain.Reception)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = returnFunc(ctx, idleFor)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockService_GetAllPvzs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllPvzs'
-type MockService_GetAllPvzs_Call struct {
+// MockService_AutoCloseStaleReceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoCloseStaleReceptions'
+type MockService_AutoCloseStaleReceptions_Call struct {
 	*mock.Call
 }
 
-// GetAllPvzs is a helper method to define mock.On call
+// AutoCloseStaleReceptions is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockService_Expecter) GetAllPvzs(ctx interface{}) *MockService_GetAllPvzs_Call {
-	return &MockService_GetAllPvzs_Call{Call: _e.mock.On("GetAllPvzs", ctx)}
+//   - idleFor time.Duration
+func (_e *MockService_Expecter) AutoCloseStaleReceptions(ctx interface{}, idleFor interface{}) *MockService_AutoCloseStaleReceptions_Call {
+	return &MockService_AutoCloseStaleReceptions_Call{Call: _e.mock.On("AutoCloseStaleReceptions", ctx, idleFor)}
 }
 
-func (_c *MockService_GetAllPvzs_Call) Run(run func(ctx context.Context)) *MockService_GetAllPvzs_Call {
+func (_c *MockService_AutoCloseStaleReceptions_Call) Run(run func(ctx context.Context, idleFor time.Duration)) *MockService_AutoCloseStaleReceptions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockService_GetAllPvzs_Call) Return(pvzs []*domain.Pvz, err error) *MockService_GetAllPvzs_Call {
-	_c.Call.Return(pvzs, err)
+func (_c *MockService_AutoCloseStaleReceptions_Call) Return(receptions []*domain.Reception, err error) *MockService_AutoCloseStaleReceptions_Call {
+	_c.Call.Return(receptions, err)
 	return _c
 }
 
-func (_c *MockService_GetAllPvzs_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Pvz, error)) *MockService_GetAllPvzs_Call {
+func (_c *MockService_AutoCloseStaleReceptions_Call) RunAndReturn(run func(ctx context.Context, idleFor time.Duration) ([]*domain.Reception, error)) *MockService_AutoCloseStaleReceptions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPvzsData provides a mock function for the type MockService
-func (_mock *MockService) GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) ([]*domain.PvzReceptions, error) {
-	ret := _mock.Called(ctx, params)
+// BeginIdempotentRequest provides a mock function for the type MockService
+func (_mock *MockService) BeginIdempotentRequest(ctx context.Context, req *domain.IdempotentRequest) (*domain.IdempotentResponse, error) {
+	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPvzsData")
+		panic("no return value specified for BeginIdempotentRequest")
 	}
 
-	var r0 []*domain.PvzReceptions
+	var r0 *domain.IdempotentResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) ([]*domain.PvzReceptions, error)); ok {
-		return returnFunc(ctx, params)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotentRequest) (*domain.IdempotentResponse, error)); ok {
+		return returnFunc(ctx, req)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) []*domain.PvzReceptions); ok {
-		r0 = returnFunc(ctx, params)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotentRequest) *domain.IdempotentResponse); ok {
+		r0 = returnFunc(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.PvzReceptions)
+			r0 = ret.Get(0).(*domain.IdempotentResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PvzsReadParams) error); ok {
-		r1 = returnFunc(ctx, params)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.IdempotentRequest) error); ok {
+		r1 = returnFunc(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockService_GetPvzsData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvzsData'
-type MockService_GetPvzsData_Call struct {
+// MockService_BeginIdempotentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginIdempotentRequest'
+type MockService_BeginIdempotentRequest_Call struct {
 	*mock.Call
 }
 
-// GetPvzsData is a helper method to define mock.On call
+// BeginIdempotentRequest is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params *domain.PvzsReadParams
-func (_e *MockService_Expecter) GetPvzsData(ctx interface{}, params interface{}) *MockService_GetPvzsData_Call {
-	return &MockService_GetPvzsData_Call{Call: _e.mock.On("GetPvzsData", ctx, params)}
+//   - req *domain.IdempotentRequest
+func (_e *MockService_Expecter) BeginIdempotentRequest(ctx interface{}, req interface{}) *MockService_BeginIdempotentRequest_Call {
+	return &MockService_BeginIdempotentRequest_Call{Call: _e.mock.On("BeginIdempotentRequest", ctx, req)}
 }
 
-func (_c *MockService_GetPvzsData_Call) Run(run func(ctx context.Context, params *domain.PvzsReadParams)) *MockService_GetPvzsData_Call {
+func (_c *MockService_BeginIdempotentRequest_Call) Run(run func(ctx context.Context, req *domain.IdempotentRequest)) *MockService_BeginIdempotentRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.PvzsReadParams
+		var arg1 *domain.IdempotentRequest
 		if args[1] != nil {
-			arg1 = args[1].(*domain.PvzsReadParams)
+			arg1 = args[1].(*domain.IdempotentRequest)
 		}
 		run(
 			arg0,
@@ -342,207 +423,213 @@ func (_c *MockService_GetPvzsData_Call) Run(run func(ctx context.Context, params
 	return _c
 }
 
-func (_c *MockService_GetPvzsData_Call) Return(pvzReceptionss []*domain.PvzReceptions, err error) *MockService_GetPvzsData_Call {
-	_c.Call.Return(pvzReceptionss, err)
+func (_c *MockService_BeginIdempotentRequest_Call) Return(idempotentResponse *domain.IdempotentResponse, err error) *MockService_BeginIdempotentRequest_Call {
+	_c.Call.Return(idempotentResponse, err)
 	return _c
 }
 
-func (_c *MockService_GetPvzsData_Call) RunAndReturn(run func(ctx context.Context, params *domain.PvzsReadParams) ([]*domain.PvzReceptions, error)) *MockService_GetPvzsData_Call {
+func (_c *MockService_BeginIdempotentRequest_Call) RunAndReturn(run func(ctx context.Context, req *domain.IdempotentRequest) (*domain.IdempotentResponse, error)) *MockService_BeginIdempotentRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LoginUser provides a mock function for the type MockService
-func (_mock *MockService) LoginUser(ctx context.Context, lParams *auth.LoginUserParams) (string, *auth.RefreshToken, error) {
-	ret := _mock.Called(ctx, lParams)
+// CancelReceptionInPvz provides a mock function for the type MockService
+func (_mock *MockService) CancelReceptionInPvz(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, pvzId, ifVersion)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LoginUser")
+		panic("no return value specified for CancelReceptionInPvz")
 	}
 
-	var r0 string
-	var r1 *auth.RefreshToken
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.LoginUserParams) (string, *auth.RefreshToken, error)); ok {
-		return returnFunc(ctx, lParams)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.LoginUserParams) string); ok {
-		r0 = returnFunc(ctx, lParams)
-	} else {
-		r0 = ret.Get(0).(string)
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, pvzId, ifVersion)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.LoginUserParams) *auth.RefreshToken); ok {
-		r1 = returnFunc(ctx, lParams)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) *domain.Reception); ok {
+		r0 = returnFunc(ctx, pvzId, ifVersion)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*auth.RefreshToken)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, *auth.LoginUserParams) error); ok {
-		r2 = returnFunc(ctx, lParams)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *int) error); ok {
+		r1 = returnFunc(ctx, pvzId, ifVersion)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockService_LoginUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginUser'
-type MockService_LoginUser_Call struct {
+// MockService_CancelReceptionInPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelReceptionInPvz'
+type MockService_CancelReceptionInPvz_Call struct {
 	*mock.Call
 }
 
-// LoginUser is a helper method to define mock.On call
+// CancelReceptionInPvz is a helper method to define mock.On call
 //   - ctx context.Context
-//   - lParams *auth.LoginUserParams
-func (_e *MockService_Expecter) LoginUser(ctx interface{}, lParams interface{}) *MockService_LoginUser_Call {
-	return &MockService_LoginUser_Call{Call: _e.mock.On("LoginUser", ctx, lParams)}
+//   - pvzId *uuid.UUID
+//   - ifVersion *int
+func (_e *MockService_Expecter) CancelReceptionInPvz(ctx interface{}, pvzId interface{}, ifVersion interface{}) *MockService_CancelReceptionInPvz_Call {
+	return &MockService_CancelReceptionInPvz_Call{Call: _e.mock.On("CancelReceptionInPvz", ctx, pvzId, ifVersion)}
 }
 
-func (_c *MockService_LoginUser_Call) Run(run func(ctx context.Context, lParams *auth.LoginUserParams)) *MockService_LoginUser_Call {
+func (_c *MockService_CancelReceptionInPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int)) *MockService_CancelReceptionInPvz_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *auth.LoginUserParams
+		var arg1 *uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(*auth.LoginUserParams)
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *int
+		if args[2] != nil {
+			arg2 = args[2].(*int)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockService_LoginUser_Call) Return(aToken string, rToken *auth.RefreshToken, err error) *MockService_LoginUser_Call {
-	_c.Call.Return(aToken, rToken, err)
+func (_c *MockService_CancelReceptionInPvz_Call) Return(reception *domain.Reception, err error) *MockService_CancelReceptionInPvz_Call {
+	_c.Call.Return(reception, err)
 	return _c
 }
 
-func (_c *MockService_LoginUser_Call) RunAndReturn(run func(ctx context.Context, lParams *auth.LoginUserParams) (string, *auth.RefreshToken, error)) *MockService_LoginUser_Call {
+func (_c *MockService_CancelReceptionInPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.Reception, error)) *MockService_CancelReceptionInPvz_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewPVZ provides a mock function for the type MockService
-func (_mock *MockService) NewPVZ(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error) {
-	ret := _mock.Called(ctx, pvz)
+// CloseReceptionInPvz provides a mock function for the type MockService
+func (_mock *MockService) CloseReceptionInPvz(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.ReceptionCloseReport, error) {
+	ret := _mock.Called(ctx, pvzId, ifVersion)
 
 	if len(ret) == 0 {
-		panic("no return value specified for NewPVZ")
+		panic("no return value specified for CloseReceptionInPvz")
 	}
 
-	var r0 *domain.Pvz
+	var r0 *domain.ReceptionCloseReport
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) (*domain.Pvz, error)); ok {
-		return returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) (*domain.ReceptionCloseReport, error)); ok {
+		return returnFunc(ctx, pvzId, ifVersion)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) *domain.Pvz); ok {
-		r0 = returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) *domain.ReceptionCloseReport); ok {
+		r0 = returnFunc(ctx, pvzId, ifVersion)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Pvz)
+			r0 = ret.Get(0).(*domain.ReceptionCloseReport)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Pvz) error); ok {
-		r1 = returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *int) error); ok {
+		r1 = returnFunc(ctx, pvzId, ifVersion)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockService_NewPVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewPVZ'
-type MockService_NewPVZ_Call struct {
+// MockService_CloseReceptionInPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseReceptionInPvz'
+type MockService_CloseReceptionInPvz_Call struct {
 	*mock.Call
 }
 
-// NewPVZ is a helper method to define mock.On call
+// CloseReceptionInPvz is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pvz *domain.Pvz
-func (_e *MockService_Expecter) NewPVZ(ctx interface{}, pvz interface{}) *MockService_NewPVZ_Call {
-	return &MockService_NewPVZ_Call{Call: _e.mock.On("NewPVZ", ctx, pvz)}
+//   - pvzId *uuid.UUID
+//   - ifVersion *int
+func (_e *MockService_Expecter) CloseReceptionInPvz(ctx interface{}, pvzId interface{}, ifVersion interface{}) *MockService_CloseReceptionInPvz_Call {
+	return &MockService_CloseReceptionInPvz_Call{Call: _e.mock.On("CloseReceptionInPvz", ctx, pvzId, ifVersion)}
 }
 
-func (_c *MockService_NewPVZ_Call) Run(run func(ctx context.Context, pvz *domain.Pvz)) *MockService_NewPVZ_Call {
+func (_c *MockService_CloseReceptionInPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int)) *MockService_CloseReceptionInPvz_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Pvz
+		var arg1 *uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Pvz)
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *int
+		if args[2] != nil {
+			arg2 = args[2].(*int)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockService_NewPVZ_Call) Return(pvz1 *domain.Pvz, err error) *MockService_NewPVZ_Call {
-	_c.Call.Return(pvz1, err)
+func (_c *MockService_CloseReceptionInPvz_Call) Return(receptionCloseReport *domain.ReceptionCloseReport, err error) *MockService_CloseReceptionInPvz_Call {
+	_c.Call.Return(receptionCloseReport, err)
 	return _c
 }
 
-func (_c *MockService_NewPVZ_Call) RunAndReturn(run func(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error)) *MockService_NewPVZ_Call {
+func (_c *MockService_CloseReceptionInPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.ReceptionCloseReport, error)) *MockService_CloseReceptionInPvz_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OpenNewPVZReception provides a mock function for the type MockService
-func (_mock *MockService) OpenNewPVZReception(ctx context.Context, rec *domain.Reception) (*domain.Reception, error) {
-	ret := _mock.Called(ctx, rec)
+// CreateExportJob provides a mock function for the type MockService
+func (_mock *MockService) CreateExportJob(ctx context.Context, job *domain.ExportJob) (*domain.ExportJob, error) {
+	ret := _mock.Called(ctx, job)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OpenNewPVZReception")
+		panic("no return value specified for CreateExportJob")
 	}
 
-	var r0 *domain.Reception
+	var r0 *domain.ExportJob
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) (*domain.Reception, error)); ok {
-		return returnFunc(ctx, rec)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ExportJob) (*domain.ExportJob, error)); ok {
+		return returnFunc(ctx, job)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) *domain.Reception); ok {
-		r0 = returnFunc(ctx, rec)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ExportJob) *domain.ExportJob); ok {
+		r0 = returnFunc(ctx, job)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Reception)
+			r0 = ret.Get(0).(*domain.ExportJob)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Reception) error); ok {
-		r1 = returnFunc(ctx, rec)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ExportJob) error); ok {
+		r1 = returnFunc(ctx, job)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockService_OpenNewPVZReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenNewPVZReception'
-type MockService_OpenNewPVZReception_Call struct {
+// MockService_CreateExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExportJob'
+type MockService_CreateExportJob_Call struct {
 	*mock.Call
 }
 
-// OpenNewPVZReception is a helper method to define mock.On call
+// CreateExportJob is a helper method to define mock.On call
 //   - ctx context.Context
-//   - rec *domain.Reception
-func (_e *MockService_Expecter) OpenNewPVZReception(ctx interface{}, rec interface{}) *MockService_OpenNewPVZReception_Call {
-	return &MockService_OpenNewPVZReception_Call{Call: _e.mock.On("OpenNewPVZReception", ctx, rec)}
+//   - job *domain.ExportJob
+func (_e *MockService_Expecter) CreateExportJob(ctx interface{}, job interface{}) *MockService_CreateExportJob_Call {
+	return &MockService_CreateExportJob_Call{Call: _e.mock.On("CreateExportJob", ctx, job)}
 }
 
-func (_c *MockService_OpenNewPVZReception_Call) Run(run func(ctx context.Context, rec *domain.Reception)) *MockService_OpenNewPVZReception_Call {
+func (_c *MockService_CreateExportJob_Call) Run(run func(ctx context.Context, job *domain.ExportJob)) *MockService_CreateExportJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Reception
+		var arg1 *domain.ExportJob
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Reception)
+			arg1 = args[1].(*domain.ExportJob)
 		}
 		run(
 			arg0,
@@ -552,234 +639,191 @@ func (_c *MockService_OpenNewPVZReception_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockService_OpenNewPVZReception_Call) Return(reception *domain.Reception, err error) *MockService_OpenNewPVZReception_Call {
-	_c.Call.Return(reception, err)
+func (_c *MockService_CreateExportJob_Call) Return(exportJob *domain.ExportJob, err error) *MockService_CreateExportJob_Call {
+	_c.Call.Return(exportJob, err)
 	return _c
 }
 
-func (_c *MockService_OpenNewPVZReception_Call) RunAndReturn(run func(ctx context.Context, rec *domain.Reception) (*domain.Reception, error)) *MockService_OpenNewPVZReception_Call {
+func (_c *MockService_CreateExportJob_Call) RunAndReturn(run func(ctx context.Context, job *domain.ExportJob) (*domain.ExportJob, error)) *MockService_CreateExportJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RefreshTokens provides a mock function for the type MockService
-func (_mock *MockService) RefreshTokens(ctx context.Context, providedToken *auth.RefreshToken) (string, *auth.RefreshToken, error) {
-	ret := _mock.Called(ctx, providedToken)
+// DeleteLastProductPvz provides a mock function for the type MockService
+func (_mock *MockService) DeleteLastProductPvz(ctx context.Context, pvzId *uuid.UUID, actorId *uuid.UUID) error {
+	ret := _mock.Called(ctx, pvzId, actorId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RefreshTokens")
+		panic("no return value specified for DeleteLastProductPvz")
 	}
 
-	var r0 string
-	var r1 *auth.RefreshToken
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RefreshToken) (string, *auth.RefreshToken, error)); ok {
-		return returnFunc(ctx, providedToken)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RefreshToken) string); ok {
-		r0 = returnFunc(ctx, providedToken)
-	} else {
-		r0 = ret.Get(0).(string)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.RefreshToken) *auth.RefreshToken); ok {
-		r1 = returnFunc(ctx, providedToken)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, pvzId, actorId)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*auth.RefreshToken)
-		}
+		r0 = ret.Error(0)
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, *auth.RefreshToken) error); ok {
-		r2 = returnFunc(ctx, providedToken)
-	} else {
-		r2 = ret.Error(2)
-	}
-	return r0, r1, r2
+	return r0
 }
 
-// MockService_RefreshTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshTokens'
-type MockService_RefreshTokens_Call struct {
+// MockService_DeleteLastProductPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLastProductPvz'
+type MockService_DeleteLastProductPvz_Call struct {
 	*mock.Call
 }
 
-// RefreshTokens is a helper method to define mock.On call
+// DeleteLastProductPvz is a helper method to define mock.On call
 //   - ctx context.Context
-//   - providedToken *auth.RefreshToken
-func (_e *MockService_Expecter) RefreshTokens(ctx interface{}, providedToken interface{}) *MockService_RefreshTokens_Call {
-	return &MockService_RefreshTokens_Call{Call: _e.mock.On("RefreshTokens", ctx, providedToken)}
+//   - pvzId *uuid.UUID
+//   - actorId *uuid.UUID
+func (_e *MockService_Expecter) DeleteLastProductPvz(ctx interface{}, pvzId interface{}, actorId interface{}) *MockService_DeleteLastProductPvz_Call {
+	return &MockService_DeleteLastProductPvz_Call{Call: _e.mock.On("DeleteLastProductPvz", ctx, pvzId, actorId)}
 }
 
-func (_c *MockService_RefreshTokens_Call) Run(run func(ctx context.Context, providedToken *auth.RefreshToken)) *MockService_RefreshTokens_Call {
+func (_c *MockService_DeleteLastProductPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, actorId *uuid.UUID)) *MockService_DeleteLastProductPvz_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *auth.RefreshToken
+		var arg1 *uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(*auth.RefreshToken)
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(*uuid.UUID)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockService_RefreshTokens_Call) Return(newAToken string, newRToken *auth.RefreshToken, err error) *MockService_RefreshTokens_Call {
-	_c.Call.Return(newAToken, newRToken, err)
+func (_c *MockService_DeleteLastProductPvz_Call) Return(err error) *MockService_DeleteLastProductPvz_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockService_RefreshTokens_Call) RunAndReturn(run func(ctx context.Context, providedToken *auth.RefreshToken) (string, *auth.RefreshToken, error)) *MockService_RefreshTokens_Call {
+func (_c *MockService_DeleteLastProductPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, actorId *uuid.UUID) error) *MockService_DeleteLastProductPvz_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegisterUser provides a mock function for the type MockService
-func (_mock *MockService) RegisterUser(ctx context.Context, userParams *auth.RegisterUserParams) (*auth.User, error) {
-	ret := _mock.Called(ctx, userParams)
+// DeleteProduct provides a mock function for the type MockService
+func (_mock *MockService) DeleteProduct(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID) error {
+	ret := _mock.Called(ctx, productId, actorId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegisterUser")
+		panic("no return value specified for DeleteProduct")
 	}
 
-	var r0 *auth.User
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RegisterUserParams) (*auth.User, error)); ok {
-		return returnFunc(ctx, userParams)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RegisterUserParams) *auth.User); ok {
-		r0 = returnFunc(ctx, userParams)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*auth.User)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.RegisterUserParams) error); ok {
-		r1 = returnFunc(ctx, userParams)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, productId, actorId)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockService_RegisterUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterUser'
-type MockService_RegisterUser_Call struct {
+// MockService_DeleteProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProduct'
+type MockService_DeleteProduct_Call struct {
 	*mock.Call
 }
 
-// RegisterUser is a helper method to define mock.On call
+// DeleteProduct is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userParams *auth.RegisterUserParams
-func (_e *MockService_Expecter) RegisterUser(ctx interface{}, userParams interface{}) *MockService_RegisterUser_Call {
-	return &MockService_RegisterUser_Call{Call: _e.mock.On("RegisterUser", ctx, userParams)}
+//   - productId *uuid.UUID
+//   - actorId *uuid.UUID
+func (_e *MockService_Expecter) DeleteProduct(ctx interface{}, productId interface{}, actorId interface{}) *MockService_DeleteProduct_Call {
+	return &MockService_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", ctx, productId, actorId)}
 }
 
-func (_c *MockService_RegisterUser_Call) Run(run func(ctx context.Context, userParams *auth.RegisterUserParams)) *MockService_RegisterUser_Call {
+func (_c *MockService_DeleteProduct_Call) Run(run func(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID)) *MockService_DeleteProduct_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *auth.RegisterUserParams
+		var arg1 *uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(*auth.RegisterUserParams)
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(*uuid.UUID)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockService_RegisterUser_Call) Return(user *auth.User, err error) *MockService_RegisterUser_Call {
-	_c.Call.Return(user, err)
+func (_c *MockService_DeleteProduct_Call) Return(err error) *MockService_DeleteProduct_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockService_RegisterUser_Call) RunAndReturn(run func(ctx context.Context, userParams *auth.RegisterUserParams) (*auth.User, error)) *MockService_RegisterUser_Call {
+func (_c *MockService_DeleteProduct_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID) error) *MockService_DeleteProduct_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockPvzsService creates a new instance of MockPvzsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPvzsService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPvzsService {
-	mock := &MockPvzsService{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockPvzsService is an autogenerated mock type for the PvzsService type
-type MockPvzsService struct {
-	mock.Mock
-}
-
-type MockPvzsService_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockPvzsService) EXPECT() *MockPvzsService_Expecter {
-	return &MockPvzsService_Expecter{mock: &_m.Mock}
-}
-
-// AddProductPVZ provides a mock function for the type MockPvzsService
-func (_mock *MockPvzsService) AddProductPVZ(ctx context.Context, prod *domain.Product) (*domain.Product, error) {
-	ret := _mock.Called(ctx, prod)
+// ExportJob provides a mock function for the type MockService
+func (_mock *MockService) ExportJob(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, error) {
+	ret := _mock.Called(ctx, jobId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AddProductPVZ")
+		panic("no return value specified for ExportJob")
 	}
 
-	var r0 *domain.Product
+	var r0 *domain.ExportJob
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product) (*domain.Product, error)); ok {
-		return returnFunc(ctx, prod)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.ExportJob, error)); ok {
+		return returnFunc(ctx, jobId)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product) *domain.Product); ok {
-		r0 = returnFunc(ctx, prod)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.ExportJob); ok {
+		r0 = returnFunc(ctx, jobId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Product)
+			r0 = ret.Get(0).(*domain.ExportJob)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Product) error); ok {
-		r1 = returnFunc(ctx, prod)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, jobId)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPvzsService_AddProductPVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProductPVZ'
-type MockPvzsService_AddProductPVZ_Call struct {
+// MockService_ExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportJob'
+type MockService_ExportJob_Call struct {
 	*mock.Call
 }
 
-// AddProductPVZ is a helper method to define mock.On call
+// ExportJob is a helper method to define mock.On call
 //   - ctx context.Context
-//   - prod *domain.Product
-func (_e *MockPvzsService_Expecter) AddProductPVZ(ctx interface{}, prod interface{}) *MockPvzsService_AddProductPVZ_Call {
-	return &MockPvzsService_AddProductPVZ_Call{Call: _e.mock.On("AddProductPVZ", ctx, prod)}
+//   - jobId *uuid.UUID
+func (_e *MockService_Expecter) ExportJob(ctx interface{}, jobId interface{}) *MockService_ExportJob_Call {
+	return &MockService_ExportJob_Call{Call: _e.mock.On("ExportJob", ctx, jobId)}
 }
 
-func (_c *MockPvzsService_AddProductPVZ_Call) Run(run func(ctx context.Context, prod *domain.Product)) *MockPvzsService_AddProductPVZ_Call {
+func (_c *MockService_ExportJob_Call) Run(run func(ctx context.Context, jobId *uuid.UUID)) *MockService_ExportJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Product
+		var arg1 *uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Product)
+			arg1 = args[1].(*uuid.UUID)
 		}
 		run(
 			arg0,
@@ -789,133 +833,157 @@ func (_c *MockPvzsService_AddProductPVZ_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockPvzsService_AddProductPVZ_Call) Return(product *domain.Product, err error) *MockPvzsService_AddProductPVZ_Call {
-	_c.Call.Return(product, err)
+func (_c *MockService_ExportJob_Call) Return(exportJob *domain.ExportJob, err error) *MockService_ExportJob_Call {
+	_c.Call.Return(exportJob, err)
 	return _c
 }
 
-func (_c *MockPvzsService_AddProductPVZ_Call) RunAndReturn(run func(ctx context.Context, prod *domain.Product) (*domain.Product, error)) *MockPvzsService_AddProductPVZ_Call {
+func (_c *MockService_ExportJob_Call) RunAndReturn(run func(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, error)) *MockService_ExportJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CloseReceptionInPvz provides a mock function for the type MockPvzsService
-func (_mock *MockPvzsService) CloseReceptionInPvz(ctx context.Context, pvzId *uuid.UUID) error {
-	ret := _mock.Called(ctx, pvzId)
+// ExportPvzsData provides a mock function for the type MockService
+func (_mock *MockService) ExportPvzsData(ctx context.Context, format domain.ExportFormat, filter *domain.PvzsFilter, sort domain.PvzsSort, w io.Writer) error {
+	ret := _mock.Called(ctx, format, filter, sort, w)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CloseReceptionInPvz")
+		panic("no return value specified for ExportPvzsData")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, pvzId)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ExportFormat, *domain.PvzsFilter, domain.PvzsSort, io.Writer) error); ok {
+		r0 = returnFunc(ctx, format, filter, sort, w)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockPvzsService_CloseReceptionInPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseReceptionInPvz'
-type MockPvzsService_CloseReceptionInPvz_Call struct {
+// MockService_ExportPvzsData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportPvzsData'
+type MockService_ExportPvzsData_Call struct {
 	*mock.Call
 }
 
-// CloseReceptionInPvz is a helper method to define mock.On call
+// ExportPvzsData is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pvzId *uuid.UUID
-func (_e *MockPvzsService_Expecter) CloseReceptionInPvz(ctx interface{}, pvzId interface{}) *MockPvzsService_CloseReceptionInPvz_Call {
-	return &MockPvzsService_CloseReceptionInPvz_Call{Call: _e.mock.On("CloseReceptionInPvz", ctx, pvzId)}
+//   - format domain.ExportFormat
+//   - filter *domain.PvzsFilter
+//   - sort domain.PvzsSort
+//   - w io.Writer
+func (_e *MockService_Expecter) ExportPvzsData(ctx interface{}, format interface{}, filter interface{}, sort interface{}, w interface{}) *MockService_ExportPvzsData_Call {
+	return &MockService_ExportPvzsData_Call{Call: _e.mock.On("ExportPvzsData", ctx, format, filter, sort, w)}
 }
 
-func (_c *MockPvzsService_CloseReceptionInPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockPvzsService_CloseReceptionInPvz_Call {
+func (_c *MockService_ExportPvzsData_Call) Run(run func(ctx context.Context, format domain.ExportFormat, filter *domain.PvzsFilter, sort domain.PvzsSort, w io.Writer)) *MockService_ExportPvzsData_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *uuid.UUID
+		var arg1 domain.ExportFormat
 		if args[1] != nil {
-			arg1 = args[1].(*uuid.UUID)
+			arg1 = args[1].(domain.ExportFormat)
+		}
+		var arg2 *domain.PvzsFilter
+		if args[2] != nil {
+			arg2 = args[2].(*domain.PvzsFilter)
+		}
+		var arg3 domain.PvzsSort
+		if args[3] != nil {
+			arg3 = args[3].(domain.PvzsSort)
+		}
+		var arg4 io.Writer
+		if args[4] != nil {
+			arg4 = args[4].(io.Writer)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPvzsService_CloseReceptionInPvz_Call) Return(err error) *MockPvzsService_CloseReceptionInPvz_Call {
+func (_c *MockService_ExportPvzsData_Call) Return(err error) *MockService_ExportPvzsData_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPvzsService_CloseReceptionInPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) error) *MockPvzsService_CloseReceptionInPvz_Call {
+func (_c *MockService_ExportPvzsData_Call) RunAndReturn(run func(ctx context.Context, format domain.ExportFormat, filter *domain.PvzsFilter, sort domain.PvzsSort, w io.Writer) error) *MockService_ExportPvzsData_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteLastProductPvz provides a mock function for the type MockPvzsService
-func (_mock *MockPvzsService) DeleteLastProductPvz(ctx context.Context, pvzId *uuid.UUID) error {
-	ret := _mock.Called(ctx, pvzId)
+// FinishIdempotentRequest provides a mock function for the type MockService
+func (_mock *MockService) FinishIdempotentRequest(ctx context.Context, req *domain.IdempotentRequest, resp *domain.IdempotentResponse) error {
+	ret := _mock.Called(ctx, req, resp)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteLastProductPvz")
+		panic("no return value specified for FinishIdempotentRequest")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, pvzId)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotentRequest, *domain.IdempotentResponse) error); ok {
+		r0 = returnFunc(ctx, req, resp)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockPvzsService_DeleteLastProductPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLastProductPvz'
-type MockPvzsService_DeleteLastProductPvz_Call struct {
+// MockService_FinishIdempotentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishIdempotentRequest'
+type MockService_FinishIdempotentRequest_Call struct {
 	*mock.Call
 }
 
-// DeleteLastProductPvz is a helper method to define mock.On call
+// FinishIdempotentRequest is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pvzId *uuid.UUID
-func (_e *MockPvzsService_Expecter) DeleteLastProductPvz(ctx interface{}, pvzId interface{}) *MockPvzsService_DeleteLastProductPvz_Call {
-	return &MockPvzsService_DeleteLastProductPvz_Call{Call: _e.mock.On("DeleteLastProductPvz", ctx, pvzId)}
+//   - req *domain.IdempotentRequest
+//   - resp *domain.IdempotentResponse
+func (_e *MockService_Expecter) FinishIdempotentRequest(ctx interface{}, req interface{}, resp interface{}) *MockService_FinishIdempotentRequest_Call {
+	return &MockService_FinishIdempotentRequest_Call{Call: _e.mock.On("FinishIdempotentRequest", ctx, req, resp)}
 }
 
-func (_c *MockPvzsService_DeleteLastProductPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockPvzsService_DeleteLastProductPvz_Call {
+func (_c *MockService_FinishIdempotentRequest_Call) Run(run func(ctx context.Context, req *domain.IdempotentRequest, resp *domain.IdempotentResponse)) *MockService_FinishIdempotentRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *uuid.UUID
+		var arg1 *domain.IdempotentRequest
 		if args[1] != nil {
-			arg1 = args[1].(*uuid.UUID)
+			arg1 = args[1].(*domain.IdempotentRequest)
+		}
+		var arg2 *domain.IdempotentResponse
+		if args[2] != nil {
+			arg2 = args[2].(*domain.IdempotentResponse)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPvzsService_DeleteLastProductPvz_Call) Return(err error) *MockPvzsService_DeleteLastProductPvz_Call {
+func (_c *MockService_FinishIdempotentRequest_Call) Return(err error) *MockService_FinishIdempotentRequest_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockPvzsService_DeleteLastProductPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) error) *MockPvzsService_DeleteLastProductPvz_Call {
+func (_c *MockService_FinishIdempotentRequest_Call) RunAndReturn(run func(ctx context.Context, req *domain.IdempotentRequest, resp *domain.IdempotentResponse) error) *MockService_FinishIdempotentRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAllPvzs provides a mock function for the type MockPvzsService
-func (_mock *MockPvzsService) GetAllPvzs(ctx context.Context) ([]*domain.Pvz, error) {
-	ret := _mock.Called(ctx)
+// GetAllPvzs provides a mock function for the type MockService
+func (_mock *MockService) GetAllPvzs(ctx context.Context, filter *domain.PvzsFilter, sort domain.PvzsSort) ([]*domain.Pvz, error) {
+	ret := _mock.Called(ctx, filter, sort)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllPvzs")
@@ -923,76 +991,88 @@ func (_mock *MockPvzsService) GetAllPvzs(ctx context.Context) ([]*domain.Pvz, er
 
 	var r0 []*domain.Pvz
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Pvz, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsFilter, domain.PvzsSort) ([]*domain.Pvz, error)); ok {
+		return returnFunc(ctx, filter, sort)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Pvz); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsFilter, domain.PvzsSort) []*domain.Pvz); ok {
+		r0 = returnFunc(ctx, filter, sort)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*domain.Pvz)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PvzsFilter, domain.PvzsSort) error); ok {
+		r1 = returnFunc(ctx, filter, sort)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPvzsService_GetAllPvzs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllPvzs'
-type MockPvzsService_GetAllPvzs_Call struct {
+// MockService_GetAllPvzs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllPvzs'
+type MockService_GetAllPvzs_Call struct {
 	*mock.Call
 }
 
 // GetAllPvzs is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockPvzsService_Expecter) GetAllPvzs(ctx interface{}) *MockPvzsService_GetAllPvzs_Call {
-	return &MockPvzsService_GetAllPvzs_Call{Call: _e.mock.On("GetAllPvzs", ctx)}
+//   - filter *domain.PvzsFilter
+//   - sort domain.PvzsSort
+func (_e *MockService_Expecter) GetAllPvzs(ctx interface{}, filter interface{}, sort interface{}) *MockService_GetAllPvzs_Call {
+	return &MockService_GetAllPvzs_Call{Call: _e.mock.On("GetAllPvzs", ctx, filter, sort)}
 }
 
-func (_c *MockPvzsService_GetAllPvzs_Call) Run(run func(ctx context.Context)) *MockPvzsService_GetAllPvzs_Call {
+func (_c *MockService_GetAllPvzs_Call) Run(run func(ctx context.Context, filter *domain.PvzsFilter, sort domain.PvzsSort)) *MockService_GetAllPvzs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 *domain.PvzsFilter
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PvzsFilter)
+		}
+		var arg2 domain.PvzsSort
+		if args[2] != nil {
+			arg2 = args[2].(domain.PvzsSort)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPvzsService_GetAllPvzs_Call) Return(pvzs []*domain.Pvz, err error) *MockPvzsService_GetAllPvzs_Call {
+func (_c *MockService_GetAllPvzs_Call) Return(pvzs []*domain.Pvz, err error) *MockService_GetAllPvzs_Call {
 	_c.Call.Return(pvzs, err)
 	return _c
 }
 
-func (_c *MockPvzsService_GetAllPvzs_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Pvz, error)) *MockPvzsService_GetAllPvzs_Call {
+func (_c *MockService_GetAllPvzs_Call) RunAndReturn(run func(ctx context.Context, filter *domain.PvzsFilter, sort domain.PvzsSort) ([]*domain.Pvz, error)) *MockService_GetAllPvzs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPvzsData provides a mock function for the type MockPvzsService
-func (_mock *MockPvzsService) GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) ([]*domain.PvzReceptions, error) {
+// GetPvzsData provides a mock function for the type MockService
+func (_mock *MockService) GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) (*domain.PvzsPage, error) {
 	ret := _mock.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPvzsData")
 	}
 
-	var r0 []*domain.PvzReceptions
+	var r0 *domain.PvzsPage
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) ([]*domain.PvzReceptions, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) (*domain.PvzsPage, error)); ok {
 		return returnFunc(ctx, params)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) []*domain.PvzReceptions); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) *domain.PvzsPage); ok {
 		r0 = returnFunc(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.PvzReceptions)
+			r0 = ret.Get(0).(*domain.PvzsPage)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PvzsReadParams) error); ok {
@@ -1003,19 +1083,19 @@ func (_mock *MockPvzsService) GetPvzsData(ctx context.Context, params *domain.Pv
 	return r0, r1
 }
 
-// MockPvzsService_GetPvzsData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvzsData'
-type MockPvzsService_GetPvzsData_Call struct {
+// MockService_GetPvzsData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvzsData'
+type MockService_GetPvzsData_Call struct {
 	*mock.Call
 }
 
 // GetPvzsData is a helper method to define mock.On call
 //   - ctx context.Context
 //   - params *domain.PvzsReadParams
-func (_e *MockPvzsService_Expecter) GetPvzsData(ctx interface{}, params interface{}) *MockPvzsService_GetPvzsData_Call {
-	return &MockPvzsService_GetPvzsData_Call{Call: _e.mock.On("GetPvzsData", ctx, params)}
+func (_e *MockService_Expecter) GetPvzsData(ctx interface{}, params interface{}) *MockService_GetPvzsData_Call {
+	return &MockService_GetPvzsData_Call{Call: _e.mock.On("GetPvzsData", ctx, params)}
 }
 
-func (_c *MockPvzsService_GetPvzsData_Call) Run(run func(ctx context.Context, params *domain.PvzsReadParams)) *MockPvzsService_GetPvzsData_Call {
+func (_c *MockService_GetPvzsData_Call) Run(run func(ctx context.Context, params *domain.PvzsReadParams)) *MockService_GetPvzsData_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1033,65 +1113,63 @@ func (_c *MockPvzsService_GetPvzsData_Call) Run(run func(ctx context.Context, pa
 	return _c
 }
 
-func (_c *MockPvzsService_GetPvzsData_Call) Return(pvzReceptionss []*domain.PvzReceptions, err error) *MockPvzsService_GetPvzsData_Call {
-	_c.Call.Return(pvzReceptionss, err)
+func (_c *MockService_GetPvzsData_Call) Return(pvzsPage *domain.PvzsPage, err error) *MockService_GetPvzsData_Call {
+	_c.Call.Return(pvzsPage, err)
 	return _c
 }
 
-func (_c *MockPvzsService_GetPvzsData_Call) RunAndReturn(run func(ctx context.Context, params *domain.PvzsReadParams) ([]*domain.PvzReceptions, error)) *MockPvzsService_GetPvzsData_Call {
+func (_c *MockService_GetPvzsData_Call) RunAndReturn(run func(ctx context.Context, params *domain.PvzsReadParams) (*domain.PvzsPage, error)) *MockService_GetPvzsData_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewPVZ provides a mock function for the type MockPvzsService
-func (_mock *MockPvzsService) NewPVZ(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error) {
-	ret := _mock.Called(ctx, pvz)
+// IssuePickupCode provides a mock function for the type MockService
+func (_mock *MockService) IssuePickupCode(ctx context.Context, productId *uuid.UUID) (string, error) {
+	ret := _mock.Called(ctx, productId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for NewPVZ")
+		panic("no return value specified for IssuePickupCode")
 	}
 
-	var r0 *domain.Pvz
+	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) (*domain.Pvz, error)); ok {
-		return returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (string, error)); ok {
+		return returnFunc(ctx, productId)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) *domain.Pvz); ok {
-		r0 = returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) string); ok {
+		r0 = returnFunc(ctx, productId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Pvz)
-		}
+		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Pvz) error); ok {
-		r1 = returnFunc(ctx, pvz)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productId)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPvzsService_NewPVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewPVZ'
-type MockPvzsService_NewPVZ_Call struct {
+// MockService_IssuePickupCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssuePickupCode'
+type MockService_IssuePickupCode_Call struct {
 	*mock.Call
 }
 
-// NewPVZ is a helper method to define mock.On call
+// IssuePickupCode is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pvz *domain.Pvz
-func (_e *MockPvzsService_Expecter) NewPVZ(ctx interface{}, pvz interface{}) *MockPvzsService_NewPVZ_Call {
-	return &MockPvzsService_NewPVZ_Call{Call: _e.mock.On("NewPVZ", ctx, pvz)}
+//   - productId *uuid.UUID
+func (_e *MockService_Expecter) IssuePickupCode(ctx interface{}, productId interface{}) *MockService_IssuePickupCode_Call {
+	return &MockService_IssuePickupCode_Call{Call: _e.mock.On("IssuePickupCode", ctx, productId)}
 }
 
-func (_c *MockPvzsService_NewPVZ_Call) Run(run func(ctx context.Context, pvz *domain.Pvz)) *MockPvzsService_NewPVZ_Call {
+func (_c *MockService_IssuePickupCode_Call) Run(run func(ctx context.Context, productId *uuid.UUID)) *MockService_IssuePickupCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Pvz
+		var arg1 *uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Pvz)
+			arg1 = args[1].(*uuid.UUID)
 		}
 		run(
 			arg0,
@@ -1101,80 +1179,4401 @@ func (_c *MockPvzsService_NewPVZ_Call) Run(run func(ctx context.Context, pvz *do
 	return _c
 }
 
-func (_c *MockPvzsService_NewPVZ_Call) Return(pvz1 *domain.Pvz, err error) *MockPvzsService_NewPVZ_Call {
-	_c.Call.Return(pvz1, err)
+func (_c *MockService_IssuePickupCode_Call) Return(s string, err error) *MockService_IssuePickupCode_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockPvzsService_NewPVZ_Call) RunAndReturn(run func(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error)) *MockPvzsService_NewPVZ_Call {
+func (_c *MockService_IssuePickupCode_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID) (string, error)) *MockService_IssuePickupCode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OpenNewPVZReception provides a mock function for the type MockPvzsService
-func (_mock *MockPvzsService) OpenNewPVZReception(ctx context.Context, rec *domain.Reception) (*domain.Reception, error) {
-	ret := _mock.Called(ctx, rec)
+// IssueProduct provides a mock function for the type MockService
+func (_mock *MockService) IssueProduct(ctx context.Context, productId *uuid.UUID, pickupCode string, actorId *uuid.UUID) (*domain.Product, error) {
+	ret := _mock.Called(ctx, productId, pickupCode, actorId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OpenNewPVZReception")
+		panic("no return value specified for IssueProduct")
 	}
 
-	var r0 *domain.Reception
+	var r0 *domain.Product
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) (*domain.Reception, error)); ok {
-		return returnFunc(ctx, rec)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string, *uuid.UUID) (*domain.Product, error)); ok {
+		return returnFunc(ctx, productId, pickupCode, actorId)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) *domain.Reception); ok {
-		r0 = returnFunc(ctx, rec)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string, *uuid.UUID) *domain.Product); ok {
+		r0 = returnFunc(ctx, productId, pickupCode, actorId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Reception)
+			r0 = ret.Get(0).(*domain.Product)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Reception) error); ok {
-		r1 = returnFunc(ctx, rec)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, string, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productId, pickupCode, actorId)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPvzsService_OpenNewPVZReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenNewPVZReception'
-type MockPvzsService_OpenNewPVZReception_Call struct {
+// MockService_IssueProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueProduct'
+type MockService_IssueProduct_Call struct {
 	*mock.Call
 }
 
-// OpenNewPVZReception is a helper method to define mock.On call
+// IssueProduct is a helper method to define mock.On call
 //   - ctx context.Context
-//   - rec *domain.Reception
-func (_e *MockPvzsService_Expecter) OpenNewPVZReception(ctx interface{}, rec interface{}) *MockPvzsService_OpenNewPVZReception_Call {
-	return &MockPvzsService_OpenNewPVZReception_Call{Call: _e.mock.On("OpenNewPVZReception", ctx, rec)}
+//   - productId *uuid.UUID
+//   - pickupCode string
+//   - actorId *uuid.UUID
+func (_e *MockService_Expecter) IssueProduct(ctx interface{}, productId interface{}, pickupCode interface{}, actorId interface{}) *MockService_IssueProduct_Call {
+	return &MockService_IssueProduct_Call{Call: _e.mock.On("IssueProduct", ctx, productId, pickupCode, actorId)}
 }
 
-func (_c *MockPvzsService_OpenNewPVZReception_Call) Run(run func(ctx context.Context, rec *domain.Reception)) *MockPvzsService_OpenNewPVZReception_Call {
+func (_c *MockService_IssueProduct_Call) Run(run func(ctx context.Context, productId *uuid.UUID, pickupCode string, actorId *uuid.UUID)) *MockService_IssueProduct_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Reception
+		var arg1 *uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Reception)
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *uuid.UUID
+		if args[3] != nil {
+			arg3 = args[3].(*uuid.UUID)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPvzsService_OpenNewPVZReception_Call) Return(reception *domain.Reception, err error) *MockPvzsService_OpenNewPVZReception_Call {
-	_c.Call.Return(reception, err)
+func (_c *MockService_IssueProduct_Call) Return(product *domain.Product, err error) *MockService_IssueProduct_Call {
+	_c.Call.Return(product, err)
 	return _c
 }
 
-func (_c *MockPvzsService_OpenNewPVZReception_Call) RunAndReturn(run func(ctx context.Context, rec *domain.Reception) (*domain.Reception, error)) *MockPvzsService_OpenNewPVZReception_Call {
+func (_c *MockService_IssueProduct_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID, pickupCode string, actorId *uuid.UUID) (*domain.Product, error)) *MockService_IssueProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginUser provides a mock function for the type MockService
+func (_mock *MockService) LoginUser(ctx context.Context, lParams *auth.LoginUserParams) (string, *auth.RefreshToken, error) {
+	ret := _mock.Called(ctx, lParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginUser")
+	}
+
+	var r0 string
+	var r1 *auth.RefreshToken
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.LoginUserParams) (string, *auth.RefreshToken, error)); ok {
+		return returnFunc(ctx, lParams)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.LoginUserParams) string); ok {
+		r0 = returnFunc(ctx, lParams)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.LoginUserParams) *auth.RefreshToken); ok {
+		r1 = returnFunc(ctx, lParams)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*auth.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *auth.LoginUserParams) error); ok {
+		r2 = returnFunc(ctx, lParams)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockService_LoginUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginUser'
+type MockService_LoginUser_Call struct {
+	*mock.Call
+}
+
+// LoginUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lParams *auth.LoginUserParams
+func (_e *MockService_Expecter) LoginUser(ctx interface{}, lParams interface{}) *MockService_LoginUser_Call {
+	return &MockService_LoginUser_Call{Call: _e.mock.On("LoginUser", ctx, lParams)}
+}
+
+func (_c *MockService_LoginUser_Call) Run(run func(ctx context.Context, lParams *auth.LoginUserParams)) *MockService_LoginUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.LoginUserParams
+		if args[1] != nil {
+			arg1 = args[1].(*auth.LoginUserParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_LoginUser_Call) Return(aToken string, rToken *auth.RefreshToken, err error) *MockService_LoginUser_Call {
+	_c.Call.Return(aToken, rToken, err)
+	return _c
+}
+
+func (_c *MockService_LoginUser_Call) RunAndReturn(run func(ctx context.Context, lParams *auth.LoginUserParams) (string, *auth.RefreshToken, error)) *MockService_LoginUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPVZ provides a mock function for the type MockService
+func (_mock *MockService) NewPVZ(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error) {
+	ret := _mock.Called(ctx, pvz)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewPVZ")
+	}
+
+	var r0 *domain.Pvz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) (*domain.Pvz, error)); ok {
+		return returnFunc(ctx, pvz)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) *domain.Pvz); ok {
+		r0 = returnFunc(ctx, pvz)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Pvz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Pvz) error); ok {
+		r1 = returnFunc(ctx, pvz)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_NewPVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewPVZ'
+type MockService_NewPVZ_Call struct {
+	*mock.Call
+}
+
+// NewPVZ is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvz *domain.Pvz
+func (_e *MockService_Expecter) NewPVZ(ctx interface{}, pvz interface{}) *MockService_NewPVZ_Call {
+	return &MockService_NewPVZ_Call{Call: _e.mock.On("NewPVZ", ctx, pvz)}
+}
+
+func (_c *MockService_NewPVZ_Call) Run(run func(ctx context.Context, pvz *domain.Pvz)) *MockService_NewPVZ_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Pvz
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Pvz)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_NewPVZ_Call) Return(pvz1 *domain.Pvz, err error) *MockService_NewPVZ_Call {
+	_c.Call.Return(pvz1, err)
+	return _c
+}
+
+func (_c *MockService_NewPVZ_Call) RunAndReturn(run func(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error)) *MockService_NewPVZ_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenExportFile provides a mock function for the type MockService
+func (_mock *MockService) OpenExportFile(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, io.ReadCloser, error) {
+	ret := _mock.Called(ctx, jobId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenExportFile")
+	}
+
+	var r0 *domain.ExportJob
+	var r1 io.ReadCloser
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.ExportJob, io.ReadCloser, error)); ok {
+		return returnFunc(ctx, jobId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.ExportJob); ok {
+		r0 = returnFunc(ctx, jobId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExportJob)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) io.ReadCloser); ok {
+		r1 = returnFunc(ctx, jobId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, jobId)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockService_OpenExportFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenExportFile'
+type MockService_OpenExportFile_Call struct {
+	*mock.Call
+}
+
+// OpenExportFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobId *uuid.UUID
+func (_e *MockService_Expecter) OpenExportFile(ctx interface{}, jobId interface{}) *MockService_OpenExportFile_Call {
+	return &MockService_OpenExportFile_Call{Call: _e.mock.On("OpenExportFile", ctx, jobId)}
+}
+
+func (_c *MockService_OpenExportFile_Call) Run(run func(ctx context.Context, jobId *uuid.UUID)) *MockService_OpenExportFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_OpenExportFile_Call) Return(exportJob *domain.ExportJob, readCloser io.ReadCloser, err error) *MockService_OpenExportFile_Call {
+	_c.Call.Return(exportJob, readCloser, err)
+	return _c
+}
+
+func (_c *MockService_OpenExportFile_Call) RunAndReturn(run func(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, io.ReadCloser, error)) *MockService_OpenExportFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenNewPVZReception provides a mock function for the type MockService
+func (_mock *MockService) OpenNewPVZReception(ctx context.Context, rec *domain.Reception) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, rec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenNewPVZReception")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, rec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) *domain.Reception); ok {
+		r0 = returnFunc(ctx, rec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Reception) error); ok {
+		r1 = returnFunc(ctx, rec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_OpenNewPVZReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenNewPVZReception'
+type MockService_OpenNewPVZReception_Call struct {
+	*mock.Call
+}
+
+// OpenNewPVZReception is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rec *domain.Reception
+func (_e *MockService_Expecter) OpenNewPVZReception(ctx interface{}, rec interface{}) *MockService_OpenNewPVZReception_Call {
+	return &MockService_OpenNewPVZReception_Call{Call: _e.mock.On("OpenNewPVZReception", ctx, rec)}
+}
+
+func (_c *MockService_OpenNewPVZReception_Call) Run(run func(ctx context.Context, rec *domain.Reception)) *MockService_OpenNewPVZReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Reception
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Reception)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_OpenNewPVZReception_Call) Return(reception *domain.Reception, err error) *MockService_OpenNewPVZReception_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockService_OpenNewPVZReception_Call) RunAndReturn(run func(ctx context.Context, rec *domain.Reception) (*domain.Reception, error)) *MockService_OpenNewPVZReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenReceptionsCount provides a mock function for the type MockService
+func (_mock *MockService) OpenReceptionsCount(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenReceptionsCount")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_OpenReceptionsCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenReceptionsCount'
+type MockService_OpenReceptionsCount_Call struct {
+	*mock.Call
+}
+
+// OpenReceptionsCount is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockService_Expecter) OpenReceptionsCount(ctx interface{}) *MockService_OpenReceptionsCount_Call {
+	return &MockService_OpenReceptionsCount_Call{Call: _e.mock.On("OpenReceptionsCount", ctx)}
+}
+
+func (_c *MockService_OpenReceptionsCount_Call) Run(run func(ctx context.Context)) *MockService_OpenReceptionsCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_OpenReceptionsCount_Call) Return(n int, err error) *MockService_OpenReceptionsCount_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockService_OpenReceptionsCount_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockService_OpenReceptionsCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductDeletions provides a mock function for the type MockService
+func (_mock *MockService) ProductDeletions(ctx context.Context, receptionId *uuid.UUID) ([]*domain.ProductDeletion, error) {
+	ret := _mock.Called(ctx, receptionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductDeletions")
+	}
+
+	var r0 []*domain.ProductDeletion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) ([]*domain.ProductDeletion, error)); ok {
+		return returnFunc(ctx, receptionId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) []*domain.ProductDeletion); ok {
+		r0 = returnFunc(ctx, receptionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductDeletion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, receptionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_ProductDeletions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductDeletions'
+type MockService_ProductDeletions_Call struct {
+	*mock.Call
+}
+
+// ProductDeletions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receptionId *uuid.UUID
+func (_e *MockService_Expecter) ProductDeletions(ctx interface{}, receptionId interface{}) *MockService_ProductDeletions_Call {
+	return &MockService_ProductDeletions_Call{Call: _e.mock.On("ProductDeletions", ctx, receptionId)}
+}
+
+func (_c *MockService_ProductDeletions_Call) Run(run func(ctx context.Context, receptionId *uuid.UUID)) *MockService_ProductDeletions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_ProductDeletions_Call) Return(productDeletions []*domain.ProductDeletion, err error) *MockService_ProductDeletions_Call {
+	_c.Call.Return(productDeletions, err)
+	return _c
+}
+
+func (_c *MockService_ProductDeletions_Call) RunAndReturn(run func(ctx context.Context, receptionId *uuid.UUID) ([]*domain.ProductDeletion, error)) *MockService_ProductDeletions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductStatusHistory provides a mock function for the type MockService
+func (_mock *MockService) ProductStatusHistory(ctx context.Context, productId *uuid.UUID) ([]*domain.ProductStatusChange, error) {
+	ret := _mock.Called(ctx, productId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductStatusHistory")
+	}
+
+	var r0 []*domain.ProductStatusChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) ([]*domain.ProductStatusChange, error)); ok {
+		return returnFunc(ctx, productId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) []*domain.ProductStatusChange); ok {
+		r0 = returnFunc(ctx, productId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductStatusChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_ProductStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductStatusHistory'
+type MockService_ProductStatusHistory_Call struct {
+	*mock.Call
+}
+
+// ProductStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+func (_e *MockService_Expecter) ProductStatusHistory(ctx interface{}, productId interface{}) *MockService_ProductStatusHistory_Call {
+	return &MockService_ProductStatusHistory_Call{Call: _e.mock.On("ProductStatusHistory", ctx, productId)}
+}
+
+func (_c *MockService_ProductStatusHistory_Call) Run(run func(ctx context.Context, productId *uuid.UUID)) *MockService_ProductStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_ProductStatusHistory_Call) Return(productStatusChanges []*domain.ProductStatusChange, err error) *MockService_ProductStatusHistory_Call {
+	_c.Call.Return(productStatusChanges, err)
+	return _c
+}
+
+func (_c *MockService_ProductStatusHistory_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID) ([]*domain.ProductStatusChange, error)) *MockService_ProductStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductsByBarcode provides a mock function for the type MockService
+func (_mock *MockService) ProductsByBarcode(ctx context.Context, barcode string) ([]*domain.ProductInfo, error) {
+	ret := _mock.Called(ctx, barcode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductsByBarcode")
+	}
+
+	var r0 []*domain.ProductInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.ProductInfo, error)); ok {
+		return returnFunc(ctx, barcode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.ProductInfo); ok {
+		r0 = returnFunc(ctx, barcode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductInfo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, barcode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_ProductsByBarcode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductsByBarcode'
+type MockService_ProductsByBarcode_Call struct {
+	*mock.Call
+}
+
+// ProductsByBarcode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - barcode string
+func (_e *MockService_Expecter) ProductsByBarcode(ctx interface{}, barcode interface{}) *MockService_ProductsByBarcode_Call {
+	return &MockService_ProductsByBarcode_Call{Call: _e.mock.On("ProductsByBarcode", ctx, barcode)}
+}
+
+func (_c *MockService_ProductsByBarcode_Call) Run(run func(ctx context.Context, barcode string)) *MockService_ProductsByBarcode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_ProductsByBarcode_Call) Return(productInfos []*domain.ProductInfo, err error) *MockService_ProductsByBarcode_Call {
+	_c.Call.Return(productInfos, err)
+	return _c
+}
+
+func (_c *MockService_ProductsByBarcode_Call) RunAndReturn(run func(ctx context.Context, barcode string) ([]*domain.ProductInfo, error)) *MockService_ProductsByBarcode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductsThroughput provides a mock function for the type MockService
+func (_mock *MockService) ProductsThroughput(ctx context.Context, params *domain.ThroughputParams) ([]*domain.ThroughputPoint, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductsThroughput")
+	}
+
+	var r0 []*domain.ThroughputPoint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ThroughputParams) ([]*domain.ThroughputPoint, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ThroughputParams) []*domain.ThroughputPoint); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ThroughputPoint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ThroughputParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_ProductsThroughput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductsThroughput'
+type MockService_ProductsThroughput_Call struct {
+	*mock.Call
+}
+
+// ProductsThroughput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *domain.ThroughputParams
+func (_e *MockService_Expecter) ProductsThroughput(ctx interface{}, params interface{}) *MockService_ProductsThroughput_Call {
+	return &MockService_ProductsThroughput_Call{Call: _e.mock.On("ProductsThroughput", ctx, params)}
+}
+
+func (_c *MockService_ProductsThroughput_Call) Run(run func(ctx context.Context, params *domain.ThroughputParams)) *MockService_ProductsThroughput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ThroughputParams
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ThroughputParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_ProductsThroughput_Call) Return(throughputPoints []*domain.ThroughputPoint, err error) *MockService_ProductsThroughput_Call {
+	_c.Call.Return(throughputPoints, err)
+	return _c
+}
+
+func (_c *MockService_ProductsThroughput_Call) RunAndReturn(run func(ctx context.Context, params *domain.ThroughputParams) ([]*domain.ThroughputPoint, error)) *MockService_ProductsThroughput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pvz provides a mock function for the type MockService
+func (_mock *MockService) Pvz(ctx context.Context, pvzId *uuid.UUID) (*domain.Pvz, error) {
+	ret := _mock.Called(ctx, pvzId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pvz")
+	}
+
+	var r0 *domain.Pvz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.Pvz, error)); ok {
+		return returnFunc(ctx, pvzId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.Pvz); ok {
+		r0 = returnFunc(ctx, pvzId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Pvz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvzId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_Pvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pvz'
+type MockService_Pvz_Call struct {
+	*mock.Call
+}
+
+// Pvz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+func (_e *MockService_Expecter) Pvz(ctx interface{}, pvzId interface{}) *MockService_Pvz_Call {
+	return &MockService_Pvz_Call{Call: _e.mock.On("Pvz", ctx, pvzId)}
+}
+
+func (_c *MockService_Pvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockService_Pvz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_Pvz_Call) Return(pvz *domain.Pvz, err error) *MockService_Pvz_Call {
+	_c.Call.Return(pvz, err)
+	return _c
+}
+
+func (_c *MockService_Pvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) (*domain.Pvz, error)) *MockService_Pvz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PvzStock provides a mock function for the type MockService
+func (_mock *MockService) PvzStock(ctx context.Context, pvzId *uuid.UUID) (*domain.PvzStock, error) {
+	ret := _mock.Called(ctx, pvzId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PvzStock")
+	}
+
+	var r0 *domain.PvzStock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.PvzStock, error)); ok {
+		return returnFunc(ctx, pvzId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.PvzStock); ok {
+		r0 = returnFunc(ctx, pvzId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PvzStock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvzId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_PvzStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PvzStock'
+type MockService_PvzStock_Call struct {
+	*mock.Call
+}
+
+// PvzStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+func (_e *MockService_Expecter) PvzStock(ctx interface{}, pvzId interface{}) *MockService_PvzStock_Call {
+	return &MockService_PvzStock_Call{Call: _e.mock.On("PvzStock", ctx, pvzId)}
+}
+
+func (_c *MockService_PvzStock_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockService_PvzStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_PvzStock_Call) Return(pvzStock *domain.PvzStock, err error) *MockService_PvzStock_Call {
+	_c.Call.Return(pvzStock, err)
+	return _c
+}
+
+func (_c *MockService_PvzStock_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) (*domain.PvzStock, error)) *MockService_PvzStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reception provides a mock function for the type MockService
+func (_mock *MockService) Reception(ctx context.Context, receptionId *uuid.UUID) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, receptionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reception")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, receptionId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.Reception); ok {
+		r0 = returnFunc(ctx, receptionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, receptionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_Reception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reception'
+type MockService_Reception_Call struct {
+	*mock.Call
+}
+
+// Reception is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receptionId *uuid.UUID
+func (_e *MockService_Expecter) Reception(ctx interface{}, receptionId interface{}) *MockService_Reception_Call {
+	return &MockService_Reception_Call{Call: _e.mock.On("Reception", ctx, receptionId)}
+}
+
+func (_c *MockService_Reception_Call) Run(run func(ctx context.Context, receptionId *uuid.UUID)) *MockService_Reception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_Reception_Call) Return(reception *domain.Reception, err error) *MockService_Reception_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockService_Reception_Call) RunAndReturn(run func(ctx context.Context, receptionId *uuid.UUID) (*domain.Reception, error)) *MockService_Reception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceptionStats provides a mock function for the type MockService
+func (_mock *MockService) ReceptionStats(ctx context.Context, params *domain.ReceptionStatsParams) ([]*domain.ReceptionStats, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceptionStats")
+	}
+
+	var r0 []*domain.ReceptionStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionStatsParams) ([]*domain.ReceptionStats, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionStatsParams) []*domain.ReceptionStats); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ReceptionStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ReceptionStatsParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_ReceptionStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceptionStats'
+type MockService_ReceptionStats_Call struct {
+	*mock.Call
+}
+
+// ReceptionStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *domain.ReceptionStatsParams
+func (_e *MockService_Expecter) ReceptionStats(ctx interface{}, params interface{}) *MockService_ReceptionStats_Call {
+	return &MockService_ReceptionStats_Call{Call: _e.mock.On("ReceptionStats", ctx, params)}
+}
+
+func (_c *MockService_ReceptionStats_Call) Run(run func(ctx context.Context, params *domain.ReceptionStatsParams)) *MockService_ReceptionStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ReceptionStatsParams
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ReceptionStatsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_ReceptionStats_Call) Return(receptionStatss []*domain.ReceptionStats, err error) *MockService_ReceptionStats_Call {
+	_c.Call.Return(receptionStatss, err)
+	return _c
+}
+
+func (_c *MockService_ReceptionStats_Call) RunAndReturn(run func(ctx context.Context, params *domain.ReceptionStatsParams) ([]*domain.ReceptionStats, error)) *MockService_ReceptionStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshDailyStats provides a mock function for the type MockService
+func (_mock *MockService) RefreshDailyStats(ctx context.Context, from time.Time, to time.Time) error {
+	ret := _mock.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshDailyStats")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) error); ok {
+		r0 = returnFunc(ctx, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_RefreshDailyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshDailyStats'
+type MockService_RefreshDailyStats_Call struct {
+	*mock.Call
+}
+
+// RefreshDailyStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *MockService_Expecter) RefreshDailyStats(ctx interface{}, from interface{}, to interface{}) *MockService_RefreshDailyStats_Call {
+	return &MockService_RefreshDailyStats_Call{Call: _e.mock.On("RefreshDailyStats", ctx, from, to)}
+}
+
+func (_c *MockService_RefreshDailyStats_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *MockService_RefreshDailyStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_RefreshDailyStats_Call) Return(err error) *MockService_RefreshDailyStats_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_RefreshDailyStats_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time) error) *MockService_RefreshDailyStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshTokens provides a mock function for the type MockService
+func (_mock *MockService) RefreshTokens(ctx context.Context, providedToken *auth.RefreshToken) (string, *auth.RefreshToken, error) {
+	ret := _mock.Called(ctx, providedToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshTokens")
+	}
+
+	var r0 string
+	var r1 *auth.RefreshToken
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RefreshToken) (string, *auth.RefreshToken, error)); ok {
+		return returnFunc(ctx, providedToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RefreshToken) string); ok {
+		r0 = returnFunc(ctx, providedToken)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.RefreshToken) *auth.RefreshToken); ok {
+		r1 = returnFunc(ctx, providedToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*auth.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *auth.RefreshToken) error); ok {
+		r2 = returnFunc(ctx, providedToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockService_RefreshTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshTokens'
+type MockService_RefreshTokens_Call struct {
+	*mock.Call
+}
+
+// RefreshTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - providedToken *auth.RefreshToken
+func (_e *MockService_Expecter) RefreshTokens(ctx interface{}, providedToken interface{}) *MockService_RefreshTokens_Call {
+	return &MockService_RefreshTokens_Call{Call: _e.mock.On("RefreshTokens", ctx, providedToken)}
+}
+
+func (_c *MockService_RefreshTokens_Call) Run(run func(ctx context.Context, providedToken *auth.RefreshToken)) *MockService_RefreshTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.RefreshToken
+		if args[1] != nil {
+			arg1 = args[1].(*auth.RefreshToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_RefreshTokens_Call) Return(newAToken string, newRToken *auth.RefreshToken, err error) *MockService_RefreshTokens_Call {
+	_c.Call.Return(newAToken, newRToken, err)
+	return _c
+}
+
+func (_c *MockService_RefreshTokens_Call) RunAndReturn(run func(ctx context.Context, providedToken *auth.RefreshToken) (string, *auth.RefreshToken, error)) *MockService_RefreshTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterUser provides a mock function for the type MockService
+func (_mock *MockService) RegisterUser(ctx context.Context, userParams *auth.RegisterUserParams) (*auth.User, error) {
+	ret := _mock.Called(ctx, userParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterUser")
+	}
+
+	var r0 *auth.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RegisterUserParams) (*auth.User, error)); ok {
+		return returnFunc(ctx, userParams)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RegisterUserParams) *auth.User); ok {
+		r0 = returnFunc(ctx, userParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.RegisterUserParams) error); ok {
+		r1 = returnFunc(ctx, userParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_RegisterUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterUser'
+type MockService_RegisterUser_Call struct {
+	*mock.Call
+}
+
+// RegisterUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userParams *auth.RegisterUserParams
+func (_e *MockService_Expecter) RegisterUser(ctx interface{}, userParams interface{}) *MockService_RegisterUser_Call {
+	return &MockService_RegisterUser_Call{Call: _e.mock.On("RegisterUser", ctx, userParams)}
+}
+
+func (_c *MockService_RegisterUser_Call) Run(run func(ctx context.Context, userParams *auth.RegisterUserParams)) *MockService_RegisterUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.RegisterUserParams
+		if args[1] != nil {
+			arg1 = args[1].(*auth.RegisterUserParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_RegisterUser_Call) Return(user *auth.User, err error) *MockService_RegisterUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockService_RegisterUser_Call) RunAndReturn(run func(ctx context.Context, userParams *auth.RegisterUserParams) (*auth.User, error)) *MockService_RegisterUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenReception provides a mock function for the type MockService
+func (_mock *MockService) ReopenReception(ctx context.Context, receptionId *uuid.UUID, ifVersion *int) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, receptionId, ifVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReopenReception")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, receptionId, ifVersion)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) *domain.Reception); ok {
+		r0 = returnFunc(ctx, receptionId, ifVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *int) error); ok {
+		r1 = returnFunc(ctx, receptionId, ifVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_ReopenReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenReception'
+type MockService_ReopenReception_Call struct {
+	*mock.Call
+}
+
+// ReopenReception is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receptionId *uuid.UUID
+//   - ifVersion *int
+func (_e *MockService_Expecter) ReopenReception(ctx interface{}, receptionId interface{}, ifVersion interface{}) *MockService_ReopenReception_Call {
+	return &MockService_ReopenReception_Call{Call: _e.mock.On("ReopenReception", ctx, receptionId, ifVersion)}
+}
+
+func (_c *MockService_ReopenReception_Call) Run(run func(ctx context.Context, receptionId *uuid.UUID, ifVersion *int)) *MockService_ReopenReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *int
+		if args[2] != nil {
+			arg2 = args[2].(*int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_ReopenReception_Call) Return(reception *domain.Reception, err error) *MockService_ReopenReception_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockService_ReopenReception_Call) RunAndReturn(run func(ctx context.Context, receptionId *uuid.UUID, ifVersion *int) (*domain.Reception, error)) *MockService_ReopenReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreProduct provides a mock function for the type MockService
+func (_mock *MockService) RestoreProduct(ctx context.Context, productId *uuid.UUID) (*domain.Product, error) {
+	ret := _mock.Called(ctx, productId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreProduct")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.Product, error)); ok {
+		return returnFunc(ctx, productId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.Product); ok {
+		r0 = returnFunc(ctx, productId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_RestoreProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreProduct'
+type MockService_RestoreProduct_Call struct {
+	*mock.Call
+}
+
+// RestoreProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+func (_e *MockService_Expecter) RestoreProduct(ctx interface{}, productId interface{}) *MockService_RestoreProduct_Call {
+	return &MockService_RestoreProduct_Call{Call: _e.mock.On("RestoreProduct", ctx, productId)}
+}
+
+func (_c *MockService_RestoreProduct_Call) Run(run func(ctx context.Context, productId *uuid.UUID)) *MockService_RestoreProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_RestoreProduct_Call) Return(product *domain.Product, err error) *MockService_RestoreProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockService_RestoreProduct_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID) (*domain.Product, error)) *MockService_RestoreProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReturnProduct provides a mock function for the type MockService
+func (_mock *MockService) ReturnProduct(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID) (*domain.Product, error) {
+	ret := _mock.Called(ctx, productId, actorId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReturnProduct")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) (*domain.Product, error)); ok {
+		return returnFunc(ctx, productId, actorId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) *domain.Product); ok {
+		r0 = returnFunc(ctx, productId, actorId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productId, actorId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_ReturnProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReturnProduct'
+type MockService_ReturnProduct_Call struct {
+	*mock.Call
+}
+
+// ReturnProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+//   - actorId *uuid.UUID
+func (_e *MockService_Expecter) ReturnProduct(ctx interface{}, productId interface{}, actorId interface{}) *MockService_ReturnProduct_Call {
+	return &MockService_ReturnProduct_Call{Call: _e.mock.On("ReturnProduct", ctx, productId, actorId)}
+}
+
+func (_c *MockService_ReturnProduct_Call) Run(run func(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID)) *MockService_ReturnProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_ReturnProduct_Call) Return(product *domain.Product, err error) *MockService_ReturnProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockService_ReturnProduct_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID) (*domain.Product, error)) *MockService_ReturnProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunNextExportJob provides a mock function for the type MockService
+func (_mock *MockService) RunNextExportJob(ctx context.Context) (*domain.ExportJob, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunNextExportJob")
+	}
+
+	var r0 *domain.ExportJob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*domain.ExportJob, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *domain.ExportJob); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExportJob)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_RunNextExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunNextExportJob'
+type MockService_RunNextExportJob_Call struct {
+	*mock.Call
+}
+
+// RunNextExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockService_Expecter) RunNextExportJob(ctx interface{}) *MockService_RunNextExportJob_Call {
+	return &MockService_RunNextExportJob_Call{Call: _e.mock.On("RunNextExportJob", ctx)}
+}
+
+func (_c *MockService_RunNextExportJob_Call) Run(run func(ctx context.Context)) *MockService_RunNextExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_RunNextExportJob_Call) Return(exportJob *domain.ExportJob, err error) *MockService_RunNextExportJob_Call {
+	_c.Call.Return(exportJob, err)
+	return _c
+}
+
+func (_c *MockService_RunNextExportJob_Call) RunAndReturn(run func(ctx context.Context) (*domain.ExportJob, error)) *MockService_RunNextExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetReceptionManifest provides a mock function for the type MockService
+func (_mock *MockService) SetReceptionManifest(ctx context.Context, manifest *domain.ReceptionManifest) (*domain.ReceptionManifest, error) {
+	ret := _mock.Called(ctx, manifest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetReceptionManifest")
+	}
+
+	var r0 *domain.ReceptionManifest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionManifest) (*domain.ReceptionManifest, error)); ok {
+		return returnFunc(ctx, manifest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionManifest) *domain.ReceptionManifest); ok {
+		r0 = returnFunc(ctx, manifest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ReceptionManifest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ReceptionManifest) error); ok {
+		r1 = returnFunc(ctx, manifest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_SetReceptionManifest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetReceptionManifest'
+type MockService_SetReceptionManifest_Call struct {
+	*mock.Call
+}
+
+// SetReceptionManifest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - manifest *domain.ReceptionManifest
+func (_e *MockService_Expecter) SetReceptionManifest(ctx interface{}, manifest interface{}) *MockService_SetReceptionManifest_Call {
+	return &MockService_SetReceptionManifest_Call{Call: _e.mock.On("SetReceptionManifest", ctx, manifest)}
+}
+
+func (_c *MockService_SetReceptionManifest_Call) Run(run func(ctx context.Context, manifest *domain.ReceptionManifest)) *MockService_SetReceptionManifest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ReceptionManifest
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ReceptionManifest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_SetReceptionManifest_Call) Return(receptionManifest *domain.ReceptionManifest, err error) *MockService_SetReceptionManifest_Call {
+	_c.Call.Return(receptionManifest, err)
+	return _c
+}
+
+func (_c *MockService_SetReceptionManifest_Call) RunAndReturn(run func(ctx context.Context, manifest *domain.ReceptionManifest) (*domain.ReceptionManifest, error)) *MockService_SetReceptionManifest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamPvzsData provides a mock function for the type MockService
+func (_mock *MockService) StreamPvzsData(ctx context.Context, params *domain.PvzsReadParams, yield func(*domain.PvzReceptions) error) (*domain.PvzsPage, error) {
+	ret := _mock.Called(ctx, params, yield)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPvzsData")
+	}
+
+	var r0 *domain.PvzsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams, func(*domain.PvzReceptions) error) (*domain.PvzsPage, error)); ok {
+		return returnFunc(ctx, params, yield)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams, func(*domain.PvzReceptions) error) *domain.PvzsPage); ok {
+		r0 = returnFunc(ctx, params, yield)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PvzsPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PvzsReadParams, func(*domain.PvzReceptions) error) error); ok {
+		r1 = returnFunc(ctx, params, yield)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_StreamPvzsData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamPvzsData'
+type MockService_StreamPvzsData_Call struct {
+	*mock.Call
+}
+
+// StreamPvzsData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *domain.PvzsReadParams
+//   - yield func(*domain.PvzReceptions) error
+func (_e *MockService_Expecter) StreamPvzsData(ctx interface{}, params interface{}, yield interface{}) *MockService_StreamPvzsData_Call {
+	return &MockService_StreamPvzsData_Call{Call: _e.mock.On("StreamPvzsData", ctx, params, yield)}
+}
+
+func (_c *MockService_StreamPvzsData_Call) Run(run func(ctx context.Context, params *domain.PvzsReadParams, yield func(*domain.PvzReceptions) error)) *MockService_StreamPvzsData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PvzsReadParams
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PvzsReadParams)
+		}
+		var arg2 func(*domain.PvzReceptions) error
+		if args[2] != nil {
+			arg2 = args[2].(func(*domain.PvzReceptions) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_StreamPvzsData_Call) Return(pvzsPage *domain.PvzsPage, err error) *MockService_StreamPvzsData_Call {
+	_c.Call.Return(pvzsPage, err)
+	return _c
+}
+
+func (_c *MockService_StreamPvzsData_Call) RunAndReturn(run func(ctx context.Context, params *domain.PvzsReadParams, yield func(*domain.PvzReceptions) error) (*domain.PvzsPage, error)) *MockService_StreamPvzsData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePvz provides a mock function for the type MockService
+func (_mock *MockService) UpdatePvz(ctx context.Context, pvzId *uuid.UUID, city domain.PVZCity, ifVersion *int) (*domain.Pvz, error) {
+	ret := _mock.Called(ctx, pvzId, city, ifVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePvz")
+	}
+
+	var r0 *domain.Pvz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, domain.PVZCity, *int) (*domain.Pvz, error)); ok {
+		return returnFunc(ctx, pvzId, city, ifVersion)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, domain.PVZCity, *int) *domain.Pvz); ok {
+		r0 = returnFunc(ctx, pvzId, city, ifVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Pvz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, domain.PVZCity, *int) error); ok {
+		r1 = returnFunc(ctx, pvzId, city, ifVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_UpdatePvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePvz'
+type MockService_UpdatePvz_Call struct {
+	*mock.Call
+}
+
+// UpdatePvz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+//   - city domain.PVZCity
+//   - ifVersion *int
+func (_e *MockService_Expecter) UpdatePvz(ctx interface{}, pvzId interface{}, city interface{}, ifVersion interface{}) *MockService_UpdatePvz_Call {
+	return &MockService_UpdatePvz_Call{Call: _e.mock.On("UpdatePvz", ctx, pvzId, city, ifVersion)}
+}
+
+func (_c *MockService_UpdatePvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, city domain.PVZCity, ifVersion *int)) *MockService_UpdatePvz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 domain.PVZCity
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZCity)
+		}
+		var arg3 *int
+		if args[3] != nil {
+			arg3 = args[3].(*int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_UpdatePvz_Call) Return(pvz *domain.Pvz, err error) *MockService_UpdatePvz_Call {
+	_c.Call.Return(pvz, err)
+	return _c
+}
+
+func (_c *MockService_UpdatePvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, city domain.PVZCity, ifVersion *int) (*domain.Pvz, error)) *MockService_UpdatePvz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPvzsService creates a new instance of MockPvzsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPvzsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPvzsService {
+	mock := &MockPvzsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPvzsService is an autogenerated mock type for the PvzsService type
+type MockPvzsService struct {
+	mock.Mock
+}
+
+type MockPvzsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPvzsService) EXPECT() *MockPvzsService_Expecter {
+	return &MockPvzsService_Expecter{mock: &_m.Mock}
+}
+
+// AddProductPVZ provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) AddProductPVZ(ctx context.Context, prod *domain.Product) (*domain.Product, error) {
+	ret := _mock.Called(ctx, prod)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddProductPVZ")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product) (*domain.Product, error)); ok {
+		return returnFunc(ctx, prod)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Product) *domain.Product); ok {
+		r0 = returnFunc(ctx, prod)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Product) error); ok {
+		r1 = returnFunc(ctx, prod)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_AddProductPVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProductPVZ'
+type MockPvzsService_AddProductPVZ_Call struct {
+	*mock.Call
+}
+
+// AddProductPVZ is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prod *domain.Product
+func (_e *MockPvzsService_Expecter) AddProductPVZ(ctx interface{}, prod interface{}) *MockPvzsService_AddProductPVZ_Call {
+	return &MockPvzsService_AddProductPVZ_Call{Call: _e.mock.On("AddProductPVZ", ctx, prod)}
+}
+
+func (_c *MockPvzsService_AddProductPVZ_Call) Run(run func(ctx context.Context, prod *domain.Product)) *MockPvzsService_AddProductPVZ_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Product
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Product)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_AddProductPVZ_Call) Return(product *domain.Product, err error) *MockPvzsService_AddProductPVZ_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockPvzsService_AddProductPVZ_Call) RunAndReturn(run func(ctx context.Context, prod *domain.Product) (*domain.Product, error)) *MockPvzsService_AddProductPVZ_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddProductsBatch provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) AddProductsBatch(ctx context.Context, batch *domain.ProductsBatch) (*domain.ProductsBatchResult, error) {
+	ret := _mock.Called(ctx, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddProductsBatch")
+	}
+
+	var r0 *domain.ProductsBatchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductsBatch) (*domain.ProductsBatchResult, error)); ok {
+		return returnFunc(ctx, batch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ProductsBatch) *domain.ProductsBatchResult); ok {
+		r0 = returnFunc(ctx, batch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProductsBatchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ProductsBatch) error); ok {
+		r1 = returnFunc(ctx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_AddProductsBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProductsBatch'
+type MockPvzsService_AddProductsBatch_Call struct {
+	*mock.Call
+}
+
+// AddProductsBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batch *domain.ProductsBatch
+func (_e *MockPvzsService_Expecter) AddProductsBatch(ctx interface{}, batch interface{}) *MockPvzsService_AddProductsBatch_Call {
+	return &MockPvzsService_AddProductsBatch_Call{Call: _e.mock.On("AddProductsBatch", ctx, batch)}
+}
+
+func (_c *MockPvzsService_AddProductsBatch_Call) Run(run func(ctx context.Context, batch *domain.ProductsBatch)) *MockPvzsService_AddProductsBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ProductsBatch
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ProductsBatch)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_AddProductsBatch_Call) Return(productsBatchResult *domain.ProductsBatchResult, err error) *MockPvzsService_AddProductsBatch_Call {
+	_c.Call.Return(productsBatchResult, err)
+	return _c
+}
+
+func (_c *MockPvzsService_AddProductsBatch_Call) RunAndReturn(run func(ctx context.Context, batch *domain.ProductsBatch) (*domain.ProductsBatchResult, error)) *MockPvzsService_AddProductsBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AllPvzsStock provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) AllPvzsStock(ctx context.Context) ([]*domain.PvzStock, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllPvzsStock")
+	}
+
+	var r0 []*domain.PvzStock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.PvzStock, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.PvzStock); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.PvzStock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_AllPvzsStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllPvzsStock'
+type MockPvzsService_AllPvzsStock_Call struct {
+	*mock.Call
+}
+
+// AllPvzsStock is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPvzsService_Expecter) AllPvzsStock(ctx interface{}) *MockPvzsService_AllPvzsStock_Call {
+	return &MockPvzsService_AllPvzsStock_Call{Call: _e.mock.On("AllPvzsStock", ctx)}
+}
+
+func (_c *MockPvzsService_AllPvzsStock_Call) Run(run func(ctx context.Context)) *MockPvzsService_AllPvzsStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_AllPvzsStock_Call) Return(pvzStocks []*domain.PvzStock, err error) *MockPvzsService_AllPvzsStock_Call {
+	_c.Call.Return(pvzStocks, err)
+	return _c
+}
+
+func (_c *MockPvzsService_AllPvzsStock_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.PvzStock, error)) *MockPvzsService_AllPvzsStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AutoCloseStaleReceptions provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) AutoCloseStaleReceptions(ctx context.Context, idleFor time.Duration) ([]*domain.Reception, error) {
+	ret := _mock.Called(ctx, idleFor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AutoCloseStaleReceptions")
+	}
+
+	var r0 []*domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration) ([]*domain.Reception, error)); ok {
+		return returnFunc(ctx, idleFor)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration) []*domain.Reception); ok {
+		r0 = returnFunc(ctx, idleFor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = returnFunc(ctx, idleFor)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_AutoCloseStaleReceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoCloseStaleReceptions'
+type MockPvzsService_AutoCloseStaleReceptions_Call struct {
+	*mock.Call
+}
+
+// AutoCloseStaleReceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - idleFor time.Duration
+func (_e *MockPvzsService_Expecter) AutoCloseStaleReceptions(ctx interface{}, idleFor interface{}) *MockPvzsService_AutoCloseStaleReceptions_Call {
+	return &MockPvzsService_AutoCloseStaleReceptions_Call{Call: _e.mock.On("AutoCloseStaleReceptions", ctx, idleFor)}
+}
+
+func (_c *MockPvzsService_AutoCloseStaleReceptions_Call) Run(run func(ctx context.Context, idleFor time.Duration)) *MockPvzsService_AutoCloseStaleReceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_AutoCloseStaleReceptions_Call) Return(receptions []*domain.Reception, err error) *MockPvzsService_AutoCloseStaleReceptions_Call {
+	_c.Call.Return(receptions, err)
+	return _c
+}
+
+func (_c *MockPvzsService_AutoCloseStaleReceptions_Call) RunAndReturn(run func(ctx context.Context, idleFor time.Duration) ([]*domain.Reception, error)) *MockPvzsService_AutoCloseStaleReceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelReceptionInPvz provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) CancelReceptionInPvz(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, pvzId, ifVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelReceptionInPvz")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, pvzId, ifVersion)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) *domain.Reception); ok {
+		r0 = returnFunc(ctx, pvzId, ifVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *int) error); ok {
+		r1 = returnFunc(ctx, pvzId, ifVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_CancelReceptionInPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelReceptionInPvz'
+type MockPvzsService_CancelReceptionInPvz_Call struct {
+	*mock.Call
+}
+
+// CancelReceptionInPvz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+//   - ifVersion *int
+func (_e *MockPvzsService_Expecter) CancelReceptionInPvz(ctx interface{}, pvzId interface{}, ifVersion interface{}) *MockPvzsService_CancelReceptionInPvz_Call {
+	return &MockPvzsService_CancelReceptionInPvz_Call{Call: _e.mock.On("CancelReceptionInPvz", ctx, pvzId, ifVersion)}
+}
+
+func (_c *MockPvzsService_CancelReceptionInPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int)) *MockPvzsService_CancelReceptionInPvz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *int
+		if args[2] != nil {
+			arg2 = args[2].(*int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_CancelReceptionInPvz_Call) Return(reception *domain.Reception, err error) *MockPvzsService_CancelReceptionInPvz_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockPvzsService_CancelReceptionInPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.Reception, error)) *MockPvzsService_CancelReceptionInPvz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloseReceptionInPvz provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) CloseReceptionInPvz(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.ReceptionCloseReport, error) {
+	ret := _mock.Called(ctx, pvzId, ifVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseReceptionInPvz")
+	}
+
+	var r0 *domain.ReceptionCloseReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) (*domain.ReceptionCloseReport, error)); ok {
+		return returnFunc(ctx, pvzId, ifVersion)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) *domain.ReceptionCloseReport); ok {
+		r0 = returnFunc(ctx, pvzId, ifVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ReceptionCloseReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *int) error); ok {
+		r1 = returnFunc(ctx, pvzId, ifVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_CloseReceptionInPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseReceptionInPvz'
+type MockPvzsService_CloseReceptionInPvz_Call struct {
+	*mock.Call
+}
+
+// CloseReceptionInPvz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+//   - ifVersion *int
+func (_e *MockPvzsService_Expecter) CloseReceptionInPvz(ctx interface{}, pvzId interface{}, ifVersion interface{}) *MockPvzsService_CloseReceptionInPvz_Call {
+	return &MockPvzsService_CloseReceptionInPvz_Call{Call: _e.mock.On("CloseReceptionInPvz", ctx, pvzId, ifVersion)}
+}
+
+func (_c *MockPvzsService_CloseReceptionInPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int)) *MockPvzsService_CloseReceptionInPvz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *int
+		if args[2] != nil {
+			arg2 = args[2].(*int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_CloseReceptionInPvz_Call) Return(receptionCloseReport *domain.ReceptionCloseReport, err error) *MockPvzsService_CloseReceptionInPvz_Call {
+	_c.Call.Return(receptionCloseReport, err)
+	return _c
+}
+
+func (_c *MockPvzsService_CloseReceptionInPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.ReceptionCloseReport, error)) *MockPvzsService_CloseReceptionInPvz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLastProductPvz provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) DeleteLastProductPvz(ctx context.Context, pvzId *uuid.UUID, actorId *uuid.UUID) error {
+	ret := _mock.Called(ctx, pvzId, actorId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLastProductPvz")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, pvzId, actorId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPvzsService_DeleteLastProductPvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLastProductPvz'
+type MockPvzsService_DeleteLastProductPvz_Call struct {
+	*mock.Call
+}
+
+// DeleteLastProductPvz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+//   - actorId *uuid.UUID
+func (_e *MockPvzsService_Expecter) DeleteLastProductPvz(ctx interface{}, pvzId interface{}, actorId interface{}) *MockPvzsService_DeleteLastProductPvz_Call {
+	return &MockPvzsService_DeleteLastProductPvz_Call{Call: _e.mock.On("DeleteLastProductPvz", ctx, pvzId, actorId)}
+}
+
+func (_c *MockPvzsService_DeleteLastProductPvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, actorId *uuid.UUID)) *MockPvzsService_DeleteLastProductPvz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_DeleteLastProductPvz_Call) Return(err error) *MockPvzsService_DeleteLastProductPvz_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPvzsService_DeleteLastProductPvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, actorId *uuid.UUID) error) *MockPvzsService_DeleteLastProductPvz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProduct provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) DeleteProduct(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID) error {
+	ret := _mock.Called(ctx, productId, actorId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, productId, actorId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPvzsService_DeleteProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProduct'
+type MockPvzsService_DeleteProduct_Call struct {
+	*mock.Call
+}
+
+// DeleteProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+//   - actorId *uuid.UUID
+func (_e *MockPvzsService_Expecter) DeleteProduct(ctx interface{}, productId interface{}, actorId interface{}) *MockPvzsService_DeleteProduct_Call {
+	return &MockPvzsService_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", ctx, productId, actorId)}
+}
+
+func (_c *MockPvzsService_DeleteProduct_Call) Run(run func(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID)) *MockPvzsService_DeleteProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_DeleteProduct_Call) Return(err error) *MockPvzsService_DeleteProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPvzsService_DeleteProduct_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID) error) *MockPvzsService_DeleteProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllPvzs provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) GetAllPvzs(ctx context.Context, filter *domain.PvzsFilter, sort domain.PvzsSort) ([]*domain.Pvz, error) {
+	ret := _mock.Called(ctx, filter, sort)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPvzs")
+	}
+
+	var r0 []*domain.Pvz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsFilter, domain.PvzsSort) ([]*domain.Pvz, error)); ok {
+		return returnFunc(ctx, filter, sort)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsFilter, domain.PvzsSort) []*domain.Pvz); ok {
+		r0 = returnFunc(ctx, filter, sort)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Pvz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PvzsFilter, domain.PvzsSort) error); ok {
+		r1 = returnFunc(ctx, filter, sort)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_GetAllPvzs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllPvzs'
+type MockPvzsService_GetAllPvzs_Call struct {
+	*mock.Call
+}
+
+// GetAllPvzs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *domain.PvzsFilter
+//   - sort domain.PvzsSort
+func (_e *MockPvzsService_Expecter) GetAllPvzs(ctx interface{}, filter interface{}, sort interface{}) *MockPvzsService_GetAllPvzs_Call {
+	return &MockPvzsService_GetAllPvzs_Call{Call: _e.mock.On("GetAllPvzs", ctx, filter, sort)}
+}
+
+func (_c *MockPvzsService_GetAllPvzs_Call) Run(run func(ctx context.Context, filter *domain.PvzsFilter, sort domain.PvzsSort)) *MockPvzsService_GetAllPvzs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PvzsFilter
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PvzsFilter)
+		}
+		var arg2 domain.PvzsSort
+		if args[2] != nil {
+			arg2 = args[2].(domain.PvzsSort)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_GetAllPvzs_Call) Return(pvzs []*domain.Pvz, err error) *MockPvzsService_GetAllPvzs_Call {
+	_c.Call.Return(pvzs, err)
+	return _c
+}
+
+func (_c *MockPvzsService_GetAllPvzs_Call) RunAndReturn(run func(ctx context.Context, filter *domain.PvzsFilter, sort domain.PvzsSort) ([]*domain.Pvz, error)) *MockPvzsService_GetAllPvzs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPvzsData provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) (*domain.PvzsPage, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPvzsData")
+	}
+
+	var r0 *domain.PvzsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) (*domain.PvzsPage, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams) *domain.PvzsPage); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PvzsPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PvzsReadParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_GetPvzsData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPvzsData'
+type MockPvzsService_GetPvzsData_Call struct {
+	*mock.Call
+}
+
+// GetPvzsData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *domain.PvzsReadParams
+func (_e *MockPvzsService_Expecter) GetPvzsData(ctx interface{}, params interface{}) *MockPvzsService_GetPvzsData_Call {
+	return &MockPvzsService_GetPvzsData_Call{Call: _e.mock.On("GetPvzsData", ctx, params)}
+}
+
+func (_c *MockPvzsService_GetPvzsData_Call) Run(run func(ctx context.Context, params *domain.PvzsReadParams)) *MockPvzsService_GetPvzsData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PvzsReadParams
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PvzsReadParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_GetPvzsData_Call) Return(pvzsPage *domain.PvzsPage, err error) *MockPvzsService_GetPvzsData_Call {
+	_c.Call.Return(pvzsPage, err)
+	return _c
+}
+
+func (_c *MockPvzsService_GetPvzsData_Call) RunAndReturn(run func(ctx context.Context, params *domain.PvzsReadParams) (*domain.PvzsPage, error)) *MockPvzsService_GetPvzsData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssuePickupCode provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) IssuePickupCode(ctx context.Context, productId *uuid.UUID) (string, error) {
+	ret := _mock.Called(ctx, productId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssuePickupCode")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (string, error)); ok {
+		return returnFunc(ctx, productId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) string); ok {
+		r0 = returnFunc(ctx, productId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_IssuePickupCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssuePickupCode'
+type MockPvzsService_IssuePickupCode_Call struct {
+	*mock.Call
+}
+
+// IssuePickupCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+func (_e *MockPvzsService_Expecter) IssuePickupCode(ctx interface{}, productId interface{}) *MockPvzsService_IssuePickupCode_Call {
+	return &MockPvzsService_IssuePickupCode_Call{Call: _e.mock.On("IssuePickupCode", ctx, productId)}
+}
+
+func (_c *MockPvzsService_IssuePickupCode_Call) Run(run func(ctx context.Context, productId *uuid.UUID)) *MockPvzsService_IssuePickupCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_IssuePickupCode_Call) Return(s string, err error) *MockPvzsService_IssuePickupCode_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockPvzsService_IssuePickupCode_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID) (string, error)) *MockPvzsService_IssuePickupCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueProduct provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) IssueProduct(ctx context.Context, productId *uuid.UUID, pickupCode string, actorId *uuid.UUID) (*domain.Product, error) {
+	ret := _mock.Called(ctx, productId, pickupCode, actorId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueProduct")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string, *uuid.UUID) (*domain.Product, error)); ok {
+		return returnFunc(ctx, productId, pickupCode, actorId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string, *uuid.UUID) *domain.Product); ok {
+		r0 = returnFunc(ctx, productId, pickupCode, actorId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, string, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productId, pickupCode, actorId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_IssueProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueProduct'
+type MockPvzsService_IssueProduct_Call struct {
+	*mock.Call
+}
+
+// IssueProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+//   - pickupCode string
+//   - actorId *uuid.UUID
+func (_e *MockPvzsService_Expecter) IssueProduct(ctx interface{}, productId interface{}, pickupCode interface{}, actorId interface{}) *MockPvzsService_IssueProduct_Call {
+	return &MockPvzsService_IssueProduct_Call{Call: _e.mock.On("IssueProduct", ctx, productId, pickupCode, actorId)}
+}
+
+func (_c *MockPvzsService_IssueProduct_Call) Run(run func(ctx context.Context, productId *uuid.UUID, pickupCode string, actorId *uuid.UUID)) *MockPvzsService_IssueProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *uuid.UUID
+		if args[3] != nil {
+			arg3 = args[3].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_IssueProduct_Call) Return(product *domain.Product, err error) *MockPvzsService_IssueProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockPvzsService_IssueProduct_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID, pickupCode string, actorId *uuid.UUID) (*domain.Product, error)) *MockPvzsService_IssueProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPVZ provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) NewPVZ(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error) {
+	ret := _mock.Called(ctx, pvz)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewPVZ")
+	}
+
+	var r0 *domain.Pvz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) (*domain.Pvz, error)); ok {
+		return returnFunc(ctx, pvz)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Pvz) *domain.Pvz); ok {
+		r0 = returnFunc(ctx, pvz)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Pvz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Pvz) error); ok {
+		r1 = returnFunc(ctx, pvz)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_NewPVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewPVZ'
+type MockPvzsService_NewPVZ_Call struct {
+	*mock.Call
+}
+
+// NewPVZ is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvz *domain.Pvz
+func (_e *MockPvzsService_Expecter) NewPVZ(ctx interface{}, pvz interface{}) *MockPvzsService_NewPVZ_Call {
+	return &MockPvzsService_NewPVZ_Call{Call: _e.mock.On("NewPVZ", ctx, pvz)}
+}
+
+func (_c *MockPvzsService_NewPVZ_Call) Run(run func(ctx context.Context, pvz *domain.Pvz)) *MockPvzsService_NewPVZ_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Pvz
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Pvz)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_NewPVZ_Call) Return(pvz1 *domain.Pvz, err error) *MockPvzsService_NewPVZ_Call {
+	_c.Call.Return(pvz1, err)
+	return _c
+}
+
+func (_c *MockPvzsService_NewPVZ_Call) RunAndReturn(run func(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error)) *MockPvzsService_NewPVZ_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenNewPVZReception provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) OpenNewPVZReception(ctx context.Context, rec *domain.Reception) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, rec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenNewPVZReception")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, rec)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Reception) *domain.Reception); ok {
+		r0 = returnFunc(ctx, rec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Reception) error); ok {
+		r1 = returnFunc(ctx, rec)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_OpenNewPVZReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenNewPVZReception'
+type MockPvzsService_OpenNewPVZReception_Call struct {
+	*mock.Call
+}
+
+// OpenNewPVZReception is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rec *domain.Reception
+func (_e *MockPvzsService_Expecter) OpenNewPVZReception(ctx interface{}, rec interface{}) *MockPvzsService_OpenNewPVZReception_Call {
+	return &MockPvzsService_OpenNewPVZReception_Call{Call: _e.mock.On("OpenNewPVZReception", ctx, rec)}
+}
+
+func (_c *MockPvzsService_OpenNewPVZReception_Call) Run(run func(ctx context.Context, rec *domain.Reception)) *MockPvzsService_OpenNewPVZReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Reception
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Reception)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_OpenNewPVZReception_Call) Return(reception *domain.Reception, err error) *MockPvzsService_OpenNewPVZReception_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockPvzsService_OpenNewPVZReception_Call) RunAndReturn(run func(ctx context.Context, rec *domain.Reception) (*domain.Reception, error)) *MockPvzsService_OpenNewPVZReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenReceptionsCount provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) OpenReceptionsCount(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenReceptionsCount")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_OpenReceptionsCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenReceptionsCount'
+type MockPvzsService_OpenReceptionsCount_Call struct {
+	*mock.Call
+}
+
+// OpenReceptionsCount is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPvzsService_Expecter) OpenReceptionsCount(ctx interface{}) *MockPvzsService_OpenReceptionsCount_Call {
+	return &MockPvzsService_OpenReceptionsCount_Call{Call: _e.mock.On("OpenReceptionsCount", ctx)}
+}
+
+func (_c *MockPvzsService_OpenReceptionsCount_Call) Run(run func(ctx context.Context)) *MockPvzsService_OpenReceptionsCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_OpenReceptionsCount_Call) Return(n int, err error) *MockPvzsService_OpenReceptionsCount_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockPvzsService_OpenReceptionsCount_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockPvzsService_OpenReceptionsCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductDeletions provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) ProductDeletions(ctx context.Context, receptionId *uuid.UUID) ([]*domain.ProductDeletion, error) {
+	ret := _mock.Called(ctx, receptionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductDeletions")
+	}
+
+	var r0 []*domain.ProductDeletion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) ([]*domain.ProductDeletion, error)); ok {
+		return returnFunc(ctx, receptionId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) []*domain.ProductDeletion); ok {
+		r0 = returnFunc(ctx, receptionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductDeletion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, receptionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_ProductDeletions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductDeletions'
+type MockPvzsService_ProductDeletions_Call struct {
+	*mock.Call
+}
+
+// ProductDeletions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receptionId *uuid.UUID
+func (_e *MockPvzsService_Expecter) ProductDeletions(ctx interface{}, receptionId interface{}) *MockPvzsService_ProductDeletions_Call {
+	return &MockPvzsService_ProductDeletions_Call{Call: _e.mock.On("ProductDeletions", ctx, receptionId)}
+}
+
+func (_c *MockPvzsService_ProductDeletions_Call) Run(run func(ctx context.Context, receptionId *uuid.UUID)) *MockPvzsService_ProductDeletions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_ProductDeletions_Call) Return(productDeletions []*domain.ProductDeletion, err error) *MockPvzsService_ProductDeletions_Call {
+	_c.Call.Return(productDeletions, err)
+	return _c
+}
+
+func (_c *MockPvzsService_ProductDeletions_Call) RunAndReturn(run func(ctx context.Context, receptionId *uuid.UUID) ([]*domain.ProductDeletion, error)) *MockPvzsService_ProductDeletions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductStatusHistory provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) ProductStatusHistory(ctx context.Context, productId *uuid.UUID) ([]*domain.ProductStatusChange, error) {
+	ret := _mock.Called(ctx, productId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductStatusHistory")
+	}
+
+	var r0 []*domain.ProductStatusChange
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) ([]*domain.ProductStatusChange, error)); ok {
+		return returnFunc(ctx, productId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) []*domain.ProductStatusChange); ok {
+		r0 = returnFunc(ctx, productId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductStatusChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_ProductStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductStatusHistory'
+type MockPvzsService_ProductStatusHistory_Call struct {
+	*mock.Call
+}
+
+// ProductStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+func (_e *MockPvzsService_Expecter) ProductStatusHistory(ctx interface{}, productId interface{}) *MockPvzsService_ProductStatusHistory_Call {
+	return &MockPvzsService_ProductStatusHistory_Call{Call: _e.mock.On("ProductStatusHistory", ctx, productId)}
+}
+
+func (_c *MockPvzsService_ProductStatusHistory_Call) Run(run func(ctx context.Context, productId *uuid.UUID)) *MockPvzsService_ProductStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_ProductStatusHistory_Call) Return(productStatusChanges []*domain.ProductStatusChange, err error) *MockPvzsService_ProductStatusHistory_Call {
+	_c.Call.Return(productStatusChanges, err)
+	return _c
+}
+
+func (_c *MockPvzsService_ProductStatusHistory_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID) ([]*domain.ProductStatusChange, error)) *MockPvzsService_ProductStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductsByBarcode provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) ProductsByBarcode(ctx context.Context, barcode string) ([]*domain.ProductInfo, error) {
+	ret := _mock.Called(ctx, barcode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductsByBarcode")
+	}
+
+	var r0 []*domain.ProductInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.ProductInfo, error)); ok {
+		return returnFunc(ctx, barcode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.ProductInfo); ok {
+		r0 = returnFunc(ctx, barcode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProductInfo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, barcode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_ProductsByBarcode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductsByBarcode'
+type MockPvzsService_ProductsByBarcode_Call struct {
+	*mock.Call
+}
+
+// ProductsByBarcode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - barcode string
+func (_e *MockPvzsService_Expecter) ProductsByBarcode(ctx interface{}, barcode interface{}) *MockPvzsService_ProductsByBarcode_Call {
+	return &MockPvzsService_ProductsByBarcode_Call{Call: _e.mock.On("ProductsByBarcode", ctx, barcode)}
+}
+
+func (_c *MockPvzsService_ProductsByBarcode_Call) Run(run func(ctx context.Context, barcode string)) *MockPvzsService_ProductsByBarcode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_ProductsByBarcode_Call) Return(productInfos []*domain.ProductInfo, err error) *MockPvzsService_ProductsByBarcode_Call {
+	_c.Call.Return(productInfos, err)
+	return _c
+}
+
+func (_c *MockPvzsService_ProductsByBarcode_Call) RunAndReturn(run func(ctx context.Context, barcode string) ([]*domain.ProductInfo, error)) *MockPvzsService_ProductsByBarcode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pvz provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) Pvz(ctx context.Context, pvzId *uuid.UUID) (*domain.Pvz, error) {
+	ret := _mock.Called(ctx, pvzId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pvz")
+	}
+
+	var r0 *domain.Pvz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.Pvz, error)); ok {
+		return returnFunc(ctx, pvzId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.Pvz); ok {
+		r0 = returnFunc(ctx, pvzId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Pvz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvzId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_Pvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pvz'
+type MockPvzsService_Pvz_Call struct {
+	*mock.Call
+}
+
+// Pvz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+func (_e *MockPvzsService_Expecter) Pvz(ctx interface{}, pvzId interface{}) *MockPvzsService_Pvz_Call {
+	return &MockPvzsService_Pvz_Call{Call: _e.mock.On("Pvz", ctx, pvzId)}
+}
+
+func (_c *MockPvzsService_Pvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockPvzsService_Pvz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_Pvz_Call) Return(pvz *domain.Pvz, err error) *MockPvzsService_Pvz_Call {
+	_c.Call.Return(pvz, err)
+	return _c
+}
+
+func (_c *MockPvzsService_Pvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) (*domain.Pvz, error)) *MockPvzsService_Pvz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PvzStock provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) PvzStock(ctx context.Context, pvzId *uuid.UUID) (*domain.PvzStock, error) {
+	ret := _mock.Called(ctx, pvzId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PvzStock")
+	}
+
+	var r0 *domain.PvzStock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.PvzStock, error)); ok {
+		return returnFunc(ctx, pvzId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.PvzStock); ok {
+		r0 = returnFunc(ctx, pvzId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PvzStock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, pvzId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_PvzStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PvzStock'
+type MockPvzsService_PvzStock_Call struct {
+	*mock.Call
+}
+
+// PvzStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+func (_e *MockPvzsService_Expecter) PvzStock(ctx interface{}, pvzId interface{}) *MockPvzsService_PvzStock_Call {
+	return &MockPvzsService_PvzStock_Call{Call: _e.mock.On("PvzStock", ctx, pvzId)}
+}
+
+func (_c *MockPvzsService_PvzStock_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID)) *MockPvzsService_PvzStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_PvzStock_Call) Return(pvzStock *domain.PvzStock, err error) *MockPvzsService_PvzStock_Call {
+	_c.Call.Return(pvzStock, err)
+	return _c
+}
+
+func (_c *MockPvzsService_PvzStock_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID) (*domain.PvzStock, error)) *MockPvzsService_PvzStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reception provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) Reception(ctx context.Context, receptionId *uuid.UUID) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, receptionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reception")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, receptionId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.Reception); ok {
+		r0 = returnFunc(ctx, receptionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, receptionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_Reception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reception'
+type MockPvzsService_Reception_Call struct {
+	*mock.Call
+}
+
+// Reception is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receptionId *uuid.UUID
+func (_e *MockPvzsService_Expecter) Reception(ctx interface{}, receptionId interface{}) *MockPvzsService_Reception_Call {
+	return &MockPvzsService_Reception_Call{Call: _e.mock.On("Reception", ctx, receptionId)}
+}
+
+func (_c *MockPvzsService_Reception_Call) Run(run func(ctx context.Context, receptionId *uuid.UUID)) *MockPvzsService_Reception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_Reception_Call) Return(reception *domain.Reception, err error) *MockPvzsService_Reception_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockPvzsService_Reception_Call) RunAndReturn(run func(ctx context.Context, receptionId *uuid.UUID) (*domain.Reception, error)) *MockPvzsService_Reception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenReception provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) ReopenReception(ctx context.Context, receptionId *uuid.UUID, ifVersion *int) (*domain.Reception, error) {
+	ret := _mock.Called(ctx, receptionId, ifVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReopenReception")
+	}
+
+	var r0 *domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) (*domain.Reception, error)); ok {
+		return returnFunc(ctx, receptionId, ifVersion)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *int) *domain.Reception); ok {
+		r0 = returnFunc(ctx, receptionId, ifVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *int) error); ok {
+		r1 = returnFunc(ctx, receptionId, ifVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_ReopenReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenReception'
+type MockPvzsService_ReopenReception_Call struct {
+	*mock.Call
+}
+
+// ReopenReception is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receptionId *uuid.UUID
+//   - ifVersion *int
+func (_e *MockPvzsService_Expecter) ReopenReception(ctx interface{}, receptionId interface{}, ifVersion interface{}) *MockPvzsService_ReopenReception_Call {
+	return &MockPvzsService_ReopenReception_Call{Call: _e.mock.On("ReopenReception", ctx, receptionId, ifVersion)}
+}
+
+func (_c *MockPvzsService_ReopenReception_Call) Run(run func(ctx context.Context, receptionId *uuid.UUID, ifVersion *int)) *MockPvzsService_ReopenReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *int
+		if args[2] != nil {
+			arg2 = args[2].(*int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_ReopenReception_Call) Return(reception *domain.Reception, err error) *MockPvzsService_ReopenReception_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockPvzsService_ReopenReception_Call) RunAndReturn(run func(ctx context.Context, receptionId *uuid.UUID, ifVersion *int) (*domain.Reception, error)) *MockPvzsService_ReopenReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreProduct provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) RestoreProduct(ctx context.Context, productId *uuid.UUID) (*domain.Product, error) {
+	ret := _mock.Called(ctx, productId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreProduct")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.Product, error)); ok {
+		return returnFunc(ctx, productId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.Product); ok {
+		r0 = returnFunc(ctx, productId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_RestoreProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreProduct'
+type MockPvzsService_RestoreProduct_Call struct {
+	*mock.Call
+}
+
+// RestoreProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+func (_e *MockPvzsService_Expecter) RestoreProduct(ctx interface{}, productId interface{}) *MockPvzsService_RestoreProduct_Call {
+	return &MockPvzsService_RestoreProduct_Call{Call: _e.mock.On("RestoreProduct", ctx, productId)}
+}
+
+func (_c *MockPvzsService_RestoreProduct_Call) Run(run func(ctx context.Context, productId *uuid.UUID)) *MockPvzsService_RestoreProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_RestoreProduct_Call) Return(product *domain.Product, err error) *MockPvzsService_RestoreProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockPvzsService_RestoreProduct_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID) (*domain.Product, error)) *MockPvzsService_RestoreProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReturnProduct provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) ReturnProduct(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID) (*domain.Product, error) {
+	ret := _mock.Called(ctx, productId, actorId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReturnProduct")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) (*domain.Product, error)); ok {
+		return returnFunc(ctx, productId, actorId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, *uuid.UUID) *domain.Product); ok {
+		r0 = returnFunc(ctx, productId, actorId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, productId, actorId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_ReturnProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReturnProduct'
+type MockPvzsService_ReturnProduct_Call struct {
+	*mock.Call
+}
+
+// ReturnProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productId *uuid.UUID
+//   - actorId *uuid.UUID
+func (_e *MockPvzsService_Expecter) ReturnProduct(ctx interface{}, productId interface{}, actorId interface{}) *MockPvzsService_ReturnProduct_Call {
+	return &MockPvzsService_ReturnProduct_Call{Call: _e.mock.On("ReturnProduct", ctx, productId, actorId)}
+}
+
+func (_c *MockPvzsService_ReturnProduct_Call) Run(run func(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID)) *MockPvzsService_ReturnProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 *uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_ReturnProduct_Call) Return(product *domain.Product, err error) *MockPvzsService_ReturnProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockPvzsService_ReturnProduct_Call) RunAndReturn(run func(ctx context.Context, productId *uuid.UUID, actorId *uuid.UUID) (*domain.Product, error)) *MockPvzsService_ReturnProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetReceptionManifest provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) SetReceptionManifest(ctx context.Context, manifest *domain.ReceptionManifest) (*domain.ReceptionManifest, error) {
+	ret := _mock.Called(ctx, manifest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetReceptionManifest")
+	}
+
+	var r0 *domain.ReceptionManifest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionManifest) (*domain.ReceptionManifest, error)); ok {
+		return returnFunc(ctx, manifest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionManifest) *domain.ReceptionManifest); ok {
+		r0 = returnFunc(ctx, manifest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ReceptionManifest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ReceptionManifest) error); ok {
+		r1 = returnFunc(ctx, manifest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_SetReceptionManifest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetReceptionManifest'
+type MockPvzsService_SetReceptionManifest_Call struct {
+	*mock.Call
+}
+
+// SetReceptionManifest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - manifest *domain.ReceptionManifest
+func (_e *MockPvzsService_Expecter) SetReceptionManifest(ctx interface{}, manifest interface{}) *MockPvzsService_SetReceptionManifest_Call {
+	return &MockPvzsService_SetReceptionManifest_Call{Call: _e.mock.On("SetReceptionManifest", ctx, manifest)}
+}
+
+func (_c *MockPvzsService_SetReceptionManifest_Call) Run(run func(ctx context.Context, manifest *domain.ReceptionManifest)) *MockPvzsService_SetReceptionManifest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ReceptionManifest
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ReceptionManifest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_SetReceptionManifest_Call) Return(receptionManifest *domain.ReceptionManifest, err error) *MockPvzsService_SetReceptionManifest_Call {
+	_c.Call.Return(receptionManifest, err)
+	return _c
+}
+
+func (_c *MockPvzsService_SetReceptionManifest_Call) RunAndReturn(run func(ctx context.Context, manifest *domain.ReceptionManifest) (*domain.ReceptionManifest, error)) *MockPvzsService_SetReceptionManifest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamPvzsData provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) StreamPvzsData(ctx context.Context, params *domain.PvzsReadParams, yield func(*domain.PvzReceptions) error) (*domain.PvzsPage, error) {
+	ret := _mock.Called(ctx, params, yield)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPvzsData")
+	}
+
+	var r0 *domain.PvzsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams, func(*domain.PvzReceptions) error) (*domain.PvzsPage, error)); ok {
+		return returnFunc(ctx, params, yield)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PvzsReadParams, func(*domain.PvzReceptions) error) *domain.PvzsPage); ok {
+		r0 = returnFunc(ctx, params, yield)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PvzsPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.PvzsReadParams, func(*domain.PvzReceptions) error) error); ok {
+		r1 = returnFunc(ctx, params, yield)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_StreamPvzsData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamPvzsData'
+type MockPvzsService_StreamPvzsData_Call struct {
+	*mock.Call
+}
+
+// StreamPvzsData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *domain.PvzsReadParams
+//   - yield func(*domain.PvzReceptions) error
+func (_e *MockPvzsService_Expecter) StreamPvzsData(ctx interface{}, params interface{}, yield interface{}) *MockPvzsService_StreamPvzsData_Call {
+	return &MockPvzsService_StreamPvzsData_Call{Call: _e.mock.On("StreamPvzsData", ctx, params, yield)}
+}
+
+func (_c *MockPvzsService_StreamPvzsData_Call) Run(run func(ctx context.Context, params *domain.PvzsReadParams, yield func(*domain.PvzReceptions) error)) *MockPvzsService_StreamPvzsData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PvzsReadParams
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PvzsReadParams)
+		}
+		var arg2 func(*domain.PvzReceptions) error
+		if args[2] != nil {
+			arg2 = args[2].(func(*domain.PvzReceptions) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_StreamPvzsData_Call) Return(pvzsPage *domain.PvzsPage, err error) *MockPvzsService_StreamPvzsData_Call {
+	_c.Call.Return(pvzsPage, err)
+	return _c
+}
+
+func (_c *MockPvzsService_StreamPvzsData_Call) RunAndReturn(run func(ctx context.Context, params *domain.PvzsReadParams, yield func(*domain.PvzReceptions) error) (*domain.PvzsPage, error)) *MockPvzsService_StreamPvzsData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePvz provides a mock function for the type MockPvzsService
+func (_mock *MockPvzsService) UpdatePvz(ctx context.Context, pvzId *uuid.UUID, city domain.PVZCity, ifVersion *int) (*domain.Pvz, error) {
+	ret := _mock.Called(ctx, pvzId, city, ifVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePvz")
+	}
+
+	var r0 *domain.Pvz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, domain.PVZCity, *int) (*domain.Pvz, error)); ok {
+		return returnFunc(ctx, pvzId, city, ifVersion)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, domain.PVZCity, *int) *domain.Pvz); ok {
+		r0 = returnFunc(ctx, pvzId, city, ifVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Pvz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, domain.PVZCity, *int) error); ok {
+		r1 = returnFunc(ctx, pvzId, city, ifVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPvzsService_UpdatePvz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePvz'
+type MockPvzsService_UpdatePvz_Call struct {
+	*mock.Call
+}
+
+// UpdatePvz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pvzId *uuid.UUID
+//   - city domain.PVZCity
+//   - ifVersion *int
+func (_e *MockPvzsService_Expecter) UpdatePvz(ctx interface{}, pvzId interface{}, city interface{}, ifVersion interface{}) *MockPvzsService_UpdatePvz_Call {
+	return &MockPvzsService_UpdatePvz_Call{Call: _e.mock.On("UpdatePvz", ctx, pvzId, city, ifVersion)}
+}
+
+func (_c *MockPvzsService_UpdatePvz_Call) Run(run func(ctx context.Context, pvzId *uuid.UUID, city domain.PVZCity, ifVersion *int)) *MockPvzsService_UpdatePvz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 domain.PVZCity
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZCity)
+		}
+		var arg3 *int
+		if args[3] != nil {
+			arg3 = args[3].(*int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPvzsService_UpdatePvz_Call) Return(pvz *domain.Pvz, err error) *MockPvzsService_UpdatePvz_Call {
+	_c.Call.Return(pvz, err)
+	return _c
+}
+
+func (_c *MockPvzsService_UpdatePvz_Call) RunAndReturn(run func(ctx context.Context, pvzId *uuid.UUID, city domain.PVZCity, ifVersion *int) (*domain.Pvz, error)) *MockPvzsService_UpdatePvz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExportsService creates a new instance of MockExportsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExportsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExportsService {
+	mock := &MockExportsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExportsService is an autogenerated mock type for the ExportsService type
+type MockExportsService struct {
+	mock.Mock
+}
+
+type MockExportsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExportsService) EXPECT() *MockExportsService_Expecter {
+	return &MockExportsService_Expecter{mock: &_m.Mock}
+}
+
+// CreateExportJob provides a mock function for the type MockExportsService
+func (_mock *MockExportsService) CreateExportJob(ctx context.Context, job *domain.ExportJob) (*domain.ExportJob, error) {
+	ret := _mock.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExportJob")
+	}
+
+	var r0 *domain.ExportJob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ExportJob) (*domain.ExportJob, error)); ok {
+		return returnFunc(ctx, job)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ExportJob) *domain.ExportJob); ok {
+		r0 = returnFunc(ctx, job)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExportJob)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ExportJob) error); ok {
+		r1 = returnFunc(ctx, job)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExportsService_CreateExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExportJob'
+type MockExportsService_CreateExportJob_Call struct {
+	*mock.Call
+}
+
+// CreateExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job *domain.ExportJob
+func (_e *MockExportsService_Expecter) CreateExportJob(ctx interface{}, job interface{}) *MockExportsService_CreateExportJob_Call {
+	return &MockExportsService_CreateExportJob_Call{Call: _e.mock.On("CreateExportJob", ctx, job)}
+}
+
+func (_c *MockExportsService_CreateExportJob_Call) Run(run func(ctx context.Context, job *domain.ExportJob)) *MockExportsService_CreateExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ExportJob
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ExportJob)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportsService_CreateExportJob_Call) Return(exportJob *domain.ExportJob, err error) *MockExportsService_CreateExportJob_Call {
+	_c.Call.Return(exportJob, err)
+	return _c
+}
+
+func (_c *MockExportsService_CreateExportJob_Call) RunAndReturn(run func(ctx context.Context, job *domain.ExportJob) (*domain.ExportJob, error)) *MockExportsService_CreateExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportJob provides a mock function for the type MockExportsService
+func (_mock *MockExportsService) ExportJob(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, error) {
+	ret := _mock.Called(ctx, jobId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportJob")
+	}
+
+	var r0 *domain.ExportJob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.ExportJob, error)); ok {
+		return returnFunc(ctx, jobId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.ExportJob); ok {
+		r0 = returnFunc(ctx, jobId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExportJob)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, jobId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExportsService_ExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportJob'
+type MockExportsService_ExportJob_Call struct {
+	*mock.Call
+}
+
+// ExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobId *uuid.UUID
+func (_e *MockExportsService_Expecter) ExportJob(ctx interface{}, jobId interface{}) *MockExportsService_ExportJob_Call {
+	return &MockExportsService_ExportJob_Call{Call: _e.mock.On("ExportJob", ctx, jobId)}
+}
+
+func (_c *MockExportsService_ExportJob_Call) Run(run func(ctx context.Context, jobId *uuid.UUID)) *MockExportsService_ExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportsService_ExportJob_Call) Return(exportJob *domain.ExportJob, err error) *MockExportsService_ExportJob_Call {
+	_c.Call.Return(exportJob, err)
+	return _c
+}
+
+func (_c *MockExportsService_ExportJob_Call) RunAndReturn(run func(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, error)) *MockExportsService_ExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportPvzsData provides a mock function for the type MockExportsService
+func (_mock *MockExportsService) ExportPvzsData(ctx context.Context, format domain.ExportFormat, filter *domain.PvzsFilter, sort domain.PvzsSort, w io.Writer) error {
+	ret := _mock.Called(ctx, format, filter, sort, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportPvzsData")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ExportFormat, *domain.PvzsFilter, domain.PvzsSort, io.Writer) error); ok {
+		r0 = returnFunc(ctx, format, filter, sort, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExportsService_ExportPvzsData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportPvzsData'
+type MockExportsService_ExportPvzsData_Call struct {
+	*mock.Call
+}
+
+// ExportPvzsData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - format domain.ExportFormat
+//   - filter *domain.PvzsFilter
+//   - sort domain.PvzsSort
+//   - w io.Writer
+func (_e *MockExportsService_Expecter) ExportPvzsData(ctx interface{}, format interface{}, filter interface{}, sort interface{}, w interface{}) *MockExportsService_ExportPvzsData_Call {
+	return &MockExportsService_ExportPvzsData_Call{Call: _e.mock.On("ExportPvzsData", ctx, format, filter, sort, w)}
+}
+
+func (_c *MockExportsService_ExportPvzsData_Call) Run(run func(ctx context.Context, format domain.ExportFormat, filter *domain.PvzsFilter, sort domain.PvzsSort, w io.Writer)) *MockExportsService_ExportPvzsData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ExportFormat
+		if args[1] != nil {
+			arg1 = args[1].(domain.ExportFormat)
+		}
+		var arg2 *domain.PvzsFilter
+		if args[2] != nil {
+			arg2 = args[2].(*domain.PvzsFilter)
+		}
+		var arg3 domain.PvzsSort
+		if args[3] != nil {
+			arg3 = args[3].(domain.PvzsSort)
+		}
+		var arg4 io.Writer
+		if args[4] != nil {
+			arg4 = args[4].(io.Writer)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportsService_ExportPvzsData_Call) Return(err error) *MockExportsService_ExportPvzsData_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExportsService_ExportPvzsData_Call) RunAndReturn(run func(ctx context.Context, format domain.ExportFormat, filter *domain.PvzsFilter, sort domain.PvzsSort, w io.Writer) error) *MockExportsService_ExportPvzsData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenExportFile provides a mock function for the type MockExportsService
+func (_mock *MockExportsService) OpenExportFile(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, io.ReadCloser, error) {
+	ret := _mock.Called(ctx, jobId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenExportFile")
+	}
+
+	var r0 *domain.ExportJob
+	var r1 io.ReadCloser
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) (*domain.ExportJob, io.ReadCloser, error)); ok {
+		return returnFunc(ctx, jobId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID) *domain.ExportJob); ok {
+		r0 = returnFunc(ctx, jobId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExportJob)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID) io.ReadCloser); ok {
+		r1 = returnFunc(ctx, jobId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, jobId)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockExportsService_OpenExportFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenExportFile'
+type MockExportsService_OpenExportFile_Call struct {
+	*mock.Call
+}
+
+// OpenExportFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobId *uuid.UUID
+func (_e *MockExportsService_Expecter) OpenExportFile(ctx interface{}, jobId interface{}) *MockExportsService_OpenExportFile_Call {
+	return &MockExportsService_OpenExportFile_Call{Call: _e.mock.On("OpenExportFile", ctx, jobId)}
+}
+
+func (_c *MockExportsService_OpenExportFile_Call) Run(run func(ctx context.Context, jobId *uuid.UUID)) *MockExportsService_OpenExportFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportsService_OpenExportFile_Call) Return(exportJob *domain.ExportJob, readCloser io.ReadCloser, err error) *MockExportsService_OpenExportFile_Call {
+	_c.Call.Return(exportJob, readCloser, err)
+	return _c
+}
+
+func (_c *MockExportsService_OpenExportFile_Call) RunAndReturn(run func(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, io.ReadCloser, error)) *MockExportsService_OpenExportFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunNextExportJob provides a mock function for the type MockExportsService
+func (_mock *MockExportsService) RunNextExportJob(ctx context.Context) (*domain.ExportJob, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunNextExportJob")
+	}
+
+	var r0 *domain.ExportJob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*domain.ExportJob, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *domain.ExportJob); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExportJob)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExportsService_RunNextExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunNextExportJob'
+type MockExportsService_RunNextExportJob_Call struct {
+	*mock.Call
+}
+
+// RunNextExportJob is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockExportsService_Expecter) RunNextExportJob(ctx interface{}) *MockExportsService_RunNextExportJob_Call {
+	return &MockExportsService_RunNextExportJob_Call{Call: _e.mock.On("RunNextExportJob", ctx)}
+}
+
+func (_c *MockExportsService_RunNextExportJob_Call) Run(run func(ctx context.Context)) *MockExportsService_RunNextExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportsService_RunNextExportJob_Call) Return(exportJob *domain.ExportJob, err error) *MockExportsService_RunNextExportJob_Call {
+	_c.Call.Return(exportJob, err)
+	return _c
+}
+
+func (_c *MockExportsService_RunNextExportJob_Call) RunAndReturn(run func(ctx context.Context) (*domain.ExportJob, error)) *MockExportsService_RunNextExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAnalyticsService creates a new instance of MockAnalyticsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAnalyticsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAnalyticsService {
+	mock := &MockAnalyticsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAnalyticsService is an autogenerated mock type for the AnalyticsService type
+type MockAnalyticsService struct {
+	mock.Mock
+}
+
+type MockAnalyticsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAnalyticsService) EXPECT() *MockAnalyticsService_Expecter {
+	return &MockAnalyticsService_Expecter{mock: &_m.Mock}
+}
+
+// ProductsThroughput provides a mock function for the type MockAnalyticsService
+func (_mock *MockAnalyticsService) ProductsThroughput(ctx context.Context, params *domain.ThroughputParams) ([]*domain.ThroughputPoint, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductsThroughput")
+	}
+
+	var r0 []*domain.ThroughputPoint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ThroughputParams) ([]*domain.ThroughputPoint, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ThroughputParams) []*domain.ThroughputPoint); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ThroughputPoint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ThroughputParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsService_ProductsThroughput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductsThroughput'
+type MockAnalyticsService_ProductsThroughput_Call struct {
+	*mock.Call
+}
+
+// ProductsThroughput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *domain.ThroughputParams
+func (_e *MockAnalyticsService_Expecter) ProductsThroughput(ctx interface{}, params interface{}) *MockAnalyticsService_ProductsThroughput_Call {
+	return &MockAnalyticsService_ProductsThroughput_Call{Call: _e.mock.On("ProductsThroughput", ctx, params)}
+}
+
+func (_c *MockAnalyticsService_ProductsThroughput_Call) Run(run func(ctx context.Context, params *domain.ThroughputParams)) *MockAnalyticsService_ProductsThroughput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ThroughputParams
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ThroughputParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsService_ProductsThroughput_Call) Return(throughputPoints []*domain.ThroughputPoint, err error) *MockAnalyticsService_ProductsThroughput_Call {
+	_c.Call.Return(throughputPoints, err)
+	return _c
+}
+
+func (_c *MockAnalyticsService_ProductsThroughput_Call) RunAndReturn(run func(ctx context.Context, params *domain.ThroughputParams) ([]*domain.ThroughputPoint, error)) *MockAnalyticsService_ProductsThroughput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceptionStats provides a mock function for the type MockAnalyticsService
+func (_mock *MockAnalyticsService) ReceptionStats(ctx context.Context, params *domain.ReceptionStatsParams) ([]*domain.ReceptionStats, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceptionStats")
+	}
+
+	var r0 []*domain.ReceptionStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionStatsParams) ([]*domain.ReceptionStats, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.ReceptionStatsParams) []*domain.ReceptionStats); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ReceptionStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.ReceptionStatsParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsService_ReceptionStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceptionStats'
+type MockAnalyticsService_ReceptionStats_Call struct {
+	*mock.Call
+}
+
+// ReceptionStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *domain.ReceptionStatsParams
+func (_e *MockAnalyticsService_Expecter) ReceptionStats(ctx interface{}, params interface{}) *MockAnalyticsService_ReceptionStats_Call {
+	return &MockAnalyticsService_ReceptionStats_Call{Call: _e.mock.On("ReceptionStats", ctx, params)}
+}
+
+func (_c *MockAnalyticsService_ReceptionStats_Call) Run(run func(ctx context.Context, params *domain.ReceptionStatsParams)) *MockAnalyticsService_ReceptionStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.ReceptionStatsParams
+		if args[1] != nil {
+			arg1 = args[1].(*domain.ReceptionStatsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsService_ReceptionStats_Call) Return(receptionStatss []*domain.ReceptionStats, err error) *MockAnalyticsService_ReceptionStats_Call {
+	_c.Call.Return(receptionStatss, err)
+	return _c
+}
+
+func (_c *MockAnalyticsService_ReceptionStats_Call) RunAndReturn(run func(ctx context.Context, params *domain.ReceptionStatsParams) ([]*domain.ReceptionStats, error)) *MockAnalyticsService_ReceptionStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshDailyStats provides a mock function for the type MockAnalyticsService
+func (_mock *MockAnalyticsService) RefreshDailyStats(ctx context.Context, from time.Time, to time.Time) error {
+	ret := _mock.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshDailyStats")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) error); ok {
+		r0 = returnFunc(ctx, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAnalyticsService_RefreshDailyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshDailyStats'
+type MockAnalyticsService_RefreshDailyStats_Call struct {
+	*mock.Call
+}
+
+// RefreshDailyStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *MockAnalyticsService_Expecter) RefreshDailyStats(ctx interface{}, from interface{}, to interface{}) *MockAnalyticsService_RefreshDailyStats_Call {
+	return &MockAnalyticsService_RefreshDailyStats_Call{Call: _e.mock.On("RefreshDailyStats", ctx, from, to)}
+}
+
+func (_c *MockAnalyticsService_RefreshDailyStats_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *MockAnalyticsService_RefreshDailyStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsService_RefreshDailyStats_Call) Return(err error) *MockAnalyticsService_RefreshDailyStats_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAnalyticsService_RefreshDailyStats_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time) error) *MockAnalyticsService_RefreshDailyStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIdempotencyService creates a new instance of MockIdempotencyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdempotencyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdempotencyService {
+	mock := &MockIdempotencyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIdempotencyService is an autogenerated mock type for the IdempotencyService type
+type MockIdempotencyService struct {
+	mock.Mock
+}
+
+type MockIdempotencyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdempotencyService) EXPECT() *MockIdempotencyService_Expecter {
+	return &MockIdempotencyService_Expecter{mock: &_m.Mock}
+}
+
+// AbortIdempotentRequest provides a mock function for the type MockIdempotencyService
+func (_mock *MockIdempotencyService) AbortIdempotentRequest(ctx context.Context, req *domain.IdempotentRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AbortIdempotentRequest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotentRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotencyService_AbortIdempotentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AbortIdempotentRequest'
+type MockIdempotencyService_AbortIdempotentRequest_Call struct {
+	*mock.Call
+}
+
+// AbortIdempotentRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.IdempotentRequest
+func (_e *MockIdempotencyService_Expecter) AbortIdempotentRequest(ctx interface{}, req interface{}) *MockIdempotencyService_AbortIdempotentRequest_Call {
+	return &MockIdempotencyService_AbortIdempotentRequest_Call{Call: _e.mock.On("AbortIdempotentRequest", ctx, req)}
+}
+
+func (_c *MockIdempotencyService_AbortIdempotentRequest_Call) Run(run func(ctx context.Context, req *domain.IdempotentRequest)) *MockIdempotencyService_AbortIdempotentRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.IdempotentRequest
+		if args[1] != nil {
+			arg1 = args[1].(*domain.IdempotentRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyService_AbortIdempotentRequest_Call) Return(err error) *MockIdempotencyService_AbortIdempotentRequest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotencyService_AbortIdempotentRequest_Call) RunAndReturn(run func(ctx context.Context, req *domain.IdempotentRequest) error) *MockIdempotencyService_AbortIdempotentRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BeginIdempotentRequest provides a mock function for the type MockIdempotencyService
+func (_mock *MockIdempotencyService) BeginIdempotentRequest(ctx context.Context, req *domain.IdempotentRequest) (*domain.IdempotentResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginIdempotentRequest")
+	}
+
+	var r0 *domain.IdempotentResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotentRequest) (*domain.IdempotentResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotentRequest) *domain.IdempotentResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.IdempotentResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.IdempotentRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIdempotencyService_BeginIdempotentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginIdempotentRequest'
+type MockIdempotencyService_BeginIdempotentRequest_Call struct {
+	*mock.Call
+}
+
+// BeginIdempotentRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.IdempotentRequest
+func (_e *MockIdempotencyService_Expecter) BeginIdempotentRequest(ctx interface{}, req interface{}) *MockIdempotencyService_BeginIdempotentRequest_Call {
+	return &MockIdempotencyService_BeginIdempotentRequest_Call{Call: _e.mock.On("BeginIdempotentRequest", ctx, req)}
+}
+
+func (_c *MockIdempotencyService_BeginIdempotentRequest_Call) Run(run func(ctx context.Context, req *domain.IdempotentRequest)) *MockIdempotencyService_BeginIdempotentRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.IdempotentRequest
+		if args[1] != nil {
+			arg1 = args[1].(*domain.IdempotentRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyService_BeginIdempotentRequest_Call) Return(idempotentResponse *domain.IdempotentResponse, err error) *MockIdempotencyService_BeginIdempotentRequest_Call {
+	_c.Call.Return(idempotentResponse, err)
+	return _c
+}
+
+func (_c *MockIdempotencyService_BeginIdempotentRequest_Call) RunAndReturn(run func(ctx context.Context, req *domain.IdempotentRequest) (*domain.IdempotentResponse, error)) *MockIdempotencyService_BeginIdempotentRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishIdempotentRequest provides a mock function for the type MockIdempotencyService
+func (_mock *MockIdempotencyService) FinishIdempotentRequest(ctx context.Context, req *domain.IdempotentRequest, resp *domain.IdempotentResponse) error {
+	ret := _mock.Called(ctx, req, resp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishIdempotentRequest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.IdempotentRequest, *domain.IdempotentResponse) error); ok {
+		r0 = returnFunc(ctx, req, resp)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotencyService_FinishIdempotentRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishIdempotentRequest'
+type MockIdempotencyService_FinishIdempotentRequest_Call struct {
+	*mock.Call
+}
+
+// FinishIdempotentRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.IdempotentRequest
+//   - resp *domain.IdempotentResponse
+func (_e *MockIdempotencyService_Expecter) FinishIdempotentRequest(ctx interface{}, req interface{}, resp interface{}) *MockIdempotencyService_FinishIdempotentRequest_Call {
+	return &MockIdempotencyService_FinishIdempotentRequest_Call{Call: _e.mock.On("FinishIdempotentRequest", ctx, req, resp)}
+}
+
+func (_c *MockIdempotencyService_FinishIdempotentRequest_Call) Run(run func(ctx context.Context, req *domain.IdempotentRequest, resp *domain.IdempotentResponse)) *MockIdempotencyService_FinishIdempotentRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.IdempotentRequest
+		if args[1] != nil {
+			arg1 = args[1].(*domain.IdempotentRequest)
+		}
+		var arg2 *domain.IdempotentResponse
+		if args[2] != nil {
+			arg2 = args[2].(*domain.IdempotentResponse)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyService_FinishIdempotentRequest_Call) Return(err error) *MockIdempotencyService_FinishIdempotentRequest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotencyService_FinishIdempotentRequest_Call) RunAndReturn(run func(ctx context.Context, req *domain.IdempotentRequest, resp *domain.IdempotentResponse) error) *MockIdempotencyService_FinishIdempotentRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
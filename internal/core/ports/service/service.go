@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shrtyk/pvz-service/internal/core/domain"
@@ -11,17 +13,83 @@ import (
 //go:generate mockery
 type Service interface {
 	PvzsService
+	ExportsService
+	AnalyticsService
+	IdempotencyService
 	AuthService
 }
 
 type PvzsService interface {
 	NewPVZ(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error)
 	OpenNewPVZReception(ctx context.Context, rec *domain.Reception) (*domain.Reception, error)
+	Pvz(ctx context.Context, pvzId *uuid.UUID) (*domain.Pvz, error)
+	UpdatePvz(ctx context.Context, pvzId *uuid.UUID, city domain.PVZCity, ifVersion *int) (*domain.Pvz, error)
+	Reception(ctx context.Context, receptionId *uuid.UUID) (*domain.Reception, error)
 	AddProductPVZ(ctx context.Context, prod *domain.Product) (*domain.Product, error)
-	DeleteLastProductPvz(ctx context.Context, pvzId *uuid.UUID) error
-	CloseReceptionInPvz(ctx context.Context, pvzId *uuid.UUID) error
-	GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) ([]*domain.PvzReceptions, error)
-	GetAllPvzs(ctx context.Context) ([]*domain.Pvz, error)
+	AddProductsBatch(ctx context.Context, batch *domain.ProductsBatch) (*domain.ProductsBatchResult, error)
+	// ProductsByBarcode returns every product with the barcode, newest first. Without
+	// global barcode uniqueness the same barcode may be found in several receptions.
+	ProductsByBarcode(ctx context.Context, barcode string) ([]*domain.ProductInfo, error)
+	DeleteLastProductPvz(ctx context.Context, pvzId, actorId *uuid.UUID) error
+	DeleteProduct(ctx context.Context, productId, actorId *uuid.UUID) error
+	RestoreProduct(ctx context.Context, productId *uuid.UUID) (*domain.Product, error)
+	ProductDeletions(ctx context.Context, receptionId *uuid.UUID) ([]*domain.ProductDeletion, error)
+	IssuePickupCode(ctx context.Context, productId *uuid.UUID) (string, error)
+	IssueProduct(ctx context.Context, productId *uuid.UUID, pickupCode string, actorId *uuid.UUID) (*domain.Product, error)
+	ReturnProduct(ctx context.Context, productId, actorId *uuid.UUID) (*domain.Product, error)
+	ProductStatusHistory(ctx context.Context, productId *uuid.UUID) ([]*domain.ProductStatusChange, error)
+	// Reception changing methods take ifVersion, when it is not nil the reception must
+	// have that version, otherwise they fail with VersionMismatch.
+	CloseReceptionInPvz(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.ReceptionCloseReport, error)
+	CancelReceptionInPvz(ctx context.Context, pvzId *uuid.UUID, ifVersion *int) (*domain.Reception, error)
+	ReopenReception(ctx context.Context, receptionId *uuid.UUID, ifVersion *int) (*domain.Reception, error)
+	SetReceptionManifest(ctx context.Context, manifest *domain.ReceptionManifest) (*domain.ReceptionManifest, error)
+	AutoCloseStaleReceptions(ctx context.Context, idleFor time.Duration) ([]*domain.Reception, error)
+	GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) (*domain.PvzsPage, error)
+	// StreamPvzsData passes every PVZ of the page to yield. The page is read in
+	// chunks, so yield is never called while a query is running.
+	// The returned page carries only the next cursor and the total.
+	StreamPvzsData(
+		ctx context.Context,
+		params *domain.PvzsReadParams,
+		yield func(*domain.PvzReceptions) error,
+	) (*domain.PvzsPage, error)
+	GetAllPvzs(ctx context.Context, filter *domain.PvzsFilter, sort domain.PvzsSort) ([]*domain.Pvz, error)
+	PvzStock(ctx context.Context, pvzId *uuid.UUID) (*domain.PvzStock, error)
+	AllPvzsStock(ctx context.Context) ([]*domain.PvzStock, error)
+	OpenReceptionsCount(ctx context.Context) (int, error)
+}
+
+type ExportsService interface {
+	// ExportPvzsData writes every PVZ matching the filter into w, reading it page by page.
+	ExportPvzsData(
+		ctx context.Context,
+		format domain.ExportFormat,
+		filter *domain.PvzsFilter,
+		sort domain.PvzsSort,
+		w io.Writer,
+	) error
+	CreateExportJob(ctx context.Context, job *domain.ExportJob) (*domain.ExportJob, error)
+	ExportJob(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, error)
+	OpenExportFile(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, io.ReadCloser, error)
+	// RunNextExportJob runs the oldest pending export job. It returns nil if there is none.
+	RunNextExportJob(ctx context.Context) (*domain.ExportJob, error)
+}
+
+type AnalyticsService interface {
+	ProductsThroughput(ctx context.Context, params *domain.ThroughputParams) ([]*domain.ThroughputPoint, error)
+	ReceptionStats(ctx context.Context, params *domain.ReceptionStatsParams) ([]*domain.ReceptionStats, error)
+	RefreshDailyStats(ctx context.Context, from, to time.Time) error
+}
+
+type IdempotencyService interface {
+	// BeginIdempotentRequest reserves the key of req. It returns the stored response
+	// when the request was already processed and nil when it should be processed now.
+	BeginIdempotentRequest(ctx context.Context, req *domain.IdempotentRequest) (*domain.IdempotentResponse, error)
+	// FinishIdempotentRequest stores the response to replay when req is retried.
+	FinishIdempotentRequest(ctx context.Context, req *domain.IdempotentRequest, resp *domain.IdempotentResponse) error
+	// AbortIdempotentRequest releases the key of req, so that the request may be retried.
+	AbortIdempotentRequest(ctx context.Context, req *domain.IdempotentRequest) error
 }
 
 type AuthService interface {
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	ps "github.com/shrtyk/pvz-service/internal/core/ports/service"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+)
+
+func (s *service) ProductsThroughput(
+	ctx context.Context,
+	params *domain.ThroughputParams,
+) ([]*domain.ThroughputPoint, error) {
+	const op = "service.ProductsThroughput"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	points, err := s.repo.ProductsThroughput(tctx, params)
+	if err != nil {
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return points, nil
+}
+
+func (s *service) ReceptionStats(
+	ctx context.Context,
+	params *domain.ReceptionStatsParams,
+) ([]*domain.ReceptionStats, error) {
+	const op = "service.ReceptionStats"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	stats, err := s.repo.ReceptionStats(tctx, params)
+	if err != nil {
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return stats, nil
+}
+
+// RefreshDailyStats recomputes the daily rollups the analytics are read from.
+// Unlike the other calls it isn't bound by the service timeout: backfilling
+// a wide range may take a while, callers pass their own deadline.
+func (s *service) RefreshDailyStats(ctx context.Context, from, to time.Time) error {
+	const op = "service.RefreshDailyStats"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	if err := s.repo.RefreshDailyStats(ctx, from, to); err != nil {
+		return xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	metricsmocks "github.com/shrtyk/pvz-service/internal/core/ports/metrics/mocks"
+	repomocks "github.com/shrtyk/pvz-service/internal/core/ports/repository/mocks"
+	ps "github.com/shrtyk/pvz-service/internal/core/ports/service"
+	"github.com/shrtyk/pvz-service/internal/core/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProductsThroughput(t *testing.T) {
+	t.Parallel()
+
+	params := &domain.ThroughputParams{Period: domain.AnalyticsWeek, GroupBy: domain.AnalyticsByPvz}
+	tests := []struct {
+		name    string
+		points  []*domain.ThroughputPoint
+		err     error
+		wantErr bool
+	}{
+		{name: "success", points: []*domain.ThroughputPoint{{Key: "k", Products: 1}}},
+		{name: "error", err: errors.New("repo error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			s := service.NewAppService(time.Second, repo, nil, nil, new(metricsmocks.MockCollector))
+			repo.On("ProductsThroughput", mock.Anything, params).Return(tt.points, tt.err)
+
+			points, err := s.ProductsThroughput(context.Background(), params)
+			if tt.wantErr {
+				assertServiceErrKind(t, err, ps.Unexpected)
+				assert.Nil(t, points)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.points, points)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReceptionStats(t *testing.T) {
+	t.Parallel()
+
+	params := &domain.ReceptionStatsParams{GroupBy: domain.AnalyticsByCity}
+	tests := []struct {
+		name    string
+		stats   []*domain.ReceptionStats
+		err     error
+		wantErr bool
+	}{
+		{name: "success", stats: []*domain.ReceptionStats{{Key: "Москва", Receptions: 2}}},
+		{name: "error", err: errors.New("repo error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			s := service.NewAppService(time.Second, repo, nil, nil, new(metricsmocks.MockCollector))
+			repo.On("ReceptionStats", mock.Anything, params).Return(tt.stats, tt.err)
+
+			stats, err := s.ReceptionStats(context.Background(), params)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, stats)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.stats, stats)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRefreshDailyStats(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 2)
+	tests := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{name: "success"},
+		{name: "error", err: errors.New("repo error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			s := service.NewAppService(time.Second, repo, nil, nil, new(metricsmocks.MockCollector))
+			repo.On("RefreshDailyStats", mock.Anything, from, to).Return(tt.err)
+
+			err := s.RefreshDailyStats(context.Background(), from, to)
+			if tt.wantErr {
+				assertServiceErrKind(t, err, ps.Unexpected)
+			} else {
+				assert.NoError(t, err)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
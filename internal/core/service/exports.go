@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	pr "github.com/shrtyk/pvz-service/internal/core/ports/repository"
+	ps "github.com/shrtyk/pvz-service/internal/core/ports/service"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+)
+
+// exportPageSize is how many PVZs are read per query, and held in memory,
+// while exporting.
+const exportPageSize = 500
+
+// exportFailedMsg is kept on failed jobs instead of the internal error.
+const exportFailedMsg = "export failed"
+
+var errExportsNotConfigured = errors.New("exports are not configured")
+
+func (s *service) ExportPvzsData(
+	ctx context.Context,
+	format domain.ExportFormat,
+	filter *domain.PvzsFilter,
+	sort domain.PvzsSort,
+	w io.Writer,
+) error {
+	const op = "service.ExportPvzsData"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	if err := s.encodeExport(ctx, format, filter, sort, w); err != nil {
+		return xerr.WrapErr(op, ps.Unexpected, err)
+	}
+	return nil
+}
+
+func (s *service) CreateExportJob(ctx context.Context, job *domain.ExportJob) (*domain.ExportJob, error) {
+	const op = "service.CreateExportJob"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	newJob, err := s.repo.CreateExportJob(tctx, job)
+	if err != nil {
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return newJob, nil
+}
+
+func (s *service) ExportJob(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, error) {
+	const op = "service.ExportJob"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	job, err := s.repo.ExportJob(tctx, jobId)
+	if err != nil {
+		var repoErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &repoErr) && repoErr.Kind == pr.NotFound {
+			return nil, xerr.WrapErr(op, ps.ExportJobNotFound, err)
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return job, nil
+}
+
+func (s *service) OpenExportFile(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, io.ReadCloser, error) {
+	const op = "service.OpenExportFile"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	job, err := s.ExportJob(ctx, jobId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if job.Status != domain.ExportJobDone {
+		return nil, nil, xerr.NewErr(op, ps.ExportNotReady)
+	}
+	if s.exportFiles == nil {
+		return nil, nil, xerr.WrapErr(op, ps.Unexpected, errExportsNotConfigured)
+	}
+
+	f, err := s.exportFiles.Open(job.FileName())
+	if err != nil {
+		return nil, nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return job, f, nil
+}
+
+func (s *service) RunNextExportJob(ctx context.Context) (*domain.ExportJob, error) {
+	const op = "service.RunNextExportJob"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	job, err := s.repo.ClaimExportJob(tctx)
+	if err != nil {
+		var repoErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &repoErr) && repoErr.Kind == pr.NotFound {
+			return nil, nil
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	runErr := s.writeExportFile(ctx, job)
+
+	job.Status = domain.ExportJobDone
+	if runErr != nil {
+		msg := exportFailedMsg
+		job.Status, job.Error = domain.ExportJobFailed, &msg
+		if s.exportFiles != nil {
+			_ = s.exportFiles.Remove(job.FileName())
+		}
+	}
+
+	fctx, fcancel := context.WithTimeout(ctx, s.timeout)
+	defer fcancel()
+
+	if err := s.repo.FinishExportJob(fctx, &job.Id, job.Status, job.Error); err != nil {
+		return nil, xerr.WrapErr(op, ps.Unexpected, errors.Join(runErr, err))
+	}
+	if runErr != nil {
+		return job, xerr.WrapErr(op, ps.Unexpected, runErr)
+	}
+
+	return job, nil
+}
+
+func (s *service) writeExportFile(ctx context.Context, job *domain.ExportJob) (err error) {
+	if s.exportFiles == nil {
+		return errExportsNotConfigured
+	}
+
+	f, err := s.exportFiles.Create(job.FileName())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, f.Close())
+	}()
+
+	return s.encodeExport(ctx, job.Format, &job.Filter, job.Sort, f)
+}
+
+func (s *service) encodeExport(
+	ctx context.Context,
+	format domain.ExportFormat,
+	filter *domain.PvzsFilter,
+	sort domain.PvzsSort,
+	w io.Writer,
+) error {
+	if s.encoders == nil {
+		return errExportsNotConfigured
+	}
+
+	enc, err := s.encoders.NewEncoder(format, w)
+	if err != nil {
+		return err
+	}
+
+	params := &domain.PvzsReadParams{
+		PvzsFilter: *filter,
+		Sort:       sort,
+		Page:       1,
+		Limit:      exportPageSize,
+	}
+	// A page is encoded only once its rows are closed, so a slow writer
+	// neither holds a connection nor eats into the query timeout.
+	for {
+		items, page, err := s.readPvzsChunk(ctx, params)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := enc.Encode(item); err != nil {
+				return err
+			}
+		}
+		if page.NextCursor == nil {
+			break
+		}
+		params.Cursor = page.NextCursor
+	}
+
+	return enc.Close()
+}
@@ -0,0 +1,282 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	exportmocks "github.com/shrtyk/pvz-service/internal/core/ports/export/mocks"
+	metricsmocks "github.com/shrtyk/pvz-service/internal/core/ports/metrics/mocks"
+	pRepo "github.com/shrtyk/pvz-service/internal/core/ports/repository"
+	repomocks "github.com/shrtyk/pvz-service/internal/core/ports/repository/mocks"
+	ps "github.com/shrtyk/pvz-service/internal/core/ports/service"
+	"github.com/shrtyk/pvz-service/internal/core/service"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type nopWriteCloser struct {
+	io.Writer
+	closed bool
+}
+
+func (w *nopWriteCloser) Close() error {
+	w.closed = true
+	return nil
+}
+
+func newExportsService(t *testing.T) (
+	ps.Service, *repomocks.MockRepository, *exportmocks.MockEncoderFactory, *exportmocks.MockFileStorage,
+) {
+	repo := new(repomocks.MockRepository)
+	encoders := exportmocks.NewMockEncoderFactory(t)
+	files := exportmocks.NewMockFileStorage(t)
+	s := service.NewAppService(
+		time.Second, repo, nil, nil, new(metricsmocks.MockCollector),
+		service.WithExports(encoders, files),
+	)
+	return s, repo, encoders, files
+}
+
+func assertServiceErrKind(t *testing.T, err error, kind ps.ServiceErrKind) {
+	t.Helper()
+
+	var serviceErr *xerr.BaseErr[ps.ServiceErrKind]
+	require.ErrorAs(t, err, &serviceErr)
+	assert.Equal(t, kind, serviceErr.Kind)
+}
+
+func TestExportPvzsData(t *testing.T) {
+	t.Parallel()
+
+	filter := &domain.PvzsFilter{Cities: []domain.PVZCity{domain.Moscow}}
+	sort := domain.PvzsSort{Field: domain.PvzsSortByCity}
+	cursor := &domain.PvzsCursor{Id: uuid.New()}
+	first := &domain.PvzReceptions{Pvz: &domain.Pvz{Id: uuid.New()}}
+	second := &domain.PvzReceptions{Pvz: &domain.Pvz{Id: uuid.New()}}
+
+	t.Run("reads every page", func(t *testing.T) {
+		t.Parallel()
+
+		s, repo, encoders, _ := newExportsService(t)
+		enc := exportmocks.NewMockEncoder(t)
+		w := new(bytes.Buffer)
+
+		// Nothing is encoded while a query is reading rows.
+		querying := false
+		encodesBetweenQueries := func(*domain.PvzReceptions) { assert.False(t, querying) }
+
+		encoders.EXPECT().NewEncoder(domain.ExportCSV, w).Return(enc, nil)
+		repo.On("StreamPvzsData", mock.Anything, mock.MatchedBy(func(p *domain.PvzsReadParams) bool {
+			return p.Cursor == nil && p.Limit == 500 && p.Cities[0] == domain.Moscow && p.Sort == sort
+		}), mock.Anything).
+			Run(func(args mock.Arguments) {
+				querying = true
+				defer func() { querying = false }()
+				yield := args.Get(2).(func(*domain.PvzReceptions) error)
+				require.NoError(t, yield(first))
+			}).
+			Return(&domain.PvzsPage{NextCursor: cursor}, nil).Once()
+		repo.On("StreamPvzsData", mock.Anything, mock.MatchedBy(func(p *domain.PvzsReadParams) bool {
+			return p.Cursor == cursor
+		}), mock.Anything).
+			Run(func(args mock.Arguments) {
+				querying = true
+				defer func() { querying = false }()
+				yield := args.Get(2).(func(*domain.PvzReceptions) error)
+				require.NoError(t, yield(second))
+			}).
+			Return(&domain.PvzsPage{}, nil).Once()
+		enc.EXPECT().Encode(first).Run(encodesBetweenQueries).Return(nil).Once()
+		enc.EXPECT().Encode(second).Run(encodesBetweenQueries).Return(nil).Once()
+		enc.EXPECT().Close().Return(nil).Once()
+
+		err := s.ExportPvzsData(context.Background(), domain.ExportCSV, filter, sort, w)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		t.Parallel()
+
+		s, repo, encoders, _ := newExportsService(t)
+		enc := exportmocks.NewMockEncoder(t)
+		w := new(bytes.Buffer)
+
+		encoders.EXPECT().NewEncoder(domain.ExportXLSX, w).Return(enc, nil)
+		repo.On("StreamPvzsData", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("repo error"))
+
+		err := s.ExportPvzsData(context.Background(), domain.ExportXLSX, filter, sort, w)
+		assertServiceErrKind(t, err, ps.Unexpected)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		t.Parallel()
+
+		s := service.NewAppService(time.Second, new(repomocks.MockRepository), nil, nil, new(metricsmocks.MockCollector))
+		err := s.ExportPvzsData(context.Background(), domain.ExportCSV, filter, sort, io.Discard)
+		assertServiceErrKind(t, err, ps.Unexpected)
+	})
+}
+
+func TestCreateExportJob(t *testing.T) {
+	t.Parallel()
+
+	job := &domain.ExportJob{Format: domain.ExportCSV, CreatedBy: uuid.New()}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		s, repo, _, _ := newExportsService(t)
+		repo.On("CreateExportJob", mock.Anything, job).
+			Return(&domain.ExportJob{Id: uuid.New(), Status: domain.ExportJobPending}, nil)
+
+		newJob, err := s.CreateExportJob(context.Background(), job)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ExportJobPending, newJob.Status)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		s, repo, _, _ := newExportsService(t)
+		repo.On("CreateExportJob", mock.Anything, job).Return(nil, errors.New("repo error"))
+
+		newJob, err := s.CreateExportJob(context.Background(), job)
+		assertServiceErrKind(t, err, ps.Unexpected)
+		assert.Nil(t, newJob)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestExportJob(t *testing.T) {
+	t.Parallel()
+
+	jobId := uuid.New()
+	tests := []struct {
+		name    string
+		repoErr error
+		want    ps.ServiceErrKind
+	}{
+		{name: "not found", repoErr: xerr.NewErr("op", pRepo.NotFound), want: ps.ExportJobNotFound},
+		{name: "unexpected", repoErr: errors.New("repo error"), want: ps.Unexpected},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, repo, _, _ := newExportsService(t)
+			repo.On("ExportJob", mock.Anything, &jobId).Return(nil, tt.repoErr)
+
+			job, err := s.ExportJob(context.Background(), &jobId)
+			assertServiceErrKind(t, err, tt.want)
+			assert.Nil(t, job)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOpenExportFile(t *testing.T) {
+	t.Parallel()
+
+	jobId := uuid.New()
+
+	t.Run("done", func(t *testing.T) {
+		t.Parallel()
+
+		s, repo, _, files := newExportsService(t)
+		job := &domain.ExportJob{Id: jobId, Format: domain.ExportCSV, Status: domain.ExportJobDone}
+		repo.On("ExportJob", mock.Anything, &jobId).Return(job, nil)
+		files.EXPECT().Open(job.FileName()).Return(io.NopCloser(bytes.NewReader(nil)), nil)
+
+		gotJob, f, err := s.OpenExportFile(context.Background(), &jobId)
+		require.NoError(t, err)
+		assert.Equal(t, job, gotJob)
+		assert.NotNil(t, f)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("not ready", func(t *testing.T) {
+		t.Parallel()
+
+		s, repo, _, _ := newExportsService(t)
+		repo.On("ExportJob", mock.Anything, &jobId).
+			Return(&domain.ExportJob{Id: jobId, Status: domain.ExportJobRunning}, nil)
+
+		_, f, err := s.OpenExportFile(context.Background(), &jobId)
+		assertServiceErrKind(t, err, ps.ExportNotReady)
+		assert.Nil(t, f)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestRunNextExportJob(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no pending jobs", func(t *testing.T) {
+		t.Parallel()
+
+		s, repo, _, _ := newExportsService(t)
+		repo.On("ClaimExportJob", mock.Anything).Return(nil, xerr.NewErr("op", pRepo.NotFound))
+
+		job, err := s.RunNextExportJob(context.Background())
+		assert.NoError(t, err)
+		assert.Nil(t, job)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("done", func(t *testing.T) {
+		t.Parallel()
+
+		s, repo, encoders, files := newExportsService(t)
+		enc := exportmocks.NewMockEncoder(t)
+		job := &domain.ExportJob{Id: uuid.New(), Format: domain.ExportXLSX, Status: domain.ExportJobRunning}
+		f := &nopWriteCloser{Writer: io.Discard}
+
+		repo.On("ClaimExportJob", mock.Anything).Return(job, nil)
+		files.EXPECT().Create(job.FileName()).Return(f, nil)
+		encoders.EXPECT().NewEncoder(domain.ExportXLSX, f).Return(enc, nil)
+		repo.On("StreamPvzsData", mock.Anything, mock.Anything, mock.Anything).Return(&domain.PvzsPage{}, nil)
+		enc.EXPECT().Close().Return(nil)
+		repo.On("FinishExportJob", mock.Anything, &job.Id, domain.ExportJobDone, (*string)(nil)).Return(nil)
+
+		gotJob, err := s.RunNextExportJob(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, domain.ExportJobDone, gotJob.Status)
+		assert.True(t, f.closed)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		t.Parallel()
+
+		s, repo, encoders, files := newExportsService(t)
+		job := &domain.ExportJob{Id: uuid.New(), Format: domain.ExportCSV, Status: domain.ExportJobRunning}
+		f := &nopWriteCloser{Writer: io.Discard}
+
+		repo.On("ClaimExportJob", mock.Anything).Return(job, nil)
+		files.EXPECT().Create(job.FileName()).Return(f, nil)
+		encoders.EXPECT().NewEncoder(domain.ExportCSV, f).Return(nil, errors.New("encoder error"))
+		files.EXPECT().Remove(job.FileName()).Return(nil)
+		repo.On("FinishExportJob", mock.Anything, &job.Id, domain.ExportJobFailed, mock.Anything).Return(nil)
+
+		gotJob, err := s.RunNextExportJob(context.Background())
+		assertServiceErrKind(t, err, ps.Unexpected)
+		require.NotNil(t, gotJob)
+		assert.Equal(t, domain.ExportJobFailed, gotJob.Status)
+		require.NotNil(t, gotJob.Error)
+		assert.Equal(t, "export failed", *gotJob.Error)
+		assert.True(t, f.closed)
+		repo.AssertExpectations(t)
+	})
+}
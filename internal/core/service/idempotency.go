@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	pr "github.com/shrtyk/pvz-service/internal/core/ports/repository"
+	ps "github.com/shrtyk/pvz-service/internal/core/ports/service"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+)
+
+// defaultIdempotencyTTL is how long idempotent responses are kept unless WithIdempotencyTTL is used.
+const defaultIdempotencyTTL = 24 * time.Hour
+
+// idempotencyLockTimeout is how long a reserved key waits for its response. After that
+// the request is considered lost and the key may be reserved again.
+const idempotencyLockTimeout = time.Minute
+
+func (s *service) BeginIdempotentRequest(
+	ctx context.Context,
+	req *domain.IdempotentRequest,
+) (*domain.IdempotentResponse, error) {
+	const op = "service.BeginIdempotentRequest"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	now := time.Now()
+	stored, err := s.repo.ReserveIdempotencyKey(tctx, &domain.IdempotencyRecord{
+		IdempotentRequest: *req,
+		CreatedAt:         now,
+		ExpiresAt:         now.Add(s.idempotencyTTL),
+	}, now.Add(-idempotencyLockTimeout))
+	if err != nil {
+		var repoErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &repoErr) && repoErr.Kind == pr.Conflict {
+			return nil, xerr.WrapErr(op, ps.IdempotentReqInProgress, err)
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	switch {
+	case stored == nil:
+		return nil, nil
+	case stored.Fingerprint != req.Fingerprint:
+		return nil, xerr.NewErr(op, ps.IdempotencyKeyReused)
+	case stored.Response == nil:
+		return nil, xerr.NewErr(op, ps.IdempotentReqInProgress)
+	}
+
+	return stored.Response, nil
+}
+
+func (s *service) FinishIdempotentRequest(
+	ctx context.Context,
+	req *domain.IdempotentRequest,
+	resp *domain.IdempotentResponse,
+) error {
+	const op = "service.FinishIdempotentRequest"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	if err := s.repo.SaveIdempotentResponse(tctx, req, resp); err != nil {
+		return xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return nil
+}
+
+func (s *service) AbortIdempotentRequest(ctx context.Context, req *domain.IdempotentRequest) error {
+	const op = "service.AbortIdempotentRequest"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	if err := s.repo.DeleteIdempotencyKey(tctx, req); err != nil {
+		return xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	metricsmocks "github.com/shrtyk/pvz-service/internal/core/ports/metrics/mocks"
+	pr "github.com/shrtyk/pvz-service/internal/core/ports/repository"
+	repomocks "github.com/shrtyk/pvz-service/internal/core/ports/repository/mocks"
+	ps "github.com/shrtyk/pvz-service/internal/core/ports/service"
+	"github.com/shrtyk/pvz-service/internal/core/service"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeginIdempotentRequest(t *testing.T) {
+	t.Parallel()
+
+	req := &domain.IdempotentRequest{UserId: uuid.New(), Key: "key", Fingerprint: "fp"}
+	resp := &domain.IdempotentResponse{StatusCode: 201, Body: []byte(`{}`)}
+	storedWith := func(fingerprint string, resp *domain.IdempotentResponse) *domain.IdempotencyRecord {
+		return &domain.IdempotencyRecord{
+			IdempotentRequest: domain.IdempotentRequest{UserId: req.UserId, Key: req.Key, Fingerprint: fingerprint},
+			Response:          resp,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		stored   *domain.IdempotencyRecord
+		err      error
+		wantResp *domain.IdempotentResponse
+		wantKind ps.ServiceErrKind
+	}{
+		{name: "key is reserved"},
+		{name: "response is replayed", stored: storedWith("fp", resp), wantResp: resp},
+		{name: "key reused", stored: storedWith("other", resp), wantKind: ps.IdempotencyKeyReused},
+		{name: "in progress", stored: storedWith("fp", nil), wantKind: ps.IdempotentReqInProgress},
+		{
+			name:     "released concurrently",
+			err:      xerr.NewErr("repo", pr.Conflict),
+			wantKind: ps.IdempotentReqInProgress,
+		},
+		{name: "unexpected", err: errors.New("repo error"), wantKind: ps.Unexpected},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			ttl := 2 * time.Hour
+			s := service.NewAppService(
+				time.Second, repo, nil, nil, new(metricsmocks.MockCollector), service.WithIdempotencyTTL(ttl),
+			)
+			repo.On(
+				"ReserveIdempotencyKey",
+				mock.Anything,
+				mock.MatchedBy(func(rec *domain.IdempotencyRecord) bool {
+					return rec.IdempotentRequest == *req && rec.ExpiresAt.Sub(rec.CreatedAt) == ttl
+				}),
+				mock.AnythingOfType("time.Time"),
+			).Return(tt.stored, tt.err)
+
+			got, err := s.BeginIdempotentRequest(context.Background(), req)
+			if tt.wantKind != "" {
+				assertServiceErrKind(t, err, tt.wantKind)
+				assert.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantResp, got)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestFinishIdempotentRequest(t *testing.T) {
+	t.Parallel()
+
+	req := &domain.IdempotentRequest{UserId: uuid.New(), Key: "key", Fingerprint: "fp"}
+	resp := &domain.IdempotentResponse{StatusCode: 200}
+
+	repo := new(repomocks.MockRepository)
+	s := service.NewAppService(time.Second, repo, nil, nil, new(metricsmocks.MockCollector))
+	repo.On("SaveIdempotentResponse", mock.Anything, req, resp).Return(nil).Once()
+	repo.On("SaveIdempotentResponse", mock.Anything, req, resp).Return(errors.New("repo error")).Once()
+
+	assert.NoError(t, s.FinishIdempotentRequest(context.Background(), req, resp))
+	assertServiceErrKind(t, s.FinishIdempotentRequest(context.Background(), req, resp), ps.Unexpected)
+	repo.AssertExpectations(t)
+}
+
+func TestAbortIdempotentRequest(t *testing.T) {
+	t.Parallel()
+
+	req := &domain.IdempotentRequest{UserId: uuid.New(), Key: "key", Fingerprint: "fp"}
+
+	repo := new(repomocks.MockRepository)
+	s := service.NewAppService(time.Second, repo, nil, nil, new(metricsmocks.MockCollector))
+	repo.On("DeleteIdempotencyKey", mock.Anything, req).Return(nil).Once()
+	repo.On("DeleteIdempotencyKey", mock.Anything, req).Return(errors.New("repo error")).Once()
+
+	assert.NoError(t, s.AbortIdempotentRequest(context.Background(), req))
+	assertServiceErrKind(t, s.AbortIdempotentRequest(context.Background(), req), ps.Unexpected)
+	repo.AssertExpectations(t)
+}
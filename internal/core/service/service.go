@@ -2,8 +2,12 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/subtle"
 	"errors"
+	"fmt"
+	"math"
+	"math/big"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,19 +15,99 @@ import (
 	"github.com/shrtyk/pvz-service/internal/core/domain"
 	"github.com/shrtyk/pvz-service/internal/core/domain/auth"
 	pa "github.com/shrtyk/pvz-service/internal/core/ports/auth"
+	pe "github.com/shrtyk/pvz-service/internal/core/ports/export"
 	"github.com/shrtyk/pvz-service/internal/core/ports/metrics"
 	pwd "github.com/shrtyk/pvz-service/internal/core/ports/pwd_service"
 	pr "github.com/shrtyk/pvz-service/internal/core/ports/repository"
 	ps "github.com/shrtyk/pvz-service/internal/core/ports/service"
 	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+	"go.opentelemetry.io/otel"
 )
 
+// tracer starts a span for every method of the service, named after its op.
+var tracer = otel.Tracer("github.com/shrtyk/pvz-service/internal/core/service")
+
+// streamChunkSize is how many PVZs of a streamed page are read per query.
+const streamChunkSize = 100
+
 type service struct {
 	timeout time.Duration
 	repo    pr.Repository
 	pwdSrc  pwd.PasswordService
 	tknSrc  pa.TokenService
 	metrics metrics.Collector
+
+	globalBarcodeUniqueness bool
+	reopenWindow            time.Duration
+	storagePeriod           time.Duration
+	pickupCodeTTL           time.Duration
+	pickupCodeMaxAttempts   int
+	pvzCapacity             int
+	encoders                pe.EncoderFactory
+	exportFiles             pe.FileStorage
+	idempotencyTTL          time.Duration
+}
+
+// Option configures optional service behaviour.
+type Option func(*service)
+
+// WithGlobalBarcodeUniqueness makes product barcodes unique across all receptions,
+// not only within a single one.
+func WithGlobalBarcodeUniqueness(enabled bool) Option {
+	return func(s *service) {
+		s.globalBarcodeUniqueness = enabled
+	}
+}
+
+// WithReopenWindow sets how long after closing a reception it can still be reopened.
+func WithReopenWindow(d time.Duration) Option {
+	return func(s *service) {
+		s.reopenWindow = d
+	}
+}
+
+// WithStoragePeriod sets how long a product is kept in a PVZ before it can be returned to sender.
+func WithStoragePeriod(d time.Duration) Option {
+	return func(s *service) {
+		s.storagePeriod = d
+	}
+}
+
+// WithPickupCodeTTL sets how long a pickup code stays valid, zero means it does not expire.
+func WithPickupCodeTTL(d time.Duration) Option {
+	return func(s *service) {
+		s.pickupCodeTTL = d
+	}
+}
+
+// WithPickupCodeMaxAttempts locks a pickup code after n wrong attempts until a new one
+// is issued, zero means no limit.
+func WithPickupCodeMaxAttempts(n int) Option {
+	return func(s *service) {
+		s.pickupCodeMaxAttempts = n
+	}
+}
+
+// WithPvzCapacity limits how many products may be on hand in a single PVZ, zero means no limit.
+func WithPvzCapacity(n int) Option {
+	return func(s *service) {
+		s.pvzCapacity = n
+	}
+}
+
+// WithExports sets how exported spreadsheets are encoded and where files of export jobs are kept.
+func WithExports(encoders pe.EncoderFactory, files pe.FileStorage) Option {
+	return func(s *service) {
+		s.encoders = encoders
+		s.exportFiles = files
+	}
+}
+
+// WithIdempotencyTTL sets how long responses of idempotent requests are kept for replays.
+func WithIdempotencyTTL(d time.Duration) Option {
+	return func(s *service) {
+		s.idempotencyTTL = d
+	}
 }
 
 func NewAppService(
@@ -32,18 +116,27 @@ func NewAppService(
 	pwdSrc pwd.PasswordService,
 	tknSrc pa.TokenService,
 	metrics metrics.Collector,
+	opts ...Option,
 ) *service {
-	return &service{
+	s := &service{
 		timeout: timeout,
 		repo:    repo,
 		pwdSrc:  pwdSrc,
 		tknSrc:  tknSrc,
 		metrics: metrics,
+
+		idempotencyTTL: defaultIdempotencyTTL,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *service) NewPVZ(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error) {
 	const op = "service.NewPVZ"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
 
 	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
 	defer tcancel()
@@ -59,6 +152,8 @@ func (s *service) NewPVZ(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, err
 
 func (s *service) OpenNewPVZReception(ctx context.Context, rec *domain.Reception) (*domain.Reception, error) {
 	const op = "service.OpenNewPVZReception"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
 
 	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
 	defer tcancel()
@@ -81,17 +176,96 @@ func (s *service) OpenNewPVZReception(ctx context.Context, rec *domain.Reception
 	return newRec, nil
 }
 
+func (s *service) Pvz(ctx context.Context, pvzId *uuid.UUID) (*domain.Pvz, error) {
+	const op = "service.Pvz"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	pvz, err := s.repo.Pvz(tctx, pvzId)
+	if err != nil {
+		var repoErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &repoErr) && repoErr.Kind == pr.NotFound {
+			return nil, xerr.WrapErr(op, ps.PvzNotFound, err)
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return pvz, nil
+}
+
+func (s *service) UpdatePvz(
+	ctx context.Context,
+	pvzId *uuid.UUID,
+	city domain.PVZCity,
+	ifVersion *int,
+) (*domain.Pvz, error) {
+	const op = "service.UpdatePvz"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	pvz, err := s.repo.UpdatePvz(tctx, pvzId, city, ifVersion)
+	if err != nil {
+		var bErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &bErr) {
+			switch bErr.Kind {
+			case pr.NotFound:
+				return nil, xerr.WrapErr(op, ps.PvzNotFound, err)
+			case pr.VersionMismatch:
+				return nil, xerr.WrapErr(op, ps.VersionMismatch, err)
+			}
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return pvz, nil
+}
+
+func (s *service) Reception(ctx context.Context, receptionId *uuid.UUID) (*domain.Reception, error) {
+	const op = "service.Reception"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	rec, err := s.repo.Reception(tctx, receptionId)
+	if err != nil {
+		var repoErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &repoErr) && repoErr.Kind == pr.NotFound {
+			return nil, xerr.WrapErr(op, ps.ReceptionNotFound, err)
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return rec, nil
+}
+
 func (s *service) AddProductPVZ(ctx context.Context, prod *domain.Product) (*domain.Product, error) {
 	const op = "service.AddProductPVZ"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
 
 	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
 	defer tcancel()
 
-	newProd, err := s.repo.CreateProduct(tctx, prod)
+	newProd, err := s.repo.CreateProduct(tctx, prod, s.pvzCapacity, s.globalBarcodeUniqueness)
 	if err != nil {
 		var repoErr *xerr.BaseErr[pr.RepoErrKind]
-		if errors.As(err, &repoErr) && repoErr.Kind == pr.NotFound {
-			return nil, xerr.WrapErr(op, ps.NoActiveReception, err)
+		if errors.As(err, &repoErr) {
+			switch repoErr.Kind {
+			case pr.NotFound:
+				return nil, xerr.WrapErr(op, ps.NoActiveReception, err)
+			case pr.Conflict:
+				return nil, xerr.WrapErr(op, ps.ProductAlreadyExists, err)
+			case pr.LimitExceeded:
+				return nil, xerr.WrapErr(op, ps.PvzCapacityExceeded, err)
+			}
 		}
 		return nil, xerr.WrapErr(op, ps.Unexpected, err)
 	}
@@ -100,13 +274,67 @@ func (s *service) AddProductPVZ(ctx context.Context, prod *domain.Product) (*dom
 	return newProd, nil
 }
 
-func (s *service) DeleteLastProductPvz(ctx context.Context, pvzId *uuid.UUID) error {
+func (s *service) AddProductsBatch(
+	ctx context.Context,
+	batch *domain.ProductsBatch,
+) (*domain.ProductsBatchResult, error) {
+	const op = "service.AddProductsBatch"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	batch.UniqueAcrossReceptions = s.globalBarcodeUniqueness
+	batch.Capacity = s.pvzCapacity
+	res, err := s.repo.CreateProductsBatch(tctx, batch)
+	if err != nil {
+		var repoErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &repoErr) {
+			switch repoErr.Kind {
+			case pr.NotFound:
+				return nil, xerr.WrapErr(op, ps.NoActiveReception, err)
+			case pr.Conflict:
+				return nil, xerr.WrapErr(op, ps.ProductAlreadyExists, err)
+			case pr.LimitExceeded:
+				return nil, xerr.WrapErr(op, ps.PvzCapacityExceeded, err)
+			}
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	s.metrics.AddProductsAdded(res.Added)
+	return res, nil
+}
+
+func (s *service) ProductsByBarcode(ctx context.Context, barcode string) ([]*domain.ProductInfo, error) {
+	const op = "service.ProductsByBarcode"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	infos, err := s.repo.ProductsByBarcode(tctx, barcode)
+	if err != nil {
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+	if len(infos) == 0 {
+		return nil, xerr.NewErr(op, ps.ProductNotFound)
+	}
+
+	return infos, nil
+}
+
+func (s *service) DeleteLastProductPvz(ctx context.Context, pvzId, actorId *uuid.UUID) error {
 	const op = "service.DeleteLastProductPvz"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
 
 	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
 	defer tcancel()
 
-	if err := s.repo.DeleteLastProduct(tctx, pvzId); err != nil {
+	if err := s.repo.DeleteLastProduct(tctx, pvzId, actorId); err != nil {
 		var bErr *xerr.BaseErr[pr.RepoErrKind]
 		if errors.As(err, &bErr) && bErr.Kind == pr.NotFound {
 			return xerr.WrapErr(op, ps.NoProdOrActiveReception, err)
@@ -117,16 +345,23 @@ func (s *service) DeleteLastProductPvz(ctx context.Context, pvzId *uuid.UUID) er
 	return nil
 }
 
-func (s *service) CloseReceptionInPvz(ctx context.Context, pvzId *uuid.UUID) error {
-	const op = "service.CloseReceptionInPvz"
+func (s *service) DeleteProduct(ctx context.Context, productId, actorId *uuid.UUID) error {
+	const op = "service.DeleteProduct"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
 
 	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
 	defer tcancel()
 
-	if err := s.repo.CloseReceptionInPvz(tctx, pvzId); err != nil {
+	if err := s.repo.DeleteProduct(tctx, productId, actorId); err != nil {
 		var bErr *xerr.BaseErr[pr.RepoErrKind]
-		if errors.As(err, &bErr) && bErr.Kind == pr.Conflict {
-			return xerr.WrapErr(op, ps.FailedToCloseReception, err)
+		if errors.As(err, &bErr) {
+			switch bErr.Kind {
+			case pr.NotFound:
+				return xerr.WrapErr(op, ps.ProductNotFound, err)
+			case pr.InvalidState:
+				return xerr.WrapErr(op, ps.ReceptionNotInProgress, err)
+			}
 		}
 		return xerr.WrapErr(op, ps.Unexpected, err)
 	}
@@ -134,8 +369,418 @@ func (s *service) CloseReceptionInPvz(ctx context.Context, pvzId *uuid.UUID) err
 	return nil
 }
 
-func (s *service) GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) ([]*domain.PvzReceptions, error) {
+func (s *service) RestoreProduct(ctx context.Context, productId *uuid.UUID) (*domain.Product, error) {
+	const op = "service.RestoreProduct"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	prod, err := s.repo.RestoreProduct(tctx, productId, s.pvzCapacity)
+	if err != nil {
+		var bErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &bErr) {
+			switch bErr.Kind {
+			case pr.NotFound:
+				return nil, xerr.WrapErr(op, ps.DeletedProductNotFound, err)
+			case pr.InvalidState:
+				return nil, xerr.WrapErr(op, ps.ReceptionNotInProgress, err)
+			case pr.Conflict:
+				return nil, xerr.WrapErr(op, ps.ProductAlreadyExists, err)
+			case pr.LimitExceeded:
+				return nil, xerr.WrapErr(op, ps.PvzCapacityExceeded, err)
+			}
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return prod, nil
+}
+
+func (s *service) ProductDeletions(ctx context.Context, receptionId *uuid.UUID) ([]*domain.ProductDeletion, error) {
+	const op = "service.ProductDeletions"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	res, err := s.repo.ProductDeletions(tctx, receptionId)
+	if err != nil {
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return res, nil
+}
+
+func (s *service) IssuePickupCode(ctx context.Context, productId *uuid.UUID) (string, error) {
+	const op = "service.IssuePickupCode"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	state, err := s.productStorageState(tctx, op, productId)
+	if err != nil {
+		return "", err
+	}
+	if state.Product.Status != domain.ProductInStorage {
+		return "", xerr.NewErr(op, ps.ProductNotInStorage)
+	}
+
+	code, err := newPickupCode()
+	if err != nil {
+		return "", xerr.WrapErr(op, ps.Unexpected, err)
+	}
+	hash, err := s.hashPassword(ctx, code)
+	if err != nil {
+		return "", xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	var expiresAt *time.Time
+	if s.pickupCodeTTL > 0 {
+		t := time.Now().Add(s.pickupCodeTTL)
+		expiresAt = &t
+	}
+
+	if err = s.repo.SetProductPickupCode(tctx, productId, hash, expiresAt); err != nil {
+		var bErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &bErr) && bErr.Kind == pr.InvalidState {
+			return "", xerr.WrapErr(op, ps.ProductNotInStorage, err)
+		}
+		return "", xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return code, nil
+}
+
+func (s *service) IssueProduct(
+	ctx context.Context,
+	productId *uuid.UUID,
+	pickupCode string,
+	actorId *uuid.UUID,
+) (*domain.Product, error) {
+	const op = "service.IssueProduct"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	state, err := s.productStorageState(tctx, op, productId)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case state.Product.Status != domain.ProductInStorage:
+		return nil, xerr.NewErr(op, ps.ProductNotInStorage)
+	case state.ReceptionStatus != domain.Close:
+		return nil, xerr.NewErr(op, ps.ReceptionNotClosed)
+	case state.PickupCodeHash == nil:
+		return nil, xerr.NewErr(op, ps.PickupCodeNotIssued)
+	case state.PickupCodeExpiresAt != nil && !time.Now().Before(*state.PickupCodeExpiresAt):
+		return nil, xerr.NewErr(op, ps.PickupCodeExpired)
+	case s.pickupCodeMaxAttempts > 0 && state.PickupCodeAttempts >= s.pickupCodeMaxAttempts:
+		return nil, xerr.NewErr(op, ps.PickupCodeLocked)
+	}
+
+	// The attempt is counted before the code is checked, so parallel guesses
+	// can't get past the limit.
+	codeHash, err := s.repo.TakePickupCodeAttempt(tctx, productId, s.pickupCodeMaxAttempts)
+	if err != nil {
+		var bErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &bErr) && bErr.Kind == pr.InvalidState {
+			return nil, xerr.WrapErr(op, ps.PickupCodeLocked, err)
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	ok, err := s.comparePassword(ctx, codeHash, pickupCode)
+	if err != nil {
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+	if !ok {
+		return nil, xerr.NewErr(op, ps.WrongPickupCode)
+	}
+
+	return s.updateProductStatus(tctx, op, &domain.ProductStatusUpdate{
+		ProductId: *productId,
+		Status:    domain.ProductIssued,
+		ActorId:   actorId,
+	})
+}
+
+func (s *service) ReturnProduct(ctx context.Context, productId, actorId *uuid.UUID) (*domain.Product, error) {
+	const op = "service.ReturnProduct"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	state, err := s.productStorageState(tctx, op, productId)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case state.Product.Status != domain.ProductInStorage:
+		return nil, xerr.NewErr(op, ps.ProductNotInStorage)
+	case state.ReceptionStatus == domain.InProgress:
+		return nil, xerr.NewErr(op, ps.ReceptionNotClosed)
+	// Products of a cancelled reception go back to the sender right away.
+	case state.ReceptionStatus == domain.Close &&
+		time.Now().Before(state.Product.DateTime.Add(s.storagePeriod)):
+		return nil, xerr.NewErr(op, ps.StorageNotExpired)
+	}
+
+	return s.updateProductStatus(tctx, op, &domain.ProductStatusUpdate{
+		ProductId: *productId,
+		Status:    domain.ProductReturned,
+		ActorId:   actorId,
+	})
+}
+
+func (s *service) ProductStatusHistory(
+	ctx context.Context,
+	productId *uuid.UUID,
+) ([]*domain.ProductStatusChange, error) {
+	const op = "service.ProductStatusHistory"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	history, err := s.repo.ProductStatusHistory(tctx, productId)
+	if err != nil {
+		var bErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &bErr) && bErr.Kind == pr.NotFound {
+			return nil, xerr.WrapErr(op, ps.ProductNotFound, err)
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return history, nil
+}
+
+func (s *service) productStorageState(
+	ctx context.Context,
+	op string,
+	productId *uuid.UUID,
+) (*domain.ProductStorageState, error) {
+	state, err := s.repo.ProductStorageState(ctx, productId)
+	if err != nil {
+		var bErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &bErr) && bErr.Kind == pr.NotFound {
+			return nil, xerr.WrapErr(op, ps.ProductNotFound, err)
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+	return state, nil
+}
+
+func (s *service) updateProductStatus(
+	ctx context.Context,
+	op string,
+	upd *domain.ProductStatusUpdate,
+) (*domain.Product, error) {
+	prod, err := s.repo.UpdateProductStatus(ctx, upd)
+	if err != nil {
+		var bErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &bErr) && bErr.Kind == pr.InvalidState {
+			return nil, xerr.WrapErr(op, ps.ProductNotInStorage, err)
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	s.metrics.IncProductStatusChanges(string(upd.Status))
+	return prod, nil
+}
+
+// newPickupCode returns a random numeric code of domain.PickupCodeLength digits.
+func newPickupCode() (string, error) {
+	upper := big.NewInt(int64(math.Pow10(domain.PickupCodeLength)))
+	n, err := rand.Int(rand.Reader, upper)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", domain.PickupCodeLength, n.Int64()), nil
+}
+
+// hashPassword and comparePassword get spans of their own, since bcrypt is slow on
+// purpose and often takes most of the time of the method calling it.
+func (s *service) hashPassword(ctx context.Context, plain string) ([]byte, error) {
+	_, span := tracer.Start(ctx, "pwd.Hash")
+	defer span.End()
+
+	return s.pwdSrc.Hash(plain)
+}
+
+func (s *service) comparePassword(ctx context.Context, hash []byte, plain string) (bool, error) {
+	_, span := tracer.Start(ctx, "pwd.Compare")
+	defer span.End()
+
+	return s.pwdSrc.Compare(hash, plain)
+}
+
+func (s *service) CloseReceptionInPvz(
+	ctx context.Context,
+	pvzId *uuid.UUID,
+	ifVersion *int,
+) (*domain.ReceptionCloseReport, error) {
+	const op = "service.CloseReceptionInPvz"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	report, err := s.repo.CloseReceptionInPvz(tctx, pvzId, ifVersion)
+	if err != nil {
+		var bErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &bErr) {
+			switch bErr.Kind {
+			case pr.Conflict:
+				return nil, xerr.WrapErr(op, ps.FailedToCloseReception, err)
+			case pr.VersionMismatch:
+				return nil, xerr.WrapErr(op, ps.VersionMismatch, err)
+			}
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return report, nil
+}
+
+func (s *service) CancelReceptionInPvz(
+	ctx context.Context,
+	pvzId *uuid.UUID,
+	ifVersion *int,
+) (*domain.Reception, error) {
+	const op = "service.CancelReceptionInPvz"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	rec, err := s.repo.CancelReceptionInPvz(tctx, pvzId, ifVersion)
+	if err != nil {
+		var bErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &bErr) {
+			switch bErr.Kind {
+			case pr.NotFound:
+				return nil, xerr.WrapErr(op, ps.NoActiveReception, err)
+			case pr.VersionMismatch:
+				return nil, xerr.WrapErr(op, ps.VersionMismatch, err)
+			}
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return rec, nil
+}
+
+func (s *service) ReopenReception(
+	ctx context.Context,
+	receptionId *uuid.UUID,
+	ifVersion *int,
+) (*domain.Reception, error) {
+	const op = "service.ReopenReception"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	rec, err := s.repo.ReopenReception(tctx, receptionId, time.Now().Add(-s.reopenWindow), ifVersion)
+	if err != nil {
+		var bErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &bErr) {
+			switch bErr.Kind {
+			case pr.NotFound:
+				return nil, xerr.WrapErr(op, ps.ReceptionNotFound, err)
+			case pr.InvalidState:
+				return nil, xerr.WrapErr(op, ps.ReceptionNotReopenable, err)
+			case pr.Conflict:
+				return nil, xerr.WrapErr(op, ps.ActiveReceptionExists, err)
+			case pr.VersionMismatch:
+				return nil, xerr.WrapErr(op, ps.VersionMismatch, err)
+			}
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return rec, nil
+}
+
+func (s *service) SetReceptionManifest(
+	ctx context.Context,
+	manifest *domain.ReceptionManifest,
+) (*domain.ReceptionManifest, error) {
+	const op = "service.SetReceptionManifest"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	seen := make(map[string]struct{}, len(manifest.Barcodes))
+	barcodes := make([]string, 0, len(manifest.Barcodes))
+	for _, b := range manifest.Barcodes {
+		if _, ok := seen[b]; ok {
+			continue
+		}
+		seen[b] = struct{}{}
+		barcodes = append(barcodes, b)
+	}
+	manifest.Barcodes = barcodes
+
+	if err := s.repo.SetReceptionManifest(tctx, manifest); err != nil {
+		var bErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &bErr) {
+			switch bErr.Kind {
+			case pr.NotFound:
+				return nil, xerr.WrapErr(op, ps.ReceptionNotFound, err)
+			case pr.InvalidState:
+				return nil, xerr.WrapErr(op, ps.ReceptionNotInProgress, err)
+			case pr.VersionMismatch:
+				return nil, xerr.WrapErr(op, ps.VersionMismatch, err)
+			}
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	return manifest, nil
+}
+
+func (s *service) AutoCloseStaleReceptions(ctx context.Context, idleFor time.Duration) ([]*domain.Reception, error) {
+	const op = "service.AutoCloseStaleReceptions"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	recs, err := s.repo.AutoCloseStaleReceptions(tctx, time.Now().Add(-idleFor))
+	if err != nil {
+		var bErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &bErr) && bErr.Kind == pr.Locked {
+			return nil, xerr.WrapErr(op, ps.AutoCloseBusy, err)
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	s.metrics.AddReceptionsAutoClosed(len(recs))
+	return recs, nil
+}
+
+func (s *service) GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) (*domain.PvzsPage, error) {
 	const op = "service.GetPvzsData"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
 
 	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
 	defer tcancel()
@@ -148,13 +793,84 @@ func (s *service) GetPvzsData(ctx context.Context, params *domain.PvzsReadParams
 	return res, nil
 }
 
-func (s *service) GetAllPvzs(ctx context.Context) ([]*domain.Pvz, error) {
+func (s *service) StreamPvzsData(
+	ctx context.Context,
+	params *domain.PvzsReadParams,
+	yield func(*domain.PvzReceptions) error,
+) (*domain.PvzsPage, error) {
+	const op = "service.StreamPvzsData"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	// The page is read in chunks, each under its own timeout, and a chunk is
+	// written only after its rows are closed: a slow client holds neither a
+	// connection nor the query deadline.
+	chunk := *params
+	if chunk.Cursor == nil && chunk.Offset == 0 {
+		chunk.Offset = (chunk.Page - 1) * chunk.Limit
+		chunk.Page = 1
+	}
+
+	page := new(domain.PvzsPage)
+	for remaining := params.Limit; ; {
+		chunk.Limit = min(remaining, streamChunkSize)
+		items, res, err := s.readPvzsChunk(ctx, &chunk)
+		if err != nil {
+			return nil, xerr.WrapErr(op, ps.Unexpected, err)
+		}
+		if chunk.WithTotal {
+			page.Total = res.Total
+			chunk.WithTotal = false
+		}
+
+		for _, item := range items {
+			if err := yield(item); err != nil {
+				return nil, xerr.WrapErr(op, ps.Unexpected, err)
+			}
+		}
+
+		remaining -= len(items)
+		page.NextCursor = res.NextCursor
+		if res.NextCursor == nil || remaining == 0 {
+			return page, nil
+		}
+		chunk.Cursor = res.NextCursor
+	}
+}
+
+// readPvzsChunk reads one chunk of PVZs into memory under the service timeout.
+func (s *service) readPvzsChunk(
+	ctx context.Context,
+	params *domain.PvzsReadParams,
+) ([]*domain.PvzReceptions, *domain.PvzsPage, error) {
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	items := make([]*domain.PvzReceptions, 0, params.Limit)
+	page, err := s.repo.StreamPvzsData(tctx, params, func(pvz *domain.PvzReceptions) error {
+		items = append(items, pvz)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return items, page, nil
+}
+
+func (s *service) GetAllPvzs(
+	ctx context.Context,
+	filter *domain.PvzsFilter,
+	sort domain.PvzsSort,
+) ([]*domain.Pvz, error) {
 	const op = "service.GetAllPvzs"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
 
 	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
 	defer tcancel()
 
-	res, err := s.repo.GetAllPvzs(tctx)
+	res, err := s.repo.GetAllPvzs(tctx, filter, sort)
 	if err != nil {
 		return nil, xerr.WrapErr(op, ps.Unexpected, err)
 	}
@@ -162,13 +878,70 @@ func (s *service) GetAllPvzs(ctx context.Context) ([]*domain.Pvz, error) {
 	return res, nil
 }
 
+func (s *service) PvzStock(ctx context.Context, pvzId *uuid.UUID) (*domain.PvzStock, error) {
+	const op = "service.PvzStock"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	stock, err := s.repo.PvzStock(tctx, pvzId)
+	if err != nil {
+		var repoErr *xerr.BaseErr[pr.RepoErrKind]
+		if errors.As(err, &repoErr) && repoErr.Kind == pr.NotFound {
+			return nil, xerr.WrapErr(op, ps.PvzNotFound, err)
+		}
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	stock.Capacity = s.pvzCapacity
+	return stock, nil
+}
+
+func (s *service) AllPvzsStock(ctx context.Context) ([]*domain.PvzStock, error) {
+	const op = "service.AllPvzsStock"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	stocks, err := s.repo.AllPvzsStock(tctx)
+	if err != nil {
+		return nil, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+
+	for _, stock := range stocks {
+		stock.Capacity = s.pvzCapacity
+	}
+	return stocks, nil
+}
+
+func (s *service) OpenReceptionsCount(ctx context.Context) (int, error) {
+	const op = "service.OpenReceptionsCount"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
+	defer tcancel()
+
+	n, err := s.repo.CountReceptions(tctx, domain.InProgress)
+	if err != nil {
+		return 0, xerr.WrapErr(op, ps.Unexpected, err)
+	}
+	return n, nil
+}
+
 func (s *service) RegisterUser(ctx context.Context, rParams *auth.RegisterUserParams) (*auth.User, error) {
 	const op = "service.RegisterUser"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
 
 	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
 	defer tcancel()
 
-	pwdHash, err := s.pwdSrc.Hash(rParams.PlainPassword)
+	pwdHash, err := s.hashPassword(ctx, rParams.PlainPassword)
 	if err != nil {
 		return nil, xerr.WrapErr(op, ps.Unexpected, err)
 	}
@@ -196,6 +969,8 @@ func (s *service) LoginUser(
 	lParams *auth.LoginUserParams,
 ) (aToken string, rToken *auth.RefreshToken, err error) {
 	const op = "service.LoginUser"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
 
 	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
 	defer tcancel()
@@ -209,7 +984,7 @@ func (s *service) LoginUser(
 		return "", nil, xerr.WrapErr(op, ps.Unexpected, err)
 	}
 
-	ok, err := s.pwdSrc.Compare(u.PasswordHash, lParams.PlainPassword)
+	ok, err := s.comparePassword(ctx, u.PasswordHash, lParams.PlainPassword)
 	if err != nil {
 		return "", nil, xerr.WrapErr(op, ps.Unexpected, err)
 	}
@@ -246,6 +1021,8 @@ func (s *service) RefreshTokens(
 	providedToken *auth.RefreshToken,
 ) (newAToken string, newRToken *auth.RefreshToken, err error) {
 	const op = "service.RefreshTokens"
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
 
 	tctx, tcancel := context.WithTimeout(ctx, s.timeout)
 	defer tcancel()
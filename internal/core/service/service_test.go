@@ -14,10 +14,12 @@ import (
 	pwdmocks "github.com/shrtyk/pvz-service/internal/core/ports/pwd_service/mocks"
 	pRepo "github.com/shrtyk/pvz-service/internal/core/ports/repository"
 	repomocks "github.com/shrtyk/pvz-service/internal/core/ports/repository/mocks"
+	ps "github.com/shrtyk/pvz-service/internal/core/ports/service"
 	"github.com/shrtyk/pvz-service/internal/core/service"
 	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewPVZ(t *testing.T) {
@@ -189,6 +191,24 @@ func TestAddProductPVZ(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "duplicate barcode in reception",
+			args: &domain.Product{PvzId: uuid.New()},
+			mockArgs: mockArgs{
+				prod: nil,
+				err:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.Conflict},
+			},
+			wantErr: true,
+		},
+		{
+			name: "pvz is full",
+			args: &domain.Product{PvzId: uuid.New()},
+			mockArgs: mockArgs{
+				prod: nil,
+				err:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.LimitExceeded},
+			},
+			wantErr: true,
+		},
 		{
 			name: "unexpected error",
 			args: &domain.Product{PvzId: uuid.New()},
@@ -200,6 +220,894 @@ func TestAddProductPVZ(t *testing.T) {
 		},
 	}
 
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			metrics := new(metricsmocks.MockCollector)
+			s := service.NewAppService(time.Second, repo, nil, nil, metrics, service.WithPvzCapacity(100))
+
+			repo.On("CreateProduct", mock.Anything, tt.args, 100, false).Return(tt.mockArgs.prod, tt.mockArgs.err)
+			if !tt.wantErr {
+				metrics.On("IncProductsAdded").Return()
+			}
+
+			result, err := s.AddProductPVZ(context.Background(), tt.args)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAddProductPVZ_GlobalBarcodeUniqueness(t *testing.T) {
+	t.Parallel()
+
+	barcode := "4601234567890"
+	tests := []struct {
+		name      string
+		createErr error
+		wantKind  ps.ServiceErrKind
+	}{
+		{
+			name: "barcode is free",
+		},
+		{
+			name:      "barcode already used",
+			createErr: &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.Conflict},
+			wantKind:  ps.ProductAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			metrics := new(metricsmocks.MockCollector)
+			s := service.NewAppService(time.Second, repo, nil, nil, metrics,
+				service.WithGlobalBarcodeUniqueness(true))
+
+			prod := &domain.Product{PvzId: uuid.New(), Barcode: &barcode}
+			if tt.createErr != nil {
+				repo.On("CreateProduct", mock.Anything, prod, 0, true).Return(nil, tt.createErr)
+			} else {
+				repo.On("CreateProduct", mock.Anything, prod, 0, true).Return(&domain.Product{Id: uuid.New()}, nil)
+				metrics.On("IncProductsAdded").Return()
+			}
+
+			result, err := s.AddProductPVZ(context.Background(), prod)
+
+			if tt.wantKind == "" {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			} else {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, result)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAddProductsBatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		global     bool
+		repoRes    *domain.ProductsBatchResult
+		repoErr    error
+		wantKind   ps.ServiceErrKind
+		wantGlobal bool
+	}{
+		{
+			name:    "success",
+			repoRes: &domain.ProductsBatchResult{Added: 2},
+		},
+		{
+			name:       "global barcode uniqueness is passed to repository",
+			global:     true,
+			repoRes:    &domain.ProductsBatchResult{Added: 1},
+			wantGlobal: true,
+		},
+		{
+			name:     "no active reception",
+			repoErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.NotFound},
+			wantKind: ps.NoActiveReception,
+		},
+		{
+			name:     "concurrent duplicate barcode",
+			repoErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.Conflict},
+			wantKind: ps.ProductAlreadyExists,
+		},
+		{
+			name:     "products do not fit in pvz",
+			repoErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.LimitExceeded},
+			wantKind: ps.PvzCapacityExceeded,
+		},
+		{
+			name:     "unexpected error",
+			repoErr:  errors.New("unexpected error"),
+			wantKind: ps.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			metrics := new(metricsmocks.MockCollector)
+			s := service.NewAppService(time.Second, repo, nil, nil, metrics,
+				service.WithGlobalBarcodeUniqueness(tt.global), service.WithPvzCapacity(50))
+
+			batch := &domain.ProductsBatch{PvzId: uuid.New()}
+			repo.On("CreateProductsBatch", mock.Anything, batch).Return(tt.repoRes, tt.repoErr)
+			if tt.repoErr == nil {
+				metrics.On("AddProductsAdded", tt.repoRes.Added).Return()
+			}
+
+			result, err := s.AddProductsBatch(context.Background(), batch)
+
+			if tt.wantKind != "" {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.repoRes, result)
+				assert.Equal(t, tt.wantGlobal, batch.UniqueAcrossReceptions)
+				assert.Equal(t, 50, batch.Capacity)
+			}
+			repo.AssertExpectations(t)
+			metrics.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProductsByBarcode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		mockInfos []*domain.ProductInfo
+		mockErr   error
+		wantKind  ps.ServiceErrKind
+	}{
+		{
+			name: "success",
+			mockInfos: []*domain.ProductInfo{
+				{Product: &domain.Product{Id: uuid.New()}},
+				{Product: &domain.Product{Id: uuid.New()}},
+			},
+		},
+		{
+			name:      "not found",
+			mockInfos: []*domain.ProductInfo{},
+			wantKind:  ps.ProductNotFound,
+		},
+		{
+			name:     "unexpected error",
+			mockErr:  errors.New("unexpected error"),
+			wantKind: ps.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			metrics := new(metricsmocks.MockCollector)
+			s := service.NewAppService(time.Second, repo, nil, nil, metrics)
+
+			repo.On("ProductsByBarcode", mock.Anything, "4601234567890").Return(tt.mockInfos, tt.mockErr)
+
+			result, err := s.ProductsByBarcode(context.Background(), "4601234567890")
+
+			if tt.wantKind != "" {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockInfos, result)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteLastProductPvz(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mockErr error
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			mockErr: nil,
+			wantErr: false,
+		},
+		{
+			name:    "not found",
+			mockErr: &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.NotFound},
+			wantErr: true,
+		},
+		{
+			name:    "unexpected error",
+			mockErr: errors.New("unexpected error"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			metrics := new(metricsmocks.MockCollector)
+			s := service.NewAppService(time.Second, repo, nil, nil, metrics)
+			pvzId := uuid.New()
+			actorId := uuid.New()
+
+			repo.On("DeleteLastProduct", mock.Anything, &pvzId, &actorId).Return(tt.mockErr)
+
+			err := s.DeleteLastProductPvz(context.Background(), &pvzId, &actorId)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteProduct(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mockErr  error
+		wantKind ps.ServiceErrKind
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:     "not found",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.NotFound},
+			wantKind: ps.ProductNotFound,
+		},
+		{
+			name:     "reception closed",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.InvalidState},
+			wantKind: ps.ReceptionNotInProgress,
+		},
+		{
+			name:     "unexpected error",
+			mockErr:  errors.New("unexpected error"),
+			wantKind: ps.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			metrics := new(metricsmocks.MockCollector)
+			s := service.NewAppService(time.Second, repo, nil, nil, metrics)
+			productId := uuid.New()
+			actorId := uuid.New()
+
+			repo.On("DeleteProduct", mock.Anything, &productId, &actorId).Return(tt.mockErr)
+
+			err := s.DeleteProduct(context.Background(), &productId, &actorId)
+
+			if tt.mockErr != nil {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+			} else {
+				assert.NoError(t, err)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRestoreProduct(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mockProd *domain.Product
+		mockErr  error
+		wantKind ps.ServiceErrKind
+	}{
+		{
+			name:     "success",
+			mockProd: &domain.Product{Id: uuid.New()},
+		},
+		{
+			name:     "nothing to restore",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.NotFound},
+			wantKind: ps.DeletedProductNotFound,
+		},
+		{
+			name:     "reception closed",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.InvalidState},
+			wantKind: ps.ReceptionNotInProgress,
+		},
+		{
+			name:     "barcode taken",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.Conflict},
+			wantKind: ps.ProductAlreadyExists,
+		},
+		{
+			name:     "pvz is full",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.LimitExceeded},
+			wantKind: ps.PvzCapacityExceeded,
+		},
+		{
+			name:     "unexpected error",
+			mockErr:  errors.New("unexpected error"),
+			wantKind: ps.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			metrics := new(metricsmocks.MockCollector)
+			s := service.NewAppService(time.Second, repo, nil, nil, metrics, service.WithPvzCapacity(10))
+			productId := uuid.New()
+
+			repo.On("RestoreProduct", mock.Anything, &productId, 10).Return(tt.mockProd, tt.mockErr)
+
+			result, err := s.RestoreProduct(context.Background(), &productId)
+
+			if tt.mockErr != nil {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockProd, result)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProductDeletions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		mockItems []*domain.ProductDeletion
+		mockErr   error
+		wantErr   bool
+	}{
+		{
+			name:      "success",
+			mockItems: []*domain.ProductDeletion{{Id: uuid.New()}},
+		},
+		{
+			name:    "unexpected error",
+			mockErr: errors.New("unexpected error"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			metrics := new(metricsmocks.MockCollector)
+			s := service.NewAppService(time.Second, repo, nil, nil, metrics)
+			recId := uuid.New()
+
+			repo.On("ProductDeletions", mock.Anything, &recId).Return(tt.mockItems, tt.mockErr)
+
+			result, err := s.ProductDeletions(context.Background(), &recId)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockItems, result)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func storageState(status domain.ProductStatus, recStatus domain.ReceptionStatus, addedAt time.Time) *domain.ProductStorageState {
+	return &domain.ProductStorageState{
+		Product:         &domain.Product{Id: uuid.New(), Status: status, DateTime: addedAt},
+		ReceptionStatus: recStatus,
+		PickupCodeHash:  []byte("hash"),
+	}
+}
+
+func TestIssuePickupCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		state    *domain.ProductStorageState
+		stateErr error
+		setErr   error
+		wantKind ps.ServiceErrKind
+	}{
+		{
+			name:  "success",
+			state: storageState(domain.ProductInStorage, domain.Close, time.Now()),
+		},
+		{
+			name:     "product not found",
+			stateErr: &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.NotFound},
+			wantKind: ps.ProductNotFound,
+		},
+		{
+			name:     "product already issued",
+			state:    storageState(domain.ProductIssued, domain.Close, time.Now()),
+			wantKind: ps.ProductNotInStorage,
+		},
+		{
+			name:     "product left storage concurrently",
+			state:    storageState(domain.ProductInStorage, domain.Close, time.Now()),
+			setErr:   &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.InvalidState},
+			wantKind: ps.ProductNotInStorage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			pwdSvc := new(pwdmocks.MockPasswordService)
+			s := service.NewAppService(time.Second, repo, pwdSvc, nil, nil, service.WithPickupCodeTTL(time.Hour))
+			productId := uuid.New()
+
+			repo.On("ProductStorageState", mock.Anything, &productId).Return(tt.state, tt.stateErr)
+			if tt.state != nil && tt.state.Product.Status == domain.ProductInStorage {
+				pwdSvc.On("Hash", mock.MatchedBy(func(code string) bool {
+					return len(code) == domain.PickupCodeLength
+				})).Return([]byte("hash"), nil)
+				expiresAt := mock.MatchedBy(func(at *time.Time) bool {
+					return at != nil && at.After(time.Now().Add(59*time.Minute))
+				})
+				repo.On("SetProductPickupCode", mock.Anything, &productId, []byte("hash"), expiresAt).Return(tt.setErr)
+			}
+
+			code, err := s.IssuePickupCode(context.Background(), &productId)
+
+			if tt.wantKind != "" {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Empty(t, code)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, code, domain.PickupCodeLength)
+			}
+			repo.AssertExpectations(t)
+			pwdSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestIssueProduct(t *testing.T) {
+	t.Parallel()
+
+	const maxAttempts = 3
+
+	noCode := storageState(domain.ProductInStorage, domain.Close, time.Now())
+	noCode.PickupCodeHash = nil
+	expiredAt := time.Now().Add(-time.Minute)
+	expired := storageState(domain.ProductInStorage, domain.Close, time.Now())
+	expired.PickupCodeExpiresAt = &expiredAt
+	locked := storageState(domain.ProductInStorage, domain.Close, time.Now())
+	locked.PickupCodeAttempts = maxAttempts
+
+	tests := []struct {
+		name      string
+		state     *domain.ProductStorageState
+		stateErr  error
+		takeErr   error
+		codeOk    bool
+		updateErr error
+		wantKind  ps.ServiceErrKind
+	}{
+		{
+			name:   "success",
+			state:  storageState(domain.ProductInStorage, domain.Close, time.Now()),
+			codeOk: true,
+		},
+		{
+			name:     "product not found",
+			stateErr: &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.NotFound},
+			wantKind: ps.ProductNotFound,
+		},
+		{
+			name:     "product already returned",
+			state:    storageState(domain.ProductReturned, domain.Close, time.Now()),
+			wantKind: ps.ProductNotInStorage,
+		},
+		{
+			name:     "reception in progress",
+			state:    storageState(domain.ProductInStorage, domain.InProgress, time.Now()),
+			wantKind: ps.ReceptionNotClosed,
+		},
+		{
+			name:     "pickup code not issued",
+			state:    noCode,
+			wantKind: ps.PickupCodeNotIssued,
+		},
+		{
+			name:     "pickup code expired",
+			state:    expired,
+			wantKind: ps.PickupCodeExpired,
+		},
+		{
+			name:     "pickup code locked",
+			state:    locked,
+			wantKind: ps.PickupCodeLocked,
+		},
+		{
+			name:     "last attempt taken concurrently",
+			state:    storageState(domain.ProductInStorage, domain.Close, time.Now()),
+			takeErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.InvalidState},
+			wantKind: ps.PickupCodeLocked,
+		},
+		{
+			name:     "wrong pickup code",
+			state:    storageState(domain.ProductInStorage, domain.Close, time.Now()),
+			wantKind: ps.WrongPickupCode,
+		},
+		{
+			name:      "issued concurrently",
+			state:     storageState(domain.ProductInStorage, domain.Close, time.Now()),
+			codeOk:    true,
+			updateErr: &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.InvalidState},
+			wantKind:  ps.ProductNotInStorage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			pwdSvc := new(pwdmocks.MockPasswordService)
+			metrics := new(metricsmocks.MockCollector)
+			s := service.NewAppService(
+				time.Second, repo, pwdSvc, nil, metrics,
+				service.WithPickupCodeMaxAttempts(maxAttempts),
+			)
+			productId := uuid.New()
+			actorId := uuid.New()
+
+			repo.On("ProductStorageState", mock.Anything, &productId).Return(tt.state, tt.stateErr)
+			if tt.state != nil && tt.state.Product.Status == domain.ProductInStorage &&
+				tt.state.ReceptionStatus == domain.Close && tt.state.PickupCodeHash != nil &&
+				tt.state.PickupCodeExpiresAt == nil && tt.state.PickupCodeAttempts < maxAttempts {
+				var codeHash []byte
+				if tt.takeErr == nil {
+					codeHash = tt.state.PickupCodeHash
+					pwdSvc.On("Compare", codeHash, "123456").Return(tt.codeOk, nil)
+				}
+				repo.On("TakePickupCodeAttempt", mock.Anything, &productId, maxAttempts).Return(codeHash, tt.takeErr)
+			}
+			if tt.codeOk {
+				upd := &domain.ProductStatusUpdate{ProductId: productId, Status: domain.ProductIssued, ActorId: &actorId}
+				var prod *domain.Product
+				if tt.updateErr == nil {
+					prod = &domain.Product{Id: productId, Status: domain.ProductIssued}
+					metrics.On("IncProductStatusChanges", string(domain.ProductIssued)).Return()
+				}
+				repo.On("UpdateProductStatus", mock.Anything, upd).Return(prod, tt.updateErr)
+			}
+
+			prod, err := s.IssueProduct(context.Background(), &productId, "123456", &actorId)
+
+			if tt.wantKind != "" {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, prod)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, domain.ProductIssued, prod.Status)
+			}
+			repo.AssertExpectations(t)
+			pwdSvc.AssertExpectations(t)
+			metrics.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReturnProduct(t *testing.T) {
+	t.Parallel()
+
+	period := 7 * 24 * time.Hour
+	expired := time.Now().Add(-period - time.Hour)
+
+	tests := []struct {
+		name       string
+		state      *domain.ProductStorageState
+		wantUpdate bool
+		wantKind   ps.ServiceErrKind
+	}{
+		{
+			name:       "storage period is over",
+			state:      storageState(domain.ProductInStorage, domain.Close, expired),
+			wantUpdate: true,
+		},
+		{
+			name:       "cancelled reception is returned right away",
+			state:      storageState(domain.ProductInStorage, domain.Cancelled, time.Now()),
+			wantUpdate: true,
+		},
+		{
+			name:     "storage period is not over",
+			state:    storageState(domain.ProductInStorage, domain.Close, time.Now()),
+			wantKind: ps.StorageNotExpired,
+		},
+		{
+			name:     "reception in progress",
+			state:    storageState(domain.ProductInStorage, domain.InProgress, expired),
+			wantKind: ps.ReceptionNotClosed,
+		},
+		{
+			name:     "product already issued",
+			state:    storageState(domain.ProductIssued, domain.Close, expired),
+			wantKind: ps.ProductNotInStorage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			metrics := new(metricsmocks.MockCollector)
+			s := service.NewAppService(time.Second, repo, nil, nil, metrics, service.WithStoragePeriod(period))
+			productId := uuid.New()
+
+			repo.On("ProductStorageState", mock.Anything, &productId).Return(tt.state, nil)
+			if tt.wantUpdate {
+				upd := &domain.ProductStatusUpdate{ProductId: productId, Status: domain.ProductReturned}
+				repo.On("UpdateProductStatus", mock.Anything, upd).
+					Return(&domain.Product{Id: productId, Status: domain.ProductReturned}, nil)
+				metrics.On("IncProductStatusChanges", string(domain.ProductReturned)).Return()
+			}
+
+			prod, err := s.ReturnProduct(context.Background(), &productId, nil)
+
+			if tt.wantKind != "" {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, prod)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, domain.ProductReturned, prod.Status)
+			}
+			repo.AssertExpectations(t)
+			metrics.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProductStatusHistory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		history  []*domain.ProductStatusChange
+		mockErr  error
+		wantKind ps.ServiceErrKind
+	}{
+		{
+			name:    "success",
+			history: []*domain.ProductStatusChange{{Status: domain.ProductInStorage}},
+		},
+		{
+			name:     "product not found",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.NotFound},
+			wantKind: ps.ProductNotFound,
+		},
+		{
+			name:     "unexpected error",
+			mockErr:  errors.New("unexpected error"),
+			wantKind: ps.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			s := service.NewAppService(time.Second, repo, nil, nil, nil)
+			productId := uuid.New()
+
+			repo.On("ProductStatusHistory", mock.Anything, &productId).Return(tt.history, tt.mockErr)
+
+			history, err := s.ProductStatusHistory(context.Background(), &productId)
+
+			if tt.wantKind != "" {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, history)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.history, history)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPvz(t *testing.T) {
+	t.Parallel()
+
+	pvzId := uuid.New()
+	tests := []struct {
+		name     string
+		repoRes  *domain.Pvz
+		repoErr  error
+		wantKind ps.ServiceErrKind
+	}{
+		{
+			name:    "success",
+			repoRes: &domain.Pvz{Id: pvzId, City: domain.Kazan, Version: 1},
+		},
+		{
+			name:     "pvz not found",
+			repoErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.NotFound},
+			wantKind: ps.PvzNotFound,
+		},
+		{
+			name:     "unexpected error",
+			repoErr:  errors.New("repo error"),
+			wantKind: ps.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			s := service.NewAppService(time.Second, repo, nil, nil, nil)
+
+			repo.On("Pvz", mock.Anything, &pvzId).Return(tt.repoRes, tt.repoErr)
+
+			result, err := s.Pvz(context.Background(), &pvzId)
+
+			if tt.wantKind != "" {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.repoRes, result)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReception(t *testing.T) {
+	t.Parallel()
+
+	recId := uuid.New()
+	tests := []struct {
+		name     string
+		repoRes  *domain.Reception
+		repoErr  error
+		wantKind ps.ServiceErrKind
+	}{
+		{
+			name:    "success",
+			repoRes: &domain.Reception{Id: recId, Status: domain.Close, Version: 2},
+		},
+		{
+			name:     "reception not found",
+			repoErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.NotFound},
+			wantKind: ps.ReceptionNotFound,
+		},
+		{
+			name:     "unexpected error",
+			repoErr:  errors.New("repo error"),
+			wantKind: ps.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			s := service.NewAppService(time.Second, repo, nil, nil, nil)
+
+			repo.On("Reception", mock.Anything, &recId).Return(tt.repoRes, tt.repoErr)
+
+			result, err := s.Reception(context.Background(), &recId)
+
+			if tt.wantKind != "" {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.repoRes, result)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCloseReceptionInPvz(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		mockReport *domain.ReceptionCloseReport
+		mockErr    error
+		wantKind   ps.ServiceErrKind
+	}{
+		{
+			name:       "success",
+			mockReport: &domain.ReceptionCloseReport{Reception: &domain.Reception{Status: domain.Close}},
+		},
+		{
+			name:     "conflict",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.Conflict},
+			wantKind: ps.FailedToCloseReception,
+		},
+		{
+			name:     "version mismatch",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.VersionMismatch},
+			wantKind: ps.VersionMismatch,
+		},
+		{
+			name:     "unexpected error",
+			mockErr:  errors.New("unexpected error"),
+			wantKind: ps.Unexpected,
+		},
+	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
@@ -207,48 +1115,54 @@ func TestAddProductPVZ(t *testing.T) {
 			repo := new(repomocks.MockRepository)
 			metrics := new(metricsmocks.MockCollector)
 			s := service.NewAppService(time.Second, repo, nil, nil, metrics)
+			pvzId := uuid.New()
+			version := 2
 
-			repo.On("CreateProduct", mock.Anything, tt.args).Return(tt.mockArgs.prod, tt.mockArgs.err)
-			if !tt.wantErr {
-				metrics.On("IncProductsAdded").Return()
-			}
+			repo.On("CloseReceptionInPvz", mock.Anything, &pvzId, &version).Return(tt.mockReport, tt.mockErr)
 
-			result, err := s.AddProductPVZ(context.Background(), tt.args)
+			report, err := s.CloseReceptionInPvz(context.Background(), &pvzId, &version)
 
-			if tt.wantErr {
-				assert.Error(t, err)
-				assert.Nil(t, result)
+			if tt.wantKind != "" {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, report)
 			} else {
 				assert.NoError(t, err)
-				assert.NotNil(t, result)
+				assert.Equal(t, tt.mockReport, report)
 			}
 			repo.AssertExpectations(t)
 		})
 	}
 }
 
-func TestDeleteLastProductPvz(t *testing.T) {
+func TestCancelReceptionInPvz(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name    string
-		mockErr error
-		wantErr bool
+		name     string
+		mockRec  *domain.Reception
+		mockErr  error
+		wantKind ps.ServiceErrKind
 	}{
 		{
 			name:    "success",
-			mockErr: nil,
-			wantErr: false,
+			mockRec: &domain.Reception{Status: domain.Cancelled},
 		},
 		{
-			name:    "not found",
-			mockErr: &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.NotFound},
-			wantErr: true,
+			name:     "no active reception",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.NotFound},
+			wantKind: ps.NoActiveReception,
 		},
 		{
-			name:    "unexpected error",
-			mockErr: errors.New("unexpected error"),
-			wantErr: true,
+			name:     "version mismatch",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.VersionMismatch},
+			wantKind: ps.VersionMismatch,
+		},
+		{
+			name:     "unexpected error",
+			mockErr:  errors.New("unexpected error"),
+			wantKind: ps.Unexpected,
 		},
 	}
 
@@ -257,46 +1171,247 @@ func TestDeleteLastProductPvz(t *testing.T) {
 			t.Parallel()
 
 			repo := new(repomocks.MockRepository)
-			metrics := new(metricsmocks.MockCollector)
-			s := service.NewAppService(time.Second, repo, nil, nil, metrics)
+			s := service.NewAppService(time.Second, repo, nil, nil, nil)
 			pvzId := uuid.New()
 
-			repo.On("DeleteLastProduct", mock.Anything, &pvzId).Return(tt.mockErr)
+			repo.On("CancelReceptionInPvz", mock.Anything, &pvzId, (*int)(nil)).Return(tt.mockRec, tt.mockErr)
 
-			err := s.DeleteLastProductPvz(context.Background(), &pvzId)
+			rec, err := s.CancelReceptionInPvz(context.Background(), &pvzId, nil)
 
-			if tt.wantErr {
-				assert.Error(t, err)
+			if tt.wantKind != "" {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, rec)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.mockRec, rec)
 			}
 			repo.AssertExpectations(t)
 		})
 	}
 }
 
-func TestCloseReceptionInPvz(t *testing.T) {
+func TestReopenReception(t *testing.T) {
 	t.Parallel()
 
+	window := 30 * time.Minute
 	tests := []struct {
-		name    string
-		mockErr error
-		wantErr bool
+		name     string
+		mockRec  *domain.Reception
+		mockErr  error
+		wantKind ps.ServiceErrKind
 	}{
 		{
 			name:    "success",
-			mockErr: nil,
-			wantErr: false,
+			mockRec: &domain.Reception{Status: domain.InProgress},
 		},
 		{
-			name:    "conflict",
-			mockErr: &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.Conflict},
-			wantErr: true,
+			name:     "not found",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.NotFound},
+			wantKind: ps.ReceptionNotFound,
 		},
 		{
-			name:    "unexpected error",
-			mockErr: errors.New("unexpected error"),
-			wantErr: true,
+			name:     "window expired or not closed",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.InvalidState},
+			wantKind: ps.ReceptionNotReopenable,
+		},
+		{
+			name:     "another reception in progress",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.Conflict},
+			wantKind: ps.ActiveReceptionExists,
+		},
+		{
+			name:     "version mismatch",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.VersionMismatch},
+			wantKind: ps.VersionMismatch,
+		},
+		{
+			name:     "unexpected error",
+			mockErr:  errors.New("unexpected error"),
+			wantKind: ps.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			s := service.NewAppService(time.Second, repo, nil, nil, nil, service.WithReopenWindow(window))
+			recId := uuid.New()
+			version := 3
+
+			before := time.Now().Add(-window)
+			repo.On("ReopenReception", mock.Anything, &recId, mock.MatchedBy(func(closedAfter time.Time) bool {
+				return !closedAfter.Before(before) && closedAfter.Before(time.Now().Add(-window+time.Second))
+			}), &version).Return(tt.mockRec, tt.mockErr)
+
+			rec, err := s.ReopenReception(context.Background(), &recId, &version)
+
+			if tt.wantKind != "" {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, rec)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockRec, rec)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdatePvz(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mockPvz  *domain.Pvz
+		mockErr  error
+		wantKind ps.ServiceErrKind
+	}{
+		{
+			name:    "success",
+			mockPvz: &domain.Pvz{City: domain.Kazan, Version: 4},
+		},
+		{
+			name:     "not found",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.NotFound},
+			wantKind: ps.PvzNotFound,
+		},
+		{
+			name:     "version mismatch",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.VersionMismatch},
+			wantKind: ps.VersionMismatch,
+		},
+		{
+			name:     "unexpected error",
+			mockErr:  errors.New("unexpected error"),
+			wantKind: ps.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			s := service.NewAppService(time.Second, repo, nil, nil, nil)
+			pvzId := uuid.New()
+			version := 3
+
+			repo.On("UpdatePvz", mock.Anything, &pvzId, domain.Kazan, &version).Return(tt.mockPvz, tt.mockErr)
+
+			pvz, err := s.UpdatePvz(context.Background(), &pvzId, domain.Kazan, &version)
+
+			if tt.wantKind != "" {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, pvz)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockPvz, pvz)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSetReceptionManifest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mockErr  error
+		wantKind ps.ServiceErrKind
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:     "reception not found",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.NotFound},
+			wantKind: ps.ReceptionNotFound,
+		},
+		{
+			name:     "reception not in progress",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.InvalidState},
+			wantKind: ps.ReceptionNotInProgress,
+		},
+		{
+			name:     "version mismatch",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.VersionMismatch},
+			wantKind: ps.VersionMismatch,
+		},
+		{
+			name:     "unexpected error",
+			mockErr:  errors.New("unexpected error"),
+			wantKind: ps.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			s := service.NewAppService(time.Second, repo, nil, nil, nil)
+			manifest := &domain.ReceptionManifest{
+				ReceptionId: uuid.New(),
+				Barcodes:    []string{"a1", "b2", "a1"},
+			}
+
+			repo.On("SetReceptionManifest", mock.Anything, manifest).Return(tt.mockErr)
+
+			res, err := s.SetReceptionManifest(context.Background(), manifest)
+
+			if tt.wantKind != "" {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, []string{"a1", "b2"}, res.Barcodes)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAutoCloseStaleReceptions(t *testing.T) {
+	t.Parallel()
+
+	idleFor := 12 * time.Hour
+	tests := []struct {
+		name     string
+		mockRecs []*domain.Reception
+		mockErr  error
+		wantKind ps.ServiceErrKind
+	}{
+		{
+			name: "success",
+			mockRecs: []*domain.Reception{
+				{Id: uuid.New(), Status: domain.Close, AutoClosed: true},
+				{Id: uuid.New(), Status: domain.Close, AutoClosed: true},
+			},
+		},
+		{
+			name:     "nothing to close",
+			mockRecs: []*domain.Reception{},
+		},
+		{
+			name:     "lock held by another instance",
+			mockErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.Locked},
+			wantKind: ps.AutoCloseBusy,
+		},
+		{
+			name:     "unexpected error",
+			mockErr:  errors.New("unexpected error"),
+			wantKind: ps.Unexpected,
 		},
 	}
 
@@ -307,18 +1422,28 @@ func TestCloseReceptionInPvz(t *testing.T) {
 			repo := new(repomocks.MockRepository)
 			metrics := new(metricsmocks.MockCollector)
 			s := service.NewAppService(time.Second, repo, nil, nil, metrics)
-			pvzId := uuid.New()
 
-			repo.On("CloseReceptionInPvz", mock.Anything, &pvzId).Return(tt.mockErr)
+			before := time.Now().Add(-idleFor)
+			repo.On("AutoCloseStaleReceptions", mock.Anything, mock.MatchedBy(func(idleBefore time.Time) bool {
+				return !idleBefore.Before(before) && idleBefore.Before(time.Now().Add(-idleFor+time.Second))
+			})).Return(tt.mockRecs, tt.mockErr)
+			if tt.mockErr == nil {
+				metrics.On("AddReceptionsAutoClosed", len(tt.mockRecs)).Return()
+			}
 
-			err := s.CloseReceptionInPvz(context.Background(), &pvzId)
+			recs, err := s.AutoCloseStaleReceptions(context.Background(), idleFor)
 
-			if tt.wantErr {
-				assert.Error(t, err)
+			if tt.wantKind != "" {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, recs)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.mockRecs, recs)
 			}
 			repo.AssertExpectations(t)
+			metrics.AssertExpectations(t)
 		})
 	}
 }
@@ -327,7 +1452,7 @@ func TestGetPvzsData(t *testing.T) {
 	t.Parallel()
 
 	type mockArgs struct {
-		res []*domain.PvzReceptions
+		res *domain.PvzsPage
 		err error
 	}
 	tests := []struct {
@@ -340,7 +1465,7 @@ func TestGetPvzsData(t *testing.T) {
 			name: "success",
 			args: &domain.PvzsReadParams{},
 			mockArgs: mockArgs{
-				res: []*domain.PvzReceptions{},
+				res: &domain.PvzsPage{Items: []*domain.PvzReceptions{}},
 				err: nil,
 			},
 			wantErr: false,
@@ -380,6 +1505,122 @@ func TestGetPvzsData(t *testing.T) {
 	}
 }
 
+func TestStreamPvzsData(t *testing.T) {
+	t.Parallel()
+
+	params := &domain.PvzsReadParams{Page: 1, Limit: 10}
+	yield := func(*domain.PvzReceptions) error { return nil }
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(repomocks.MockRepository)
+		s := service.NewAppService(time.Second, repo, nil, nil, new(metricsmocks.MockCollector))
+		repo.On("StreamPvzsData", mock.Anything, params, mock.Anything).Return(&domain.PvzsPage{}, nil)
+
+		page, err := s.StreamPvzsData(context.Background(), params, yield)
+		assert.NoError(t, err)
+		assert.NotNil(t, page)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(repomocks.MockRepository)
+		s := service.NewAppService(time.Second, repo, nil, nil, new(metricsmocks.MockCollector))
+		repo.On("StreamPvzsData", mock.Anything, params, mock.Anything).Return(nil, errors.New("repo error"))
+
+		page, err := s.StreamPvzsData(context.Background(), params, yield)
+		assert.Error(t, err)
+		assert.Nil(t, page)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("reads the page in chunks", func(t *testing.T) {
+		t.Parallel()
+
+		total := 250
+		first := &domain.PvzReceptions{Pvz: &domain.Pvz{Id: uuid.New()}}
+		second := &domain.PvzReceptions{Pvz: &domain.Pvz{Id: uuid.New()}}
+		chunkEnd, pageEnd := &domain.PvzsCursor{Id: uuid.New()}, &domain.PvzsCursor{Id: uuid.New()}
+
+		repo := new(repomocks.MockRepository)
+		s := service.NewAppService(time.Second, repo, nil, nil, new(metricsmocks.MockCollector))
+		repo.On("StreamPvzsData", mock.Anything, mock.MatchedBy(func(p *domain.PvzsReadParams) bool {
+			return p.Cursor == nil && p.Page == 1 && p.Offset == 150 && p.Limit == 100 && p.WithTotal
+		}), mock.Anything).
+			Run(func(args mock.Arguments) {
+				yield := args.Get(2).(func(*domain.PvzReceptions) error)
+				for range 100 {
+					require.NoError(t, yield(first))
+				}
+			}).
+			Return(&domain.PvzsPage{NextCursor: chunkEnd, Total: &total}, nil).Once()
+		repo.On("StreamPvzsData", mock.Anything, mock.MatchedBy(func(p *domain.PvzsReadParams) bool {
+			return p.Cursor == chunkEnd && p.Limit == 50 && !p.WithTotal
+		}), mock.Anything).
+			Run(func(args mock.Arguments) {
+				yield := args.Get(2).(func(*domain.PvzReceptions) error)
+				for range 50 {
+					require.NoError(t, yield(second))
+				}
+			}).
+			Return(&domain.PvzsPage{NextCursor: pageEnd}, nil).Once()
+
+		var got []*domain.PvzReceptions
+		page, err := s.StreamPvzsData(
+			context.Background(),
+			&domain.PvzsReadParams{Page: 2, Limit: 150, WithTotal: true},
+			func(p *domain.PvzReceptions) error {
+				got = append(got, p)
+				return nil
+			},
+		)
+		require.NoError(t, err)
+		assert.Len(t, got, 150)
+		assert.Same(t, first, got[0])
+		assert.Same(t, second, got[149])
+		assert.Same(t, pageEnd, page.NextCursor)
+		assert.Equal(t, &total, page.Total)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("stops when the filter runs out", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(repomocks.MockRepository)
+		s := service.NewAppService(time.Second, repo, nil, nil, new(metricsmocks.MockCollector))
+		repo.On("StreamPvzsData", mock.Anything, mock.Anything, mock.Anything).
+			Return(&domain.PvzsPage{}, nil).Once()
+
+		page, err := s.StreamPvzsData(context.Background(), &domain.PvzsReadParams{Page: 1, Limit: 1000}, yield)
+		require.NoError(t, err)
+		assert.Nil(t, page.NextCursor)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("yield error", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(repomocks.MockRepository)
+		s := service.NewAppService(time.Second, repo, nil, nil, new(metricsmocks.MockCollector))
+		repo.On("StreamPvzsData", mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				yield := args.Get(2).(func(*domain.PvzReceptions) error)
+				require.NoError(t, yield(&domain.PvzReceptions{}))
+			}).
+			Return(&domain.PvzsPage{}, nil).Once()
+
+		page, err := s.StreamPvzsData(context.Background(), params, func(*domain.PvzReceptions) error {
+			return errors.New("client gone")
+		})
+		assertServiceErrKind(t, err, ps.Unexpected)
+		assert.Nil(t, page)
+		repo.AssertExpectations(t)
+	})
+}
+
 func TestGetAllPvzs(t *testing.T) {
 	t.Parallel()
 
@@ -418,9 +1659,9 @@ func TestGetAllPvzs(t *testing.T) {
 			metrics := new(metricsmocks.MockCollector)
 			s := service.NewAppService(time.Second, repo, nil, nil, metrics)
 
-			repo.On("GetAllPvzs", mock.Anything).Return(tt.mockArgs.res, tt.mockArgs.err)
+			repo.On("GetAllPvzs", mock.Anything, mock.Anything, mock.Anything).Return(tt.mockArgs.res, tt.mockArgs.err)
 
-			result, err := s.GetAllPvzs(context.Background())
+			result, err := s.GetAllPvzs(context.Background(), &domain.PvzsFilter{}, domain.PvzsSort{})
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -434,6 +1675,130 @@ func TestGetAllPvzs(t *testing.T) {
 	}
 }
 
+func TestPvzStock(t *testing.T) {
+	t.Parallel()
+
+	pvzId := uuid.New()
+	tests := []struct {
+		name     string
+		repoRes  *domain.PvzStock
+		repoErr  error
+		wantKind ps.ServiceErrKind
+	}{
+		{
+			name:    "success",
+			repoRes: &domain.PvzStock{PvzId: pvzId, OnHand: 12},
+		},
+		{
+			name:     "pvz not found",
+			repoErr:  &xerr.BaseErr[pRepo.RepoErrKind]{Kind: pRepo.NotFound},
+			wantKind: ps.PvzNotFound,
+		},
+		{
+			name:     "unexpected error",
+			repoErr:  errors.New("repo error"),
+			wantKind: ps.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := new(repomocks.MockRepository)
+			s := service.NewAppService(time.Second, repo, nil, nil, nil, service.WithPvzCapacity(100))
+
+			repo.On("PvzStock", mock.Anything, &pvzId).Return(tt.repoRes, tt.repoErr)
+
+			result, err := s.PvzStock(context.Background(), &pvzId)
+
+			if tt.wantKind != "" {
+				var sErr *xerr.BaseErr[ps.ServiceErrKind]
+				require.ErrorAs(t, err, &sErr)
+				assert.Equal(t, tt.wantKind, sErr.Kind)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, &domain.PvzStock{PvzId: pvzId, OnHand: 12, Capacity: 100}, result)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAllPvzsStock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("capacity is set for every pvz", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(repomocks.MockRepository)
+		s := service.NewAppService(time.Second, repo, nil, nil, nil, service.WithPvzCapacity(10))
+
+		repo.On("AllPvzsStock", mock.Anything).
+			Return([]*domain.PvzStock{{PvzId: uuid.New(), OnHand: 3}, {PvzId: uuid.New()}}, nil)
+
+		result, err := s.AllPvzsStock(context.Background())
+
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		for _, stock := range result {
+			assert.Equal(t, 10, stock.Capacity)
+		}
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(repomocks.MockRepository)
+		s := service.NewAppService(time.Second, repo, nil, nil, nil)
+
+		repo.On("AllPvzsStock", mock.Anything).Return(nil, errors.New("repo error"))
+
+		result, err := s.AllPvzsStock(context.Background())
+
+		var sErr *xerr.BaseErr[ps.ServiceErrKind]
+		require.ErrorAs(t, err, &sErr)
+		assert.Equal(t, ps.Unexpected, sErr.Kind)
+		assert.Nil(t, result)
+	})
+}
+
+func TestOpenReceptionsCount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(repomocks.MockRepository)
+		s := service.NewAppService(time.Second, repo, nil, nil, nil)
+
+		repo.On("CountReceptions", mock.Anything, domain.InProgress).Return(5, nil)
+
+		n, err := s.OpenReceptionsCount(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, n)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		repo := new(repomocks.MockRepository)
+		s := service.NewAppService(time.Second, repo, nil, nil, nil)
+
+		repo.On("CountReceptions", mock.Anything, domain.InProgress).Return(0, errors.New("repo error"))
+
+		_, err := s.OpenReceptionsCount(context.Background())
+
+		var sErr *xerr.BaseErr[ps.ServiceErrKind]
+		require.ErrorAs(t, err, &sErr)
+		assert.Equal(t, ps.Unexpected, sErr.Kind)
+	})
+}
+
 func TestRegisterUser(t *testing.T) {
 	t.Parallel()
 
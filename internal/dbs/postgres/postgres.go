@@ -5,18 +5,22 @@ import (
 	"fmt"
 	"net/url"
 
-	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/shrtyk/pvz-service/internal/config"
+	"go.opentelemetry.io/otel"
 )
 
 func MustCreateConnectionPool(cfg *config.PostgresCfg) *sql.DB {
 	dsn := buildDSN(cfg)
 
-	db, err := sql.Open("pgx", dsn)
+	connCfg, err := pgx.ParseConfig(dsn)
 	if err != nil {
 		msg := fmt.Sprintf("Coludn't create connections pool for DSN: '%s': %s", dsn, err)
 		panic(msg)
 	}
+	connCfg.Tracer = newQueryTracer(otel.GetTracerProvider())
+	db := stdlib.OpenDB(*connCfg)
 
 	db.SetMaxOpenConns(cfg.MaxOpenConns)
 	db.SetMaxIdleConns(cfg.MaxIdleConns)
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/shrtyk/pvz-service/internal/dbs/postgres"
+
+// queryTracer records a span for every statement sent to the database, with its text and
+// the number of rows it returned or changed. Arguments are left out, as they may hold
+// personal data.
+type queryTracer struct {
+	tracer trace.Tracer
+}
+
+func newQueryTracer(tp trace.TracerProvider) *queryTracer {
+	return &queryTracer{tracer: tp.Tracer(tracerName)}
+}
+
+func (t *queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	op := operationName(data.SQL)
+	ctx, _ = t.tracer.Start(ctx, op,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNamePostgreSQL,
+			semconv.DBOperationName(op),
+			semconv.DBQueryText(data.SQL),
+		),
+	)
+	return ctx
+}
+
+func (t *queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+		return
+	}
+	span.SetAttributes(semconv.DBResponseReturnedRows(int(data.CommandTag.RowsAffected())))
+}
+
+// operationName returns the SQL command a statement starts with, like SELECT or WITH.
+func operationName(sql string) string {
+	words := strings.Fields(sql)
+	if len(words) == 0 {
+		return "postgres.query"
+	}
+	return strings.ToUpper(words[0])
+}
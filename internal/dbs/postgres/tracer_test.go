@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+func TestQueryTracer(t *testing.T) {
+	t.Parallel()
+
+	const query = "\n\tselect id from pvzs where city = $1"
+
+	testCases := []struct {
+		name       string
+		end        pgx.TraceQueryEndData
+		wantStatus codes.Code
+		wantRows   bool
+	}{
+		{
+			name:     "successful query",
+			end:      pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 3")},
+			wantRows: true,
+		},
+		{
+			name:       "failed query",
+			end:        pgx.TraceQueryEndData{Err: errors.New("connection reset")},
+			wantStatus: codes.Error,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := tracetest.NewSpanRecorder()
+			qt := newQueryTracer(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)))
+
+			ctx := qt.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{
+				SQL:  query,
+				Args: []any{"Москва"},
+			})
+			qt.TraceQueryEnd(ctx, nil, tc.end)
+
+			spans := rec.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			assert.Equal(t, "SELECT", span.Name())
+			assert.Equal(t, tc.wantStatus, span.Status().Code)
+			assert.Contains(t, span.Attributes(), semconv.DBQueryText(query))
+			if tc.wantRows {
+				assert.Contains(t, span.Attributes(), semconv.DBResponseReturnedRows(3))
+			}
+			for _, attr := range span.Attributes() {
+				assert.NotContains(t, attr.Value.Emit(), "Москва")
+			}
+		})
+	}
+}
+
+func TestOperationName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "WITH", operationName("with moved as (delete from products) select 1"))
+	assert.Equal(t, "INSERT", operationName("  INSERT INTO pvzs (city) VALUES ($1)"))
+	assert.Equal(t, "postgres.query", operationName(" \n"))
+}
@@ -0,0 +1,132 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	pe "github.com/shrtyk/pvz-service/internal/core/ports/export"
+	"github.com/shrtyk/pvz-service/pkg/xlsx"
+)
+
+const sheetName = "receptions"
+
+// header lists the columns of an export. Every product takes a row, receptions
+// without products and PVZs without receptions take a row with empty columns.
+var header = []string{
+	"pvz_id", "city", "registered_at",
+	"reception_id", "reception_status", "reception_date", "closed_at",
+	"product_id", "product_type", "barcode", "added_at", "product_status",
+}
+
+type rowWriter interface {
+	WriteRow(cells []string) error
+	Close() error
+}
+
+type encoder struct {
+	w           rowWriter
+	wroteHeader bool
+}
+
+type encoderFactory struct{}
+
+func NewEncoderFactory() *encoderFactory {
+	return &encoderFactory{}
+}
+
+func (f *encoderFactory) NewEncoder(format domain.ExportFormat, w io.Writer) (pe.Encoder, error) {
+	switch format {
+	case domain.ExportCSV:
+		return &encoder{w: &csvWriter{w: csv.NewWriter(w)}}, nil
+	case domain.ExportXLSX:
+		xw, err := xlsx.NewWriter(w, sheetName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start xlsx workbook: %w", err)
+		}
+		return &encoder{w: xw}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format: %q", format)
+	}
+}
+
+func (e *encoder) Encode(data *domain.PvzReceptions) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	row := make([]string, len(header))
+	row[0] = data.Pvz.Id.String()
+	row[1] = string(data.Pvz.City)
+	row[2] = formatTime(&data.Pvz.RegistrationDate)
+	if len(data.Receptions) == 0 {
+		return e.w.WriteRow(row)
+	}
+
+	for _, rp := range data.Receptions {
+		r := rp.Reception
+		row[3] = r.Id.String()
+		row[4] = string(r.Status)
+		row[5] = formatTime(&r.DateTime)
+		row[6] = formatTime(r.ClosedAt)
+		clear(row[7:])
+		if len(rp.Products) == 0 {
+			if err := e.w.WriteRow(row); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, p := range rp.Products {
+			row[7] = p.Id.String()
+			row[8] = string(p.Type)
+			row[9] = ""
+			if p.Barcode != nil {
+				row[9] = *p.Barcode
+			}
+			row[10] = formatTime(&p.DateTime)
+			row[11] = string(p.Status)
+			if err := e.w.WriteRow(row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (e *encoder) Close() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	return e.w.Close()
+}
+
+func (e *encoder) writeHeader() error {
+	if e.wroteHeader {
+		return nil
+	}
+	e.wroteHeader = true
+	return e.w.WriteRow(header)
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) WriteRow(cells []string) error {
+	return c.w.Write(cells)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
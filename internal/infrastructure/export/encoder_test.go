@@ -0,0 +1,84 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncoder_CSV(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	barcode := "4600000000001"
+	pvz := &domain.Pvz{Id: uuid.New(), City: domain.Moscow, RegistrationDate: now}
+	withProducts := &domain.Reception{Id: uuid.New(), PvzId: pvz.Id, DateTime: now, Status: domain.Close, ClosedAt: &now}
+	empty := &domain.Reception{Id: uuid.New(), PvzId: pvz.Id, DateTime: now, Status: domain.InProgress}
+	product := &domain.Product{
+		Id:       uuid.New(),
+		Type:     domain.ProductTypeElectronics,
+		DateTime: now,
+		Barcode:  &barcode,
+		Status:   domain.ProductInStorage,
+	}
+	lonely := &domain.Pvz{Id: uuid.New(), City: domain.Kazan, RegistrationDate: now}
+
+	buf := new(bytes.Buffer)
+	enc, err := NewEncoderFactory().NewEncoder(domain.ExportCSV, buf)
+	require.NoError(t, err)
+	require.NoError(t, enc.Encode(&domain.PvzReceptions{
+		Pvz: pvz,
+		Receptions: []*domain.ReceptionProducts{
+			{Reception: withProducts, Products: []*domain.Product{product}},
+			{Reception: empty},
+		},
+	}))
+	require.NoError(t, enc.Encode(&domain.PvzReceptions{Pvz: lonely}))
+	require.NoError(t, enc.Close())
+
+	records, err := csv.NewReader(buf).ReadAll()
+	require.NoError(t, err)
+	ts := now.Format(time.RFC3339)
+	assert.Equal(t, [][]string{
+		header,
+		{
+			pvz.Id.String(), "Москва", ts,
+			withProducts.Id.String(), "close", ts, ts,
+			product.Id.String(), "электроника", barcode, ts, "in_storage",
+		},
+		{pvz.Id.String(), "Москва", ts, empty.Id.String(), "in_progress", ts, "", "", "", "", "", ""},
+		{lonely.Id.String(), "Казань", ts, "", "", "", "", "", "", "", "", ""},
+	}, records)
+}
+
+func TestEncoder_EmptyExportHasHeader(t *testing.T) {
+	t.Parallel()
+
+	buf := new(bytes.Buffer)
+	enc, err := NewEncoderFactory().NewEncoder(domain.ExportCSV, buf)
+	require.NoError(t, err)
+	require.NoError(t, enc.Close())
+
+	records, err := csv.NewReader(buf).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{header}, records)
+}
+
+func TestEncoderFactory_Formats(t *testing.T) {
+	t.Parallel()
+
+	buf := new(bytes.Buffer)
+	enc, err := NewEncoderFactory().NewEncoder(domain.ExportXLSX, buf)
+	require.NoError(t, err)
+	require.NoError(t, enc.Close())
+	assert.Equal(t, []byte("PK"), buf.Bytes()[:2])
+
+	_, err = NewEncoderFactory().NewEncoder("pdf", buf)
+	assert.Error(t, err)
+}
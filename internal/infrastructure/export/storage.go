@@ -0,0 +1,66 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+var ErrInvalidName = errors.New("invalid file name")
+
+// dirStorage keeps export files in a local directory.
+type dirStorage struct {
+	dir string
+}
+
+// NewDirStorage creates the directory if it doesn't exist yet.
+func NewDirStorage(dir string) (*dirStorage, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create exports directory: %w", err)
+	}
+	return &dirStorage{dir: dir}, nil
+}
+
+func MustCreateDirStorage(dir string) *dirStorage {
+	s, err := NewDirStorage(dir)
+	if err != nil {
+		panic(err.Error())
+	}
+	return s
+}
+
+func (s *dirStorage) Create(name string) (io.WriteCloser, error) {
+	path, err := s.path(name)
+	if err != nil {
+		return nil, err
+	}
+	return os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o640)
+}
+
+func (s *dirStorage) Open(name string) (io.ReadCloser, error) {
+	path, err := s.path(name)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+func (s *dirStorage) Remove(name string) error {
+	path, err := s.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *dirStorage) path(name string) (string, error) {
+	if name == "" || name != filepath.Base(name) || name == "." || name == ".." {
+		return "", fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+	return filepath.Join(s.dir, name), nil
+}
@@ -0,0 +1,42 @@
+package export
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirStorage(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "exports")
+	s, err := NewDirStorage(dir)
+	require.NoError(t, err)
+
+	w, err := s.Create("report.csv")
+	require.NoError(t, err)
+	_, err = io.WriteString(w, "pvz_id\n")
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	r, err := s.Open("report.csv")
+	require.NoError(t, err)
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, "pvz_id\n", string(b))
+
+	require.NoError(t, s.Remove("report.csv"))
+	require.NoError(t, s.Remove("report.csv"))
+	_, err = s.Open("report.csv")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	for _, name := range []string{"", ".", "..", "../report.csv", "a/b.csv"} {
+		_, err := s.Create(name)
+		assert.ErrorIs(t, err, ErrInvalidName, name)
+	}
+}
@@ -0,0 +1,48 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// schemaVersionQuery finds the goose version of the schema: the newest migration
+// whose last record is an applied one, as rolled back migrations leave records too.
+const schemaVersionQuery = `
+	SELECT coalesce(max(version_id), 0)
+	FROM (
+		SELECT DISTINCT ON (version_id) version_id, is_applied
+		FROM goose_db_version
+		ORDER BY version_id, id DESC
+	) v
+	WHERE is_applied`
+
+// PostgresCheck pings the database.
+func PostgresCheck(db *sql.DB) Check {
+	return func(ctx context.Context) error {
+		return db.PingContext(ctx)
+	}
+}
+
+// MigrationsCheck verifies the schema has all migrations up to the expected goose
+// version applied. Newer schemas are fine, so that rolling updates applying their
+// migrations first don't fail instances of the previous release.
+func MigrationsCheck(db *sql.DB, expected int64) Check {
+	return func(ctx context.Context) error {
+		var version int64
+		if err := db.QueryRowContext(ctx, schemaVersionQuery).Scan(&version); err != nil {
+			return fmt.Errorf("failed to get schema version: %w", err)
+		}
+		if version < expected {
+			return fmt.Errorf("schema version %d is behind the expected %d", version, expected)
+		}
+		return nil
+	}
+}
+
+// TokenKeysCheck verifies the keys signing the auth tokens are loaded.
+func TokenKeysCheck(keys interface{ CheckKeys() error }) Check {
+	return func(ctx context.Context) error {
+		return keys.CheckKeys()
+	}
+}
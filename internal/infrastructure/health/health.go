@@ -0,0 +1,102 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusUp   Status = "up"
+	StatusDown Status = "down"
+)
+
+// ErrShuttingDown fails the readiness of an instance once its graceful shutdown
+// has started, so that load balancers stop routing to it.
+var ErrShuttingDown = errors.New("shutting down")
+
+// Check reports whether a dependency of the app is usable.
+type Check func(ctx context.Context) error
+
+type ComponentReport struct {
+	Status Status `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status     Status                     `json:"status"`
+	Components map[string]ComponentReport `json:"components"`
+}
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker runs the readiness checks of the app, each one bounded by the timeout.
+type Checker struct {
+	timeout      time.Duration
+	checks       []namedCheck
+	shuttingDown atomic.Bool
+}
+
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+// Register adds a check reported under the name. It isn't safe to call
+// concurrently with Check, so all checks are registered on startup.
+func (c *Checker) Register(name string, check Check) {
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// Shutdown makes every following report fail.
+func (c *Checker) Shutdown() {
+	c.shuttingDown.Store(true)
+}
+
+// Check runs all checks concurrently. The app is ready only if all of them pass
+// and it isn't shutting down.
+func (c *Checker) Check(ctx context.Context) Report {
+	report := Report{
+		Status:     StatusUp,
+		Components: make(map[string]ComponentReport, len(c.checks)+1),
+	}
+	if c.shuttingDown.Load() {
+		report.Status = StatusDown
+		report.Components["app"] = ComponentReport{Status: StatusDown, Error: ErrShuttingDown.Error()}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, nc := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			cr := ComponentReport{Status: StatusUp}
+			if err := nc.check(ctx); err != nil {
+				cr = ComponentReport{Status: StatusDown, Error: err.Error()}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Components[nc.name] = cr
+			if cr.Status == StatusDown {
+				report.Status = StatusDown
+			}
+		}()
+	}
+	wg.Wait()
+
+	return report
+}
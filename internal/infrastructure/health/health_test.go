@@ -0,0 +1,129 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	ok := func(ctx context.Context) error { return nil }
+	failing := func(ctx context.Context) error { return errors.New("boom") }
+	slow := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	t.Run("all checks pass", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewChecker(time.Second)
+		c.Register("postgres", ok)
+		c.Register("token_keys", ok)
+
+		report := c.Check(context.Background())
+
+		assert.Equal(t, Report{
+			Status: StatusUp,
+			Components: map[string]ComponentReport{
+				"postgres":   {Status: StatusUp},
+				"token_keys": {Status: StatusUp},
+			},
+		}, report)
+	})
+
+	t.Run("failing and timed out checks", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewChecker(10 * time.Millisecond)
+		c.Register("postgres", slow)
+		c.Register("migrations", failing)
+		c.Register("token_keys", ok)
+
+		report := c.Check(context.Background())
+
+		assert.Equal(t, StatusDown, report.Status)
+		assert.Equal(t, ComponentReport{Status: StatusDown, Error: context.DeadlineExceeded.Error()}, report.Components["postgres"])
+		assert.Equal(t, ComponentReport{Status: StatusDown, Error: "boom"}, report.Components["migrations"])
+		assert.Equal(t, ComponentReport{Status: StatusUp}, report.Components["token_keys"])
+	})
+
+	t.Run("shutting down", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewChecker(time.Second)
+		c.Register("postgres", ok)
+		c.Shutdown()
+
+		report := c.Check(context.Background())
+
+		assert.Equal(t, StatusDown, report.Status)
+		assert.Equal(t, ComponentReport{Status: StatusDown, Error: ErrShuttingDown.Error()}, report.Components["app"])
+		assert.Equal(t, ComponentReport{Status: StatusUp}, report.Components["postgres"])
+	})
+}
+
+func TestMigrationsCheck(t *testing.T) {
+	t.Parallel()
+
+	const expected = int64(20261018210100)
+
+	tests := []struct {
+		name    string
+		version int64
+		err     error
+		wantErr string
+	}{
+		{name: "at the expected version", version: expected},
+		{name: "ahead of the expected version", version: expected + 1},
+		{name: "behind the expected version", version: expected - 1, wantErr: "is behind"},
+		{name: "no goose table", err: errors.New("relation does not exist"), wantErr: "failed to get schema version"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			exp := mock.ExpectQuery(regexp.QuoteMeta(schemaVersionQuery))
+			if tt.err != nil {
+				exp.WillReturnError(tt.err)
+			} else {
+				exp.WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(tt.version))
+			}
+
+			err = MigrationsCheck(db, expected)(context.Background())
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPostgresCheck(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectPing().WillReturnError(errors.New("connection refused"))
+
+	assert.ErrorContains(t, PostgresCheck(db)(context.Background()), "connection refused")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
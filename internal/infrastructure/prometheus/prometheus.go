@@ -1,16 +1,27 @@
 package prometheus
 
 import (
+	"database/sql"
+
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
 type PrometheusCollector struct {
 	httpRequestsTotal      *prometheus.CounterVec
 	httpRequestDuration    *prometheus.HistogramVec
+	grpcRequestsTotal      *prometheus.CounterVec
+	grpcRequestDuration    *prometheus.HistogramVec
+	dbQueryDuration        *prometheus.HistogramVec
+	openReceptions         prometheus.Gauge
 	pvzsCreatedTotal       prometheus.Counter
 	receptionsCreatedTotal prometheus.Counter
 	productsAddedTotal     prometheus.Counter
+	productStatusChanges   *prometheus.CounterVec
+	receptionsAutoClosed   prometheus.Counter
+	autoCloseRunsTotal     *prometheus.CounterVec
+	pvzProductsOnHand      *prometheus.GaugeVec
 }
 
 func NewPrometheusCollector() *PrometheusCollector {
@@ -20,7 +31,7 @@ func NewPrometheusCollector() *PrometheusCollector {
 				Name: "http_requests_total",
 				Help: "Total number of HTTP requests",
 			},
-			[]string{"method", "code"},
+			[]string{"method", "route", "code"},
 		),
 		httpRequestDuration: promauto.NewHistogramVec(
 			prometheus.HistogramOpts{
@@ -28,8 +39,37 @@ func NewPrometheusCollector() *PrometheusCollector {
 				Help:    "Duration of HTTP requests",
 				Buckets: prometheus.DefBuckets,
 			},
+			[]string{"method", "route"},
+		),
+		grpcRequestsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "grpc_requests_total",
+				Help: "Total number of gRPC requests",
+			},
+			[]string{"method", "code"},
+		),
+		grpcRequestDuration: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "grpc_request_duration_seconds",
+				Help:    "Duration of gRPC requests",
+				Buckets: prometheus.DefBuckets,
+			},
 			[]string{"method"},
 		),
+		dbQueryDuration: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "db_query_duration_seconds",
+				Help:    "Duration of database queries by repository operation",
+				Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+			},
+			[]string{"operation"},
+		),
+		openReceptions: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "receptions_open",
+				Help: "Number of receptions currently in progress",
+			},
+		),
 		pvzsCreatedTotal: promauto.NewCounter(
 			prometheus.CounterOpts{
 				Name: "pvzs_created_total",
@@ -48,6 +88,33 @@ func NewPrometheusCollector() *PrometheusCollector {
 				Help: "Total number of added products",
 			},
 		),
+		productStatusChanges: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "product_status_changes_total",
+				Help: "Total number of products that left storage by new status",
+			},
+			[]string{"status"},
+		),
+		receptionsAutoClosed: promauto.NewCounter(
+			prometheus.CounterOpts{
+				Name: "receptions_auto_closed_total",
+				Help: "Total number of receptions closed automatically after being idle",
+			},
+		),
+		autoCloseRunsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "reception_auto_close_runs_total",
+				Help: "Total number of reception auto close runs by result",
+			},
+			[]string{"result"},
+		),
+		pvzProductsOnHand: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "pvz_products_on_hand",
+				Help: "Number of products currently stored in a PVZ",
+			},
+			[]string{"pvz_id"},
+		),
 	}
 }
 
@@ -63,10 +130,52 @@ func (c *PrometheusCollector) IncProductsAdded() {
 	c.productsAddedTotal.Inc()
 }
 
-func (c *PrometheusCollector) ObserveHTTPRequestDuration(method string, duration float64) {
-	c.httpRequestDuration.WithLabelValues(method).Observe(duration)
+func (c *PrometheusCollector) AddProductsAdded(n int) {
+	c.productsAddedTotal.Add(float64(n))
+}
+
+func (c *PrometheusCollector) IncProductStatusChanges(status string) {
+	c.productStatusChanges.WithLabelValues(status).Inc()
+}
+
+func (c *PrometheusCollector) AddReceptionsAutoClosed(n int) {
+	c.receptionsAutoClosed.Add(float64(n))
+}
+
+func (c *PrometheusCollector) IncReceptionAutoCloseRuns(result string) {
+	c.autoCloseRunsTotal.WithLabelValues(result).Inc()
+}
+
+func (c *PrometheusCollector) SetPvzProductsOnHand(pvzId string, n int) {
+	c.pvzProductsOnHand.WithLabelValues(pvzId).Set(float64(n))
+}
+
+func (c *PrometheusCollector) ObserveHTTPRequestDuration(method, route string, duration float64) {
+	c.httpRequestDuration.WithLabelValues(method, route).Observe(duration)
+}
+
+func (c *PrometheusCollector) IncHTTPRequestsTotal(method, route, code string) {
+	c.httpRequestsTotal.WithLabelValues(method, route, code).Inc()
+}
+
+func (c *PrometheusCollector) ObserveGRPCRequestDuration(method string, duration float64) {
+	c.grpcRequestDuration.WithLabelValues(method).Observe(duration)
+}
+
+func (c *PrometheusCollector) IncGRPCRequestsTotal(method, code string) {
+	c.grpcRequestsTotal.WithLabelValues(method, code).Inc()
+}
+
+func (c *PrometheusCollector) ObserveDBQueryDuration(operation string, duration float64) {
+	c.dbQueryDuration.WithLabelValues(operation).Observe(duration)
+}
+
+func (c *PrometheusCollector) SetOpenReceptions(n int) {
+	c.openReceptions.Set(float64(n))
 }
 
-func (c *PrometheusCollector) IncHTTPRequestsTotal(method, code string) {
-	c.httpRequestsTotal.WithLabelValues(method, code).Inc()
+// RegisterDBStats exports the connection pool statistics of db, like open and idle
+// connections and the time spent waiting for one.
+func (c *PrometheusCollector) RegisterDBStats(db *sql.DB, dbName string) {
+	prometheus.MustRegister(collectors.NewDBStatsCollector(db, dbName))
 }
@@ -0,0 +1,33 @@
+package ratelimit
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/shrtyk/pvz-service/internal/config"
+	pr "github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
+)
+
+// MustCreateLimiter returns the limiter configured by cfg, or nil if rate limiting is off.
+func MustCreateLimiter(cfg *config.RateLimitCfg, db *sql.DB) pr.Limiter {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	def, err := cfg.DefaultLimit()
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse default rate limit: %s", err))
+	}
+
+	var store Store
+	switch cfg.Backend {
+	case "memory":
+		store = NewMemoryStore()
+	case "postgres":
+		store = NewPostgresStore(db)
+	default:
+		panic(fmt.Sprintf("unknown rate limit backend: %q", cfg.Backend))
+	}
+
+	return NewLimiter(store, def, cfg.Operations, cfg.APIKeys)
+}
@@ -0,0 +1,118 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"time"
+
+	pr "github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
+)
+
+// defaultScope is the bucket scope of operations without a limit of their own,
+// so that they share the default limit of a client.
+const defaultScope = "default"
+
+// Store keeps the token buckets of the limiter.
+type Store interface {
+	// Take takes a token from the bucket of key if there is one, refilling
+	// the bucket according to the limit first.
+	Take(ctx context.Context, key string, limit pr.Limit) (pr.Result, error)
+}
+
+type limiter struct {
+	store      Store
+	def        pr.Limit
+	operations map[string]pr.Limit
+	apiKeys    map[string]struct{}
+}
+
+// NewLimiter returns a limiter applying the limits of operations to their requests
+// and the default one to the requests of all other operations together. Clients
+// sending one of the API keys are told apart by the key rather than their IP.
+func NewLimiter(store Store, def pr.Limit, operations map[string]pr.Limit, apiKeys []string) *limiter {
+	keys := make(map[string]struct{}, len(apiKeys))
+	for _, k := range apiKeys {
+		keys[hashKey(k)] = struct{}{}
+	}
+
+	return &limiter{
+		store:      store,
+		def:        def,
+		operations: operations,
+		apiKeys:    keys,
+	}
+}
+
+func (l *limiter) Take(ctx context.Context, operation string, client pr.Client) (pr.Result, error) {
+	limit, scope := l.def, defaultScope
+	if opLimit, ok := l.operations[operation]; ok {
+		limit, scope = opLimit, operation
+	}
+	if limit.Unlimited() {
+		return pr.Result{Allowed: true}, nil
+	}
+
+	return l.store.Take(ctx, scope+"|"+l.identity(client), limit)
+}
+
+// identity returns the id of the bucket owner. API keys are hashed, so that
+// they don't end up in the store.
+func (l *limiter) identity(c pr.Client) string {
+	if c.UserID != "" {
+		return "user:" + c.UserID
+	}
+	if c.APIKey != "" {
+		if h := hashKey(c.APIKey); l.hasKey(h) {
+			return "key:" + h
+		}
+	}
+	return "ip:" + c.IP
+}
+
+func (l *limiter) hasKey(hash string) bool {
+	_, ok := l.apiKeys[hash]
+	return ok
+}
+
+func hashKey(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(h[:])
+}
+
+// refill returns the tokens of a bucket after the elapsed time, capped by its size.
+func refill(tokens float64, elapsed time.Duration, limit pr.Limit) float64 {
+	return math.Min(float64(limit.Requests), tokens+elapsed.Seconds()*perSecond(limit))
+}
+
+// take takes a token from refilled bucket if there is a whole one.
+func take(tokens float64) (float64, bool) {
+	if tokens >= 1 {
+		return tokens - 1, true
+	}
+	return tokens, false
+}
+
+// result describes a bucket left with the tokens.
+func result(tokens float64, allowed bool, limit pr.Limit) pr.Result {
+	rate := perSecond(limit)
+	res := pr.Result{
+		Allowed:   allowed,
+		Limit:     limit.Requests,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     seconds((float64(limit.Requests) - tokens) / rate),
+	}
+	if !allowed {
+		res.RetryAfter = seconds((1 - tokens) / rate)
+	}
+	return res
+}
+
+func perSecond(limit pr.Limit) float64 {
+	return float64(limit.Requests) / limit.Per.Seconds()
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
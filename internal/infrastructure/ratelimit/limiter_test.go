@@ -0,0 +1,154 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/config"
+	pr "github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// keyStore records the keys of the buckets it is asked for.
+type keyStore struct {
+	keys   []string
+	limits []pr.Limit
+}
+
+func (s *keyStore) Take(ctx context.Context, key string, limit pr.Limit) (pr.Result, error) {
+	s.keys = append(s.keys, key)
+	s.limits = append(s.limits, limit)
+	return pr.Result{Allowed: true, Limit: limit.Requests}, nil
+}
+
+func TestLimiter_Take(t *testing.T) {
+	t.Parallel()
+
+	def := pr.Limit{Requests: 100, Per: time.Minute}
+	login := pr.Limit{Requests: 5, Per: time.Minute}
+	operations := map[string]pr.Limit{
+		"POST /login": login,
+		"GET /pvz":    {},
+	}
+
+	tests := []struct {
+		name      string
+		operation string
+		client    pr.Client
+		wantKey   string
+		wantLimit pr.Limit
+	}{
+		{
+			name:      "user of the operation limit",
+			operation: "POST /login",
+			client:    pr.Client{UserID: "1", APIKey: "known-key", IP: "10.0.0.1"},
+			wantKey:   "POST /login|user:1",
+			wantLimit: login,
+		},
+		{
+			name:      "known api key of the default limit",
+			operation: "POST /pvz",
+			client:    pr.Client{APIKey: "known-key", IP: "10.0.0.1"},
+			wantKey:   "default|key:" + hashKey("known-key"),
+			wantLimit: def,
+		},
+		{
+			name:      "unknown api key falls back to ip",
+			operation: "POST /pvz",
+			client:    pr.Client{APIKey: "made-up-key", IP: "10.0.0.1"},
+			wantKey:   "default|ip:10.0.0.1",
+			wantLimit: def,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := &keyStore{}
+			l := NewLimiter(store, def, operations, []string{"known-key"})
+
+			res, err := l.Take(context.Background(), tt.operation, tt.client)
+
+			require.NoError(t, err)
+			assert.True(t, res.Allowed)
+			assert.Equal(t, []string{tt.wantKey}, store.keys)
+			assert.Equal(t, []pr.Limit{tt.wantLimit}, store.limits)
+		})
+	}
+
+	t.Run("unlimited operation", func(t *testing.T) {
+		t.Parallel()
+
+		store := &keyStore{}
+		l := NewLimiter(store, def, operations, nil)
+
+		res, err := l.Take(context.Background(), "GET /pvz", pr.Client{IP: "10.0.0.1"})
+
+		require.NoError(t, err)
+		assert.Equal(t, pr.Result{Allowed: true}, res)
+		assert.Empty(t, store.keys)
+	})
+}
+
+func TestMemoryStore_Take(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	s := NewMemoryStore()
+	s.now = func() time.Time { return now }
+	s.lastSweep = now
+	limit := pr.Limit{Requests: 2, Per: 2 * time.Second}
+	ctx := context.Background()
+
+	res, err := s.Take(ctx, "k", limit)
+	require.NoError(t, err)
+	assert.Equal(t, pr.Result{Allowed: true, Limit: 2, Remaining: 1, Reset: time.Second}, res)
+
+	res, err = s.Take(ctx, "k", limit)
+	require.NoError(t, err)
+	assert.Equal(t, pr.Result{Allowed: true, Limit: 2, Remaining: 0, Reset: 2 * time.Second}, res)
+
+	res, err = s.Take(ctx, "k", limit)
+	require.NoError(t, err)
+	assert.Equal(t, pr.Result{Allowed: false, Limit: 2, Remaining: 0, RetryAfter: time.Second, Reset: 2 * time.Second}, res)
+
+	res, err = s.Take(ctx, "other", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed, "buckets are per key")
+
+	now = now.Add(500 * time.Millisecond)
+	res, err = s.Take(ctx, "k", limit)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 500*time.Millisecond, res.RetryAfter)
+
+	now = now.Add(500 * time.Millisecond)
+	res, err = s.Take(ctx, "k", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	now = now.Add(time.Hour)
+	_, err = s.Take(ctx, "k", limit)
+	require.NoError(t, err)
+	assert.Len(t, s.buckets, 1, "refilled buckets are swept")
+}
+
+func TestMustCreateLimiter(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, MustCreateLimiter(&config.RateLimitCfg{Enabled: false}, nil))
+
+	l := MustCreateLimiter(&config.RateLimitCfg{Enabled: true, Backend: "postgres", Default: "10/s"}, nil)
+	require.NotNil(t, l)
+	assert.IsType(t, &postgresStore{}, l.(*limiter).store)
+
+	assert.Panics(t, func() {
+		MustCreateLimiter(&config.RateLimitCfg{Enabled: true, Backend: "redis", Default: "10/s"}, nil)
+	})
+	assert.Panics(t, func() {
+		MustCreateLimiter(&config.RateLimitCfg{Enabled: true, Backend: "memory", Default: "fast"}, nil)
+	})
+}
@@ -0,0 +1,72 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pr "github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
+)
+
+// sweepInterval is how often full buckets are dropped.
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	fullAt  time.Time
+}
+
+// memoryStore keeps the buckets of a single instance in memory.
+type memoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryStore() *memoryStore {
+	return &memoryStore{
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+		now:       time.Now,
+	}
+}
+
+func (s *memoryStore) Take(ctx context.Context, key string, limit pr.Limit) (pr.Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Requests), updated: now}
+		s.buckets[key] = b
+	}
+
+	tokens, allowed := take(refill(b.tokens, now.Sub(b.updated), limit))
+	res := result(tokens, allowed, limit)
+
+	b.tokens = tokens
+	b.updated = now
+	b.fullAt = now.Add(res.Reset)
+
+	return res, nil
+}
+
+// sweep drops the buckets that have been refilled, as they are the same as
+// missing ones. It scans all buckets, so it is done once in a sweepInterval.
+func (s *memoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, b := range s.buckets {
+		if !now.Before(b.fullAt) {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	pr "github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
+)
+
+// takeQuery refills the bucket of $1 of size $2 at $3 tokens per second and takes
+// a token from it if there is a whole one, all in one statement, so that replicas
+// don't race. Buckets are refilled by the database clock.
+const takeQuery = `
+	INSERT INTO rate_limit_buckets AS b (key, tokens, allowed, updated_at, full_at)
+	VALUES ($1, $2::float8 - 1, true, now(), now() + make_interval(secs => 1 / $3::float8))
+	ON CONFLICT (key) DO UPDATE SET (tokens, allowed, updated_at, full_at) = (
+		SELECT
+			t.tokens,
+			t.allowed,
+			now(),
+			now() + make_interval(secs => ($2::float8 - t.tokens) / $3::float8)
+		FROM (
+			SELECT
+				CASE WHEN r.tokens >= 1 THEN r.tokens - 1 ELSE r.tokens END AS tokens,
+				r.tokens >= 1 AS allowed
+			FROM (
+				SELECT least(
+					$2::float8,
+					b.tokens + extract(epoch FROM now() - b.updated_at)::float8 * $3::float8
+				) AS tokens
+			) r
+		) t
+	)
+	RETURNING tokens, allowed`
+
+const pruneQuery = `DELETE FROM rate_limit_buckets WHERE full_at < now()`
+
+// postgresStore keeps the buckets in Postgres, so that they are shared by replicas.
+type postgresStore struct {
+	db        *sql.DB
+	mu        sync.Mutex
+	lastPrune time.Time
+}
+
+func NewPostgresStore(db *sql.DB) *postgresStore {
+	return &postgresStore{db: db, lastPrune: time.Now()}
+}
+
+func (s *postgresStore) Take(ctx context.Context, key string, limit pr.Limit) (pr.Result, error) {
+	if err := s.prune(ctx); err != nil {
+		return pr.Result{}, err
+	}
+
+	var (
+		tokens  float64
+		allowed bool
+	)
+	err := s.db.QueryRowContext(ctx, takeQuery, key, limit.Requests, perSecond(limit)).Scan(&tokens, &allowed)
+	if err != nil {
+		return pr.Result{}, fmt.Errorf("failed to take rate limit token: %w", err)
+	}
+
+	return result(tokens, allowed, limit), nil
+}
+
+// prune deletes the refilled buckets once in a sweepInterval.
+func (s *postgresStore) prune(ctx context.Context) error {
+	s.mu.Lock()
+	due := time.Since(s.lastPrune) >= sweepInterval
+	if due {
+		s.lastPrune = time.Now()
+	}
+	s.mu.Unlock()
+
+	if !due {
+		return nil
+	}
+	if _, err := s.db.ExecContext(ctx, pruneQuery); err != nil {
+		return fmt.Errorf("failed to prune rate limit buckets: %w", err)
+	}
+	return nil
+}
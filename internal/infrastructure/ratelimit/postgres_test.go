@@ -0,0 +1,91 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	pr "github.com/shrtyk/pvz-service/internal/core/ports/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresStore_Take(t *testing.T) {
+	t.Parallel()
+
+	limit := pr.Limit{Requests: 10, Per: 10 * time.Second}
+
+	tests := []struct {
+		name    string
+		prune   bool
+		mock    func(mock sqlmock.Sqlmock)
+		want    pr.Result
+		wantErr string
+	}{
+		{
+			name: "allowed",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(takeQuery)).
+					WithArgs("k", 10, 1.0).
+					WillReturnRows(sqlmock.NewRows([]string{"tokens", "allowed"}).AddRow(4.5, true))
+			},
+			want: pr.Result{Allowed: true, Limit: 10, Remaining: 4, Reset: 5500 * time.Millisecond},
+		},
+		{
+			name: "denied",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(takeQuery)).
+					WithArgs("k", 10, 1.0).
+					WillReturnRows(sqlmock.NewRows([]string{"tokens", "allowed"}).AddRow(0.25, false))
+			},
+			want: pr.Result{Limit: 10, RetryAfter: 750 * time.Millisecond, Reset: 9750 * time.Millisecond},
+		},
+		{
+			name:  "prunes refilled buckets once in a while",
+			prune: true,
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(pruneQuery)).WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectQuery(regexp.QuoteMeta(takeQuery)).
+					WithArgs("k", 10, 1.0).
+					WillReturnRows(sqlmock.NewRows([]string{"tokens", "allowed"}).AddRow(9.0, true))
+			},
+			want: pr.Result{Allowed: true, Limit: 10, Remaining: 9, Reset: time.Second},
+		},
+		{
+			name: "db error",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(takeQuery)).WillReturnError(errors.New("db error"))
+			},
+			wantErr: "failed to take rate limit token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			s := NewPostgresStore(db)
+			if tt.prune {
+				s.lastPrune = time.Now().Add(-sweepInterval)
+			}
+			tt.mock(mock)
+
+			res, err := s.Take(context.Background(), "k", limit)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, res)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
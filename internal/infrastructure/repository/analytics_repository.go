@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	pRepo "github.com/shrtyk/pvz-service/internal/core/ports/repository"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+)
+
+func (r *repo) ProductsThroughput(
+	ctx context.Context,
+	params *domain.ThroughputParams,
+) ([]*domain.ThroughputPoint, error) {
+	const op = "repository.ProductsThroughput"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
+
+	q, args, err := buildProductsThroughputQuery(params)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Warn("failed to close rows", logger.WithErr(closeErr))
+		}
+	}()
+
+	points := make([]*domain.ThroughputPoint, 0)
+	for rows.Next() {
+		p := new(domain.ThroughputPoint)
+		if err := rows.Scan(&p.PeriodStart, &p.Key, &p.Products); err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+		p.PeriodStart = p.PeriodStart.UTC()
+		points = append(points, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return points, nil
+}
+
+func (r *repo) ReceptionStats(
+	ctx context.Context,
+	params *domain.ReceptionStatsParams,
+) ([]*domain.ReceptionStats, error) {
+	const op = "repository.ReceptionStats"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
+
+	q, args, err := buildReceptionStatsQuery(params)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Warn("failed to close rows", logger.WithErr(closeErr))
+		}
+	}()
+
+	stats := make([]*domain.ReceptionStats, 0)
+	for rows.Next() {
+		s := new(domain.ReceptionStats)
+		var avgSeconds float64
+		if err := rows.Scan(&s.Key, &s.Receptions, &avgSeconds, &s.AvgProducts); err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+		s.AvgDuration = time.Duration(avgSeconds * float64(time.Second))
+		stats = append(stats, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return stats, nil
+}
+
+// dailyStatsLockKey identifies the advisory lock that serializes rollup refreshes,
+// so that the background job and a backfill don't rewrite the same days at once.
+const dailyStatsLockKey int64 = 7_201_801_800
+
+func (r *repo) RefreshDailyStats(ctx context.Context, from, to time.Time) (err error) {
+	const op = "repository.RefreshDailyStats"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
+
+	from, to = rollupDays(&domain.AnalyticsRange{From: from, To: to})
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		err = r.FinishTx(tx, &err, l)
+	}()
+
+	if _, err = tx.ExecContext(ctx, string(advisoryXactLockQuery), dailyStatsLockKey); err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	for _, q := range []query{
+		deleteDailyProductStatsQuery,
+		insertDailyProductStatsQuery,
+		deleteDailyReceptionStatsQuery,
+		insertDailyReceptionStatsQuery,
+	} {
+		if _, err = tx.ExecContext(ctx, string(q), from, to); err != nil {
+			return xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+	}
+
+	return nil
+}
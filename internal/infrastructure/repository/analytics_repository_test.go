@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductsThroughput(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func(db *sql.DB) { _ = db.Close() }(db)
+
+	repo := NewRepo(db)
+	to := time.Now()
+	params := &domain.ThroughputParams{
+		AnalyticsRange: domain.AnalyticsRange{From: to.AddDate(0, 0, -7), To: to},
+		Period:         domain.AnalyticsDay,
+		GroupBy:        domain.AnalyticsByProductType,
+	}
+	day := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("FROM daily_product_stats").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"period", "key", "products"}).
+			AddRow(day, "электроника", 12).
+			AddRow(day, "обувь", 3))
+	mock.ExpectQuery("FROM daily_product_stats").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnError(errors.New("db error"))
+
+	points, err := repo.ProductsThroughput(context.Background(), params)
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.ThroughputPoint{
+		{PeriodStart: day, Key: "электроника", Products: 12},
+		{PeriodStart: day, Key: "обувь", Products: 3},
+	}, points)
+
+	_, err = repo.ProductsThroughput(context.Background(), params)
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReceptionStats(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func(db *sql.DB) { _ = db.Close() }(db)
+
+	repo := NewRepo(db)
+	to := time.Now()
+	params := &domain.ReceptionStatsParams{
+		AnalyticsRange: domain.AnalyticsRange{From: to.AddDate(0, 0, -7), To: to},
+		GroupBy:        domain.AnalyticsByCity,
+	}
+
+	mock.ExpectQuery("FROM daily_reception_stats").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"key", "receptions", "avg_duration", "avg_products"}).
+			AddRow("Москва", 4, 5400.5, 12.25))
+
+	stats, err := repo.ReceptionStats(context.Background(), params)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, "Москва", stats[0].Key)
+	assert.Equal(t, 4, stats[0].Receptions)
+	assert.Equal(t, 90*time.Minute+500*time.Millisecond, stats[0].AvgDuration)
+	assert.Equal(t, 12.25, stats[0].AvgProducts)
+
+	_, err = repo.ReceptionStats(context.Background(), &domain.ReceptionStatsParams{GroupBy: domain.AnalyticsByProductType})
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshDailyStats(t *testing.T) {
+	from := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	dayAfter := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer func(db *sql.DB) { _ = db.Close() }(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("pg_advisory_xact_lock").WithArgs(dailyStatsLockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE FROM\\s+daily_product_stats").WithArgs(from, dayAfter).WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec("INSERT INTO daily_product_stats").WithArgs(from, dayAfter).WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectExec("DELETE FROM\\s+daily_reception_stats").WithArgs(from, dayAfter).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO daily_reception_stats").WithArgs(from, dayAfter).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err = NewRepo(db).RefreshDailyStats(context.Background(), from, to)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back on error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer func(db *sql.DB) { _ = db.Close() }(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("pg_advisory_xact_lock").WithArgs(dailyStatsLockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE FROM\\s+daily_product_stats").WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		err = NewRepo(db).RefreshDailyStats(context.Background(), from, to)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"log/slog"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/shrtyk/pvz-service/internal/core/domain/auth"
@@ -15,6 +16,7 @@ import (
 
 func (r *repo) UserByEmail(ctx context.Context, email string) (*auth.User, error) {
 	const op = "repository.GetUserByEmail"
+	defer r.observe(op, time.Now())
 
 	u := new(auth.User)
 	err := r.db.QueryRow(string(getUserByEmailQuery), email).Scan(
@@ -35,6 +37,7 @@ func (r *repo) UserByEmail(ctx context.Context, email string) (*auth.User, error
 
 func (r *repo) CreateUser(ctx context.Context, user *auth.User) (*auth.User, error) {
 	const op = "repository.CreateUser"
+	defer r.observe(op, time.Now())
 
 	err := r.db.QueryRowContext(
 		ctx,
@@ -60,6 +63,7 @@ func (r *repo) CreateUser(ctx context.Context, user *auth.User) (*auth.User, err
 
 func (r *repo) SaveRefreshToken(ctx context.Context, rToken *auth.RefreshToken) error {
 	const op = "repository.SaveRefreshToken"
+	defer r.observe(op, time.Now())
 
 	_, err := r.db.ExecContext(
 		ctx,
@@ -81,6 +85,7 @@ func (r *repo) SaveRefreshToken(ctx context.Context, rToken *auth.RefreshToken)
 
 func (r *repo) UserRoleAndRefreshToken(ctx context.Context, tokenHash []byte) (*auth.UserRoleAndRToken, error) {
 	const op = "repository.UserRoleAndRefreshToken"
+	defer r.observe(op, time.Now())
 
 	urt := &auth.UserRoleAndRToken{
 		RToken: new(auth.RefreshToken),
@@ -113,6 +118,7 @@ func (r *repo) UserRoleAndRefreshToken(ctx context.Context, tokenHash []byte) (*
 
 func (r *repo) UpdateUserRefreshToken(ctx context.Context, usedHash []byte, rToken *auth.RefreshToken) error {
 	const op = "repository.UpdateUserRefreshToken"
+	defer r.observe(op, time.Now())
 	l := logger.FromCtx(ctx)
 
 	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
@@ -148,6 +154,7 @@ func (r *repo) UpdateUserRefreshToken(ctx context.Context, usedHash []byte, rTok
 
 func (r *repo) FinishTx(tx *sql.Tx, err *error, l *slog.Logger) error {
 	const op = "repository.FinishTx"
+	defer r.observe(op, time.Now())
 
 	if *err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	pRepo "github.com/shrtyk/pvz-service/internal/core/ports/repository"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+)
+
+// exportParams is how the filter and sort of an export job are kept in the params column.
+type exportParams struct {
+	Filter domain.PvzsFilter `json:"filter"`
+	Sort   domain.PvzsSort   `json:"sort"`
+}
+
+func (r *repo) CreateExportJob(ctx context.Context, job *domain.ExportJob) (*domain.ExportJob, error) {
+	const op = "repository.CreateExportJob"
+	defer r.observe(op, time.Now())
+
+	params, err := json.Marshal(exportParams{Filter: job.Filter, Sort: job.Sort})
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	row := r.db.QueryRowContext(ctx, string(insertExportJobQuery), job.Format, params, job.CreatedBy)
+	newJob, err := scanExportJob(row)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return newJob, nil
+}
+
+func (r *repo) ExportJob(ctx context.Context, jobId *uuid.UUID) (*domain.ExportJob, error) {
+	const op = "repository.ExportJob"
+	defer r.observe(op, time.Now())
+
+	job, err := scanExportJob(r.db.QueryRowContext(ctx, string(exportJobQuery), jobId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, xerr.WrapErr(op, pRepo.NotFound, err)
+		}
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return job, nil
+}
+
+func (r *repo) ClaimExportJob(ctx context.Context) (*domain.ExportJob, error) {
+	const op = "repository.ClaimExportJob"
+	defer r.observe(op, time.Now())
+
+	job, err := scanExportJob(r.db.QueryRowContext(ctx, string(claimExportJobQuery)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, xerr.WrapErr(op, pRepo.NotFound, err)
+		}
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return job, nil
+}
+
+func (r *repo) FinishExportJob(
+	ctx context.Context,
+	jobId *uuid.UUID,
+	status domain.ExportJobStatus,
+	errMsg *string,
+) error {
+	const op = "repository.FinishExportJob"
+	defer r.observe(op, time.Now())
+
+	res, err := r.db.ExecContext(ctx, string(finishExportJobQuery), jobId, status, errMsg)
+	if err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	if n == 0 {
+		return xerr.NewErr(op, pRepo.NotFound)
+	}
+
+	return nil
+}
+
+// scanExportJob scans the id, format, params, status, created_by, created_at, finished_at, error columns.
+func scanExportJob(row interface{ Scan(dest ...any) error }) (*domain.ExportJob, error) {
+	job := new(domain.ExportJob)
+	var (
+		params     []byte
+		finishedAt sql.NullTime
+		errMsg     sql.NullString
+	)
+	err := row.Scan(
+		&job.Id, &job.Format, &params, &job.Status, &job.CreatedBy, &job.CreatedAt, &finishedAt, &errMsg,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var p exportParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, err
+	}
+	job.Filter, job.Sort = p.Filter, p.Sort
+
+	if finishedAt.Valid {
+		job.FinishedAt = &finishedAt.Time
+	}
+	job.Error = nullStringPtr(errMsg)
+
+	return job, nil
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	pRepo "github.com/shrtyk/pvz-service/internal/core/ports/repository"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var exportJobColumns = []string{"id", "format", "params", "status", "created_by", "created_at", "finished_at", "error"}
+
+func TestCreateExportJob(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func(db *sql.DB) { _ = db.Close() }(db)
+
+	repo := NewRepo(db)
+	job := &domain.ExportJob{
+		Format:    domain.ExportXLSX,
+		Filter:    domain.PvzsFilter{Cities: []domain.PVZCity{domain.Moscow}},
+		Sort:      domain.PvzsSort{Field: domain.PvzsSortByCity, Desc: true},
+		CreatedBy: uuid.New(),
+	}
+	params := `{"filter":{"Cities":["Москва"]},"sort":{"Field":"city","Desc":true}}`
+	jobId := uuid.New()
+
+	mock.ExpectQuery("INSERT INTO export_jobs").
+		WithArgs(job.Format, sqlmock.AnyArg(), job.CreatedBy).
+		WillReturnRows(sqlmock.NewRows(exportJobColumns).
+			AddRow(jobId, job.Format, []byte(params), domain.ExportJobPending, job.CreatedBy, time.Now(), nil, nil))
+
+	newJob, err := repo.CreateExportJob(context.Background(), job)
+	require.NoError(t, err)
+	assert.Equal(t, jobId, newJob.Id)
+	assert.Equal(t, domain.ExportJobPending, newJob.Status)
+	assert.Equal(t, job.Filter.Cities, newJob.Filter.Cities)
+	assert.Equal(t, job.Sort, newJob.Sort)
+	assert.Nil(t, newJob.FinishedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExportJob(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func(db *sql.DB) { _ = db.Close() }(db)
+
+	repo := NewRepo(db)
+	jobId := uuid.New()
+	finishedAt := time.Now()
+
+	mock.ExpectQuery("FROM\\s+export_jobs").
+		WithArgs(&jobId).
+		WillReturnRows(sqlmock.NewRows(exportJobColumns).
+			AddRow(jobId, "csv", []byte(`{}`), "failed", uuid.New(), time.Now(), finishedAt, "export failed"))
+	mock.ExpectQuery("FROM\\s+export_jobs").
+		WithArgs(&jobId).
+		WillReturnError(sql.ErrNoRows)
+
+	job, err := repo.ExportJob(context.Background(), &jobId)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ExportJobFailed, job.Status)
+	require.NotNil(t, job.FinishedAt)
+	require.NotNil(t, job.Error)
+	assert.Equal(t, "export failed", *job.Error)
+
+	_, err = repo.ExportJob(context.Background(), &jobId)
+	var repoErr *xerr.BaseErr[pRepo.RepoErrKind]
+	require.ErrorAs(t, err, &repoErr)
+	assert.Equal(t, pRepo.NotFound, repoErr.Kind)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimExportJob(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func(db *sql.DB) { _ = db.Close() }(db)
+
+	repo := NewRepo(db)
+
+	mock.ExpectQuery("FOR UPDATE SKIP LOCKED").
+		WillReturnRows(sqlmock.NewRows(exportJobColumns).
+			AddRow(uuid.New(), "csv", []byte(`{}`), "running", uuid.New(), time.Now(), nil, nil))
+	mock.ExpectQuery("FOR UPDATE SKIP LOCKED").
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("FOR UPDATE SKIP LOCKED").
+		WillReturnError(errors.New("db error"))
+
+	job, err := repo.ClaimExportJob(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, domain.ExportJobRunning, job.Status)
+
+	var repoErr *xerr.BaseErr[pRepo.RepoErrKind]
+	_, err = repo.ClaimExportJob(context.Background())
+	require.ErrorAs(t, err, &repoErr)
+	assert.Equal(t, pRepo.NotFound, repoErr.Kind)
+
+	_, err = repo.ClaimExportJob(context.Background())
+	require.ErrorAs(t, err, &repoErr)
+	assert.Equal(t, pRepo.Unexpected, repoErr.Kind)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFinishExportJob(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func(db *sql.DB) { _ = db.Close() }(db)
+
+	repo := NewRepo(db)
+	jobId := uuid.New()
+	msg := "export failed"
+
+	mock.ExpectExec("UPDATE\\s+export_jobs").
+		WithArgs(&jobId, domain.ExportJobFailed, &msg).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE\\s+export_jobs").
+		WithArgs(&jobId, domain.ExportJobDone, nil).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.NoError(t, repo.FinishExportJob(context.Background(), &jobId, domain.ExportJobFailed, &msg))
+
+	err = repo.FinishExportJob(context.Background(), &jobId, domain.ExportJobDone, nil)
+	var repoErr *xerr.BaseErr[pRepo.RepoErrKind]
+	require.ErrorAs(t, err, &repoErr)
+	assert.Equal(t, pRepo.NotFound, repoErr.Kind)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	pRepo "github.com/shrtyk/pvz-service/internal/core/ports/repository"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+)
+
+func (r *repo) ReserveIdempotencyKey(
+	ctx context.Context,
+	rec *domain.IdempotencyRecord,
+	staleBefore time.Time,
+) (*domain.IdempotencyRecord, error) {
+	const op = "repository.ReserveIdempotencyKey"
+	defer r.observe(op, time.Now())
+
+	_, err := r.db.ExecContext(
+		ctx, string(deleteStaleIdempotencyKeysQuery),
+		rec.UserId, rec.Key, rec.CreatedAt, staleBefore,
+	)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	res, err := r.db.ExecContext(
+		ctx, string(insertIdempotencyKeyQuery),
+		rec.UserId, rec.Key, rec.Fingerprint, rec.CreatedAt, rec.ExpiresAt,
+	)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	if n == 1 {
+		return nil, nil
+	}
+
+	stored := &domain.IdempotencyRecord{
+		IdempotentRequest: domain.IdempotentRequest{UserId: rec.UserId, Key: rec.Key},
+	}
+	var (
+		statusCode sql.NullInt64
+		headers    []byte
+		body       []byte
+	)
+	err = r.db.QueryRowContext(ctx, string(idempotencyKeyQuery), rec.UserId, rec.Key).Scan(
+		&stored.Fingerprint, &statusCode, &headers, &body, &stored.CreatedAt, &stored.ExpiresAt,
+	)
+	if err != nil {
+		// The key was released between the insert and the select.
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, xerr.WrapErr(op, pRepo.Conflict, err)
+		}
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	if statusCode.Valid {
+		stored.Response = &domain.IdempotentResponse{StatusCode: int(statusCode.Int64), Body: body}
+		if len(headers) > 0 {
+			if err := json.Unmarshal(headers, &stored.Response.Headers); err != nil {
+				return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+			}
+		}
+	}
+
+	return stored, nil
+}
+
+func (r *repo) SaveIdempotentResponse(
+	ctx context.Context,
+	req *domain.IdempotentRequest,
+	resp *domain.IdempotentResponse,
+) error {
+	const op = "repository.SaveIdempotentResponse"
+	defer r.observe(op, time.Now())
+
+	headers, err := json.Marshal(resp.Headers)
+	if err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	res, err := r.db.ExecContext(
+		ctx, string(saveIdempotentResponseQuery),
+		req.UserId, req.Key, req.Fingerprint, resp.StatusCode, headers, resp.Body,
+	)
+	if err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	if n == 0 {
+		return xerr.NewErr(op, pRepo.NotFound)
+	}
+
+	return nil
+}
+
+func (r *repo) DeleteIdempotencyKey(ctx context.Context, req *domain.IdempotentRequest) error {
+	const op = "repository.DeleteIdempotencyKey"
+	defer r.observe(op, time.Now())
+
+	_, err := r.db.ExecContext(ctx, string(deleteIdempotencyKeyQuery), req.UserId, req.Key, req.Fingerprint)
+	if err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	pRepo "github.com/shrtyk/pvz-service/internal/core/ports/repository"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var idempotencyKeyColumns = []string{"fingerprint", "status_code", "headers", "body", "created_at", "expires_at"}
+
+func TestReserveIdempotencyKey(t *testing.T) {
+	now := time.Now()
+	staleBefore := now.Add(-time.Minute)
+	rec := &domain.IdempotencyRecord{
+		IdempotentRequest: domain.IdempotentRequest{UserId: uuid.New(), Key: "key", Fingerprint: "fp"},
+		CreatedAt:         now,
+		ExpiresAt:         now.Add(time.Hour),
+	}
+
+	expectInsert := func(mock sqlmock.Sqlmock, inserted int64) {
+		mock.ExpectExec("DELETE FROM\\s+idempotency_keys").
+			WithArgs(rec.UserId, rec.Key, rec.CreatedAt, staleBefore).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("INSERT INTO idempotency_keys").
+			WithArgs(rec.UserId, rec.Key, rec.Fingerprint, rec.CreatedAt, rec.ExpiresAt).
+			WillReturnResult(sqlmock.NewResult(0, inserted))
+	}
+
+	t.Run("key is reserved", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer func(db *sql.DB) { _ = db.Close() }(db)
+
+		expectInsert(mock, 1)
+
+		stored, err := NewRepo(db).ReserveIdempotencyKey(context.Background(), rec, staleBefore)
+		assert.NoError(t, err)
+		assert.Nil(t, stored)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("request in progress", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer func(db *sql.DB) { _ = db.Close() }(db)
+
+		expectInsert(mock, 0)
+		mock.ExpectQuery("FROM\\s+idempotency_keys").
+			WithArgs(rec.UserId, rec.Key).
+			WillReturnRows(sqlmock.NewRows(idempotencyKeyColumns).AddRow("other", nil, nil, nil, now, rec.ExpiresAt))
+
+		stored, err := NewRepo(db).ReserveIdempotencyKey(context.Background(), rec, staleBefore)
+		require.NoError(t, err)
+		assert.Equal(t, "other", stored.Fingerprint)
+		assert.Nil(t, stored.Response)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("stored response", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer func(db *sql.DB) { _ = db.Close() }(db)
+
+		expectInsert(mock, 0)
+		mock.ExpectQuery("FROM\\s+idempotency_keys").
+			WithArgs(rec.UserId, rec.Key).
+			WillReturnRows(sqlmock.NewRows(idempotencyKeyColumns).
+				AddRow("fp", 201, []byte(`{"Content-Type":"application/json"}`), []byte(`{}`), now, rec.ExpiresAt))
+
+		stored, err := NewRepo(db).ReserveIdempotencyKey(context.Background(), rec, staleBefore)
+		require.NoError(t, err)
+		assert.Equal(t, &domain.IdempotentResponse{
+			StatusCode: 201,
+			Headers:    map[string]string{"Content-Type": "application/json"},
+			Body:       []byte(`{}`),
+		}, stored.Response)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("key released in between", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer func(db *sql.DB) { _ = db.Close() }(db)
+
+		expectInsert(mock, 0)
+		mock.ExpectQuery("FROM\\s+idempotency_keys").WillReturnError(sql.ErrNoRows)
+
+		_, err = NewRepo(db).ReserveIdempotencyKey(context.Background(), rec, staleBefore)
+		var repoErr *xerr.BaseErr[pRepo.RepoErrKind]
+		require.True(t, errors.As(err, &repoErr))
+		assert.Equal(t, pRepo.Conflict, repoErr.Kind)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer func(db *sql.DB) { _ = db.Close() }(db)
+
+		mock.ExpectExec("DELETE FROM\\s+idempotency_keys").WillReturnError(errors.New("db error"))
+
+		_, err = NewRepo(db).ReserveIdempotencyKey(context.Background(), rec, staleBefore)
+		var repoErr *xerr.BaseErr[pRepo.RepoErrKind]
+		require.True(t, errors.As(err, &repoErr))
+		assert.Equal(t, pRepo.Unexpected, repoErr.Kind)
+	})
+}
+
+func TestSaveIdempotentResponse(t *testing.T) {
+	req := &domain.IdempotentRequest{UserId: uuid.New(), Key: "key", Fingerprint: "fp"}
+	resp := &domain.IdempotentResponse{
+		StatusCode: 201,
+		Headers:    map[string]string{"Location": "/pvz/1"},
+		Body:       []byte(`{}`),
+	}
+
+	for _, tt := range []struct {
+		name     string
+		affected int64
+		wantKind pRepo.RepoErrKind
+	}{
+		{name: "saved", affected: 1},
+		{name: "key is gone", wantKind: pRepo.NotFound},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			mock.ExpectExec("UPDATE\\s+idempotency_keys").
+				WithArgs(req.UserId, req.Key, req.Fingerprint, 201, []byte(`{"Location":"/pvz/1"}`), resp.Body).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			err = NewRepo(db).SaveIdempotentResponse(context.Background(), req, resp)
+			if tt.wantKind == "" {
+				assert.NoError(t, err)
+			} else {
+				var repoErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.True(t, errors.As(err, &repoErr))
+				assert.Equal(t, tt.wantKind, repoErr.Kind)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDeleteIdempotencyKey(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func(db *sql.DB) { _ = db.Close() }(db)
+
+	req := &domain.IdempotentRequest{UserId: uuid.New(), Key: "key", Fingerprint: "fp"}
+	mock.ExpectExec("DELETE FROM\\s+idempotency_keys").
+		WithArgs(req.UserId, req.Key, req.Fingerprint).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, NewRepo(db).DeleteIdempotencyKey(context.Background(), req))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -10,17 +10,27 @@ import (
 )
 
 type pvzRow struct {
-	PvzID        string
-	PvzCity      string
-	PvzCreatedAt time.Time
-	RecID        sql.NullString
-	RecStatus    sql.NullString
-	RecDateTime  sql.NullTime
-	RecPvzID     sql.NullString
-	ProdID       sql.NullString
-	ProdDateTime sql.NullTime
-	ProdRecID    sql.NullString
-	ProdType     sql.NullString
+	PvzID         string
+	PvzCity       string
+	PvzCreatedAt  time.Time
+	PvzVersion    int
+	RecID         sql.NullString
+	RecStatus     sql.NullString
+	RecDateTime   sql.NullTime
+	RecPvzID      sql.NullString
+	RecClosedAt   sql.NullTime
+	RecAutoClosed sql.NullBool
+	RecVersion    sql.NullInt32
+	ProdID        sql.NullString
+	ProdDateTime  sql.NullTime
+	ProdRecID     sql.NullString
+	ProdType      sql.NullString
+	ProdBarcode   sql.NullString
+	ProdWeight    sql.NullInt32
+	ProdLength    sql.NullInt32
+	ProdWidth     sql.NullInt32
+	ProdHeight    sql.NullInt32
+	ProdStatus    sql.NullString
 }
 
 type pvzAggregator struct {
@@ -55,20 +65,9 @@ func (a *pvzAggregator) processPvz(row pvzRow) error {
 		return nil
 	}
 
-	pvzUUID, err := uuid.Parse(row.PvzID)
+	pvzData, err := pvzFromRow(row)
 	if err != nil {
-		return fmt.Errorf("invalid PVZ UUID: %w", err)
-	}
-
-	pvz := &domain.Pvz{
-		Id:               pvzUUID,
-		City:             domain.PVZCity(row.PvzCity),
-		RegistrationDate: row.PvzCreatedAt,
-	}
-
-	pvzData := &domain.PvzReceptions{
-		Pvz:        pvz,
-		Receptions: []*domain.ReceptionProducts{},
+		return err
 	}
 
 	a.pvzMap[row.PvzID] = pvzData
@@ -85,6 +84,119 @@ func (a *pvzAggregator) processReception(row pvzRow) (*domain.ReceptionProducts,
 		return recData, nil
 	}
 
+	recData, err := receptionFromRow(row)
+	if err != nil {
+		return nil, err
+	}
+
+	a.recMap[row.RecID.String] = recData
+	a.pvzMap[row.PvzID].Receptions = append(
+		a.pvzMap[row.PvzID].Receptions,
+		recData,
+	)
+
+	return recData, nil
+}
+
+func (a *pvzAggregator) processProduct(row pvzRow, recData *domain.ReceptionProducts) error {
+	if recData == nil || !row.ProdID.Valid {
+		return nil
+	}
+
+	product, err := productFromRow(row)
+	if err != nil {
+		return err
+	}
+
+	recData.Products = append(recData.Products, product)
+	return nil
+}
+
+func (a *pvzAggregator) Results() []*domain.PvzReceptions {
+	return a.ordPvzs
+}
+
+// pvzStreamAggregator builds one PVZ at a time instead of keeping the whole page.
+// It relies on the rows of a PVZ being adjacent and ordered by reception, as
+// buildGetPvzDataQuery returns them.
+type pvzStreamAggregator struct {
+	cur      *domain.PvzReceptions
+	curId    string
+	curRec   *domain.ReceptionProducts
+	curRecId string
+}
+
+// processRow returns the previous PVZ once the first row of the next one arrives.
+func (a *pvzStreamAggregator) processRow(row pvzRow) (*domain.PvzReceptions, error) {
+	var done *domain.PvzReceptions
+	if a.cur == nil || a.curId != row.PvzID {
+		pvzData, err := pvzFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		done = a.cur
+		a.cur, a.curId = pvzData, row.PvzID
+		a.curRec, a.curRecId = nil, ""
+	}
+
+	if !row.RecID.Valid {
+		return done, nil
+	}
+
+	if a.curRec == nil || a.curRecId != row.RecID.String {
+		recData, err := receptionFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		a.cur.Receptions = append(a.cur.Receptions, recData)
+		a.curRec, a.curRecId = recData, row.RecID.String
+	}
+
+	if row.ProdID.Valid {
+		product, err := productFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		a.curRec.Products = append(a.curRec.Products, product)
+	}
+
+	return done, nil
+}
+
+// flush returns the PVZ that is still being built, if any.
+func (a *pvzStreamAggregator) flush() *domain.PvzReceptions {
+	done := a.cur
+	a.cur, a.curId = nil, ""
+	a.curRec, a.curRecId = nil, ""
+	return done
+}
+
+func parseUUID(val, context string) (uuid.UUID, error) {
+	id, err := uuid.Parse(val)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid %s UUID '%s': %w", context, val, err)
+	}
+	return id, nil
+}
+
+func pvzFromRow(row pvzRow) (*domain.PvzReceptions, error) {
+	pvzUUID, err := uuid.Parse(row.PvzID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PVZ UUID: %w", err)
+	}
+
+	return &domain.PvzReceptions{
+		Pvz: &domain.Pvz{
+			Id:               pvzUUID,
+			City:             domain.PVZCity(row.PvzCity),
+			RegistrationDate: row.PvzCreatedAt,
+			Version:          row.PvzVersion,
+		},
+		Receptions: []*domain.ReceptionProducts{},
+	}, nil
+}
+
+func receptionFromRow(row pvzRow) (*domain.ReceptionProducts, error) {
 	recUUID, err := parseUUID(row.RecID.String, "reception")
 	if err != nil {
 		return nil, err
@@ -96,60 +208,100 @@ func (a *pvzAggregator) processReception(row pvzRow) (*domain.ReceptionProducts,
 	}
 
 	reception := &domain.Reception{
-		Id:       recUUID,
-		PvzId:    recPvzUUID,
-		DateTime: row.RecDateTime.Time,
-		Status:   domain.ReceptionStatus(row.RecStatus.String),
+		Id:         recUUID,
+		PvzId:      recPvzUUID,
+		DateTime:   row.RecDateTime.Time,
+		Status:     domain.ReceptionStatus(row.RecStatus.String),
+		AutoClosed: row.RecAutoClosed.Bool,
+		Version:    int(row.RecVersion.Int32),
+	}
+	if row.RecClosedAt.Valid {
+		reception.ClosedAt = &row.RecClosedAt.Time
 	}
 
-	recData := &domain.ReceptionProducts{
+	return &domain.ReceptionProducts{
 		Reception: reception,
 		Products:  []*domain.Product{},
-	}
+	}, nil
+}
 
-	a.recMap[row.RecID.String] = recData
-	a.pvzMap[row.PvzID].Receptions = append(
-		a.pvzMap[row.PvzID].Receptions,
-		recData,
+// scanPvzRow scans a row of the query built by buildGetPvzDataQuery.
+func scanPvzRow(rows *sql.Rows) (pvzRow, error) {
+	var row pvzRow
+	err := rows.Scan(
+		&row.PvzID, &row.PvzCity, &row.PvzCreatedAt, &row.PvzVersion,
+		&row.RecID, &row.RecStatus, &row.RecDateTime, &row.RecPvzID, &row.RecClosedAt, &row.RecAutoClosed, &row.RecVersion,
+		&row.ProdID, &row.ProdDateTime, &row.ProdRecID, &row.ProdType,
+		&row.ProdBarcode, &row.ProdWeight, &row.ProdLength, &row.ProdWidth, &row.ProdHeight, &row.ProdStatus,
 	)
-
-	return recData, nil
+	return row, err
 }
 
-func (a *pvzAggregator) processProduct(row pvzRow, recData *domain.ReceptionProducts) error {
-	if recData == nil || !row.ProdID.Valid {
-		return nil
-	}
-
+func productFromRow(row pvzRow) (*domain.Product, error) {
 	prodUUID, err := parseUUID(row.ProdID.String, "product")
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	prodRecUUID, err := parseUUID(row.ProdRecID.String, "product reception")
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	product := &domain.Product{
+	return &domain.Product{
 		Id:          prodUUID,
 		DateTime:    row.ProdDateTime.Time,
 		ReceptionId: prodRecUUID,
 		Type:        domain.ProductType(row.ProdType.String),
+		Barcode:     nullStringPtr(row.ProdBarcode),
+		WeightGrams: nullIntPtr(row.ProdWeight),
+		Dimensions:  nullDimensions(row.ProdLength, row.ProdWidth, row.ProdHeight),
+		Status:      domain.ProductStatus(row.ProdStatus.String),
+	}, nil
+}
+
+func nullStringPtr(v sql.NullString) *string {
+	if !v.Valid {
+		return nil
 	}
+	return &v.String
+}
 
-	recData.Products = append(recData.Products, product)
-	return nil
+func nullIntPtr(v sql.NullInt32) *int {
+	if !v.Valid {
+		return nil
+	}
+	i := int(v.Int32)
+	return &i
 }
 
-func (a *pvzAggregator) Results() []*domain.PvzReceptions {
-	return a.ordPvzs
+func nullDimensions(length, width, height sql.NullInt32) *domain.ProductDimensions {
+	if !length.Valid || !width.Valid || !height.Valid {
+		return nil
+	}
+	return &domain.ProductDimensions{
+		LengthMm: int(length.Int32),
+		WidthMm:  int(width.Int32),
+		HeightMm: int(height.Int32),
+	}
 }
 
-func parseUUID(val, context string) (uuid.UUID, error) {
-	id, err := uuid.Parse(val)
-	if err != nil {
-		return uuid.Nil, fmt.Errorf("invalid %s UUID '%s': %w", context, val, err)
+// scanReception scans the id, created_at, pvz_id, status, closed_at, auto_closed, version columns of a reception.
+func scanReception(row interface{ Scan(dest ...any) error }) (*domain.Reception, error) {
+	rec := new(domain.Reception)
+	var closedAt sql.NullTime
+	if err := row.Scan(&rec.Id, &rec.DateTime, &rec.PvzId, &rec.Status, &closedAt, &rec.AutoClosed, &rec.Version); err != nil {
+		return nil, err
 	}
-	return id, nil
+	if closedAt.Valid {
+		rec.ClosedAt = &closedAt.Time
+	}
+	return rec, nil
+}
+
+func dimensionsArgs(d *domain.ProductDimensions) (length, width, height *int) {
+	if d == nil {
+		return nil, nil, nil
+	}
+	return &d.LengthMm, &d.WidthMm, &d.HeightMm
 }
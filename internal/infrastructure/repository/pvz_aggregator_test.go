@@ -212,3 +212,52 @@ func Test_pvzAggregator_untested_parts(t *testing.T) {
 		assert.Len(t, aggregator.Results()[0].Receptions, 1)
 	})
 }
+
+func Test_pvzStreamAggregator(t *testing.T) {
+	t.Parallel()
+
+	pvzID1, pvzID2 := uuid.New(), uuid.New()
+	recID1, recID2 := uuid.New(), uuid.New()
+	rec := func(pvzID, recID uuid.UUID) pvzRow {
+		return pvzRow{
+			PvzID:    pvzID.String(),
+			RecID:    sql.NullString{String: recID.String(), Valid: true},
+			RecPvzID: sql.NullString{String: pvzID.String(), Valid: true},
+		}
+	}
+	prod := func(row pvzRow) pvzRow {
+		row.ProdID = sql.NullString{String: uuid.NewString(), Valid: true}
+		row.ProdRecID = row.RecID
+		return row
+	}
+
+	aggregator := new(pvzStreamAggregator)
+	for _, row := range []pvzRow{
+		prod(rec(pvzID1, recID1)),
+		prod(rec(pvzID1, recID1)),
+		rec(pvzID1, recID2),
+	} {
+		done, err := aggregator.processRow(row)
+		assert.NoError(t, err)
+		assert.Nil(t, done)
+	}
+
+	done, err := aggregator.processRow(pvzRow{PvzID: pvzID2.String()})
+	assert.NoError(t, err)
+	if assert.NotNil(t, done) {
+		assert.Equal(t, pvzID1, done.Pvz.Id)
+		assert.Len(t, done.Receptions, 2)
+		assert.Len(t, done.Receptions[0].Products, 2)
+		assert.Empty(t, done.Receptions[1].Products)
+	}
+
+	last := aggregator.flush()
+	if assert.NotNil(t, last) {
+		assert.Equal(t, pvzID2, last.Pvz.Id)
+		assert.Empty(t, last.Receptions)
+	}
+	assert.Nil(t, aggregator.flush())
+
+	_, err = aggregator.processRow(pvzRow{PvzID: "invalid-uuid"})
+	assert.ErrorContains(t, err, "invalid PVZ UUID")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -15,10 +16,12 @@ import (
 
 func (r *repo) CreatePVZ(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, error) {
 	const op = "repository.CreatePVZ"
+	defer r.observe(op, time.Now())
 
 	err := r.db.QueryRowContext(ctx, string(createPvzQuery), pvz.City).Scan(
 		&pvz.Id,
 		&pvz.RegistrationDate,
+		&pvz.Version,
 	)
 	if err != nil {
 		return nil, xerr.WrapErr(op, pRepo.FailedCreatePvz, err)
@@ -27,14 +30,78 @@ func (r *repo) CreatePVZ(ctx context.Context, pvz *domain.Pvz) (*domain.Pvz, err
 	return pvz, nil
 }
 
+func (r *repo) Pvz(ctx context.Context, pvzId *uuid.UUID) (*domain.Pvz, error) {
+	const op = "repository.Pvz"
+	defer r.observe(op, time.Now())
+
+	pvz := new(domain.Pvz)
+	err := r.db.QueryRowContext(ctx, string(pvzQuery), pvzId).
+		Scan(&pvz.Id, &pvz.RegistrationDate, &pvz.City, &pvz.Version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, xerr.WrapErr(op, pRepo.NotFound, err)
+		}
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return pvz, nil
+}
+
+func (r *repo) UpdatePvz(
+	ctx context.Context,
+	pvzId *uuid.UUID,
+	city domain.PVZCity,
+	ifVersion *int,
+) (*domain.Pvz, error) {
+	const op = "repository.UpdatePvz"
+	defer r.observe(op, time.Now())
+
+	pvz := new(domain.Pvz)
+	err := r.db.QueryRowContext(ctx, string(updatePvzQuery), pvzId, city, ifVersion).
+		Scan(&pvz.Id, &pvz.RegistrationDate, &pvz.City, &pvz.Version)
+	if err == nil {
+		return pvz, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	// Nothing was updated: either the PVZ does not exist or its version moved on.
+	err = r.db.QueryRowContext(ctx, string(pvzQuery), pvzId).
+		Scan(&pvz.Id, &pvz.RegistrationDate, &pvz.City, &pvz.Version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, xerr.WrapErr(op, pRepo.NotFound, err)
+		}
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	return nil, xerr.NewErr(op, pRepo.VersionMismatch)
+}
+
+func (r *repo) Reception(ctx context.Context, receptionId *uuid.UUID) (*domain.Reception, error) {
+	const op = "repository.Reception"
+	defer r.observe(op, time.Now())
+
+	rec, err := scanReception(r.db.QueryRowContext(ctx, string(receptionQuery), receptionId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, xerr.WrapErr(op, pRepo.NotFound, err)
+		}
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return rec, nil
+}
+
 func (r *repo) CreateReception(ctx context.Context, rec *domain.Reception) (*domain.Reception, error) {
 	const op = "repository.CreateReception"
+	defer r.observe(op, time.Now())
 
 	err := r.db.QueryRowContext(
 		ctx,
 		string(createReceptionQuery),
 		rec.PvzId).
-		Scan(&rec.Id, &rec.DateTime, &rec.Status)
+		Scan(&rec.Id, &rec.DateTime, &rec.Status, &rec.Version)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -51,17 +118,42 @@ func (r *repo) CreateReception(ctx context.Context, rec *domain.Reception) (*dom
 	return rec, nil
 }
 
-func (r *repo) CreateProduct(ctx context.Context, prod *domain.Product) (*domain.Product, error) {
+func (r *repo) CreateProduct(
+	ctx context.Context,
+	prod *domain.Product,
+	capacity int,
+	uniqueBarcode bool,
+) (res *domain.Product, err error) {
 	const op = "repository.CreateProduct"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
 
-	err := r.db.QueryRowContext(
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		err = r.FinishTx(tx, &err, l)
+	}()
+
+	length, width, height := dimensionsArgs(prod.Dimensions)
+	err = tx.QueryRowContext(
 		ctx,
 		string(createProductQuery),
 		prod.PvzId,
 		domain.InProgress,
-		prod.Type).
-		Scan(&prod.Id, &prod.DateTime, &prod.ReceptionId, &prod.Type)
+		prod.Type,
+		prod.Barcode,
+		prod.WeightGrams,
+		length,
+		width,
+		height,
+		uniqueBarcode).
+		Scan(&prod.Id, &prod.DateTime, &prod.ReceptionId, &prod.Type, &prod.Status)
 	if err != nil {
+		if isBarcodeConflict(err) {
+			return nil, xerr.WrapErr(op, pRepo.Conflict, err)
+		}
 		var pgErr *pgconn.PgError
 		if (errors.As(err, &pgErr) && pgErr.Code == "23502") || errors.Is(err, sql.ErrNoRows) {
 			return nil, xerr.WrapErr(op, pRepo.NotFound, err)
@@ -69,13 +161,17 @@ func (r *repo) CreateProduct(ctx context.Context, prod *domain.Product) (*domain
 		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
 	}
 
+	if err = reservePvzStock(ctx, tx, op, prod.PvzId, 1, capacity); err != nil {
+		return nil, err
+	}
+
 	return prod, nil
 }
 
-func (r *repo) DeleteLastProduct(ctx context.Context, pvzId *uuid.UUID) error {
-	const op = "repository.DeleteLastProduct"
-
-	res, err := r.db.ExecContext(ctx, string(deleteLastProductQuery), pvzId, domain.InProgress)
+// reservePvzStock counts n new products as on hand in the PVZ, failing with
+// LimitExceeded if that would put it over capacity.
+func reservePvzStock(ctx context.Context, tx *sql.Tx, op string, pvzId uuid.UUID, n, capacity int) error {
+	res, err := tx.ExecContext(ctx, string(reservePvzStockQuery), pvzId, n, capacity)
 	if err != nil {
 		return xerr.WrapErr(op, pRepo.Unexpected, err)
 	}
@@ -86,50 +182,126 @@ func (r *repo) DeleteLastProduct(ctx context.Context, pvzId *uuid.UUID) error {
 	}
 
 	if rowsAffected == 0 {
-		return xerr.WrapErr(op, pRepo.NotFound, sql.ErrNoRows)
+		return xerr.NewErr(op, pRepo.LimitExceeded)
 	}
 
 	return nil
 }
 
-func (r *repo) CloseReceptionInPvz(ctx context.Context, pvzId *uuid.UUID) error {
-	const op = "repository.CloseReceptionInPvz"
+func (r *repo) CreateProductsBatch(
+	ctx context.Context,
+	batch *domain.ProductsBatch,
+) (res *domain.ProductsBatchResult, err error) {
+	const op = "repository.CreateProductsBatch"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
 
-	res, err := r.db.ExecContext(
-		ctx,
-		string(closeReceptionPvzQuery),
-		domain.Close,
-		pvzId,
-		domain.InProgress,
-	)
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
 	if err != nil {
-		return xerr.WrapErr(op, pRepo.Unexpected, err)
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
 	}
+	defer func() {
+		err = r.FinishTx(tx, &err, l)
+	}()
 
-	rowsAffected, err := res.RowsAffected()
+	var recId uuid.UUID
+	err = tx.QueryRowContext(ctx, string(lockActiveReceptionQuery), batch.PvzId, domain.InProgress).Scan(&recId)
 	if err != nil {
-		return xerr.WrapErr(op, pRepo.Unexpected, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, xerr.WrapErr(op, pRepo.NotFound, err)
+		}
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
 	}
 
-	if rowsAffected == 0 {
-		return xerr.WrapErr(op, pRepo.Conflict, sql.ErrNoRows)
+	taken, err := r.takenBarcodes(ctx, tx, recId, batch)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
 	}
 
-	return nil
+	res, toInsert := splitProductsBatch(batch, taken)
+	if !batch.Atomic && batch.Capacity > 0 && len(toInsert) > 0 {
+		var onHand int
+		err = tx.QueryRowContext(ctx, string(lockPvzStockQuery), batch.PvzId).Scan(&onHand)
+		if err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+		toInsert = markOverCapacity(res, toInsert, batch.Capacity-onHand)
+	}
+	if len(toInsert) == 0 {
+		return res, nil
+	}
+
+	q, args, err := buildInsertProductsQuery(recId, toInsert, batch.UniqueAcrossReceptions)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	rows, err := tx.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, xerr.WrapErr(op, productsInsertErrKind(err), err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Warn("failed to close rows", logger.WithErr(closeErr))
+		}
+	}()
+
+	addedAt := make(map[uuid.UUID]time.Time, len(toInsert))
+	for rows.Next() {
+		var (
+			id uuid.UUID
+			at time.Time
+		)
+		if err = rows.Scan(&id, &at); err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+		addedAt[id] = at
+	}
+	if err = rows.Err(); err != nil {
+		return nil, xerr.WrapErr(op, productsInsertErrKind(err), err)
+	}
+
+	if err = reservePvzStock(ctx, tx, op, batch.PvzId, len(toInsert), batch.Capacity); err != nil {
+		return nil, err
+	}
+
+	for _, p := range toInsert {
+		p.PvzId = batch.PvzId
+		p.ReceptionId = recId
+		p.DateTime = addedAt[p.Id]
+		p.Status = domain.ProductInStorage
+	}
+
+	return res, nil
 }
 
-func (r *repo) GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) ([]*domain.PvzReceptions, error) {
-	const op = "repository.GetPvzsData"
+func (r *repo) takenBarcodes(
+	ctx context.Context,
+	tx *sql.Tx,
+	recId uuid.UUID,
+	batch *domain.ProductsBatch,
+) (map[string]struct{}, error) {
 	l := logger.FromCtx(ctx)
+	taken := make(map[string]struct{})
 
-	q, args, err := buildGetPvzDataQuery(params)
+	barcodes := make([]string, 0, len(batch.Products))
+	for _, p := range batch.Products {
+		if p.Barcode != nil {
+			barcodes = append(barcodes, *p.Barcode)
+		}
+	}
+	if len(barcodes) == 0 {
+		return taken, nil
+	}
+
+	q, args, err := buildTakenBarcodesQuery(recId, barcodes, batch.UniqueAcrossReceptions)
 	if err != nil {
-		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		return nil, err
 	}
 
-	rows, err := r.db.QueryContext(ctx, string(q), args...)
+	rows, err := tx.QueryContext(ctx, q, args...)
 	if err != nil {
-		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		return nil, err
 	}
 	defer func() {
 		if closeErr := rows.Close(); closeErr != nil {
@@ -137,36 +309,317 @@ func (r *repo) GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) (
 		}
 	}()
 
-	aggregator := newPvzAggregator()
+	for rows.Next() {
+		var b string
+		if err := rows.Scan(&b); err != nil {
+			return nil, err
+		}
+		taken[b] = struct{}{}
+	}
+
+	return taken, rows.Err()
+}
+
+// productsInsertErrKind reports a Conflict when a concurrent insert took one of the barcodes.
+func productsInsertErrKind(err error) pRepo.RepoErrKind {
+	if isBarcodeConflict(err) {
+		return pRepo.Conflict
+	}
+	return pRepo.Unexpected
+}
+
+// isBarcodeConflict reports whether the barcode is already taken within the reception,
+// or across all receptions by a product added while barcodes were globally unique.
+func isBarcodeConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.ConstraintName == "uniq_products_reception_id_barcode" ||
+		pgErr.ConstraintName == "uniq_products_barcode"
+}
+
+// splitProductsBatch marks items with already taken or repeated barcodes as duplicates
+// and returns the products that should be inserted. Nothing is inserted for an atomic
+// batch with at least one duplicate.
+func splitProductsBatch(
+	batch *domain.ProductsBatch,
+	taken map[string]struct{},
+) (*domain.ProductsBatchResult, []*domain.Product) {
+	res := &domain.ProductsBatchResult{
+		Items: make([]*domain.BatchItemResult, len(batch.Products)),
+	}
+	toInsert := make([]*domain.Product, 0, len(batch.Products))
+	seen := make(map[string]struct{}, len(batch.Products))
+
+	for i, p := range batch.Products {
+		if p.Barcode != nil {
+			_, isTaken := taken[*p.Barcode]
+			_, isSeen := seen[*p.Barcode]
+			if isTaken || isSeen {
+				res.Items[i] = &domain.BatchItemResult{Status: domain.BatchItemDuplicateBarcode}
+				continue
+			}
+			seen[*p.Barcode] = struct{}{}
+		}
+
+		p.Id = uuid.New()
+		res.Items[i] = &domain.BatchItemResult{Status: domain.BatchItemAdded, Product: p}
+		toInsert = append(toInsert, p)
+	}
+
+	if batch.Atomic && len(toInsert) != len(batch.Products) {
+		for _, item := range res.Items {
+			if item.Status == domain.BatchItemAdded {
+				item.Status = domain.BatchItemNotAdded
+				item.Product = nil
+			}
+		}
+		return res, nil
+	}
+
+	res.Added = len(toInsert)
+	return res, toInsert
+}
+
+// markOverCapacity keeps the first free products to be added and reports the
+// rest as over capacity. It returns the products that are still to be inserted.
+func markOverCapacity(
+	res *domain.ProductsBatchResult,
+	toInsert []*domain.Product,
+	free int,
+) []*domain.Product {
+	free = max(free, 0)
+	if len(toInsert) <= free {
+		return toInsert
+	}
+
+	kept := 0
+	for _, item := range res.Items {
+		if item.Status != domain.BatchItemAdded {
+			continue
+		}
+		if kept < free {
+			kept++
+			continue
+		}
+		item.Status = domain.BatchItemOverCapacity
+		item.Product = nil
+	}
+
+	res.Added = free
+	return toInsert[:free]
+}
+
+func (r *repo) ProductsByBarcode(ctx context.Context, barcode string) ([]*domain.ProductInfo, error) {
+	const op = "repository.ProductsByBarcode"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
+
+	rows, err := r.db.QueryContext(ctx, string(productsByBarcodeQuery), barcode)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Warn("failed to close rows", logger.WithErr(closeErr))
+		}
+	}()
 
+	infos := make([]*domain.ProductInfo, 0)
 	for rows.Next() {
 		var row pvzRow
 		err := rows.Scan(
+			&row.ProdID, &row.ProdDateTime, &row.ProdRecID, &row.ProdType, &row.ProdBarcode,
+			&row.ProdWeight, &row.ProdLength, &row.ProdWidth, &row.ProdHeight, &row.ProdStatus,
+			&row.RecStatus, &row.RecDateTime,
 			&row.PvzID, &row.PvzCity, &row.PvzCreatedAt,
-			&row.RecID, &row.RecStatus, &row.RecDateTime, &row.RecPvzID,
-			&row.ProdID, &row.ProdDateTime, &row.ProdRecID, &row.ProdType,
 		)
 		if err != nil {
 			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
 		}
 
-		if err := aggregator.processRow(row); err != nil {
+		info, err := productInfoFromRow(row)
+		if err != nil {
 			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
 		}
+		infos = append(infos, info)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
 	}
 
-	return aggregator.Results(), nil
+	return infos, nil
 }
 
-func (r *repo) GetAllPvzs(ctx context.Context) ([]*domain.Pvz, error) {
-	const op = "repository.GetAllPvzs"
+func productInfoFromRow(row pvzRow) (*domain.ProductInfo, error) {
+	prod, err := productFromRow(row)
+	if err != nil {
+		return nil, err
+	}
+	pvzId, err := parseUUID(row.PvzID, "PVZ")
+	if err != nil {
+		return nil, err
+	}
+	prod.PvzId = pvzId
+
+	return &domain.ProductInfo{
+		Product: prod,
+		Reception: &domain.Reception{
+			Id:       prod.ReceptionId,
+			PvzId:    pvzId,
+			DateTime: row.RecDateTime.Time,
+			Status:   domain.ReceptionStatus(row.RecStatus.String),
+		},
+		Pvz: &domain.Pvz{
+			Id:               pvzId,
+			City:             domain.PVZCity(row.PvzCity),
+			RegistrationDate: row.PvzCreatedAt,
+		},
+	}, nil
+}
+
+func (r *repo) DeleteLastProduct(ctx context.Context, pvzId, actorId *uuid.UUID) error {
+	const op = "repository.DeleteLastProduct"
+	defer r.observe(op, time.Now())
+
+	res, err := r.db.ExecContext(ctx, string(deleteLastProductQuery),
+		pvzId, domain.InProgress, actorId, domain.ProductInStorage)
+	if err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	if rowsAffected == 0 {
+		return xerr.WrapErr(op, pRepo.NotFound, sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+func (r *repo) DeleteProduct(ctx context.Context, productId, actorId *uuid.UUID) (err error) {
+	const op = "repository.DeleteProduct"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		err = r.FinishTx(tx, &err, l)
+	}()
+
+	if err = r.checkProductEditable(ctx, tx, op, productId, false); err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, string(deleteProductQuery), productId, actorId, domain.ProductInStorage); err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return nil
+}
+
+// RestoreProduct takes the place of a restored product still in storage back, failing
+// with LimitExceeded if that would put the PVZ over capacity.
+func (r *repo) RestoreProduct(ctx context.Context, productId *uuid.UUID, capacity int) (prod *domain.Product, err error) {
+	const op = "repository.RestoreProduct"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		err = r.FinishTx(tx, &err, l)
+	}()
+
+	if err = r.checkProductEditable(ctx, tx, op, productId, true); err != nil {
+		return nil, err
+	}
+
+	var row pvzRow
+	err = tx.QueryRowContext(ctx, string(restoreProductQuery), productId).Scan(
+		&row.ProdID, &row.ProdDateTime, &row.ProdRecID, &row.ProdType, &row.ProdBarcode,
+		&row.ProdWeight, &row.ProdLength, &row.ProdWidth, &row.ProdHeight, &row.ProdStatus,
+		&row.PvzID,
+	)
+	if err != nil {
+		if isBarcodeConflict(err) {
+			return nil, xerr.WrapErr(op, pRepo.Conflict, err)
+		}
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, string(markProductDeletionRestoredQuery), productId); err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	prod, err = productFromRow(row)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	if prod.Status == domain.ProductInStorage {
+		pvzId, err := parseUUID(row.PvzID, "PVZ")
+		if err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+		if err := reservePvzStock(ctx, tx, op, pvzId, 1, capacity); err != nil {
+			return nil, err
+		}
+		prod.PvzId = pvzId
+	}
+
+	return prod, nil
+}
+
+// checkProductEditable locks the product row and makes sure it may be deleted
+// (or restored, if deleted is true) within its reception.
+func (r *repo) checkProductEditable(
+	ctx context.Context,
+	tx *sql.Tx,
+	op string,
+	productId *uuid.UUID,
+	deleted bool,
+) error {
+	var (
+		status    domain.ReceptionStatus
+		deletedAt sql.NullTime
+	)
+	err := tx.QueryRowContext(ctx, string(lockProductWithReceptionStatusQuery), productId).
+		Scan(&status, &deletedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return xerr.WrapErr(op, pRepo.NotFound, err)
+		}
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	if deletedAt.Valid != deleted {
+		return xerr.WrapErr(op, pRepo.NotFound, sql.ErrNoRows)
+	}
+
+	if status != domain.InProgress {
+		return xerr.NewErr(op, pRepo.InvalidState)
+	}
+
+	return nil
+}
+
+func (r *repo) ProductDeletions(ctx context.Context, receptionId *uuid.UUID) ([]*domain.ProductDeletion, error) {
+	const op = "repository.ProductDeletions"
+	defer r.observe(op, time.Now())
 	l := logger.FromCtx(ctx)
 
-	rows, err := r.db.QueryContext(ctx, string(getAllPvzsQuery))
+	rows, err := r.db.QueryContext(ctx, string(getProductDeletionsQuery), receptionId)
 	if err != nil {
 		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
 	}
@@ -176,19 +629,759 @@ func (r *repo) GetAllPvzs(ctx context.Context) ([]*domain.Pvz, error) {
 		}
 	}()
 
-	pvzs := make([]*domain.Pvz, 0)
+	deletions := make([]*domain.ProductDeletion, 0)
 	for rows.Next() {
-		pvz := new(domain.Pvz)
-		err := rows.Scan(&pvz.Id, &pvz.RegistrationDate, &pvz.City)
+		var (
+			del        domain.ProductDeletion
+			deletedBy  uuid.NullUUID
+			restoredAt sql.NullTime
+			row        pvzRow
+		)
+		err := rows.Scan(
+			&del.Id, &deletedBy, &del.DeletedAt, &restoredAt,
+			&row.ProdID, &row.ProdDateTime, &row.ProdRecID, &row.ProdType, &row.ProdBarcode,
+			&row.ProdWeight, &row.ProdLength, &row.ProdWidth, &row.ProdHeight, &row.ProdStatus,
+		)
 		if err != nil {
 			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
 		}
-		pvzs = append(pvzs, pvz)
+
+		del.Product, err = productFromRow(row)
+		if err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+		if deletedBy.Valid {
+			del.DeletedBy = &deletedBy.UUID
+		}
+		if restoredAt.Valid {
+			del.RestoredAt = &restoredAt.Time
+		}
+		deletions = append(deletions, &del)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
 	}
 
-	return pvzs, nil
+	return deletions, nil
+}
+
+func (r *repo) ProductStorageState(
+	ctx context.Context,
+	productId *uuid.UUID,
+) (*domain.ProductStorageState, error) {
+	const op = "repository.ProductStorageState"
+	defer r.observe(op, time.Now())
+
+	var (
+		row   pvzRow
+		state domain.ProductStorageState
+		pvzId uuid.UUID
+	)
+	err := r.db.QueryRowContext(ctx, string(productStorageStateQuery), productId).Scan(
+		&row.ProdID, &row.ProdDateTime, &row.ProdRecID, &row.ProdType, &row.ProdBarcode,
+		&row.ProdWeight, &row.ProdLength, &row.ProdWidth, &row.ProdHeight, &row.ProdStatus,
+		&state.PickupCodeHash, &state.PickupCodeExpiresAt, &state.PickupCodeAttempts,
+		&state.ReceptionStatus, &pvzId,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, xerr.WrapErr(op, pRepo.NotFound, err)
+		}
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	state.Product, err = productFromRow(row)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	state.Product.PvzId = pvzId
+
+	return &state, nil
+}
+
+func (r *repo) SetProductPickupCode(
+	ctx context.Context,
+	productId *uuid.UUID,
+	codeHash []byte,
+	expiresAt *time.Time,
+) error {
+	const op = "repository.SetProductPickupCode"
+	defer r.observe(op, time.Now())
+
+	res, err := r.db.ExecContext(
+		ctx,
+		string(setProductPickupCodeQuery),
+		productId,
+		codeHash,
+		domain.ProductInStorage,
+		expiresAt,
+	)
+	if err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	if n == 0 {
+		return xerr.NewErr(op, pRepo.InvalidState)
+	}
+
+	return nil
+}
+
+func (r *repo) TakePickupCodeAttempt(ctx context.Context, productId *uuid.UUID, maxAttempts int) ([]byte, error) {
+	const op = "repository.TakePickupCodeAttempt"
+	defer r.observe(op, time.Now())
+
+	var codeHash []byte
+	err := r.db.QueryRowContext(
+		ctx,
+		string(takePickupCodeAttemptQuery),
+		productId,
+		domain.ProductInStorage,
+		maxAttempts,
+	).Scan(&codeHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, xerr.WrapErr(op, pRepo.InvalidState, err)
+		}
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return codeHash, nil
+}
+
+func (r *repo) UpdateProductStatus(ctx context.Context, upd *domain.ProductStatusUpdate) (*domain.Product, error) {
+	const op = "repository.UpdateProductStatus"
+	defer r.observe(op, time.Now())
+
+	var row pvzRow
+	err := r.db.QueryRowContext(
+		ctx,
+		string(updateProductStatusQuery),
+		upd.ProductId,
+		upd.Status,
+		domain.ProductInStorage,
+		upd.ActorId,
+	).Scan(
+		&row.ProdID, &row.ProdDateTime, &row.ProdRecID, &row.ProdType, &row.ProdBarcode,
+		&row.ProdWeight, &row.ProdLength, &row.ProdWidth, &row.ProdHeight, &row.ProdStatus,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, xerr.WrapErr(op, pRepo.InvalidState, err)
+		}
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	prod, err := productFromRow(row)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return prod, nil
+}
+
+func (r *repo) ProductStatusHistory(
+	ctx context.Context,
+	productId *uuid.UUID,
+) ([]*domain.ProductStatusChange, error) {
+	const op = "repository.ProductStatusHistory"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
+
+	rows, err := r.db.QueryContext(ctx, string(productStatusHistoryQuery), productId, domain.ProductInStorage)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Warn("failed to close rows", logger.WithErr(closeErr))
+		}
+	}()
+
+	history := make([]*domain.ProductStatusChange, 0)
+	for rows.Next() {
+		var (
+			change    domain.ProductStatusChange
+			changedBy uuid.NullUUID
+		)
+		if err := rows.Scan(&change.Status, &change.ChangedAt, &changedBy); err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+		if changedBy.Valid {
+			change.ChangedBy = &changedBy.UUID
+		}
+		history = append(history, &change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	if len(history) == 0 {
+		return nil, xerr.NewErr(op, pRepo.NotFound)
+	}
+
+	return history, nil
+}
+
+func (r *repo) CloseReceptionInPvz(
+	ctx context.Context,
+	pvzId *uuid.UUID,
+	ifVersion *int,
+) (report *domain.ReceptionCloseReport, err error) {
+	const op = "repository.CloseReceptionInPvz"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		err = r.FinishTx(tx, &err, l)
+	}()
+
+	rec, err := scanReception(tx.QueryRowContext(
+		ctx,
+		string(closeReceptionPvzQuery),
+		domain.Close,
+		pvzId,
+		domain.InProgress,
+		ifVersion,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, xerr.WrapErr(op, activeReceptionMissKind(ctx, tx, pvzId, ifVersion, pRepo.Conflict), err)
+		}
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	manifest, err := manifestReport(ctx, tx, rec.Id)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return &domain.ReceptionCloseReport{
+		Reception: rec,
+		Manifest:  manifest,
+	}, nil
+}
+
+// manifestReport compares reception products with its manifest. It returns nil
+// if the reception has no manifest.
+func manifestReport(ctx context.Context, tx *sql.Tx, recId uuid.UUID) (*domain.ManifestReport, error) {
+	l := logger.FromCtx(ctx)
+
+	var expected int
+	if err := tx.QueryRowContext(ctx, string(countReceptionManifestQuery), recId).Scan(&expected); err != nil {
+		return nil, err
+	}
+	if expected == 0 {
+		return nil, nil
+	}
+
+	rows, err := tx.QueryContext(ctx, string(receptionManifestDiffQuery), recId)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Warn("failed to close rows", logger.WithErr(closeErr))
+		}
+	}()
+
+	report := &domain.ManifestReport{
+		Expected:   expected,
+		Missing:    []string{},
+		Unexpected: []string{},
+	}
+	for rows.Next() {
+		var kind, barcode string
+		if err := rows.Scan(&kind, &barcode); err != nil {
+			return nil, err
+		}
+		if kind == "missing" {
+			report.Missing = append(report.Missing, barcode)
+		} else {
+			report.Unexpected = append(report.Unexpected, barcode)
+		}
+	}
+
+	return report, rows.Err()
+}
+
+func (r *repo) CancelReceptionInPvz(
+	ctx context.Context,
+	pvzId *uuid.UUID,
+	ifVersion *int,
+) (*domain.Reception, error) {
+	const op = "repository.CancelReceptionInPvz"
+	defer r.observe(op, time.Now())
+
+	rec, err := scanReception(r.db.QueryRowContext(
+		ctx,
+		string(cancelReceptionPvzQuery),
+		domain.Cancelled,
+		pvzId,
+		domain.InProgress,
+		ifVersion,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, xerr.WrapErr(op, activeReceptionMissKind(ctx, r.db, pvzId, ifVersion, pRepo.NotFound), err)
+		}
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return rec, nil
+}
+
+// rowQuerier is implemented by both *sql.DB and *sql.Tx.
+type rowQuerier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// activeReceptionMissKind tells why a conditional update of the reception in progress
+// of a PVZ matched no rows: VersionMismatch if the reception exists but has another
+// version, noActive otherwise.
+func activeReceptionMissKind(
+	ctx context.Context,
+	q rowQuerier,
+	pvzId *uuid.UUID,
+	ifVersion *int,
+	noActive pRepo.RepoErrKind,
+) pRepo.RepoErrKind {
+	if ifVersion == nil {
+		return noActive
+	}
+
+	var version int
+	err := q.QueryRowContext(ctx, string(activeReceptionVersionQuery), pvzId, domain.InProgress).Scan(&version)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return noActive
+	case err != nil:
+		return pRepo.Unexpected
+	case version != *ifVersion:
+		return pRepo.VersionMismatch
+	default:
+		return noActive
+	}
+}
+
+func (r *repo) ReopenReception(
+	ctx context.Context,
+	receptionId *uuid.UUID,
+	closedAfter time.Time,
+	ifVersion *int,
+) (*domain.Reception, error) {
+	const op = "repository.ReopenReception"
+	defer r.observe(op, time.Now())
+
+	rec, err := scanReception(r.db.QueryRowContext(
+		ctx,
+		string(reopenReceptionQuery),
+		receptionId,
+		domain.InProgress,
+		domain.Close,
+		closedAfter,
+		domain.Cancelled,
+		ifVersion,
+	))
+	if err == nil {
+		return rec, nil
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == "one_in_progress_reception_per_pvz_id" {
+		return nil, xerr.WrapErr(op, pRepo.Conflict, err)
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	var (
+		status  domain.ReceptionStatus
+		version int
+	)
+	err = r.db.QueryRowContext(ctx, string(receptionStatusQuery), receptionId).Scan(&status, &version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, xerr.WrapErr(op, pRepo.NotFound, err)
+		}
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	if ifVersion != nil && version != *ifVersion {
+		return nil, xerr.NewErr(op, pRepo.VersionMismatch)
+	}
+
+	return nil, xerr.NewErr(op, pRepo.InvalidState)
+}
+
+func (r *repo) SetReceptionManifest(ctx context.Context, manifest *domain.ReceptionManifest) (err error) {
+	const op = "repository.SetReceptionManifest"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		err = r.FinishTx(tx, &err, l)
+	}()
+
+	var (
+		status  domain.ReceptionStatus
+		version int
+	)
+	err = tx.QueryRowContext(ctx, string(lockReceptionStatusQuery), manifest.ReceptionId).Scan(&status, &version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return xerr.WrapErr(op, pRepo.NotFound, err)
+		}
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	if manifest.IfVersion != nil && version != *manifest.IfVersion {
+		return xerr.NewErr(op, pRepo.VersionMismatch)
+	}
+	if status != domain.InProgress {
+		return xerr.NewErr(op, pRepo.InvalidState)
+	}
+
+	err = tx.QueryRowContext(ctx, string(bumpReceptionVersionQuery), manifest.ReceptionId).Scan(&manifest.Version)
+	if err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, string(deleteReceptionManifestQuery), manifest.ReceptionId); err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	if len(manifest.Barcodes) == 0 {
+		return nil
+	}
+
+	q, args, err := buildInsertManifestQuery(manifest.ReceptionId, manifest.Barcodes)
+	if err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	if _, err = tx.ExecContext(ctx, q, args...); err != nil {
+		return xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return nil
+}
+
+// autoCloseLockKey identifies the advisory lock that keeps reception auto closing
+// to a single replica at a time.
+const autoCloseLockKey int64 = 7_201_801_300
+
+func (r *repo) AutoCloseStaleReceptions(
+	ctx context.Context,
+	idleBefore time.Time,
+) (recs []*domain.Reception, err error) {
+	const op = "repository.AutoCloseStaleReceptions"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		err = r.FinishTx(tx, &err, l)
+	}()
+
+	var locked bool
+	if err = tx.QueryRowContext(ctx, string(tryAdvisoryXactLockQuery), autoCloseLockKey).Scan(&locked); err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	if !locked {
+		return nil, xerr.NewErr(op, pRepo.Locked)
+	}
+
+	rows, err := tx.QueryContext(
+		ctx,
+		string(autoCloseStaleReceptionsQuery),
+		domain.Close,
+		domain.InProgress,
+		idleBefore,
+	)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Warn("failed to close rows", logger.WithErr(closeErr))
+		}
+	}()
+
+	recs = make([]*domain.Reception, 0)
+	for rows.Next() {
+		rec, err := scanReception(rows)
+		if err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+		recs = append(recs, rec)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return recs, nil
+}
+
+func (r *repo) GetPvzsData(ctx context.Context, params *domain.PvzsReadParams) (*domain.PvzsPage, error) {
+	const op = "repository.GetPvzsData"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
+
+	q, args, err := buildGetPvzDataQuery(params)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, string(q), args...)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Warn("failed to close rows", logger.WithErr(closeErr))
+		}
+	}()
+
+	aggregator := newPvzAggregator()
+
+	for rows.Next() {
+		row, err := scanPvzRow(rows)
+		if err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+
+		if err := aggregator.processRow(row); err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	page := &domain.PvzsPage{Items: aggregator.Results()}
+	if len(page.Items) > params.Limit {
+		page.Items = page.Items[:params.Limit]
+		page.NextCursor = pvzsCursorAfter(page.Items[len(page.Items)-1].Pvz)
+	}
+
+	if params.WithTotal {
+		total, err := r.countPvzs(ctx, params)
+		if err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+		page.Total = &total
+	}
+
+	return page, nil
+}
+
+func (r *repo) StreamPvzsData(
+	ctx context.Context,
+	params *domain.PvzsReadParams,
+	yield func(*domain.PvzReceptions) error,
+) (*domain.PvzsPage, error) {
+	const op = "repository.StreamPvzsData"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
+
+	page := new(domain.PvzsPage)
+	if params.WithTotal {
+		total, err := r.countPvzs(ctx, params)
+		if err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+		page.Total = &total
+	}
+
+	q, args, err := buildGetPvzDataQuery(params)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Warn("failed to close rows", logger.WithErr(closeErr))
+		}
+	}()
+
+	aggregator := new(pvzStreamAggregator)
+	sent := 0
+	for rows.Next() {
+		row, err := scanPvzRow(rows)
+		if err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+
+		done, err := aggregator.processRow(row)
+		if err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+		if done == nil {
+			continue
+		}
+
+		if err := yield(done); err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+		sent++
+
+		// The query returns one extra PVZ, its first row has just been read.
+		if sent == params.Limit {
+			page.NextCursor = pvzsCursorAfter(done.Pvz)
+			return page, nil
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	if done := aggregator.flush(); done != nil {
+		if err := yield(done); err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+	}
+
+	return page, nil
+}
+
+func pvzsCursorAfter(pvz *domain.Pvz) *domain.PvzsCursor {
+	return &domain.PvzsCursor{
+		RegistrationDate: pvz.RegistrationDate,
+		City:             pvz.City,
+		Id:               pvz.Id,
+	}
+}
+
+func (r *repo) countPvzs(ctx context.Context, params *domain.PvzsReadParams) (int, error) {
+	q, args, err := buildCountPvzsQuery(&params.PvzsFilter)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, q, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (r *repo) GetAllPvzs(
+	ctx context.Context,
+	filter *domain.PvzsFilter,
+	sort domain.PvzsSort,
+) ([]*domain.Pvz, error) {
+	const op = "repository.GetAllPvzs"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
+
+	q, args, err := buildGetAllPvzsQuery(filter, sort)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Warn("failed to close rows", logger.WithErr(closeErr))
+		}
+	}()
+
+	pvzs := make([]*domain.Pvz, 0)
+	for rows.Next() {
+		pvz := new(domain.Pvz)
+		err := rows.Scan(&pvz.Id, &pvz.RegistrationDate, &pvz.City, &pvz.Version)
+		if err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+		pvzs = append(pvzs, pvz)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return pvzs, nil
+}
+
+func (r *repo) PvzStock(ctx context.Context, pvzId *uuid.UUID) (*domain.PvzStock, error) {
+	const op = "repository.PvzStock"
+	defer r.observe(op, time.Now())
+
+	stock := new(domain.PvzStock)
+	err := r.db.QueryRowContext(ctx, string(pvzStockQuery), pvzId).Scan(&stock.PvzId, &stock.OnHand)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, xerr.WrapErr(op, pRepo.NotFound, err)
+		}
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return stock, nil
+}
+
+func (r *repo) AllPvzsStock(ctx context.Context) ([]*domain.PvzStock, error) {
+	const op = "repository.AllPvzsStock"
+	defer r.observe(op, time.Now())
+	l := logger.FromCtx(ctx)
+
+	rows, err := r.db.QueryContext(ctx, string(allPvzsStockQuery))
+	if err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.Warn("failed to close rows", logger.WithErr(closeErr))
+		}
+	}()
+
+	stocks := make([]*domain.PvzStock, 0)
+	for rows.Next() {
+		stock := new(domain.PvzStock)
+		if err := rows.Scan(&stock.PvzId, &stock.OnHand); err != nil {
+			return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+		}
+		stocks = append(stocks, stock)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return stocks, nil
+}
+
+func (r *repo) CountReceptions(ctx context.Context, status domain.ReceptionStatus) (int, error) {
+	const op = "repository.CountReceptions"
+	defer r.observe(op, time.Now())
+
+	var n int
+	if err := r.db.QueryRowContext(ctx, string(countReceptionsQuery), status).Scan(&n); err != nil {
+		return 0, xerr.WrapErr(op, pRepo.Unexpected, err)
+	}
+
+	return n, nil
 }
@@ -12,8 +12,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/shrtyk/pvz-service/internal/core/domain"
+	metricsmocks "github.com/shrtyk/pvz-service/internal/core/ports/metrics/mocks"
+	pRepo "github.com/shrtyk/pvz-service/internal/core/ports/repository"
 	"github.com/shrtyk/pvz-service/pkg/logger"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
 	"github.com/stretchr/testify/assert"
+	mockpkg "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -34,8 +38,8 @@ func TestCreatePVZ(t *testing.T) {
 				pvz: &domain.Pvz{
 					City: "Moscow",
 				},
-				rows: sqlmock.NewRows([]string{"id", "registration_date"}).
-					AddRow(uuid.New(), time.Now()),
+				rows: sqlmock.NewRows([]string{"id", "registration_date", "version"}).
+					AddRow(uuid.New(), time.Now(), 1),
 			},
 			wantErr: false,
 		},
@@ -98,8 +102,8 @@ func TestCreateReception(t *testing.T) {
 				rec: &domain.Reception{
 					PvzId: uuid.New(),
 				},
-				rows: sqlmock.NewRows([]string{"id", "date_time", "status"}).
-					AddRow(uuid.New(), time.Now(), domain.InProgress),
+				rows: sqlmock.NewRows([]string{"id", "date_time", "status", "version"}).
+					AddRow(uuid.New(), time.Now(), domain.InProgress, 1),
 			},
 			wantErr: false,
 		},
@@ -165,16 +169,142 @@ func TestCreateReception(t *testing.T) {
 	}
 }
 
+func TestPvz(t *testing.T) {
+	t.Parallel()
+
+	pvzId := uuid.New()
+	createdAt := time.Now()
+
+	tests := []struct {
+		name     string
+		setup    func(mock sqlmock.Sqlmock)
+		wantKind pRepo.RepoErrKind
+	}{
+		{
+			name: "success",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM\\s+pvzs").WithArgs(&pvzId).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "city", "version"}).
+						AddRow(pvzId, createdAt, domain.Kazan, 1))
+			},
+		},
+		{
+			name: "pvz not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM\\s+pvzs").WillReturnError(sql.ErrNoRows)
+			},
+			wantKind: pRepo.NotFound,
+		},
+		{
+			name: "unexpected error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM\\s+pvzs").WillReturnError(errors.New("db error"))
+			},
+			wantKind: pRepo.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			tt.setup(mock)
+
+			pvz, err := NewRepo(db).Pvz(context.Background(), &pvzId)
+
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+				assert.Nil(t, pvz)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, &domain.Pvz{Id: pvzId, RegistrationDate: createdAt, City: domain.Kazan, Version: 1}, pvz)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReception(t *testing.T) {
+	t.Parallel()
+
+	recId := uuid.New()
+	recCols := []string{"id", "created_at", "pvz_id", "status", "closed_at", "auto_closed", "version"}
+
+	tests := []struct {
+		name     string
+		setup    func(mock sqlmock.Sqlmock)
+		wantKind pRepo.RepoErrKind
+	}{
+		{
+			name: "success",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM\\s+receptions").WithArgs(&recId).
+					WillReturnRows(sqlmock.NewRows(recCols).
+						AddRow(recId, time.Now(), uuid.New(), domain.Close, time.Now(), false, 4))
+			},
+		},
+		{
+			name: "reception not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM\\s+receptions").WillReturnError(sql.ErrNoRows)
+			},
+			wantKind: pRepo.NotFound,
+		},
+		{
+			name: "unexpected error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM\\s+receptions").WillReturnError(errors.New("db error"))
+			},
+			wantKind: pRepo.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			tt.setup(mock)
+
+			rec, err := NewRepo(db).Reception(context.Background(), &recId)
+
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+				assert.Nil(t, rec)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, recId, rec.Id)
+				assert.Equal(t, 4, rec.Version)
+				assert.NotNil(t, rec.ClosedAt)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestCreateProduct(t *testing.T) {
 	type mockArgs struct {
-		prod *domain.Product
-		rows *sqlmock.Rows
-		err  error
+		prod          *domain.Product
+		rows          *sqlmock.Rows
+		err           error
+		capacity      int
+		uniqueBarcode bool
+		full          bool
 	}
 	tests := []struct {
 		name     string
 		mockArgs mockArgs
 		wantErr  bool
+		wantKind pRepo.RepoErrKind
 	}{
 		{
 			name: "success",
@@ -183,11 +313,41 @@ func TestCreateProduct(t *testing.T) {
 					PvzId: uuid.New(),
 					Type:  domain.ProductTypeClothing,
 				},
-				rows: sqlmock.NewRows([]string{"id", "date_time", "reception_id", "type"}).
-					AddRow(uuid.New(), time.Now(), uuid.New(), domain.ProductTypeClothing),
+				rows: sqlmock.NewRows([]string{"id", "date_time", "reception_id", "type", "status"}).
+					AddRow(uuid.New(), time.Now(), uuid.New(), domain.ProductTypeClothing, domain.ProductInStorage),
+			},
+			wantErr: false,
+		},
+		{
+			name: "success with barcode and dimensions",
+			mockArgs: mockArgs{
+				prod: &domain.Product{
+					PvzId:       uuid.New(),
+					Type:        domain.ProductTypeElectronics,
+					Barcode:     ptr("4601234567890"),
+					WeightGrams: ptr(1200),
+					Dimensions:  &domain.ProductDimensions{LengthMm: 300, WidthMm: 200, HeightMm: 100},
+				},
+				rows: sqlmock.NewRows([]string{"id", "date_time", "reception_id", "type", "status"}).
+					AddRow(uuid.New(), time.Now(), uuid.New(), domain.ProductTypeElectronics, domain.ProductInStorage),
 			},
 			wantErr: false,
 		},
+		{
+			name: "pvz is full",
+			mockArgs: mockArgs{
+				prod: &domain.Product{
+					PvzId: uuid.New(),
+					Type:  domain.ProductTypeClothing,
+				},
+				rows: sqlmock.NewRows([]string{"id", "date_time", "reception_id", "type", "status"}).
+					AddRow(uuid.New(), time.Now(), uuid.New(), domain.ProductTypeClothing, domain.ProductInStorage),
+				capacity: 10,
+				full:     true,
+			},
+			wantErr:  true,
+			wantKind: pRepo.LimitExceeded,
+		},
 		{
 			name: "not found error pg",
 			mockArgs: mockArgs{
@@ -199,6 +359,33 @@ func TestCreateProduct(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "duplicate barcode in reception",
+			mockArgs: mockArgs{
+				prod: &domain.Product{
+					PvzId:   uuid.New(),
+					Type:    domain.ProductTypeClothing,
+					Barcode: ptr("4601234567890"),
+				},
+				err: &pgconn.PgError{Code: "23505", ConstraintName: "uniq_products_reception_id_barcode"},
+			},
+			wantErr:  true,
+			wantKind: pRepo.Conflict,
+		},
+		{
+			name: "duplicate globally unique barcode",
+			mockArgs: mockArgs{
+				prod: &domain.Product{
+					PvzId:   uuid.New(),
+					Type:    domain.ProductTypeClothing,
+					Barcode: ptr("4601234567890"),
+				},
+				uniqueBarcode: true,
+				err:           &pgconn.PgError{Code: "23505", ConstraintName: "uniq_products_barcode"},
+			},
+			wantErr:  true,
+			wantKind: pRepo.Conflict,
+		},
 		{
 			name: "not found error no rows",
 			mockArgs: mockArgs{
@@ -231,20 +418,43 @@ func TestCreateProduct(t *testing.T) {
 
 			repo := NewRepo(db)
 
-			expect := mock.ExpectQuery(".*").
-				WithArgs(tt.mockArgs.prod.PvzId, domain.InProgress, tt.mockArgs.prod.Type)
-
-			if tt.mockArgs.err != nil {
+			length, width, height := dimensionsArgs(tt.mockArgs.prod.Dimensions)
+			mock.ExpectBegin()
+			expect := mock.ExpectQuery("INSERT INTO products").
+				WithArgs(
+					tt.mockArgs.prod.PvzId, domain.InProgress, tt.mockArgs.prod.Type,
+					tt.mockArgs.prod.Barcode, tt.mockArgs.prod.WeightGrams, length, width, height,
+					tt.mockArgs.uniqueBarcode,
+				)
+
+			switch {
+			case tt.mockArgs.err != nil:
 				expect.WillReturnError(tt.mockArgs.err)
-			} else {
+				mock.ExpectRollback()
+			case tt.mockArgs.full:
 				expect.WillReturnRows(tt.mockArgs.rows)
+				mock.ExpectExec("UPDATE pvzs").
+					WithArgs(tt.mockArgs.prod.PvzId, 1, tt.mockArgs.capacity).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			default:
+				expect.WillReturnRows(tt.mockArgs.rows)
+				mock.ExpectExec("UPDATE pvzs").
+					WithArgs(tt.mockArgs.prod.PvzId, 1, tt.mockArgs.capacity).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
 			}
 
-			result, err := repo.CreateProduct(context.Background(), tt.mockArgs.prod)
+			result, err := repo.CreateProduct(context.Background(), tt.mockArgs.prod, tt.mockArgs.capacity, tt.mockArgs.uniqueBarcode)
 
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Nil(t, result)
+				if tt.wantKind != "" {
+					var bErr *xerr.BaseErr[pRepo.RepoErrKind]
+					require.ErrorAs(t, err, &bErr)
+					assert.Equal(t, tt.wantKind, bErr.Kind)
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
@@ -254,6 +464,248 @@ func TestCreateProduct(t *testing.T) {
 	}
 }
 
+func TestCreateProductsBatch(t *testing.T) {
+	t.Parallel()
+
+	pvzId := uuid.New()
+	recId := uuid.New()
+
+	newBatch := func(atomic bool) *domain.ProductsBatch {
+		return &domain.ProductsBatch{
+			PvzId:  pvzId,
+			Atomic: atomic,
+			Products: []*domain.Product{
+				{Type: domain.ProductTypeClothing, Barcode: ptr("a1")},
+				{Type: domain.ProductTypeFootwear, Barcode: ptr("b2")},
+				{Type: domain.ProductTypeElectronics},
+			},
+		}
+	}
+	lockRows := func() *sqlmock.Rows { return sqlmock.NewRows([]string{"id"}).AddRow(recId) }
+
+	tests := []struct {
+		name      string
+		batch     *domain.ProductsBatch
+		setup     func(mock sqlmock.Sqlmock)
+		wantKind  pRepo.RepoErrKind
+		wantAdded int
+		wantItems []domain.BatchItemStatus
+	}{
+		{
+			name:  "success with duplicate skipped",
+			batch: newBatch(false),
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE").WithArgs(pvzId, domain.InProgress).WillReturnRows(lockRows())
+				mock.ExpectQuery("SELECT barcode FROM products").
+					WillReturnRows(sqlmock.NewRows([]string{"barcode"}).AddRow("b2"))
+				mock.ExpectQuery("INSERT INTO products").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "added_at"}))
+				mock.ExpectExec("UPDATE pvzs").WithArgs(pvzId, 2, 0).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantAdded: 2,
+			wantItems: []domain.BatchItemStatus{
+				domain.BatchItemAdded, domain.BatchItemDuplicateBarcode, domain.BatchItemAdded,
+			},
+		},
+		{
+			name: "products over capacity skipped",
+			batch: func() *domain.ProductsBatch {
+				b := newBatch(false)
+				b.Capacity = 5
+				return b
+			}(),
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE").WithArgs(pvzId, domain.InProgress).WillReturnRows(lockRows())
+				mock.ExpectQuery("SELECT barcode FROM products").
+					WillReturnRows(sqlmock.NewRows([]string{"barcode"}))
+				mock.ExpectQuery("SELECT products_on_hand").WithArgs(pvzId).
+					WillReturnRows(sqlmock.NewRows([]string{"products_on_hand"}).AddRow(4))
+				mock.ExpectQuery("INSERT INTO products").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "added_at"}))
+				mock.ExpectExec("UPDATE pvzs").WithArgs(pvzId, 1, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantAdded: 1,
+			wantItems: []domain.BatchItemStatus{
+				domain.BatchItemAdded, domain.BatchItemOverCapacity, domain.BatchItemOverCapacity,
+			},
+		},
+		{
+			name: "pvz is full",
+			batch: func() *domain.ProductsBatch {
+				b := newBatch(false)
+				b.Capacity = 2
+				return b
+			}(),
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE").WithArgs(pvzId, domain.InProgress).WillReturnRows(lockRows())
+				mock.ExpectQuery("SELECT barcode FROM products").
+					WillReturnRows(sqlmock.NewRows([]string{"barcode"}))
+				mock.ExpectQuery("SELECT products_on_hand").WithArgs(pvzId).
+					WillReturnRows(sqlmock.NewRows([]string{"products_on_hand"}).AddRow(2))
+				mock.ExpectCommit()
+			},
+			wantItems: []domain.BatchItemStatus{
+				domain.BatchItemOverCapacity, domain.BatchItemOverCapacity, domain.BatchItemOverCapacity,
+			},
+		},
+		{
+			name: "atomic batch does not fit in pvz",
+			batch: func() *domain.ProductsBatch {
+				b := newBatch(true)
+				b.Capacity = 2
+				return b
+			}(),
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE").WithArgs(pvzId, domain.InProgress).WillReturnRows(lockRows())
+				mock.ExpectQuery("SELECT barcode FROM products").
+					WillReturnRows(sqlmock.NewRows([]string{"barcode"}))
+				mock.ExpectQuery("INSERT INTO products").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "added_at"}))
+				mock.ExpectExec("UPDATE pvzs").WithArgs(pvzId, 3, 2).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.LimitExceeded,
+		},
+		{
+			name:  "atomic batch with duplicate inserts nothing",
+			batch: newBatch(true),
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE").WithArgs(pvzId, domain.InProgress).WillReturnRows(lockRows())
+				mock.ExpectQuery("SELECT barcode FROM products").
+					WillReturnRows(sqlmock.NewRows([]string{"barcode"}).AddRow("a1"))
+				mock.ExpectCommit()
+			},
+			wantItems: []domain.BatchItemStatus{
+				domain.BatchItemDuplicateBarcode, domain.BatchItemNotAdded, domain.BatchItemNotAdded,
+			},
+		},
+		{
+			name:  "no active reception",
+			batch: newBatch(false),
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE").WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.NotFound,
+		},
+		{
+			name:  "begin error",
+			batch: newBatch(false),
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(errors.New("begin error"))
+			},
+			wantKind: pRepo.Unexpected,
+		},
+		{
+			name:  "taken barcodes error",
+			batch: newBatch(false),
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE").WillReturnRows(lockRows())
+				mock.ExpectQuery("SELECT barcode FROM products").WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.Unexpected,
+		},
+		{
+			name:  "concurrent duplicate on insert",
+			batch: newBatch(false),
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE").WillReturnRows(lockRows())
+				mock.ExpectQuery("SELECT barcode FROM products").
+					WillReturnRows(sqlmock.NewRows([]string{"barcode"}))
+				mock.ExpectQuery("INSERT INTO products").WillReturnError(
+					&pgconn.PgError{Code: "23505", ConstraintName: "uniq_products_reception_id_barcode"},
+				)
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.Conflict,
+		},
+		{
+			name:  "insert error",
+			batch: newBatch(false),
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE").WillReturnRows(lockRows())
+				mock.ExpectQuery("SELECT barcode FROM products").
+					WillReturnRows(sqlmock.NewRows([]string{"barcode"}))
+				mock.ExpectQuery("INSERT INTO products").WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			repo := NewRepo(db)
+			tt.setup(mock)
+
+			res, err := repo.CreateProductsBatch(context.Background(), tt.batch)
+
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+				assert.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantAdded, res.Added)
+				require.Len(t, res.Items, len(tt.wantItems))
+				for i, st := range tt.wantItems {
+					assert.Equal(t, st, res.Items[i].Status)
+				}
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_splitProductsBatch(t *testing.T) {
+	t.Parallel()
+
+	batch := &domain.ProductsBatch{
+		PvzId: uuid.New(),
+		Products: []*domain.Product{
+			{Type: domain.ProductTypeClothing, Barcode: ptr("a1")},
+			{Type: domain.ProductTypeClothing, Barcode: ptr("a1")},
+			{Type: domain.ProductTypeClothing},
+			{Type: domain.ProductTypeClothing},
+			{Type: domain.ProductTypeClothing, Barcode: ptr("taken")},
+		},
+	}
+
+	res, toInsert := splitProductsBatch(batch, map[string]struct{}{"taken": {}})
+
+	assert.Equal(t, 3, res.Added)
+	assert.Len(t, toInsert, 3)
+	assert.Equal(t, []domain.BatchItemStatus{
+		domain.BatchItemAdded,
+		domain.BatchItemDuplicateBarcode,
+		domain.BatchItemAdded,
+		domain.BatchItemAdded,
+		domain.BatchItemDuplicateBarcode,
+	}, []domain.BatchItemStatus{
+		res.Items[0].Status, res.Items[1].Status, res.Items[2].Status, res.Items[3].Status, res.Items[4].Status,
+	})
+	assert.NotEqual(t, uuid.Nil, res.Items[0].Product.Id)
+	assert.Nil(t, res.Items[1].Product)
+}
+
 func TestDeleteLastProduct(t *testing.T) {
 	type mockArgs struct {
 		pvzId  uuid.UUID
@@ -295,107 +747,1400 @@ func TestDeleteLastProduct(t *testing.T) {
 				pvzId:  uuid.New(),
 				result: sqlmock.NewResult(0, 0),
 			},
-			wantErr: true,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+			assert.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			repo := NewRepo(db)
+
+			actorId := uuid.New()
+			expect := mock.ExpectExec(".*").
+				WithArgs(tt.mockArgs.pvzId, domain.InProgress, &actorId, domain.ProductInStorage)
+
+			if tt.mockArgs.err != nil {
+				expect.WillReturnError(tt.mockArgs.err)
+			} else {
+				expect.WillReturnResult(tt.mockArgs.result)
+			}
+
+			err = repo.DeleteLastProduct(context.Background(), &tt.mockArgs.pvzId, &actorId)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDeleteProduct(t *testing.T) {
+	t.Parallel()
+
+	productId := uuid.New()
+	actorId := uuid.New()
+	lockCols := []string{"status", "deleted_at"}
+
+	tests := []struct {
+		name     string
+		setup    func(mock sqlmock.Sqlmock)
+		wantKind pRepo.RepoErrKind
+	}{
+		{
+			name: "success",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE OF p").WithArgs(&productId).
+					WillReturnRows(sqlmock.NewRows(lockCols).AddRow(domain.InProgress, nil))
+				mock.ExpectExec("INSERT INTO product_deletions").WithArgs(&productId, &actorId, domain.ProductInStorage).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "begin error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(errors.New("begin error"))
+			},
+			wantKind: pRepo.Unexpected,
+		},
+		{
+			name: "product not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE OF p").WithArgs(&productId).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.NotFound,
+		},
+		{
+			name: "already deleted",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE OF p").WithArgs(&productId).
+					WillReturnRows(sqlmock.NewRows(lockCols).AddRow(domain.InProgress, time.Now()))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.NotFound,
+		},
+		{
+			name: "reception closed",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE OF p").WithArgs(&productId).
+					WillReturnRows(sqlmock.NewRows(lockCols).AddRow(domain.Close, nil))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.InvalidState,
+		},
+		{
+			name: "delete error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE OF p").WithArgs(&productId).
+					WillReturnRows(sqlmock.NewRows(lockCols).AddRow(domain.InProgress, nil))
+				mock.ExpectExec("INSERT INTO product_deletions").WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			repo := NewRepo(db)
+			tt.setup(mock)
+
+			err = repo.DeleteProduct(context.Background(), &productId, &actorId)
+
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRestoreProduct(t *testing.T) {
+	t.Parallel()
+
+	productId := uuid.New()
+	recId := uuid.New()
+	pvzId := uuid.New()
+	lockCols := []string{"status", "deleted_at"}
+	prodCols := []string{
+		"id", "added_at", "reception_id", "type",
+		"barcode", "weight_grams", "length_mm", "width_mm", "height_mm", "status", "pvz_id",
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(mock sqlmock.Sqlmock)
+		wantKind pRepo.RepoErrKind
+	}{
+		{
+			name: "success",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE OF p").WithArgs(&productId).
+					WillReturnRows(sqlmock.NewRows(lockCols).AddRow(domain.InProgress, time.Now()))
+				mock.ExpectQuery("UPDATE products").WithArgs(&productId).
+					WillReturnRows(sqlmock.NewRows(prodCols).AddRow(
+						productId, time.Now(), recId, domain.ProductTypeClothing,
+						"4601234567890", nil, nil, nil, nil, domain.ProductInStorage, pvzId.String(),
+					))
+				mock.ExpectExec("UPDATE product_deletions").WithArgs(&productId).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE pvzs").WithArgs(pvzId, 1, 10).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "issued product takes no place",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE OF p").WithArgs(&productId).
+					WillReturnRows(sqlmock.NewRows(lockCols).AddRow(domain.InProgress, time.Now()))
+				mock.ExpectQuery("UPDATE products").WithArgs(&productId).
+					WillReturnRows(sqlmock.NewRows(prodCols).AddRow(
+						productId, time.Now(), recId, domain.ProductTypeClothing,
+						nil, nil, nil, nil, nil, domain.ProductIssued, pvzId.String(),
+					))
+				mock.ExpectExec("UPDATE product_deletions").WithArgs(&productId).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "pvz is full",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE OF p").WithArgs(&productId).
+					WillReturnRows(sqlmock.NewRows(lockCols).AddRow(domain.InProgress, time.Now()))
+				mock.ExpectQuery("UPDATE products").WithArgs(&productId).
+					WillReturnRows(sqlmock.NewRows(prodCols).AddRow(
+						productId, time.Now(), recId, domain.ProductTypeClothing,
+						nil, nil, nil, nil, nil, domain.ProductInStorage, pvzId.String(),
+					))
+				mock.ExpectExec("UPDATE product_deletions").WithArgs(&productId).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE pvzs").WithArgs(pvzId, 1, 10).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.LimitExceeded,
+		},
+		{
+			name: "product is not deleted",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE OF p").WithArgs(&productId).
+					WillReturnRows(sqlmock.NewRows(lockCols).AddRow(domain.InProgress, nil))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.NotFound,
+		},
+		{
+			name: "reception closed",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE OF p").WithArgs(&productId).
+					WillReturnRows(sqlmock.NewRows(lockCols).AddRow(domain.Close, time.Now()))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.InvalidState,
+		},
+		{
+			name: "barcode taken by another product",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE OF p").WithArgs(&productId).
+					WillReturnRows(sqlmock.NewRows(lockCols).AddRow(domain.InProgress, time.Now()))
+				mock.ExpectQuery("UPDATE products").WithArgs(&productId).
+					WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "uniq_products_reception_id_barcode"})
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.Conflict,
+		},
+		{
+			name: "history update error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE OF p").WithArgs(&productId).
+					WillReturnRows(sqlmock.NewRows(lockCols).AddRow(domain.InProgress, time.Now()))
+				mock.ExpectQuery("UPDATE products").WithArgs(&productId).
+					WillReturnRows(sqlmock.NewRows(prodCols).AddRow(
+						productId, time.Now(), recId, domain.ProductTypeClothing,
+						nil, nil, nil, nil, nil, domain.ProductInStorage, pvzId.String(),
+					))
+				mock.ExpectExec("UPDATE product_deletions").WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			repo := NewRepo(db)
+			tt.setup(mock)
+
+			prod, err := repo.RestoreProduct(context.Background(), &productId, 10)
+
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+				assert.Nil(t, prod)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, productId, prod.Id)
+				assert.Equal(t, recId, prod.ReceptionId)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestProductDeletions(t *testing.T) {
+	t.Parallel()
+
+	recId := uuid.New()
+	cols := []string{
+		"d_id", "d_deleted_by", "d_deleted_at", "d_restored_at",
+		"p_id", "p_added_at", "p_reception_id", "p_type",
+		"p_barcode", "p_weight", "p_length", "p_width", "p_height", "p_status",
+	}
+	l, _ := logger.NewTestLogger()
+	ctx := logger.ToCtx(context.Background(), l)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer func(db *sql.DB) { _ = db.Close() }(db)
+
+		actorId := uuid.New()
+		rows := sqlmock.NewRows(cols).
+			AddRow(
+				uuid.New(), actorId, time.Now(), nil,
+				uuid.New(), time.Now(), recId, domain.ProductTypeClothing,
+				nil, nil, nil, nil, nil, domain.ProductInStorage).
+			AddRow(
+				uuid.New(), nil, time.Now(), time.Now(),
+				uuid.New(), time.Now(), recId, domain.ProductTypeFootwear,
+				"4601234567890", nil, nil, nil, nil, domain.ProductInStorage)
+		mock.ExpectQuery("FROM\\s+product_deletions").WithArgs(&recId).WillReturnRows(rows)
+
+		res, err := NewRepo(db).ProductDeletions(ctx, &recId)
+
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, &actorId, res[0].DeletedBy)
+		assert.Nil(t, res[0].RestoredAt)
+		assert.Nil(t, res[1].DeletedBy)
+		assert.NotNil(t, res[1].RestoredAt)
+		assert.Equal(t, "4601234567890", *res[1].Product.Barcode)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		t.Parallel()
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer func(db *sql.DB) { _ = db.Close() }(db)
+
+		mock.ExpectQuery(".*").WithArgs(&recId).WillReturnError(errors.New("db error"))
+
+		res, err := NewRepo(db).ProductDeletions(ctx, &recId)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("invalid product id", func(t *testing.T) {
+		t.Parallel()
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer func(db *sql.DB) { _ = db.Close() }(db)
+
+		rows := sqlmock.NewRows(cols).AddRow(
+			uuid.New(), nil, time.Now(), nil,
+			"not a uuid", time.Now(), recId, domain.ProductTypeClothing,
+			nil, nil, nil, nil, nil, nil)
+		mock.ExpectQuery(".*").WithArgs(&recId).WillReturnRows(rows)
+
+		res, err := NewRepo(db).ProductDeletions(ctx, &recId)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProductStorageState(t *testing.T) {
+	t.Parallel()
+
+	productId := uuid.New()
+	pvzId := uuid.New()
+	cols := []string{
+		"p_id", "p_added_at", "p_reception_id", "p_type",
+		"p_barcode", "p_weight", "p_length", "p_width", "p_height", "p_status",
+		"p_pickup_code_hash", "p_pickup_code_expires_at", "p_pickup_code_attempts", "r_status", "r_pvz_id",
+	}
+	expiresAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		rows     *sqlmock.Rows
+		err      error
+		wantKind pRepo.RepoErrKind
+	}{
+		{
+			name: "success",
+			rows: sqlmock.NewRows(cols).AddRow(
+				productId, time.Now(), uuid.New(), domain.ProductTypeClothing,
+				"4601234567890", nil, nil, nil, nil, domain.ProductInStorage,
+				[]byte("hash"), expiresAt, 2, domain.Close, pvzId,
+			),
+		},
+		{
+			name:     "not found",
+			err:      sql.ErrNoRows,
+			wantKind: pRepo.NotFound,
+		},
+		{
+			name:     "unexpected error",
+			err:      errors.New("db error"),
+			wantKind: pRepo.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			expect := mock.ExpectQuery("pickup_code_hash").WithArgs(&productId)
+			if tt.err != nil {
+				expect.WillReturnError(tt.err)
+			} else {
+				expect.WillReturnRows(tt.rows)
+			}
+
+			state, err := NewRepo(db).ProductStorageState(context.Background(), &productId)
+
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+				assert.Nil(t, state)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, productId, state.Product.Id)
+				assert.Equal(t, pvzId, state.Product.PvzId)
+				assert.Equal(t, domain.ProductInStorage, state.Product.Status)
+				assert.Equal(t, domain.Close, state.ReceptionStatus)
+				assert.Equal(t, []byte("hash"), state.PickupCodeHash)
+				assert.Equal(t, &expiresAt, state.PickupCodeExpiresAt)
+				assert.Equal(t, 2, state.PickupCodeAttempts)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSetProductPickupCode(t *testing.T) {
+	t.Parallel()
+
+	productId := uuid.New()
+	hash := []byte("hash")
+	expiresAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		setup    func(mock sqlmock.Sqlmock)
+		wantKind pRepo.RepoErrKind
+	}{
+		{
+			name: "success",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE").WithArgs(&productId, hash, domain.ProductInStorage, &expiresAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "product left storage",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantKind: pRepo.InvalidState,
+		},
+		{
+			name: "unexpected error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE").WillReturnError(errors.New("db error"))
+			},
+			wantKind: pRepo.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			tt.setup(mock)
+
+			err = NewRepo(db).SetProductPickupCode(context.Background(), &productId, hash, &expiresAt)
+
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTakePickupCodeAttempt(t *testing.T) {
+	t.Parallel()
+
+	productId := uuid.New()
+
+	tests := []struct {
+		name     string
+		setup    func(mock sqlmock.Sqlmock)
+		wantKind pRepo.RepoErrKind
+	}{
+		{
+			name: "success",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("pickup_code_attempts = pickup_code_attempts \\+ 1").
+					WithArgs(&productId, domain.ProductInStorage, 5).
+					WillReturnRows(sqlmock.NewRows([]string{"pickup_code_hash"}).AddRow([]byte("hash")))
+			},
+		},
+		{
+			name: "code expired or locked",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").WillReturnError(sql.ErrNoRows)
+			},
+			wantKind: pRepo.InvalidState,
+		},
+		{
+			name: "unexpected error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").WillReturnError(errors.New("db error"))
+			},
+			wantKind: pRepo.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			tt.setup(mock)
+
+			hash, err := NewRepo(db).TakePickupCodeAttempt(context.Background(), &productId, 5)
+
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+				assert.Nil(t, hash)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, []byte("hash"), hash)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateProductStatus(t *testing.T) {
+	t.Parallel()
+
+	actorId := uuid.New()
+	upd := &domain.ProductStatusUpdate{ProductId: uuid.New(), Status: domain.ProductIssued, ActorId: &actorId}
+	prodCols := []string{
+		"id", "added_at", "reception_id", "type",
+		"barcode", "weight_grams", "length_mm", "width_mm", "height_mm", "status",
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(mock sqlmock.Sqlmock)
+		wantKind pRepo.RepoErrKind
+	}{
+		{
+			name: "success",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO product_status_history").
+					WithArgs(upd.ProductId, domain.ProductIssued, domain.ProductInStorage, &actorId).
+					WillReturnRows(sqlmock.NewRows(prodCols).AddRow(
+						upd.ProductId, time.Now(), uuid.New(), domain.ProductTypeClothing,
+						nil, nil, nil, nil, nil, domain.ProductIssued,
+					))
+			},
+		},
+		{
+			name: "product is not in storage",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").WillReturnError(sql.ErrNoRows)
+			},
+			wantKind: pRepo.InvalidState,
+		},
+		{
+			name: "unexpected error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").WillReturnError(errors.New("db error"))
+			},
+			wantKind: pRepo.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			tt.setup(mock)
+
+			prod, err := NewRepo(db).UpdateProductStatus(context.Background(), upd)
+
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+				assert.Nil(t, prod)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, upd.ProductId, prod.Id)
+				assert.Equal(t, domain.ProductIssued, prod.Status)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestProductStatusHistory(t *testing.T) {
+	t.Parallel()
+
+	productId := uuid.New()
+	cols := []string{"status", "changed_at", "changed_by"}
+	l, _ := logger.NewTestLogger()
+	ctx := logger.ToCtx(context.Background(), l)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer func(db *sql.DB) { _ = db.Close() }(db)
+
+		actorId := uuid.New()
+		rows := sqlmock.NewRows(cols).
+			AddRow(domain.ProductInStorage, time.Now().Add(-time.Hour), nil).
+			AddRow(domain.ProductIssued, time.Now(), actorId)
+		mock.ExpectQuery("FROM\\s+product_status_history").
+			WithArgs(&productId, domain.ProductInStorage).WillReturnRows(rows)
+
+		res, err := NewRepo(db).ProductStatusHistory(ctx, &productId)
+
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, domain.ProductInStorage, res[0].Status)
+		assert.Nil(t, res[0].ChangedBy)
+		assert.Equal(t, domain.ProductIssued, res[1].Status)
+		assert.Equal(t, &actorId, res[1].ChangedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		t.Parallel()
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer func(db *sql.DB) { _ = db.Close() }(db)
+
+		mock.ExpectQuery(".*").WillReturnRows(sqlmock.NewRows(cols))
+
+		res, err := NewRepo(db).ProductStatusHistory(ctx, &productId)
+
+		var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+		require.ErrorAs(t, err, &rErr)
+		assert.Equal(t, pRepo.NotFound, rErr.Kind)
+		assert.Nil(t, res)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		t.Parallel()
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer func(db *sql.DB) { _ = db.Close() }(db)
+
+		mock.ExpectQuery(".*").WillReturnError(errors.New("db error"))
+
+		res, err := NewRepo(db).ProductStatusHistory(ctx, &productId)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCloseReceptionInPvz(t *testing.T) {
+	t.Parallel()
+
+	pvzId := uuid.New()
+	recId := uuid.New()
+	recCols := []string{"id", "created_at", "pvz_id", "status", "closed_at", "auto_closed", "version"}
+	closedRow := func() *sqlmock.Rows {
+		return sqlmock.NewRows(recCols).AddRow(recId, time.Now(), pvzId, domain.Close, time.Now(), false, 3)
+	}
+	version := 2
+
+	tests := []struct {
+		name         string
+		ifVersion    *int
+		setup        func(mock sqlmock.Sqlmock)
+		wantKind     pRepo.RepoErrKind
+		wantManifest *domain.ManifestReport
+	}{
+		{
+			name: "success without manifest",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("UPDATE").WithArgs(domain.Close, &pvzId, domain.InProgress, nil).
+					WillReturnRows(closedRow())
+				mock.ExpectQuery("COUNT").WithArgs(recId).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:      "success with manifest and matching version",
+			ifVersion: &version,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("UPDATE").WithArgs(domain.Close, &pvzId, domain.InProgress, &version).
+					WillReturnRows(closedRow())
+				mock.ExpectQuery("COUNT").WithArgs(recId).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+				mock.ExpectQuery("'missing'").WithArgs(recId).
+					WillReturnRows(sqlmock.NewRows([]string{"kind", "barcode"}).
+						AddRow("missing", "a1").
+						AddRow("unexpected", "z9"))
+				mock.ExpectCommit()
+			},
+			wantManifest: &domain.ManifestReport{
+				Expected:   3,
+				Missing:    []string{"a1"},
+				Unexpected: []string{"z9"},
+			},
+		},
+		{
+			name: "begin error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin().WillReturnError(errors.New("begin error"))
+			},
+			wantKind: pRepo.Unexpected,
+		},
+		{
+			name: "no reception in progress",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("UPDATE").WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.Conflict,
+		},
+		{
+			name:      "no reception in progress with if-match",
+			ifVersion: &version,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("UPDATE").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery("SELECT").WithArgs(&pvzId, domain.InProgress).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.Conflict,
+		},
+		{
+			name:      "version mismatch",
+			ifVersion: &version,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("UPDATE").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery("SELECT").WithArgs(&pvzId, domain.InProgress).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(5))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.VersionMismatch,
+		},
+		{
+			name: "update error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("UPDATE").WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.Unexpected,
+		},
+		{
+			name: "manifest diff error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("UPDATE").WillReturnRows(closedRow())
+				mock.ExpectQuery("COUNT").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery("'missing'").WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			repo := NewRepo(db)
+			tt.setup(mock)
+
+			report, err := repo.CloseReceptionInPvz(context.Background(), &pvzId, tt.ifVersion)
+
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+				assert.Nil(t, report)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, recId, report.Reception.Id)
+				assert.Equal(t, domain.Close, report.Reception.Status)
+				assert.NotNil(t, report.Reception.ClosedAt)
+				assert.Equal(t, 3, report.Reception.Version)
+				assert.Equal(t, tt.wantManifest, report.Manifest)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCancelReceptionInPvz(t *testing.T) {
+	t.Parallel()
+
+	pvzId := uuid.New()
+	recCols := []string{"id", "created_at", "pvz_id", "status", "closed_at", "auto_closed", "version"}
+	version := 1
+
+	tests := []struct {
+		name      string
+		ifVersion *int
+		setup     func(mock sqlmock.Sqlmock)
+		wantKind  pRepo.RepoErrKind
+	}{
+		{
+			name:      "success",
+			ifVersion: &version,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").WithArgs(domain.Cancelled, &pvzId, domain.InProgress, &version).
+					WillReturnRows(sqlmock.NewRows(recCols).AddRow(uuid.New(), time.Now(), pvzId, domain.Cancelled, nil, false, 2))
+			},
+		},
+		{
+			name: "no reception in progress",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").WillReturnError(sql.ErrNoRows)
+			},
+			wantKind: pRepo.NotFound,
+		},
+		{
+			name:      "version mismatch",
+			ifVersion: &version,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery("SELECT").WithArgs(&pvzId, domain.InProgress).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(4))
+			},
+			wantKind: pRepo.VersionMismatch,
+		},
+		{
+			name:      "version lookup error",
+			ifVersion: &version,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery("SELECT").WillReturnError(errors.New("db error"))
+			},
+			wantKind: pRepo.Unexpected,
+		},
+		{
+			name: "unexpected error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").WillReturnError(errors.New("db error"))
+			},
+			wantKind: pRepo.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			repo := NewRepo(db)
+			tt.setup(mock)
+
+			rec, err := repo.CancelReceptionInPvz(context.Background(), &pvzId, tt.ifVersion)
+
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+				assert.Nil(t, rec)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, domain.Cancelled, rec.Status)
+				assert.Nil(t, rec.ClosedAt)
+				assert.Equal(t, 2, rec.Version)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAutoCloseStaleReceptions(t *testing.T) {
+	t.Parallel()
+
+	idleBefore := time.Now().Add(-12 * time.Hour)
+	recCols := []string{"id", "created_at", "pvz_id", "status", "closed_at", "auto_closed", "version"}
+	lockCols := []string{"pg_try_advisory_xact_lock"}
+
+	tests := []struct {
+		name      string
+		setup     func(mock sqlmock.Sqlmock)
+		wantKind  pRepo.RepoErrKind
+		wantCount int
+	}{
+		{
+			name: "success",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("pg_try_advisory_xact_lock").WithArgs(autoCloseLockKey).
+					WillReturnRows(sqlmock.NewRows(lockCols).AddRow(true))
+				mock.ExpectQuery("UPDATE").WithArgs(domain.Close, domain.InProgress, idleBefore).
+					WillReturnRows(sqlmock.NewRows(recCols).
+						AddRow(uuid.New(), time.Now(), uuid.New(), domain.Close, time.Now(), true, 2).
+						AddRow(uuid.New(), time.Now(), uuid.New(), domain.Close, time.Now(), true, 4))
+				mock.ExpectCommit()
+			},
+			wantCount: 2,
+		},
+		{
+			name: "nothing to close",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("pg_try_advisory_xact_lock").
+					WillReturnRows(sqlmock.NewRows(lockCols).AddRow(true))
+				mock.ExpectQuery("UPDATE").WillReturnRows(sqlmock.NewRows(recCols))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "lock held by another instance",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("pg_try_advisory_xact_lock").
+					WillReturnRows(sqlmock.NewRows(lockCols).AddRow(false))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.Locked,
+		},
+		{
+			name: "update error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("pg_try_advisory_xact_lock").
+					WillReturnRows(sqlmock.NewRows(lockCols).AddRow(true))
+				mock.ExpectQuery("UPDATE").WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			repo := NewRepo(db)
+			tt.setup(mock)
+
+			recs, err := repo.AutoCloseStaleReceptions(context.Background(), idleBefore)
+
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+				assert.Nil(t, recs)
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, recs, tt.wantCount)
+				for _, rec := range recs {
+					assert.True(t, rec.AutoClosed)
+					assert.Equal(t, domain.Close, rec.Status)
+				}
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdatePvz(t *testing.T) {
+	t.Parallel()
+
+	pvzId := uuid.New()
+	pvzCols := []string{"id", "created_at", "city", "version"}
+	version := 1
+
+	tests := []struct {
+		name      string
+		ifVersion *int
+		setup     func(mock sqlmock.Sqlmock)
+		wantKind  pRepo.RepoErrKind
+	}{
+		{
+			name:      "success",
+			ifVersion: &version,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE pvzs").
+					WithArgs(&pvzId, domain.Kazan, &version).
+					WillReturnRows(sqlmock.NewRows(pvzCols).AddRow(pvzId, time.Now(), domain.Kazan, 2))
+			},
+		},
+		{
+			name:      "stale version",
+			ifVersion: &version,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE pvzs").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery("SELECT").WithArgs(&pvzId).
+					WillReturnRows(sqlmock.NewRows(pvzCols).AddRow(pvzId, time.Now(), domain.Moscow, 2))
+			},
+			wantKind: pRepo.VersionMismatch,
+		},
+		{
+			name: "not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE pvzs").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery("SELECT").WithArgs(&pvzId).WillReturnError(sql.ErrNoRows)
+			},
+			wantKind: pRepo.NotFound,
+		},
+		{
+			name: "lookup error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE pvzs").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery("SELECT").WillReturnError(errors.New("db error"))
+			},
+			wantKind: pRepo.Unexpected,
+		},
+		{
+			name: "unexpected error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE pvzs").WillReturnError(errors.New("db error"))
+			},
+			wantKind: pRepo.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			repo := NewRepo(db)
+			tt.setup(mock)
+
+			pvz, err := repo.UpdatePvz(context.Background(), &pvzId, domain.Kazan, tt.ifVersion)
+
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+				assert.Nil(t, pvz)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, domain.Kazan, pvz.City)
+				assert.Equal(t, 2, pvz.Version)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReopenReception(t *testing.T) {
+	t.Parallel()
+
+	recId := uuid.New()
+	closedAfter := time.Now().Add(-time.Hour)
+	recCols := []string{"id", "created_at", "pvz_id", "status", "closed_at", "auto_closed", "version"}
+	statusCols := []string{"status", "version"}
+	version := 2
+
+	tests := []struct {
+		name      string
+		ifVersion *int
+		setup     func(mock sqlmock.Sqlmock)
+		wantKind  pRepo.RepoErrKind
+	}{
+		{
+			name:      "success",
+			ifVersion: &version,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").
+					WithArgs(&recId, domain.InProgress, domain.Close, closedAfter, domain.Cancelled, &version).
+					WillReturnRows(sqlmock.NewRows(recCols).AddRow(recId, time.Now(), uuid.New(), domain.InProgress, nil, false, 3))
+			},
+		},
+		{
+			name: "not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery("SELECT").WithArgs(&recId).WillReturnError(sql.ErrNoRows)
+			},
+			wantKind: pRepo.NotFound,
+		},
+		{
+			name: "not reopenable",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery("SELECT").WithArgs(&recId).
+					WillReturnRows(sqlmock.NewRows(statusCols).AddRow(domain.Close, 2))
+			},
+			wantKind: pRepo.InvalidState,
+		},
+		{
+			name:      "version mismatch",
+			ifVersion: &version,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery("SELECT").WithArgs(&recId).
+					WillReturnRows(sqlmock.NewRows(statusCols).AddRow(domain.Close, 5))
+			},
+			wantKind: pRepo.VersionMismatch,
+		},
+		{
+			name: "status lookup error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery("SELECT").WillReturnError(errors.New("db error"))
+			},
+			wantKind: pRepo.Unexpected,
+		},
+		{
+			name: "another reception in progress",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").WillReturnError(
+					&pgconn.PgError{Code: "23505", ConstraintName: "one_in_progress_reception_per_pvz_id"},
+				)
+			},
+			wantKind: pRepo.Conflict,
+		},
+		{
+			name: "unexpected error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE").WillReturnError(errors.New("db error"))
+			},
+			wantKind: pRepo.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			repo := NewRepo(db)
+			tt.setup(mock)
+
+			rec, err := repo.ReopenReception(context.Background(), &recId, closedAfter, tt.ifVersion)
+
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+				assert.Nil(t, rec)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, domain.InProgress, rec.Status)
+				assert.Equal(t, 3, rec.Version)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSetReceptionManifest(t *testing.T) {
+	t.Parallel()
+
+	recId := uuid.New()
+	statusCols := []string{"status", "version"}
+	bumped := func() *sqlmock.Rows { return sqlmock.NewRows([]string{"version"}).AddRow(2) }
+	version := 1
+
+	tests := []struct {
+		name      string
+		barcodes  []string
+		ifVersion *int
+		setup     func(mock sqlmock.Sqlmock)
+		wantKind  pRepo.RepoErrKind
+	}{
+		{
+			name:      "success",
+			barcodes:  []string{"a1", "b2"},
+			ifVersion: &version,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE").WithArgs(recId).
+					WillReturnRows(sqlmock.NewRows(statusCols).AddRow(domain.InProgress, 1))
+				mock.ExpectQuery("version = version \\+ 1").WithArgs(recId).WillReturnRows(bumped())
+				mock.ExpectExec("DELETE FROM").WithArgs(recId).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO reception_manifest_items").
+					WithArgs(recId.String(), "a1", recId.String(), "b2").
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "empty manifest clears it",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE").
+					WillReturnRows(sqlmock.NewRows(statusCols).AddRow(domain.InProgress, 1))
+				mock.ExpectQuery("version = version \\+ 1").WillReturnRows(bumped())
+				mock.ExpectExec("DELETE FROM").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:     "reception not found",
+			barcodes: []string{"a1"},
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE").WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.NotFound,
+		},
+		{
+			name:     "reception closed",
+			barcodes: []string{"a1"},
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE").
+					WillReturnRows(sqlmock.NewRows(statusCols).AddRow(domain.Close, 2))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.InvalidState,
+		},
+		{
+			name:      "version mismatch",
+			barcodes:  []string{"a1"},
+			ifVersion: &version,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE").
+					WillReturnRows(sqlmock.NewRows(statusCols).AddRow(domain.InProgress, 3))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.VersionMismatch,
+		},
+		{
+			name:     "insert error",
+			barcodes: []string{"a1"},
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("FOR UPDATE").
+					WillReturnRows(sqlmock.NewRows(statusCols).AddRow(domain.InProgress, 1))
+				mock.ExpectQuery("version = version \\+ 1").WillReturnRows(bumped())
+				mock.ExpectExec("DELETE FROM").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("INSERT INTO reception_manifest_items").WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
+			},
+			wantKind: pRepo.Unexpected,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
-			assert.NoError(t, err)
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
 			defer func(db *sql.DB) { _ = db.Close() }(db)
 
 			repo := NewRepo(db)
+			tt.setup(mock)
 
-			expect := mock.ExpectExec(".*").
-				WithArgs(tt.mockArgs.pvzId, domain.InProgress)
-
-			if tt.mockArgs.err != nil {
-				expect.WillReturnError(tt.mockArgs.err)
-			} else {
-				expect.WillReturnResult(tt.mockArgs.result)
+			manifest := &domain.ReceptionManifest{
+				ReceptionId: recId,
+				Barcodes:    tt.barcodes,
+				IfVersion:   tt.ifVersion,
 			}
+			err = repo.SetReceptionManifest(context.Background(), manifest)
 
-			err = repo.DeleteLastProduct(context.Background(), &tt.mockArgs.pvzId)
-
-			if tt.wantErr {
-				assert.Error(t, err)
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, 2, manifest.Version)
 			}
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestCloseReceptionInPvz(t *testing.T) {
-	type mockArgs struct {
-		pvzId  uuid.UUID
-		result sql.Result
-		err    error
+func TestProductsByBarcode(t *testing.T) {
+	cols := []string{
+		"p_id", "p_added_at", "p_reception_id", "p_type",
+		"p_barcode", "p_weight", "p_length", "p_width", "p_height", "p_status",
+		"r_status", "r_created_at",
+		"pvz_id", "pvz_city", "pvz_created_at",
 	}
+	pvzId := uuid.New()
+	recId := uuid.New()
+	prodId := uuid.New()
+	olderId := uuid.New()
+
 	tests := []struct {
 		name     string
-		mockArgs mockArgs
-		wantErr  bool
+		rows     *sqlmock.Rows
+		err      error
+		wantLen  int
+		wantKind pRepo.RepoErrKind
 	}{
 		{
 			name: "success",
-			mockArgs: mockArgs{
-				pvzId:  uuid.New(),
-				result: sqlmock.NewResult(1, 1),
-			},
-			wantErr: false,
+			rows: sqlmock.NewRows(cols).AddRow(
+				prodId, time.Now(), recId, domain.ProductTypeClothing,
+				"4601234567890", 1200, nil, nil, nil, domain.ProductIssued,
+				domain.Close, time.Now(),
+				pvzId, domain.Moscow, time.Now(),
+			).AddRow(
+				olderId, time.Now().Add(-time.Hour), uuid.New(), domain.ProductTypeFootwear,
+				"4601234567890", nil, nil, nil, nil, domain.ProductIssued,
+				domain.Close, time.Now(),
+				uuid.New(), domain.Kazan, time.Now(),
+			),
+			wantLen: 2,
 		},
 		{
-			name: "unexpected error on exec",
-			mockArgs: mockArgs{
-				pvzId: uuid.New(),
-				err:   errors.New("db error"),
-			},
-			wantErr: true,
+			name: "not found",
+			rows: sqlmock.NewRows(cols),
 		},
 		{
-			name: "unexpected error on rows affected",
-			mockArgs: mockArgs{
-				pvzId:  uuid.New(),
-				result: sqlmock.NewErrorResult(errors.New("rows affected error")),
-			},
-			wantErr: true,
+			name:     "unexpected error",
+			err:      errors.New("db error"),
+			wantKind: pRepo.Unexpected,
 		},
 		{
-			name: "conflict",
-			mockArgs: mockArgs{
-				pvzId:  uuid.New(),
-				result: sqlmock.NewResult(0, 0),
-			},
-			wantErr: true,
+			name: "invalid uuid",
+			rows: sqlmock.NewRows(cols).AddRow(
+				"not a uuid", time.Now(), recId, domain.ProductTypeClothing,
+				"4601234567890", nil, nil, nil, nil, domain.ProductInStorage,
+				domain.Close, time.Now(),
+				pvzId, domain.Moscow, time.Now(),
+			),
+			wantKind: pRepo.Unexpected,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
-			assert.NoError(t, err)
+			require.NoError(t, err)
 			defer func(db *sql.DB) { _ = db.Close() }(db)
 
 			repo := NewRepo(db)
 
-			expect := mock.ExpectExec(".*").
-				WithArgs(domain.Close, tt.mockArgs.pvzId, domain.InProgress)
-
-			if tt.mockArgs.err != nil {
-				expect.WillReturnError(tt.mockArgs.err)
+			expect := mock.ExpectQuery(".*").WithArgs("4601234567890")
+			if tt.err != nil {
+				expect.WillReturnError(tt.err)
 			} else {
-				expect.WillReturnResult(tt.mockArgs.result)
+				expect.WillReturnRows(tt.rows)
 			}
 
-			err = repo.CloseReceptionInPvz(context.Background(), &tt.mockArgs.pvzId)
+			infos, err := repo.ProductsByBarcode(context.Background(), "4601234567890")
 
-			if tt.wantErr {
-				assert.Error(t, err)
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+				assert.Nil(t, infos)
 			} else {
-				assert.NoError(t, err)
+				require.NoError(t, err)
+				require.Len(t, infos, tt.wantLen)
+			}
+			if tt.wantLen > 0 {
+				info := infos[0]
+				assert.Equal(t, prodId, info.Product.Id)
+				assert.Equal(t, "4601234567890", *info.Product.Barcode)
+				assert.Equal(t, 1200, *info.Product.WeightGrams)
+				assert.Nil(t, info.Product.Dimensions)
+				assert.Equal(t, recId, info.Reception.Id)
+				assert.Equal(t, domain.Close, info.Reception.Status)
+				assert.Equal(t, pvzId, info.Pvz.Id)
+				assert.Equal(t, domain.Moscow, info.Pvz.City)
+				assert.Equal(t, olderId, infos[1].Product.Id)
 			}
 			assert.NoError(t, mock.ExpectationsWereMet())
 		})
@@ -424,9 +2169,10 @@ func TestGetPvzsData(t *testing.T) {
 
 		rows := sqlmock.NewRows(
 			[]string{
-				"pvz_id", "pvz_city", "pvz_created_at", "rec_id",
-				"rec_status", "rec_date_time", "rec_pvz_id", "prod_id",
-				"prod_date_time", "prod_rec_id", "prod_type"})
+				"pvz_id", "pvz_city", "pvz_created_at", "pvz_version", "rec_id",
+				"rec_status", "rec_date_time", "rec_pvz_id", "rec_closed_at", "rec_auto_closed", "rec_version", "prod_id",
+				"prod_date_time", "prod_rec_id", "prod_type", "prod_barcode",
+				"prod_weight", "prod_length", "prod_width", "prod_height", "prod_status"})
 
 		mock.ExpectQuery(".*").WithArgs(driverArgs...).WillReturnRows(rows)
 
@@ -434,7 +2180,9 @@ func TestGetPvzsData(t *testing.T) {
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
-		assert.Empty(t, result)
+		assert.Empty(t, result.Items)
+		assert.Nil(t, result.NextCursor)
+		assert.Nil(t, result.Total)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
@@ -453,13 +2201,15 @@ func TestGetPvzsData(t *testing.T) {
 
 		rows := sqlmock.NewRows(
 			[]string{
-				"pvz_id", "pvz_city", "pvz_created_at", "rec_id",
-				"rec_status", "rec_date_time", "rec_pvz_id", "prod_id",
-				"prod_date_time", "prod_rec_id", "prod_type"}).
+				"pvz_id", "pvz_city", "pvz_created_at", "pvz_version", "rec_id",
+				"rec_status", "rec_date_time", "rec_pvz_id", "rec_closed_at", "rec_auto_closed", "rec_version", "prod_id",
+				"prod_date_time", "prod_rec_id", "prod_type", "prod_barcode",
+				"prod_weight", "prod_length", "prod_width", "prod_height", "prod_status"}).
 			AddRow(
-				pvzId, "Moscow", time.Now(), recId,
-				domain.InProgress, time.Now(), pvzId, prodId,
-				time.Now(), recId, domain.ProductTypeClothing)
+				pvzId, "Moscow", time.Now(), 1, recId,
+				domain.InProgress, time.Now(), pvzId, nil, false, 2, prodId,
+				time.Now(), recId, domain.ProductTypeClothing, "4601234567890",
+				1200, 300, 200, 100, domain.ProductInStorage)
 
 		mock.ExpectQuery(".*").WithArgs(driverArgs...).WillReturnRows(rows)
 
@@ -467,7 +2217,57 @@ func TestGetPvzsData(t *testing.T) {
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
-		assert.Len(t, result, 1)
+		assert.Len(t, result.Items, 1)
+		assert.Nil(t, result.NextCursor)
+		prod := result.Items[0].Receptions[0].Products[0]
+		assert.Equal(t, "4601234567890", *prod.Barcode)
+		assert.Equal(t, 1200, *prod.WeightGrams)
+		assert.Equal(t, &domain.ProductDimensions{LengthMm: 300, WidthMm: 200, HeightMm: 100}, prod.Dimensions)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("next cursor points to the last pvz of the page", func(t *testing.T) {
+		params := &domain.PvzsReadParams{Limit: 1, WithTotal: true}
+		firstId, secondId := uuid.New(), uuid.New()
+		firstAt := time.Now().Add(-time.Hour)
+
+		rows := sqlmock.NewRows(
+			[]string{
+				"pvz_id", "pvz_city", "pvz_created_at", "pvz_version", "rec_id",
+				"rec_status", "rec_date_time", "rec_pvz_id", "rec_closed_at", "rec_auto_closed", "rec_version", "prod_id",
+				"prod_date_time", "prod_rec_id", "prod_type", "prod_barcode",
+				"prod_weight", "prod_length", "prod_width", "prod_height", "prod_status"}).
+			AddRow(
+				firstId, "Moscow", firstAt, 1, nil, nil, nil, nil, nil, nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil).
+			AddRow(
+				secondId, "Kazan", time.Now(), 1, nil, nil, nil, nil, nil, nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		mock.ExpectQuery("WITH pvzs_ids").WillReturnRows(rows)
+		mock.ExpectQuery("COUNT").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+
+		result, err := repo.GetPvzsData(ctx, params)
+
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, firstId, result.Items[0].Pvz.Id)
+		assert.Equal(t, &domain.PvzsCursor{RegistrationDate: firstAt, City: "Moscow", Id: firstId}, result.NextCursor)
+		require.NotNil(t, result.Total)
+		assert.Equal(t, 5, *result.Total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("count error", func(t *testing.T) {
+		params := &domain.PvzsReadParams{Page: 1, Limit: 10, WithTotal: true}
+
+		mock.ExpectQuery("WITH pvzs_ids").WillReturnRows(sqlmock.NewRows([]string{"pvz_id"}))
+		mock.ExpectQuery("COUNT").WillReturnError(errors.New("db error"))
+
+		result, err := repo.GetPvzsData(ctx, params)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
@@ -519,13 +2319,15 @@ func TestGetPvzsData(t *testing.T) {
 		}
 
 		rows := sqlmock.NewRows(
-			[]string{"pvz_id", "pvz_city", "pvz_created_at", "rec_id",
-				"rec_status", "rec_date_time", "rec_pvz_id", "prod_id",
-				"prod_date_time", "prod_rec_id", "prod_type"}).
+			[]string{"pvz_id", "pvz_city", "pvz_created_at", "pvz_version", "rec_id",
+				"rec_status", "rec_date_time", "rec_pvz_id", "rec_closed_at", "rec_auto_closed", "rec_version", "prod_id",
+				"prod_date_time", "prod_rec_id", "prod_type", "prod_barcode",
+				"prod_weight", "prod_length", "prod_width", "prod_height", "prod_status"}).
 			AddRow(
-				uuid.New(), "Moscow", time.Now(), uuid.New(),
-				domain.InProgress, time.Now(), uuid.New(), uuid.New(),
-				time.Now(), uuid.New(), domain.ProductTypeClothing).
+				uuid.New(), "Moscow", time.Now(), 1, uuid.New(),
+				domain.InProgress, time.Now(), uuid.New(), nil, false, 1, uuid.New(),
+				time.Now(), uuid.New(), domain.ProductTypeClothing, nil,
+				nil, nil, nil, nil, nil).
 			RowError(0, errors.New("row error"))
 
 		mock.ExpectQuery(".*").WithArgs(driverArgs...).WillReturnRows(rows)
@@ -538,6 +2340,107 @@ func TestGetPvzsData(t *testing.T) {
 	})
 }
 
+func TestStreamPvzsData(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	require.NoError(t, err)
+	defer func(db *sql.DB) {
+		_ = db.Close()
+	}(db)
+
+	repo := NewRepo(db)
+	l, _ := logger.NewTestLogger()
+	ctx := logger.ToCtx(context.Background(), l)
+
+	columns := []string{
+		"pvz_id", "pvz_city", "pvz_created_at", "pvz_version", "rec_id",
+		"rec_status", "rec_date_time", "rec_pvz_id", "rec_closed_at", "rec_auto_closed", "rec_version", "prod_id",
+		"prod_date_time", "prod_rec_id", "prod_type", "prod_barcode",
+		"prod_weight", "prod_length", "prod_width", "prod_height", "prod_status",
+	}
+	firstId, secondId, thirdId := uuid.New(), uuid.New(), uuid.New()
+	recId := uuid.New()
+	firstAt, secondAt := time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour)
+	pageRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows(columns).
+			AddRow(
+				firstId, "Moscow", firstAt, 1, recId, domain.Close, firstAt, firstId, firstAt, false, 2, uuid.New(),
+				firstAt, recId, domain.ProductTypeClothing, nil, nil, nil, nil, nil, domain.ProductInStorage).
+			AddRow(
+				firstId, "Moscow", firstAt, 1, recId, domain.Close, firstAt, firstId, firstAt, false, 2, uuid.New(),
+				firstAt, recId, domain.ProductTypeFootwear, nil, nil, nil, nil, nil, domain.ProductInStorage).
+			AddRow(
+				secondId, "Kazan", secondAt, 1, nil, nil, nil, nil, nil, nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil).
+			AddRow(
+				thirdId, "Kazan", time.Now(), 1, nil, nil, nil, nil, nil, nil, nil, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	}
+
+	t.Run("yields complete pvzs and stops at the limit", func(t *testing.T) {
+		params := &domain.PvzsReadParams{Page: 1, Limit: 2, WithTotal: true}
+		mock.ExpectQuery("COUNT").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+		mock.ExpectQuery("WITH pvzs_ids").WillReturnRows(pageRows())
+
+		var got []*domain.PvzReceptions
+		page, err := repo.StreamPvzsData(ctx, params, func(p *domain.PvzReceptions) error {
+			got = append(got, p)
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, firstId, got[0].Pvz.Id)
+		require.Len(t, got[0].Receptions, 1)
+		assert.Len(t, got[0].Receptions[0].Products, 2)
+		assert.Equal(t, secondId, got[1].Pvz.Id)
+		assert.Equal(t, &domain.PvzsCursor{RegistrationDate: secondAt, City: "Kazan", Id: secondId}, page.NextCursor)
+		require.NotNil(t, page.Total)
+		assert.Equal(t, 3, *page.Total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("last page flushes the last pvz", func(t *testing.T) {
+		params := &domain.PvzsReadParams{Page: 1, Limit: 10}
+		mock.ExpectQuery("WITH pvzs_ids").WillReturnRows(pageRows())
+
+		sent := 0
+		page, err := repo.StreamPvzsData(ctx, params, func(*domain.PvzReceptions) error {
+			sent++
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, sent)
+		assert.Nil(t, page.NextCursor)
+		assert.Nil(t, page.Total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("yield error", func(t *testing.T) {
+		params := &domain.PvzsReadParams{Page: 1, Limit: 10}
+		mock.ExpectQuery("WITH pvzs_ids").WillReturnRows(pageRows())
+
+		page, err := repo.StreamPvzsData(ctx, params, func(*domain.PvzReceptions) error {
+			return errors.New("client gone")
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, page)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		params := &domain.PvzsReadParams{Page: 1, Limit: 10}
+		mock.ExpectQuery("WITH pvzs_ids").WillReturnError(errors.New("db error"))
+
+		page, err := repo.StreamPvzsData(ctx, params, func(*domain.PvzReceptions) error { return nil })
+
+		assert.Error(t, err)
+		assert.Nil(t, page)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestGetAllPvzs(t *testing.T) {
 	t.Parallel()
 
@@ -553,10 +2456,10 @@ func TestGetAllPvzs(t *testing.T) {
 		{
 			name: "success - multiple pvzs",
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "created_at", "city"}).
-					AddRow(uuid.New(), time.Now(), "Moscow").
-					AddRow(uuid.New(), time.Now(), "Kazan")
-				mock.ExpectQuery("SELECT id, created_at, city FROM pvzs").WillReturnRows(rows)
+				rows := sqlmock.NewRows([]string{"id", "created_at", "city", "version"}).
+					AddRow(uuid.New(), time.Now(), "Moscow", 1).
+					AddRow(uuid.New(), time.Now(), "Kazan", 1)
+				mock.ExpectQuery("SELECT pvz.id, pvz.created_at, pvz.city, pvz.version FROM pvzs AS pvz ORDER BY pvz.created_at ASC, pvz.id ASC").WillReturnRows(rows)
 			},
 			wantErr: false,
 			assert: func(t *testing.T, pvzs []*domain.Pvz) {
@@ -566,8 +2469,8 @@ func TestGetAllPvzs(t *testing.T) {
 		{
 			name: "success - no pvzs",
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "created_at", "city"})
-				mock.ExpectQuery("SELECT id, created_at, city FROM pvzs").WillReturnRows(rows)
+				rows := sqlmock.NewRows([]string{"id", "created_at", "city", "version"})
+				mock.ExpectQuery("SELECT pvz.id, pvz.created_at, pvz.city, pvz.version FROM pvzs AS pvz ORDER BY pvz.created_at ASC, pvz.id ASC").WillReturnRows(rows)
 			},
 			wantErr: false,
 			assert: func(t *testing.T, pvzs []*domain.Pvz) {
@@ -577,7 +2480,7 @@ func TestGetAllPvzs(t *testing.T) {
 		{
 			name: "query error",
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, created_at, city FROM pvzs").WillReturnError(errors.New("db error"))
+				mock.ExpectQuery("SELECT pvz.id, pvz.created_at, pvz.city, pvz.version FROM pvzs AS pvz ORDER BY pvz.created_at ASC, pvz.id ASC").WillReturnError(errors.New("db error"))
 			},
 			wantErr: true,
 			assert:  func(t *testing.T, pvzs []*domain.Pvz) {},
@@ -585,9 +2488,9 @@ func TestGetAllPvzs(t *testing.T) {
 		{
 			name: "scan error",
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "created_at", "city"}).
-					AddRow("not-a-uuid", time.Now(), "Moscow")
-				mock.ExpectQuery("SELECT id, created_at, city FROM pvzs").WillReturnRows(rows)
+				rows := sqlmock.NewRows([]string{"id", "created_at", "city", "version"}).
+					AddRow("not-a-uuid", time.Now(), "Moscow", 1)
+				mock.ExpectQuery("SELECT pvz.id, pvz.created_at, pvz.city, pvz.version FROM pvzs AS pvz ORDER BY pvz.created_at ASC, pvz.id ASC").WillReturnRows(rows)
 			},
 			wantErr: true,
 			assert:  func(t *testing.T, pvzs []*domain.Pvz) {},
@@ -595,10 +2498,10 @@ func TestGetAllPvzs(t *testing.T) {
 		{
 			name: "rows error",
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "created_at", "city"}).
-					AddRow(uuid.New(), time.Now(), "Moscow").
+				rows := sqlmock.NewRows([]string{"id", "created_at", "city", "version"}).
+					AddRow(uuid.New(), time.Now(), "Moscow", 1).
 					RowError(0, errors.New("rows error"))
-				mock.ExpectQuery("SELECT id, created_at, city FROM pvzs").WillReturnRows(rows)
+				mock.ExpectQuery("SELECT pvz.id, pvz.created_at, pvz.city, pvz.version FROM pvzs AS pvz ORDER BY pvz.created_at ASC, pvz.id ASC").WillReturnRows(rows)
 			},
 			wantErr: true,
 			assert:  func(t *testing.T, pvzs []*domain.Pvz) {},
@@ -618,7 +2521,7 @@ func TestGetAllPvzs(t *testing.T) {
 			repo := NewRepo(db)
 			tt.setup(mock)
 
-			result, err := repo.GetAllPvzs(ctx)
+			result, err := repo.GetAllPvzs(ctx, &domain.PvzsFilter{}, domain.PvzsSort{})
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -632,3 +2535,178 @@ func TestGetAllPvzs(t *testing.T) {
 		})
 	}
 }
+
+func TestPvzStock(t *testing.T) {
+	t.Parallel()
+
+	pvzId := uuid.New()
+
+	tests := []struct {
+		name     string
+		setup    func(mock sqlmock.Sqlmock)
+		wantKind pRepo.RepoErrKind
+	}{
+		{
+			name: "success",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM\\s+pvzs").WithArgs(&pvzId).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "products_on_hand"}).AddRow(pvzId, 42))
+			},
+		},
+		{
+			name: "pvz not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM\\s+pvzs").WillReturnError(sql.ErrNoRows)
+			},
+			wantKind: pRepo.NotFound,
+		},
+		{
+			name: "unexpected error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM\\s+pvzs").WillReturnError(errors.New("db error"))
+			},
+			wantKind: pRepo.Unexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			tt.setup(mock)
+
+			stock, err := NewRepo(db).PvzStock(context.Background(), &pvzId)
+
+			if tt.wantKind != "" {
+				var rErr *xerr.BaseErr[pRepo.RepoErrKind]
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantKind, rErr.Kind)
+				assert.Nil(t, stock)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, &domain.PvzStock{PvzId: pvzId, OnHand: 42}, stock)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAllPvzsStock(t *testing.T) {
+	t.Parallel()
+
+	l, _ := logger.NewTestLogger()
+	ctx := logger.ToCtx(context.Background(), l)
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr bool
+		wantLen int
+	}{
+		{
+			name: "success",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "products_on_hand"}).
+					AddRow(uuid.New(), 3).
+					AddRow(uuid.New(), 0)
+				mock.ExpectQuery("FROM\\s+pvzs").WillReturnRows(rows)
+			},
+			wantLen: 2,
+		},
+		{
+			name: "query error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM\\s+pvzs").WillReturnError(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "scan error",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "products_on_hand"}).AddRow("not-a-uuid", 1)
+				mock.ExpectQuery("FROM\\s+pvzs").WillReturnRows(rows)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			tt.setup(mock)
+
+			stocks, err := NewRepo(db).AllPvzsStock(ctx)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, stocks)
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, stocks, tt.wantLen)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCountReceptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "success",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM\\s+receptions").
+					WithArgs(domain.InProgress).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+			},
+			want: 4,
+		},
+		{
+			name: "query error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM\\s+receptions").WillReturnError(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func(db *sql.DB) { _ = db.Close() }(db)
+
+			tt.setup(mock)
+			metrics := metricsmocks.NewMockCollector(t)
+			metrics.EXPECT().ObserveDBQueryDuration("CountReceptions", mockpkg.AnythingOfType("float64")).Return()
+
+			n, err := NewRepo(db, WithMetrics(metrics)).CountReceptions(context.Background(), domain.InProgress)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, n)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -2,8 +2,11 @@ package repository
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 	"github.com/shrtyk/pvz-service/internal/core/domain"
 )
 
@@ -16,7 +19,7 @@ const (
 		VALUES
 			($1)
 		RETURNING
-			id, created_at
+			id, created_at, version
 	`
 
 	createReceptionQuery query = `
@@ -25,47 +28,496 @@ const (
 		VALUES
 			($1)
 		RETURNING
-			id, created_at, status
+			id, created_at, status, version
 	`
 
 	createProductQuery query = `
 		INSERT INTO products
-	 		(reception_id, type)
+	 		(reception_id, type, barcode, weight_grams, length_mm, width_mm, height_mm, barcode_unique)
 		VALUES(
-			(SELECT id FROM receptions WHERE pvz_id = $1 AND status = $2), $3
+			(SELECT id FROM receptions WHERE pvz_id = $1 AND status = $2), $3, $4, $5, $6, $7, $8, $9
 		)
 		RETURNING
-			id, added_at, reception_id, type
+			id, added_at, reception_id, type, status
+	`
+
+	// reservePvzStockQuery takes $2 places in the PVZ unless it would go over
+	// capacity $3, zero capacity means no limit. The stock is counted apart from
+	// the PVZ record and leaves its version alone, as do the releases below.
+	reservePvzStockQuery query = `
+		UPDATE
+			pvzs
+		SET
+			products_on_hand = products_on_hand + $2
+		WHERE
+			id = $1 AND ($3 = 0 OR products_on_hand + $2 <= $3)
+	`
+
+	lockPvzStockQuery query = `
+		SELECT
+			products_on_hand
+		FROM
+			pvzs
+		WHERE
+			id = $1
+		FOR UPDATE
+	`
+
+	lockActiveReceptionQuery query = `
+		SELECT
+			id
+		FROM
+			receptions
+		WHERE
+			pvz_id = $1 AND status = $2
+		FOR UPDATE
+	`
+
+	// productsByBarcodeQuery finds every product with the barcode. There may be
+	// several of them, one per reception, while barcodes aren't globally unique.
+	productsByBarcodeQuery query = `
+		SELECT
+			p.id, p.added_at, p.reception_id, p.type,
+			p.barcode, p.weight_grams, p.length_mm, p.width_mm, p.height_mm, p.status,
+			r.status, r.created_at,
+			pvz.id, pvz.city, pvz.created_at
+		FROM
+			products AS p
+		JOIN receptions AS r
+			ON r.id = p.reception_id
+		JOIN pvzs AS pvz
+			ON pvz.id = r.pvz_id
+		WHERE
+			p.barcode = $1 AND p.deleted_at IS NULL
+		ORDER BY
+			p.added_at DESC, p.id
 	`
 
 	deleteLastProductQuery query = `
-		DELETE FROM
+		WITH deleted AS (
+			UPDATE
+				products
+			SET
+				deleted_at = NOW()
+			WHERE
+				id = (
+				SELECT id FROM products
+				WHERE reception_id = (
+					SELECT id FROM receptions WHERE pvz_id = $1 AND status = $2
+				) AND deleted_at IS NULL
+				ORDER BY added_at DESC
+				LIMIT 1
+			) AND deleted_at IS NULL
+			RETURNING
+				id, deleted_at, reception_id, status
+		), released AS (
+			UPDATE
+				pvzs
+			SET
+				products_on_hand = products_on_hand - 1
+			FROM
+				deleted AS d
+			JOIN receptions AS r
+				ON r.id = d.reception_id
+			WHERE
+				pvzs.id = r.pvz_id AND d.status = $4
+		)
+		INSERT INTO product_deletions
+			(product_id, deleted_by, deleted_at)
+		SELECT
+			id, $3, deleted_at
+		FROM
+			deleted
+	`
+
+	lockProductWithReceptionStatusQuery query = `
+		SELECT
+			r.status, p.deleted_at
+		FROM
+			products AS p
+		JOIN receptions AS r
+			ON r.id = p.reception_id
+		WHERE
+			p.id = $1
+		FOR UPDATE OF p
+	`
+
+	deleteProductQuery query = `
+		WITH deleted AS (
+			UPDATE
+				products
+			SET
+				deleted_at = NOW()
+			WHERE
+				id = $1 AND deleted_at IS NULL
+			RETURNING
+				id, deleted_at, reception_id, status
+		), released AS (
+			UPDATE
+				pvzs
+			SET
+				products_on_hand = products_on_hand - 1
+			FROM
+				deleted AS d
+			JOIN receptions AS r
+				ON r.id = d.reception_id
+			WHERE
+				pvzs.id = r.pvz_id AND d.status = $3
+		)
+		INSERT INTO product_deletions
+			(product_id, deleted_by, deleted_at)
+		SELECT
+			id, $2, deleted_at
+		FROM
+			deleted
+	`
+
+	restoreProductQuery query = `
+		WITH restored AS (
+			UPDATE
+				products
+			SET
+				deleted_at = NULL
+			WHERE
+				id = $1
+			RETURNING
+				id, added_at, reception_id, type, barcode, weight_grams, length_mm, width_mm, height_mm, status
+		)
+		SELECT
+			rs.id, rs.added_at, rs.reception_id, rs.type, rs.barcode,
+			rs.weight_grams, rs.length_mm, rs.width_mm, rs.height_mm, rs.status,
+			r.pvz_id
+		FROM
+			restored AS rs
+		JOIN receptions AS r
+			ON r.id = rs.reception_id
+	`
+
+	markProductDeletionRestoredQuery query = `
+		UPDATE
+			product_deletions
+		SET
+			restored_at = NOW()
+		WHERE
+			product_id = $1 AND restored_at IS NULL
+	`
+
+	getProductDeletionsQuery query = `
+		SELECT
+			d.id, d.deleted_by, d.deleted_at, d.restored_at,
+			p.id, p.added_at, p.reception_id, p.type,
+			p.barcode, p.weight_grams, p.length_mm, p.width_mm, p.height_mm, p.status
+		FROM
+			product_deletions AS d
+		JOIN products AS p
+			ON p.id = d.product_id
+		WHERE
+			p.reception_id = $1
+		ORDER BY
+			d.deleted_at DESC
+	`
+
+	productStorageStateQuery query = `
+		SELECT
+			p.id, p.added_at, p.reception_id, p.type,
+			p.barcode, p.weight_grams, p.length_mm, p.width_mm, p.height_mm, p.status,
+			p.pickup_code_hash, p.pickup_code_expires_at, p.pickup_code_attempts, r.status, r.pvz_id
+		FROM
+			products AS p
+		JOIN receptions AS r
+			ON r.id = p.reception_id
+		WHERE
+			p.id = $1 AND p.deleted_at IS NULL
+	`
+
+	// setProductPickupCodeQuery replaces the pickup code, giving the new one a
+	// fresh set of attempts.
+	setProductPickupCodeQuery query = `
+		UPDATE
 			products
+		SET
+			pickup_code_hash = $2, pickup_code_expires_at = $4, pickup_code_attempts = 0
 		WHERE
-			id = (
-			SELECT id FROM products
-			WHERE reception_id = (
-				SELECT id FROM receptions WHERE pvz_id = $1 AND status = $2
-			)
-			ORDER BY added_at DESC
-			LIMIT 1
+			id = $1 AND status = $3 AND deleted_at IS NULL
+	`
+
+	// takePickupCodeAttemptQuery counts an attempt to enter the pickup code before
+	// it is checked, so concurrent guesses can't go over the limit $3, zero means
+	// no limit. Nothing is returned once the code has expired or is locked.
+	takePickupCodeAttemptQuery query = `
+		UPDATE
+			products
+		SET
+			pickup_code_attempts = pickup_code_attempts + 1
+		WHERE
+			id = $1 AND status = $2 AND deleted_at IS NULL AND
+			pickup_code_hash IS NOT NULL AND
+			($3 = 0 OR pickup_code_attempts < $3) AND
+			(pickup_code_expires_at IS NULL OR pickup_code_expires_at > NOW())
+		RETURNING
+			pickup_code_hash
+	`
+
+	// updateProductStatusQuery moves a product out of status $3, records the change
+	// in its status history and frees its place in the PVZ. The pickup code is single
+	// use, so it is dropped as well.
+	updateProductStatusQuery query = `
+		WITH updated AS (
+			UPDATE
+				products
+			SET
+				status = $2, pickup_code_hash = NULL, pickup_code_expires_at = NULL, pickup_code_attempts = 0
+			WHERE
+				id = $1 AND status = $3 AND deleted_at IS NULL
+			RETURNING
+				id, added_at, reception_id, type, barcode, weight_grams, length_mm, width_mm, height_mm, status
+		), logged AS (
+			INSERT INTO product_status_history
+				(product_id, status, changed_by)
+			SELECT
+				id, status, $4
+			FROM
+				updated
+		), released AS (
+			UPDATE
+				pvzs
+			SET
+				products_on_hand = products_on_hand - 1
+			FROM
+				updated AS u
+			JOIN receptions AS r
+				ON r.id = u.reception_id
+			WHERE
+				pvzs.id = r.pvz_id
 		)
+		SELECT
+			id, added_at, reception_id, type, barcode, weight_grams, length_mm, width_mm, height_mm, status
+		FROM
+			updated
 	`
 
+	// productStatusHistoryQuery starts the history with the product being put in storage
+	// when it was scanned.
+	productStatusHistoryQuery query = `
+		SELECT
+			$2::product_statuses, added_at, NULL::uuid
+		FROM
+			products
+		WHERE
+			id = $1
+		UNION ALL
+		SELECT
+			status, changed_at, changed_by
+		FROM
+			product_status_history
+		WHERE
+			product_id = $1
+		ORDER BY
+			2
+	`
+
+	// closeReceptionPvzQuery closes the reception in progress of PVZ $2. When $4 is
+	// not NULL, the reception must have version $4.
 	closeReceptionPvzQuery query = `
 		UPDATE
 			receptions
 		SET
-			status = $1
+			status = $1, closed_at = NOW(), version = version + 1
 		WHERE
 			id = (SELECT id FROM receptions WHERE pvz_id = $2 AND status = $3)
+			AND ($4::int IS NULL OR version = $4)
+		RETURNING
+			id, created_at, pvz_id, status, closed_at, auto_closed, version
+	`
+
+	cancelReceptionPvzQuery query = `
+		UPDATE
+			receptions
+		SET
+			status = $1, version = version + 1
+		WHERE
+			id = (SELECT id FROM receptions WHERE pvz_id = $2 AND status = $3)
+			AND ($4::int IS NULL OR version = $4)
+		RETURNING
+			id, created_at, pvz_id, status, closed_at, auto_closed, version
+	`
+
+	activeReceptionVersionQuery query = `
+		SELECT
+			version
+		FROM
+			receptions
+		WHERE
+			pvz_id = $1 AND status = $2
+	`
+
+	// reopenReceptionQuery reopens a reception only if it is closed, was closed after $4
+	// and is the latest not cancelled reception of its PVZ. When $6 is not NULL,
+	// the reception must have version $6.
+	reopenReceptionQuery query = `
+		UPDATE
+			receptions AS r
+		SET
+			status = $2, closed_at = NULL, auto_closed = FALSE, version = r.version + 1
+		WHERE
+			r.id = $1 AND r.status = $3 AND r.closed_at >= $4
+			AND ($6::int IS NULL OR r.version = $6)
+			AND NOT EXISTS (
+				SELECT 1 FROM receptions AS later
+				WHERE later.pvz_id = r.pvz_id AND later.created_at > r.created_at AND later.status <> $5
+			)
+		RETURNING
+			r.id, r.created_at, r.pvz_id, r.status, r.closed_at, r.auto_closed, r.version
+	`
+
+	tryAdvisoryXactLockQuery query = `
+		SELECT pg_try_advisory_xact_lock($1)
 	`
 
-	getAllPvzsQuery query = `
+	// autoCloseStaleReceptionsQuery closes receptions in progress whose last activity,
+	// either opening or the latest scanned product, happened before $3.
+	autoCloseStaleReceptionsQuery query = `
+		UPDATE
+			receptions AS r
+		SET
+			status = $1, closed_at = NOW(), auto_closed = TRUE, version = r.version + 1
+		WHERE
+			r.status = $2
+			AND r.created_at < $3
+			AND NOT EXISTS (
+				SELECT 1 FROM products AS p
+				WHERE p.reception_id = r.id AND p.added_at >= $3
+			)
+		RETURNING
+			r.id, r.created_at, r.pvz_id, r.status, r.closed_at, r.auto_closed, r.version
+	`
+
+	receptionStatusQuery query = `
 		SELECT
-			id, created_at, city
+			status, version
+		FROM
+			receptions
+		WHERE
+			id = $1
+	`
+
+	lockReceptionStatusQuery query = `
+		SELECT
+			status, version
+		FROM
+			receptions
+		WHERE
+			id = $1
+		FOR UPDATE
+	`
+
+	bumpReceptionVersionQuery query = `
+		UPDATE
+			receptions
+		SET
+			version = version + 1
+		WHERE
+			id = $1
+		RETURNING
+			version
+	`
+
+	receptionQuery query = `
+		SELECT
+			id, created_at, pvz_id, status, closed_at, auto_closed, version
+		FROM
+			receptions
+		WHERE
+			id = $1
+	`
+
+	updatePvzQuery query = `
+		UPDATE pvzs
+		SET
+			city = $2,
+			version = version + 1
+		WHERE
+			id = $1 AND ($3::int IS NULL OR version = $3)
+		RETURNING
+			id, created_at, city, version
+	`
+
+	pvzQuery query = `
+		SELECT
+			id, created_at, city, version
 		FROM
 			pvzs
+		WHERE
+			id = $1
+	`
+
+	deleteReceptionManifestQuery query = `
+		DELETE FROM
+			reception_manifest_items
+		WHERE
+			reception_id = $1
+	`
+
+	countReceptionManifestQuery query = `
+		SELECT
+			COUNT(*)
+		FROM
+			reception_manifest_items
+		WHERE
+			reception_id = $1
+	`
+
+	// receptionManifestDiffQuery returns expected barcodes that never arrived ('missing')
+	// and scanned barcodes that were not expected ('unexpected').
+	receptionManifestDiffQuery query = `
+		SELECT
+			'missing', m.barcode
+		FROM
+			reception_manifest_items AS m
+		WHERE
+			m.reception_id = $1
+			AND NOT EXISTS (
+				SELECT 1 FROM products AS p
+				WHERE p.reception_id = m.reception_id AND p.barcode = m.barcode AND p.deleted_at IS NULL
+			)
+		UNION ALL
+		SELECT
+			'unexpected', p.barcode
+		FROM
+			products AS p
+		WHERE
+			p.reception_id = $1 AND p.barcode IS NOT NULL AND p.deleted_at IS NULL
+			AND NOT EXISTS (
+				SELECT 1 FROM reception_manifest_items AS m
+				WHERE m.reception_id = p.reception_id AND m.barcode = p.barcode
+			)
+		ORDER BY
+			1, 2
+	`
+
+	pvzStockQuery query = `
+		SELECT
+			id, products_on_hand
+		FROM
+			pvzs
+		WHERE
+			id = $1
+	`
+
+	allPvzsStockQuery query = `
+		SELECT
+			id, products_on_hand
+		FROM
+			pvzs
+	`
+
+	countReceptionsQuery query = `
+		SELECT
+			count(*)
+		FROM
+			receptions
+		WHERE
+			status = $1
 	`
 
 	insertUserQuery query = `
@@ -113,29 +565,184 @@ const (
 		WHERE
 			token_hash = $1
 	`
+
+	insertExportJobQuery query = `
+		INSERT INTO export_jobs
+			(format, params, created_by)
+		VALUES
+			($1, $2, $3)
+		RETURNING
+			id, format, params, status, created_by, created_at, finished_at, error
+	`
+
+	exportJobQuery query = `
+		SELECT
+			id, format, params, status, created_by, created_at, finished_at, error
+		FROM
+			export_jobs
+		WHERE
+			id = $1
+	`
+
+	// claimExportJobQuery moves the oldest pending job to running. Jobs picked
+	// by another instance are skipped instead of waited for.
+	claimExportJobQuery query = `
+		UPDATE
+			export_jobs
+		SET
+			status = 'running', started_at = NOW()
+		WHERE id = (
+			SELECT
+				id
+			FROM
+				export_jobs
+			WHERE
+				status = 'pending'
+			ORDER BY
+				created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING
+			id, format, params, status, created_by, created_at, finished_at, error
+	`
+
+	advisoryXactLockQuery query = `
+		SELECT pg_advisory_xact_lock($1)
+	`
+
+	deleteDailyProductStatsQuery query = `
+		DELETE FROM
+			daily_product_stats
+		WHERE
+			day >= $1 AND day < $2
+	`
+
+	// insertDailyProductStatsQuery rolls up products scanned within [$1, $2), both UTC midnights.
+	insertDailyProductStatsQuery query = `
+		INSERT INTO daily_product_stats
+			(day, pvz_id, product_type, products)
+		SELECT
+			(p.added_at AT TIME ZONE 'UTC')::date, r.pvz_id, p.type, COUNT(*)
+		FROM
+			products AS p
+		JOIN
+			receptions AS r ON r.id = p.reception_id
+		WHERE
+			p.deleted_at IS NULL AND p.added_at >= $1 AND p.added_at < $2
+		GROUP BY
+			1, 2, 3
+	`
+
+	deleteDailyReceptionStatsQuery query = `
+		DELETE FROM
+			daily_reception_stats
+		WHERE
+			day >= $1 AND day < $2
+	`
+
+	// insertDailyReceptionStatsQuery rolls up closed receptions opened within [$1, $2).
+	// Receptions without products count as zero products.
+	insertDailyReceptionStatsQuery query = `
+		INSERT INTO daily_reception_stats
+			(day, pvz_id, receptions, total_duration_seconds, total_products)
+		SELECT
+			(r.created_at AT TIME ZONE 'UTC')::date,
+			r.pvz_id,
+			COUNT(*),
+			SUM(EXTRACT(EPOCH FROM r.closed_at - r.created_at)),
+			SUM(pc.products)
+		FROM
+			receptions AS r
+		CROSS JOIN LATERAL (
+			SELECT
+				COUNT(*) AS products
+			FROM
+				products AS p
+			WHERE
+				p.reception_id = r.id AND p.deleted_at IS NULL
+		) AS pc
+		WHERE
+			r.status = 'close' AND r.closed_at IS NOT NULL AND r.created_at >= $1 AND r.created_at < $2
+		GROUP BY
+			1, 2
+	`
+
+	finishExportJobQuery query = `
+		UPDATE
+			export_jobs
+		SET
+			status = $2, error = $3, finished_at = NOW()
+		WHERE
+			id = $1 AND status = 'running'
+	`
+
+	// deleteStaleIdempotencyKeysQuery drops the expired keys of the user and the given key
+	// if it was reserved before $4 and never got a response, e.g. because the instance
+	// processing it went down.
+	deleteStaleIdempotencyKeysQuery query = `
+		DELETE FROM
+			idempotency_keys
+		WHERE
+			user_id = $1
+			AND (expires_at <= $3 OR (key = $2 AND status_code IS NULL AND created_at < $4))
+	`
+
+	insertIdempotencyKeyQuery query = `
+		INSERT INTO idempotency_keys
+			(user_id, key, fingerprint, created_at, expires_at)
+		VALUES
+			($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, key) DO NOTHING
+	`
+
+	idempotencyKeyQuery query = `
+		SELECT
+			fingerprint, status_code, headers, body, created_at, expires_at
+		FROM
+			idempotency_keys
+		WHERE
+			user_id = $1 AND key = $2
+	`
+
+	saveIdempotentResponseQuery query = `
+		UPDATE
+			idempotency_keys
+		SET
+			status_code = $4, headers = $5, body = $6
+		WHERE
+			user_id = $1 AND key = $2 AND fingerprint = $3 AND status_code IS NULL
+	`
+
+	deleteIdempotencyKeyQuery query = `
+		DELETE FROM
+			idempotency_keys
+		WHERE
+			user_id = $1 AND key = $2 AND fingerprint = $3 AND status_code IS NULL
+	`
 )
 
+// buildGetPvzDataQuery selects one PVZ more than params.Limit, so the caller can tell
+// whether there is a next page.
 func buildGetPvzDataQuery(params *domain.PvzsReadParams) (string, []any, error) {
-	sb := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
-	sub := sb.
-		Select("DISTINCT pvz.id").
-		From("pvzs AS pvz")
-
-	if params.StartDate != nil || params.EndDate != nil {
-		sub = sub.Join("receptions AS r ON pvz.id = r.pvz_id")
-		if params.StartDate != nil {
-			sub = sub.Where("r.created_at >= ?", params.StartDate)
-		}
-		if params.EndDate != nil {
-			sub = sub.Where("r.created_at <= ?", params.EndDate)
+	keys, dir := pvzsSortKeys(params.Sort)
+	sub := filterPvzs(
+		sq.StatementBuilder.PlaceholderFormat(sq.Dollar).Select("pvz.id").From("pvzs AS pvz"),
+		&params.PvzsFilter,
+	)
+
+	if c := params.Cursor; c != nil {
+		sub = sub.Where(pvzsAfterCursor(params.Sort, c))
+	} else {
+		offset := params.Offset
+		if offset == 0 {
+			offset = (params.Page - 1) * params.Limit
 		}
+		sub = sub.Offset(uint64(offset))
 	}
-
-	offset := (params.Page - 1) * params.Limit
 	sub = sub.
-		OrderBy("pvz.id").
-		Limit(uint64(params.Limit)).
-		Offset(uint64(offset))
+		OrderBy(orderByClauses(keys, dir)...).
+		Limit(uint64(params.Limit + 1))
 
 	subSQL, subArgs, err := sub.ToSql()
 	if err != nil {
@@ -145,19 +752,278 @@ func buildGetPvzDataQuery(params *domain.PvzsReadParams) (string, []any, error)
 	mainSQL := fmt.Sprintf(`
 WITH pvzs_ids AS (%s)
 SELECT
-	pvz.id, pvz.city, pvz.created_at,
-	r.id, r.status, r.created_at, r.pvz_id,
-	p.id, p.added_at, p.reception_id, p.type
+	pvz.id, pvz.city, pvz.created_at, pvz.version,
+	r.id, r.status, r.created_at, r.pvz_id, r.closed_at, r.auto_closed, r.version,
+	p.id, p.added_at, p.reception_id, p.type,
+	p.barcode, p.weight_grams, p.length_mm, p.width_mm, p.height_mm, p.status
 FROM pvzs AS pvz
 LEFT JOIN receptions AS r
 	ON pvz.id = r.pvz_id
 LEFT JOIN products AS p
-	ON p.reception_id = r.id
+	ON p.reception_id = r.id AND p.deleted_at IS NULL
 WHERE
 	pvz.id IN (SELECT id FROM pvzs_ids)
 ORDER BY
-	pvz.id, r.id, p.id
-`, subSQL)
+	%s, r.id, p.id
+`, subSQL, strings.Join(orderByClauses(keys, dir), ", "))
 
 	return mainSQL, subArgs, nil
 }
+
+func buildCountPvzsQuery(filter *domain.PvzsFilter) (string, []any, error) {
+	return filterPvzs(
+		sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+			Select("COUNT(*)").
+			From("pvzs AS pvz"),
+		filter,
+	).ToSql()
+}
+
+func buildGetAllPvzsQuery(filter *domain.PvzsFilter, sort domain.PvzsSort) (string, []any, error) {
+	keys, dir := pvzsSortKeys(sort)
+	return filterPvzs(
+		sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+			Select("pvz.id", "pvz.created_at", "pvz.city", "pvz.version").
+			From("pvzs AS pvz"),
+		filter,
+	).OrderBy(orderByClauses(keys, dir)...).ToSql()
+}
+
+func filterPvzs(q sq.SelectBuilder, f *domain.PvzsFilter) sq.SelectBuilder {
+	if len(f.Cities) > 0 {
+		q = q.Where(sq.Eq{"pvz.city": f.Cities})
+	}
+	if f.RegisteredFrom != nil {
+		q = q.Where("pvz.created_at >= ?", f.RegisteredFrom)
+	}
+	if f.RegisteredTo != nil {
+		q = q.Where("pvz.created_at <= ?", f.RegisteredTo)
+	}
+
+	if rec, ok := matchingReception(f); ok {
+		q = q.Where(exists(rec, true))
+	}
+
+	if f.HasOpenReception != nil {
+		open := sq.Select("1").
+			From("receptions AS o").
+			Where("o.pvz_id = pvz.id").
+			Where(sq.Eq{"o.status": domain.InProgress})
+		q = q.Where(exists(open, *f.HasOpenReception))
+	}
+
+	return q
+}
+
+// matchingReception builds a subquery for a reception of the PVZ that satisfies
+// all reception filters. It reports false if there are no such filters.
+func matchingReception(f *domain.PvzsFilter) (sq.SelectBuilder, bool) {
+	rec := sq.Select("1").From("receptions AS r").Where("r.pvz_id = pvz.id")
+	filtered := false
+
+	if f.StartDate != nil {
+		rec, filtered = rec.Where("r.created_at >= ?", f.StartDate), true
+	}
+	if f.EndDate != nil {
+		rec, filtered = rec.Where("r.created_at <= ?", f.EndDate), true
+	}
+	if len(f.ReceptionStatuses) > 0 {
+		rec, filtered = rec.Where(sq.Eq{"r.status": f.ReceptionStatuses}), true
+	}
+	if len(f.ProductTypes) > 0 {
+		prod := sq.Select("1").
+			From("products AS p").
+			Where("p.reception_id = r.id AND p.deleted_at IS NULL").
+			Where(sq.Eq{"p.type": f.ProductTypes})
+		rec, filtered = rec.Where(exists(prod, true)), true
+	}
+
+	return rec, filtered
+}
+
+// existsExpr renders a correlated subquery as an EXISTS or NOT EXISTS condition.
+type existsExpr struct {
+	sub  sq.SelectBuilder
+	want bool
+}
+
+func exists(sub sq.SelectBuilder, want bool) existsExpr {
+	return existsExpr{sub: sub, want: want}
+}
+
+func (e existsExpr) ToSql() (string, []any, error) {
+	subSQL, args, err := e.sub.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !e.want {
+		return "NOT EXISTS (" + subSQL + ")", args, nil
+	}
+	return "EXISTS (" + subSQL + ")", args, nil
+}
+
+// pvzsSortKeys returns the columns PVZs are ordered by, the last one is always the id.
+func pvzsSortKeys(sort domain.PvzsSort) ([]string, string) {
+	dir := "ASC"
+	if sort.Desc {
+		dir = "DESC"
+	}
+
+	if sort.Field == domain.PvzsSortByCity {
+		return []string{"pvz.city", "pvz.id"}, dir
+	}
+	return []string{"pvz.created_at", "pvz.id"}, dir
+}
+
+func orderByClauses(keys []string, dir string) []string {
+	res := make([]string, len(keys))
+	for i, k := range keys {
+		res[i] = k + " " + dir
+	}
+	return res
+}
+
+func pvzsAfterCursor(sort domain.PvzsSort, c *domain.PvzsCursor) sq.Sqlizer {
+	keys, _ := pvzsSortKeys(sort)
+	op := ">"
+	if sort.Desc {
+		op = "<"
+	}
+
+	var key any = c.RegistrationDate
+	if sort.Field == domain.PvzsSortByCity {
+		key = c.City
+	}
+
+	return sq.Expr(fmt.Sprintf("(%s) %s (?, ?)", strings.Join(keys, ", "), op), key, c.Id)
+}
+
+// analyticsKeys are the expressions rollups are grouped by. Cities need the pvzs table joined.
+var analyticsKeys = map[domain.AnalyticsGroup]string{
+	domain.AnalyticsByPvz:         "s.pvz_id::text",
+	domain.AnalyticsByCity:        "pvz.city::text",
+	domain.AnalyticsByProductType: "s.product_type::text",
+}
+
+var analyticsPeriods = map[domain.AnalyticsPeriod]string{
+	domain.AnalyticsDay:  "day",
+	domain.AnalyticsWeek: "week",
+}
+
+// buildProductsThroughputQuery sums daily product rollups per period and group.
+// Weeks start on Monday.
+func buildProductsThroughputQuery(params *domain.ThroughputParams) (string, []any, error) {
+	key, ok := analyticsKeys[params.GroupBy]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown analytics group: %q", params.GroupBy)
+	}
+	period, ok := analyticsPeriods[params.Period]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown analytics period: %q", params.Period)
+	}
+
+	q := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(
+			"date_trunc('"+period+"', s.day::timestamp) AS period",
+			key+" AS key",
+			"SUM(s.products) AS products",
+		).
+		From("daily_product_stats AS s")
+	if params.GroupBy == domain.AnalyticsByCity {
+		q = q.Join("pvzs AS pvz ON pvz.id = s.pvz_id")
+	}
+
+	from, to := rollupDays(&params.AnalyticsRange)
+	return q.
+		Where(sq.GtOrEq{"s.day": from}).
+		Where(sq.Lt{"s.day": to}).
+		GroupBy("1", "2").
+		OrderBy("1", "2").
+		ToSql()
+}
+
+// buildReceptionStatsQuery divides summed reception rollups per group.
+func buildReceptionStatsQuery(params *domain.ReceptionStatsParams) (string, []any, error) {
+	key, ok := analyticsKeys[params.GroupBy]
+	if !ok || params.GroupBy == domain.AnalyticsByProductType {
+		return "", nil, fmt.Errorf("unsupported reception stats group: %q", params.GroupBy)
+	}
+
+	q := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(
+			key+" AS key",
+			"SUM(s.receptions) AS receptions",
+			"SUM(s.total_duration_seconds) / SUM(s.receptions) AS avg_duration",
+			"SUM(s.total_products)::float8 / SUM(s.receptions) AS avg_products",
+		).
+		From("daily_reception_stats AS s")
+	if params.GroupBy == domain.AnalyticsByCity {
+		q = q.Join("pvzs AS pvz ON pvz.id = s.pvz_id")
+	}
+
+	from, to := rollupDays(&params.AnalyticsRange)
+	return q.
+		Where(sq.GtOrEq{"s.day": from}).
+		Where(sq.Lt{"s.day": to}).
+		GroupBy("1").
+		OrderBy("1").
+		ToSql()
+}
+
+// rollupDays widens the range to whole UTC days, as rollups can't be split within a day.
+func rollupDays(r *domain.AnalyticsRange) (time.Time, time.Time) {
+	from := r.From.UTC().Truncate(24 * time.Hour)
+	to := r.To.UTC()
+	if day := to.Truncate(24 * time.Hour); !day.Equal(to) {
+		to = day.Add(24 * time.Hour)
+	}
+	return from, to
+}
+
+func buildTakenBarcodesQuery(receptionId uuid.UUID, barcodes []string, global bool) (string, []any, error) {
+	q := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("barcode").
+		From("products").
+		Where(sq.Eq{"barcode": barcodes}).
+		Where("deleted_at IS NULL")
+
+	if !global {
+		q = q.Where(sq.Eq{"reception_id": receptionId})
+	}
+
+	return q.ToSql()
+}
+
+// buildInsertProductsQuery builds a single multi-row insert. Ids are generated by
+// the caller, and every row gets its own clock_timestamp() so LIFO order is kept.
+func buildInsertProductsQuery(receptionId uuid.UUID, prods []*domain.Product, uniqueBarcode bool) (string, []any, error) {
+	q := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("products").
+		Columns(
+			"id", "reception_id", "type", "barcode",
+			"weight_grams", "length_mm", "width_mm", "height_mm", "added_at", "barcode_unique",
+		)
+
+	for _, p := range prods {
+		length, width, height := dimensionsArgs(p.Dimensions)
+		q = q.Values(
+			p.Id, receptionId, p.Type, p.Barcode,
+			p.WeightGrams, length, width, height, sq.Expr("clock_timestamp()"), uniqueBarcode,
+		)
+	}
+
+	return q.Suffix("RETURNING id, added_at").ToSql()
+}
+
+func buildInsertManifestQuery(receptionId uuid.UUID, barcodes []string) (string, []any, error) {
+	q := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("reception_manifest_items").
+		Columns("reception_id", "barcode")
+
+	for _, b := range barcodes {
+		q = q.Values(receptionId, b)
+	}
+
+	return q.ToSql()
+}
@@ -5,8 +5,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/shrtyk/pvz-service/internal/core/domain"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_buildGetPvzDataQuery(t *testing.T) {
@@ -15,19 +17,27 @@ func Test_buildGetPvzDataQuery(t *testing.T) {
 	const mainQueryTpl = `
 WITH pvzs_ids AS (%s)
 SELECT
-	pvz.id, pvz.city, pvz.created_at,
-	r.id, r.status, r.created_at, r.pvz_id,
-	p.id, p.added_at, p.reception_id, p.type
+	pvz.id, pvz.city, pvz.created_at, pvz.version,
+	r.id, r.status, r.created_at, r.pvz_id, r.closed_at, r.auto_closed, r.version,
+	p.id, p.added_at, p.reception_id, p.type,
+	p.barcode, p.weight_grams, p.length_mm, p.width_mm, p.height_mm, p.status
 FROM pvzs AS pvz
 LEFT JOIN receptions AS r
 	ON pvz.id = r.pvz_id
 LEFT JOIN products AS p
-	ON p.reception_id = r.id
+	ON p.reception_id = r.id AND p.deleted_at IS NULL
 WHERE
 	pvz.id IN (SELECT id FROM pvzs_ids)
 ORDER BY
-	pvz.id, r.id, p.id
+	%s, r.id, p.id
 `
+	const (
+		byDate    = "pvz.created_at ASC, pvz.id ASC"
+		byCityDsc = "pvz.city DESC, pvz.id DESC"
+	)
+
+	cursorAt, cursorId := time.Now(), uuid.New()
+	open := false
 
 	type args struct {
 		params *domain.PvzsReadParams
@@ -46,38 +56,41 @@ ORDER BY
 					Page:  1,
 				},
 			},
-			wantQuery: fmt.Sprintf(mainQueryTpl, "SELECT DISTINCT pvz.id FROM pvzs AS pvz ORDER BY pvz.id LIMIT 10 OFFSET 0"),
-			wantArgs:  nil,
+			wantQuery: fmt.Sprintf(mainQueryTpl,
+				"SELECT pvz.id FROM pvzs AS pvz ORDER BY "+byDate+" LIMIT 11 OFFSET 0",
+				byDate,
+			),
+			wantArgs: nil,
 		},
 		{
-			name: "with start date",
+			name: "offset over page",
 			args: args{
 				params: &domain.PvzsReadParams{
-					Limit:     10,
-					Page:      1,
-					StartDate: &time.Time{},
+					Limit:  10,
+					Page:   1,
+					Offset: 25,
 				},
 			},
 			wantQuery: fmt.Sprintf(mainQueryTpl,
-				"SELECT DISTINCT pvz.id FROM pvzs AS pvz "+
-					"JOIN receptions AS r ON pvz.id = r.pvz_id "+
-					"WHERE r.created_at >= $1 ORDER BY pvz.id LIMIT 10 OFFSET 0",
+				"SELECT pvz.id FROM pvzs AS pvz ORDER BY "+byDate+" LIMIT 11 OFFSET 25",
+				byDate,
 			),
-			wantArgs: []any{&time.Time{}},
+			wantArgs: nil,
 		},
 		{
-			name: "with end date",
+			name: "with start date",
 			args: args{
 				params: &domain.PvzsReadParams{
-					Limit:   10,
-					Page:    1,
-					EndDate: &time.Time{},
+					PvzsFilter: domain.PvzsFilter{StartDate: &time.Time{}},
+					Limit:      10,
+					Page:       1,
 				},
 			},
 			wantQuery: fmt.Sprintf(mainQueryTpl,
-				"SELECT DISTINCT pvz.id FROM pvzs AS pvz "+
-					"JOIN receptions AS r ON pvz.id = r.pvz_id "+
-					"WHERE r.created_at <= $1 ORDER BY pvz.id LIMIT 10 OFFSET 0",
+				"SELECT pvz.id FROM pvzs AS pvz "+
+					"WHERE EXISTS (SELECT 1 FROM receptions AS r WHERE r.pvz_id = pvz.id AND r.created_at >= $1) "+
+					"ORDER BY "+byDate+" LIMIT 11 OFFSET 0",
+				byDate,
 			),
 			wantArgs: []any{&time.Time{}},
 		},
@@ -85,19 +98,98 @@ ORDER BY
 			name: "with both dates",
 			args: args{
 				params: &domain.PvzsReadParams{
-					Limit:     10,
-					Page:      1,
-					StartDate: &time.Time{},
-					EndDate:   &time.Time{},
+					PvzsFilter: domain.PvzsFilter{StartDate: &time.Time{}, EndDate: &time.Time{}},
+					Limit:      10,
+					Page:       1,
 				},
 			},
 			wantQuery: fmt.Sprintf(mainQueryTpl,
-				"SELECT DISTINCT pvz.id FROM pvzs AS pvz "+
-					"JOIN receptions AS r ON pvz.id = r.pvz_id "+
-					"WHERE r.created_at >= $1 AND r.created_at <= $2 ORDER BY pvz.id LIMIT 10 OFFSET 0",
+				"SELECT pvz.id FROM pvzs AS pvz "+
+					"WHERE EXISTS (SELECT 1 FROM receptions AS r WHERE r.pvz_id = pvz.id "+
+					"AND r.created_at >= $1 AND r.created_at <= $2) "+
+					"ORDER BY "+byDate+" LIMIT 11 OFFSET 0",
+				byDate,
 			),
 			wantArgs: []any{&time.Time{}, &time.Time{}},
 		},
+		{
+			name: "with pvz filters",
+			args: args{
+				params: &domain.PvzsReadParams{
+					PvzsFilter: domain.PvzsFilter{
+						Cities:         []domain.PVZCity{domain.Moscow, domain.Kazan},
+						RegisteredFrom: &time.Time{},
+						RegisteredTo:   &time.Time{},
+					},
+					Limit: 10,
+					Page:  2,
+				},
+			},
+			wantQuery: fmt.Sprintf(mainQueryTpl,
+				"SELECT pvz.id FROM pvzs AS pvz "+
+					"WHERE pvz.city IN ($1,$2) AND pvz.created_at >= $3 AND pvz.created_at <= $4 "+
+					"ORDER BY "+byDate+" LIMIT 11 OFFSET 10",
+				byDate,
+			),
+			wantArgs: []any{domain.Moscow, domain.Kazan, &time.Time{}, &time.Time{}},
+		},
+		{
+			name: "with reception filters",
+			args: args{
+				params: &domain.PvzsReadParams{
+					PvzsFilter: domain.PvzsFilter{
+						ReceptionStatuses: []domain.ReceptionStatus{domain.Close},
+						ProductTypes:      []domain.ProductType{domain.ProductTypeFootwear},
+						HasOpenReception:  &open,
+					},
+					Limit: 10,
+					Page:  1,
+				},
+			},
+			wantQuery: fmt.Sprintf(mainQueryTpl,
+				"SELECT pvz.id FROM pvzs AS pvz "+
+					"WHERE EXISTS (SELECT 1 FROM receptions AS r WHERE r.pvz_id = pvz.id AND r.status IN ($1) "+
+					"AND EXISTS (SELECT 1 FROM products AS p WHERE p.reception_id = r.id AND p.deleted_at IS NULL "+
+					"AND p.type IN ($2))) "+
+					"AND NOT EXISTS (SELECT 1 FROM receptions AS o WHERE o.pvz_id = pvz.id AND o.status = $3) "+
+					"ORDER BY "+byDate+" LIMIT 11 OFFSET 0",
+				byDate,
+			),
+			wantArgs: []any{domain.Close, domain.ProductTypeFootwear, domain.InProgress},
+		},
+		{
+			name: "with cursor",
+			args: args{
+				params: &domain.PvzsReadParams{
+					Limit:  10,
+					Page:   3,
+					Cursor: &domain.PvzsCursor{RegistrationDate: cursorAt, Id: cursorId},
+				},
+			},
+			wantQuery: fmt.Sprintf(mainQueryTpl,
+				"SELECT pvz.id FROM pvzs AS pvz "+
+					"WHERE (pvz.created_at, pvz.id) > ($1, $2) ORDER BY "+byDate+" LIMIT 11",
+				byDate,
+			),
+			wantArgs: []any{cursorAt, cursorId},
+		},
+		{
+			name: "sorted by city desc with cursor",
+			args: args{
+				params: &domain.PvzsReadParams{
+					Sort:   domain.PvzsSort{Field: domain.PvzsSortByCity, Desc: true},
+					Limit:  10,
+					Page:   1,
+					Cursor: &domain.PvzsCursor{City: domain.Kazan, Id: cursorId},
+				},
+			},
+			wantQuery: fmt.Sprintf(mainQueryTpl,
+				"SELECT pvz.id FROM pvzs AS pvz "+
+					"WHERE (pvz.city, pvz.id) < ($1, $2) ORDER BY "+byCityDsc+" LIMIT 11",
+				byCityDsc,
+			),
+			wantArgs: []any{domain.Kazan, cursorId},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -110,3 +202,222 @@ ORDER BY
 		})
 	}
 }
+
+func Test_buildCountPvzsQuery(t *testing.T) {
+	t.Parallel()
+
+	q, args, err := buildCountPvzsQuery(&domain.PvzsFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT COUNT(*) FROM pvzs AS pvz", q)
+	assert.Empty(t, args)
+
+	start := time.Now()
+	q, args, err = buildCountPvzsQuery(&domain.PvzsFilter{StartDate: &start})
+	require.NoError(t, err)
+	assert.Equal(t,
+		"SELECT COUNT(*) FROM pvzs AS pvz "+
+			"WHERE EXISTS (SELECT 1 FROM receptions AS r WHERE r.pvz_id = pvz.id AND r.created_at >= $1)",
+		q,
+	)
+	assert.Equal(t, []any{&start}, args)
+}
+
+func Test_buildGetAllPvzsQuery(t *testing.T) {
+	t.Parallel()
+
+	open := true
+	q, args, err := buildGetAllPvzsQuery(
+		&domain.PvzsFilter{Cities: []domain.PVZCity{domain.Moscow}, HasOpenReception: &open},
+		domain.PvzsSort{Field: domain.PvzsSortByCity},
+	)
+	require.NoError(t, err)
+	assert.Equal(t,
+		"SELECT pvz.id, pvz.created_at, pvz.city, pvz.version FROM pvzs AS pvz "+
+			"WHERE pvz.city IN ($1) "+
+			"AND EXISTS (SELECT 1 FROM receptions AS o WHERE o.pvz_id = pvz.id AND o.status = $2) "+
+			"ORDER BY pvz.city ASC, pvz.id ASC",
+		q,
+	)
+	assert.Equal(t, []any{domain.Moscow, domain.InProgress}, args)
+}
+
+func Test_buildProductsThroughputQuery(t *testing.T) {
+	t.Parallel()
+
+	rng := domain.AnalyticsRange{
+		From: time.Date(2026, 9, 18, 15, 30, 0, 0, time.UTC),
+		To:   time.Date(2026, 10, 18, 15, 30, 0, 0, time.UTC),
+	}
+
+	q, args, err := buildProductsThroughputQuery(&domain.ThroughputParams{
+		AnalyticsRange: rng,
+		Period:         domain.AnalyticsWeek,
+		GroupBy:        domain.AnalyticsByCity,
+	})
+	require.NoError(t, err)
+	assert.Equal(t,
+		"SELECT date_trunc('week', s.day::timestamp) AS period, pvz.city::text AS key, "+
+			"SUM(s.products) AS products FROM daily_product_stats AS s "+
+			"JOIN pvzs AS pvz ON pvz.id = s.pvz_id "+
+			"WHERE s.day >= $1 AND s.day < $2 "+
+			"GROUP BY 1, 2 ORDER BY 1, 2",
+		q,
+	)
+	assert.Equal(t, []any{
+		time.Date(2026, 9, 18, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+	}, args)
+
+	q, _, err = buildProductsThroughputQuery(&domain.ThroughputParams{
+		AnalyticsRange: rng,
+		Period:         domain.AnalyticsDay,
+		GroupBy:        domain.AnalyticsByProductType,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, q, "date_trunc('day'")
+	assert.Contains(t, q, "s.product_type::text AS key")
+	assert.NotContains(t, q, "pvzs")
+
+	_, _, err = buildProductsThroughputQuery(&domain.ThroughputParams{Period: "year", GroupBy: domain.AnalyticsByPvz})
+	assert.Error(t, err)
+	_, _, err = buildProductsThroughputQuery(&domain.ThroughputParams{Period: domain.AnalyticsDay, GroupBy: "courier"})
+	assert.Error(t, err)
+}
+
+func Test_buildReceptionStatsQuery(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 10, 11, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	q, args, err := buildReceptionStatsQuery(&domain.ReceptionStatsParams{
+		AnalyticsRange: domain.AnalyticsRange{From: from, To: to},
+		GroupBy:        domain.AnalyticsByPvz,
+	})
+	require.NoError(t, err)
+	assert.Equal(t,
+		"SELECT s.pvz_id::text AS key, SUM(s.receptions) AS receptions, "+
+			"SUM(s.total_duration_seconds) / SUM(s.receptions) AS avg_duration, "+
+			"SUM(s.total_products)::float8 / SUM(s.receptions) AS avg_products "+
+			"FROM daily_reception_stats AS s "+
+			"WHERE s.day >= $1 AND s.day < $2 "+
+			"GROUP BY 1 ORDER BY 1",
+		q,
+	)
+	assert.Equal(t, []any{from, to}, args)
+
+	q, _, err = buildReceptionStatsQuery(&domain.ReceptionStatsParams{GroupBy: domain.AnalyticsByCity})
+	require.NoError(t, err)
+	assert.Contains(t, q, "JOIN pvzs AS pvz ON pvz.id = s.pvz_id")
+
+	_, _, err = buildReceptionStatsQuery(&domain.ReceptionStatsParams{GroupBy: domain.AnalyticsByProductType})
+	assert.Error(t, err)
+}
+
+func Test_rollupDays(t *testing.T) {
+	t.Parallel()
+
+	msk := time.FixedZone("MSK", 3*60*60)
+	tests := []struct {
+		name     string
+		from, to time.Time
+		wantFrom time.Time
+		wantTo   time.Time
+	}{
+		{
+			name:     "whole days are kept",
+			from:     time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC),
+			wantFrom: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			wantTo:   time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "partial days are widened in UTC",
+			from:     time.Date(2026, 10, 1, 2, 0, 0, 0, msk),
+			to:       time.Date(2026, 10, 2, 12, 0, 0, 0, msk),
+			wantFrom: time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC),
+			wantTo:   time.Date(2026, 10, 3, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			from, to := rollupDays(&domain.AnalyticsRange{From: tt.from, To: tt.to})
+			assert.Equal(t, tt.wantFrom, from)
+			assert.Equal(t, tt.wantTo, to)
+		})
+	}
+}
+
+func Test_buildTakenBarcodesQuery(t *testing.T) {
+	t.Parallel()
+
+	recId := uuid.New()
+	barcodes := []string{"a1", "b2"}
+
+	tests := []struct {
+		name      string
+		global    bool
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			name:      "within reception",
+			wantQuery: "SELECT barcode FROM products WHERE barcode IN ($1,$2) AND deleted_at IS NULL AND reception_id = $3",
+			wantArgs:  []any{"a1", "b2", recId.String()},
+		},
+		{
+			name:      "across receptions",
+			global:    true,
+			wantQuery: "SELECT barcode FROM products WHERE barcode IN ($1,$2) AND deleted_at IS NULL",
+			wantArgs:  []any{"a1", "b2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			q, args, err := buildTakenBarcodesQuery(recId, barcodes, tt.global)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, q)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func Test_buildInsertProductsQuery(t *testing.T) {
+	t.Parallel()
+
+	recId := uuid.New()
+	prods := []*domain.Product{
+		{Id: uuid.New(), Type: domain.ProductTypeClothing},
+		{
+			Id:          uuid.New(),
+			Type:        domain.ProductTypeElectronics,
+			Barcode:     ptr("4601234567890"),
+			WeightGrams: ptr(500),
+			Dimensions:  &domain.ProductDimensions{LengthMm: 1, WidthMm: 2, HeightMm: 3},
+		},
+	}
+
+	q, args, err := buildInsertProductsQuery(recId, prods, true)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"INSERT INTO products "+
+			"(id,reception_id,type,barcode,weight_grams,length_mm,width_mm,height_mm,added_at,barcode_unique) VALUES "+
+			"($1,$2,$3,$4,$5,$6,$7,$8,clock_timestamp(),$9),"+
+			"($10,$11,$12,$13,$14,$15,$16,$17,clock_timestamp(),$18) "+
+			"RETURNING id, added_at",
+		q,
+	)
+	require.Len(t, args, 18)
+	assert.Equal(t, prods[0].Id, args[0])
+	assert.Equal(t, recId, args[1])
+	assert.Equal(t, true, args[8])
+	assert.Equal(t, prods[1].Barcode, args[12])
+	assert.Equal(t, ptr(3), args[16])
+}
@@ -2,14 +2,41 @@ package repository
 
 import (
 	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/core/ports/metrics"
 )
 
 type repo struct {
-	db *sql.DB
+	db      *sql.DB
+	metrics metrics.Collector
+}
+
+// Option configures optional repository behaviour.
+type Option func(*repo)
+
+// WithMetrics makes the repository report how long each of its operations takes.
+func WithMetrics(m metrics.Collector) Option {
+	return func(r *repo) {
+		r.metrics = m
+	}
 }
 
-func NewRepo(db *sql.DB) *repo {
-	return &repo{
+func NewRepo(db *sql.DB, opts ...Option) *repo {
+	r := &repo{
 		db: db,
 	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// observe reports the duration of the operation op started at start.
+func (r *repo) observe(op string, start time.Time) {
+	if r.metrics == nil {
+		return
+	}
+	r.metrics.ObserveDBQueryDuration(strings.TrimPrefix(op, "repository."), time.Since(start).Seconds())
 }
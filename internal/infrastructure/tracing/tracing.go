@@ -0,0 +1,77 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/shrtyk/pvz-service/internal/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+const (
+	noneExporter   = "none"
+	stdoutExporter = "stdout"
+	otlpExporter   = "otlp"
+)
+
+// MustSetupTracing installs a global tracer provider exporting spans as configured and
+// the W3C trace context and baggage propagators. Without an exporter spans are still
+// created, so trace ids get into logs and are passed on to other services.
+//
+// The returned provider has to be shut down to flush the spans left in its buffers.
+func MustSetupTracing(ctx context.Context, cfg *config.TracingCfg) *sdktrace.TracerProvider {
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(newResource(cfg.ServiceName)),
+	}
+
+	switch cfg.Exporter {
+	case noneExporter:
+	case stdoutExporter:
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+		if err != nil {
+			panic(fmt.Sprintf("failed to create stdout trace exporter: %s", err))
+		}
+		opts = append(opts, sdktrace.WithSyncer(exp))
+	case otlpExporter:
+		expOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint)}
+		if cfg.OTLPInsecure {
+			expOpts = append(expOpts, otlptracegrpc.WithInsecure())
+		}
+		exp, err := otlptracegrpc.New(ctx, expOpts...)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create OTLP trace exporter: %s", err))
+		}
+		opts = append(opts, sdktrace.WithBatcher(exp))
+	default:
+		panic(fmt.Sprintf("unknown trace exporter: '%s'", cfg.Exporter))
+	}
+
+	tp := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return tp
+}
+
+func newResource(serviceName string) *resource.Resource {
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewSchemaless(semconv.ServiceName(serviceName)),
+	)
+	if err != nil {
+		// The default resource is always valid, so this is a programming error.
+		panic(fmt.Sprintf("failed to create trace resource: %s", err))
+	}
+	return res
+}
@@ -0,0 +1,47 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shrtyk/pvz-service/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestMustSetupTracing(t *testing.T) {
+	t.Run("without exporter", func(t *testing.T) {
+		tp := MustSetupTracing(context.Background(), &config.TracingCfg{
+			Exporter:    noneExporter,
+			SampleRatio: 1,
+			ServiceName: "pvz-service",
+		})
+		t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+		ctx, span := otel.Tracer("test").Start(context.Background(), "op")
+		defer span.End()
+		assert.True(t, span.SpanContext().IsValid())
+		assert.True(t, span.SpanContext().IsSampled())
+
+		carrier := propagation.MapCarrier{}
+		otel.GetTextMapPropagator().Inject(ctx, carrier)
+		assert.Contains(t, carrier.Get("traceparent"), span.SpanContext().TraceID().String())
+	})
+
+	t.Run("nothing sampled", func(t *testing.T) {
+		tp := MustSetupTracing(context.Background(), &config.TracingCfg{Exporter: stdoutExporter})
+		t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+		_, span := tp.Tracer("test").Start(context.Background(), "op")
+		defer span.End()
+		assert.False(t, span.SpanContext().IsSampled())
+	})
+
+	t.Run("unknown exporter", func(t *testing.T) {
+		assert.Panics(t, func() {
+			MustSetupTracing(context.Background(), &config.TracingCfg{Exporter: "jaeger"})
+		})
+	})
+}
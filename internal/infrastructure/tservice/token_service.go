@@ -87,6 +87,18 @@ func (s *tokenService) GenerateRefreshToken(userID, ua, ip string) *auth.Refresh
 	}
 }
 
+// CheckKeys verifies that the RSA key pair is loaded and that its keys match,
+// so tokens signed by the service can be verified by it.
+func (s *tokenService) CheckKeys() error {
+	if s.publicKey == nil || s.privateKey == nil {
+		return errors.New("rsa keys aren't loaded")
+	}
+	if !s.privateKey.PublicKey.Equal(s.publicKey) {
+		return errors.New("rsa public key doesn't match the private key")
+	}
+	return nil
+}
+
 func (s *tokenService) Hash(token string) []byte {
 	hash := sha256.Sum256([]byte(token))
 	return hash[:]
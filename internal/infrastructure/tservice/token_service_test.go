@@ -56,6 +56,21 @@ func TestTokenService_Context(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestTokenService_CheckKeys(t *testing.T) {
+	t.Parallel()
+
+	s := newTestTokenService(t, time.Minute)
+	require.NoError(t, s.CheckKeys())
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	s.publicKey = &otherKey.PublicKey
+	assert.ErrorContains(t, s.CheckKeys(), "doesn't match")
+
+	s.publicKey = nil
+	assert.ErrorContains(t, s.CheckKeys(), "aren't loaded")
+}
+
 func TestTokensService(t *testing.T) {
 	t.Parallel()
 
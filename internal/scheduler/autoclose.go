@@ -0,0 +1,102 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/core/ports/metrics"
+	"github.com/shrtyk/pvz-service/internal/core/ports/service"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+)
+
+const (
+	runResultOk      = "ok"
+	runResultSkipped = "skipped"
+	runResultError   = "error"
+)
+
+// ReceptionAutoCloser periodically closes receptions that stayed in progress
+// without any activity for longer than idleFor.
+type ReceptionAutoCloser struct {
+	wg         *sync.WaitGroup
+	appService service.Service
+	metrics    metrics.Collector
+	logger     *slog.Logger
+	interval   time.Duration
+	idleFor    time.Duration
+}
+
+func NewReceptionAutoCloser(
+	wg *sync.WaitGroup,
+	appService service.Service,
+	metrics metrics.Collector,
+	logger *slog.Logger,
+	interval time.Duration,
+	idleFor time.Duration,
+) *ReceptionAutoCloser {
+	return &ReceptionAutoCloser{
+		wg:         wg,
+		appService: appService,
+		metrics:    metrics,
+		logger:     logger,
+		interval:   interval,
+		idleFor:    idleFor,
+	}
+}
+
+// Start runs auto closing every interval until ctx is done.
+func (c *ReceptionAutoCloser) Start(ctx context.Context) {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				c.logger.Info("Reception auto closer stopped")
+				return
+			case <-ticker.C:
+				c.runOnce(ctx)
+			}
+		}
+	}()
+}
+
+func (c *ReceptionAutoCloser) runOnce(ctx context.Context) {
+	recs, err := c.appService.AutoCloseStaleReceptions(logger.ToCtx(ctx, c.logger), c.idleFor)
+	if err != nil {
+		var bErr *xerr.BaseErr[service.ServiceErrKind]
+		if errors.As(err, &bErr) && bErr.Kind == service.AutoCloseBusy {
+			c.logger.Debug("Reception auto close is running on another instance")
+			c.metrics.IncReceptionAutoCloseRuns(runResultSkipped)
+			return
+		}
+		c.logger.Error("Failed to auto close stale receptions", logger.WithErr(err))
+		c.metrics.IncReceptionAutoCloseRuns(runResultError)
+		return
+	}
+
+	for _, rec := range recs {
+		c.logger.Info(
+			"Reception auto closed",
+			slog.String("reception_id", rec.Id.String()),
+			slog.String("pvz_id", rec.PvzId.String()),
+			slog.Time("created_at", rec.DateTime),
+		)
+	}
+	if len(recs) > 0 {
+		c.logger.Info(
+			"Stale receptions auto closed",
+			slog.Int("count", len(recs)),
+			slog.Duration("idle_for", c.idleFor),
+		)
+	}
+	c.metrics.IncReceptionAutoCloseRuns(runResultOk)
+}
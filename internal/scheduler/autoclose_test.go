@@ -0,0 +1,109 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	metricsmocks "github.com/shrtyk/pvz-service/internal/core/ports/metrics/mocks"
+	"github.com/shrtyk/pvz-service/internal/core/ports/service"
+	servicemocks "github.com/shrtyk/pvz-service/internal/core/ports/service/mocks"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+	xerr "github.com/shrtyk/pvz-service/pkg/xerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReceptionAutoCloser_runOnce(t *testing.T) {
+	t.Parallel()
+
+	idleFor := 12 * time.Hour
+	recId := uuid.New()
+
+	tests := []struct {
+		name       string
+		recs       []*domain.Reception
+		err        error
+		wantResult string
+		wantLog    string
+	}{
+		{
+			name:       "closed receptions are logged",
+			recs:       []*domain.Reception{{Id: recId, PvzId: uuid.New(), AutoClosed: true}},
+			wantResult: runResultOk,
+			wantLog:    recId.String(),
+		},
+		{
+			name:       "nothing to close",
+			recs:       []*domain.Reception{},
+			wantResult: runResultOk,
+		},
+		{
+			name:       "another instance holds the lock",
+			err:        &xerr.BaseErr[service.ServiceErrKind]{Kind: service.AutoCloseBusy},
+			wantResult: runResultSkipped,
+		},
+		{
+			name:       "unexpected error",
+			err:        errors.New("db is down"),
+			wantResult: runResultError,
+			wantLog:    "Failed to auto close stale receptions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			appService := servicemocks.NewMockService(t)
+			metrics := metricsmocks.NewMockCollector(t)
+			l, buf := logger.NewTestLogger()
+
+			appService.EXPECT().AutoCloseStaleReceptions(mock.Anything, idleFor).Return(tt.recs, tt.err)
+			metrics.EXPECT().IncReceptionAutoCloseRuns(tt.wantResult).Return()
+
+			c := NewReceptionAutoCloser(new(sync.WaitGroup), appService, metrics, l, time.Minute, idleFor)
+			c.runOnce(context.Background())
+
+			if tt.wantLog != "" {
+				assert.Contains(t, buf.String(), tt.wantLog)
+			}
+		})
+	}
+}
+
+func TestReceptionAutoCloser_Start(t *testing.T) {
+	t.Parallel()
+
+	appService := servicemocks.NewMockService(t)
+	metrics := metricsmocks.NewMockCollector(t)
+	l, _ := logger.NewTestLogger()
+
+	ran := make(chan struct{}, 1)
+	appService.EXPECT().AutoCloseStaleReceptions(mock.Anything, time.Hour).
+		Return([]*domain.Reception{}, nil)
+	metrics.EXPECT().IncReceptionAutoCloseRuns(runResultOk).
+		Run(func(string) {
+			select {
+			case ran <- struct{}{}:
+			default:
+			}
+		}).Return()
+
+	var wg sync.WaitGroup
+	ctx, cancel := context.WithCancel(context.Background())
+	NewReceptionAutoCloser(&wg, appService, metrics, l, 10*time.Millisecond, time.Hour).Start(ctx)
+
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("auto closer did not run")
+	}
+
+	cancel()
+	wg.Wait()
+}
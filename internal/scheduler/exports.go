@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/core/ports/service"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+)
+
+// ExportWorker periodically runs pending export jobs one after another
+// until there are none left.
+type ExportWorker struct {
+	wg         *sync.WaitGroup
+	appService service.Service
+	logger     *slog.Logger
+	interval   time.Duration
+}
+
+func NewExportWorker(
+	wg *sync.WaitGroup,
+	appService service.Service,
+	logger *slog.Logger,
+	interval time.Duration,
+) *ExportWorker {
+	return &ExportWorker{
+		wg:         wg,
+		appService: appService,
+		logger:     logger,
+		interval:   interval,
+	}
+}
+
+// Start runs pending jobs every interval until ctx is done.
+func (w *ExportWorker) Start(ctx context.Context) {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				w.logger.Info("Export worker stopped")
+				return
+			case <-ticker.C:
+				w.runOnce(ctx)
+			}
+		}
+	}()
+}
+
+func (w *ExportWorker) runOnce(ctx context.Context) {
+	for ctx.Err() == nil {
+		job, err := w.appService.RunNextExportJob(logger.ToCtx(ctx, w.logger))
+		if job == nil {
+			if err != nil {
+				w.logger.Error("Failed to run export job", logger.WithErr(err))
+			}
+			return
+		}
+
+		if err != nil {
+			w.logger.Error("Export job failed", slog.String("job_id", job.Id.String()), logger.WithErr(err))
+			continue
+		}
+		w.logger.Info(
+			"Export job done",
+			slog.String("job_id", job.Id.String()),
+			slog.String("format", string(job.Format)),
+		)
+	}
+}
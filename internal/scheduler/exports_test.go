@@ -0,0 +1,78 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	servicemocks "github.com/shrtyk/pvz-service/internal/core/ports/service/mocks"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExportWorker_runOnce(t *testing.T) {
+	t.Parallel()
+
+	done := &domain.ExportJob{Id: uuid.New(), Format: domain.ExportCSV, Status: domain.ExportJobDone}
+	failed := &domain.ExportJob{Id: uuid.New(), Format: domain.ExportXLSX, Status: domain.ExportJobFailed}
+
+	appService := servicemocks.NewMockService(t)
+	l, buf := logger.NewTestLogger()
+
+	appService.EXPECT().RunNextExportJob(mock.Anything).Return(done, nil).Once()
+	appService.EXPECT().RunNextExportJob(mock.Anything).Return(failed, errors.New("disk is full")).Once()
+	appService.EXPECT().RunNextExportJob(mock.Anything).Return(nil, nil).Once()
+
+	NewExportWorker(new(sync.WaitGroup), appService, l, time.Minute).runOnce(context.Background())
+
+	assert.Contains(t, buf.String(), done.Id.String())
+	assert.Contains(t, buf.String(), "Export job failed")
+	assert.Contains(t, buf.String(), failed.Id.String())
+}
+
+func TestExportWorker_runOnceClaimError(t *testing.T) {
+	t.Parallel()
+
+	appService := servicemocks.NewMockService(t)
+	l, buf := logger.NewTestLogger()
+
+	appService.EXPECT().RunNextExportJob(mock.Anything).Return(nil, errors.New("db is down")).Once()
+
+	NewExportWorker(new(sync.WaitGroup), appService, l, time.Minute).runOnce(context.Background())
+
+	assert.Contains(t, buf.String(), "Failed to run export job")
+}
+
+func TestExportWorker_Start(t *testing.T) {
+	t.Parallel()
+
+	appService := servicemocks.NewMockService(t)
+	l, _ := logger.NewTestLogger()
+
+	ran := make(chan struct{}, 1)
+	appService.EXPECT().RunNextExportJob(mock.Anything).
+		Run(func(context.Context) {
+			select {
+			case ran <- struct{}{}:
+			default:
+			}
+		}).Return(nil, nil)
+
+	var wg sync.WaitGroup
+	ctx, cancel := context.WithCancel(context.Background())
+	NewExportWorker(&wg, appService, l, 10*time.Millisecond).Start(ctx)
+
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("export worker did not run")
+	}
+
+	cancel()
+	wg.Wait()
+}
@@ -0,0 +1,74 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/core/ports/service"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+)
+
+// DailyStatsRefresher periodically recomputes the analytics rollups of the
+// last few UTC days, so that late changes like deleted products or closed
+// receptions get into the stats.
+type DailyStatsRefresher struct {
+	wg         *sync.WaitGroup
+	appService service.Service
+	logger     *slog.Logger
+	interval   time.Duration
+	days       int
+}
+
+func NewDailyStatsRefresher(
+	wg *sync.WaitGroup,
+	appService service.Service,
+	logger *slog.Logger,
+	interval time.Duration,
+	days int,
+) *DailyStatsRefresher {
+	return &DailyStatsRefresher{
+		wg:         wg,
+		appService: appService,
+		logger:     logger,
+		interval:   interval,
+		days:       max(days, 1),
+	}
+}
+
+// Start refreshes the rollups right away and then every interval until ctx is done.
+func (r *DailyStatsRefresher) Start(ctx context.Context) {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		r.runOnce(ctx, time.Now())
+		for {
+			select {
+			case <-ctx.Done():
+				r.logger.Info("Daily stats refresher stopped")
+				return
+			case now := <-ticker.C:
+				r.runOnce(ctx, now)
+			}
+		}
+	}()
+}
+
+// runOnce refreshes the days from days-1 days before now up to the end of today.
+// A single run may take no longer than the interval.
+func (r *DailyStatsRefresher) runOnce(ctx context.Context, now time.Time) {
+	to := now.UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	from := to.AddDate(0, 0, -r.days)
+
+	tctx, tcancel := context.WithTimeout(ctx, r.interval)
+	defer tcancel()
+
+	if err := r.appService.RefreshDailyStats(logger.ToCtx(tctx, r.logger), from, to); err != nil {
+		r.logger.Error("Failed to refresh daily stats", logger.WithErr(err))
+	}
+}
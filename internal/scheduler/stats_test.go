@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	servicemocks "github.com/shrtyk/pvz-service/internal/core/ports/service/mocks"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDailyStatsRefresher_runOnce(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 18, 1, 30, 0, 0, time.FixedZone("MSK", 3*60*60))
+	from := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		err     error
+		wantLog string
+	}{
+		{name: "last days are refreshed"},
+		{name: "unexpected error", err: errors.New("db is down"), wantLog: "Failed to refresh daily stats"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			appService := servicemocks.NewMockService(t)
+			l, buf := logger.NewTestLogger()
+
+			appService.EXPECT().RefreshDailyStats(mock.Anything, from, to).Return(tt.err)
+
+			NewDailyStatsRefresher(new(sync.WaitGroup), appService, l, time.Minute, 2).runOnce(context.Background(), now)
+
+			if tt.wantLog != "" {
+				assert.Contains(t, buf.String(), tt.wantLog)
+			}
+		})
+	}
+}
+
+func TestDailyStatsRefresher_Start(t *testing.T) {
+	t.Parallel()
+
+	appService := servicemocks.NewMockService(t)
+	l, _ := logger.NewTestLogger()
+
+	ran := make(chan struct{}, 1)
+	appService.EXPECT().RefreshDailyStats(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(context.Context, time.Time, time.Time) {
+			select {
+			case ran <- struct{}{}:
+			default:
+			}
+		}).Return(nil)
+
+	var wg sync.WaitGroup
+	ctx, cancel := context.WithCancel(context.Background())
+	NewDailyStatsRefresher(&wg, appService, l, time.Hour, 2).Start(ctx)
+
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("daily stats refresher did not run")
+	}
+
+	cancel()
+	wg.Wait()
+}
@@ -0,0 +1,79 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/shrtyk/pvz-service/internal/core/ports/metrics"
+	"github.com/shrtyk/pvz-service/internal/core/ports/service"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+)
+
+// PvzStockReporter periodically exports the number of products on hand
+// in every PVZ and the number of open receptions as gauges.
+type PvzStockReporter struct {
+	wg         *sync.WaitGroup
+	appService service.Service
+	metrics    metrics.Collector
+	logger     *slog.Logger
+	interval   time.Duration
+}
+
+func NewPvzStockReporter(
+	wg *sync.WaitGroup,
+	appService service.Service,
+	metrics metrics.Collector,
+	logger *slog.Logger,
+	interval time.Duration,
+) *PvzStockReporter {
+	return &PvzStockReporter{
+		wg:         wg,
+		appService: appService,
+		metrics:    metrics,
+		logger:     logger,
+		interval:   interval,
+	}
+}
+
+// Start reports stock right away and then every interval until ctx is done.
+func (r *PvzStockReporter) Start(ctx context.Context) {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		r.runOnce(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				r.logger.Info("PVZ stock reporter stopped")
+				return
+			case <-ticker.C:
+				r.runOnce(ctx)
+			}
+		}
+	}()
+}
+
+func (r *PvzStockReporter) runOnce(ctx context.Context) {
+	ctx = logger.ToCtx(ctx, r.logger)
+
+	stocks, err := r.appService.AllPvzsStock(ctx)
+	if err != nil {
+		r.logger.Error("Failed to read PVZ stock", logger.WithErr(err))
+	}
+	for _, stock := range stocks {
+		r.metrics.SetPvzProductsOnHand(stock.PvzId.String(), stock.OnHand)
+	}
+
+	open, err := r.appService.OpenReceptionsCount(ctx)
+	if err != nil {
+		r.logger.Error("Failed to count open receptions", logger.WithErr(err))
+		return
+	}
+	r.metrics.SetOpenReceptions(open)
+}
@@ -0,0 +1,107 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shrtyk/pvz-service/internal/core/domain"
+	metricsmocks "github.com/shrtyk/pvz-service/internal/core/ports/metrics/mocks"
+	servicemocks "github.com/shrtyk/pvz-service/internal/core/ports/service/mocks"
+	"github.com/shrtyk/pvz-service/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPvzStockReporter_runOnce(t *testing.T) {
+	t.Parallel()
+
+	pvzId := uuid.New()
+
+	tests := []struct {
+		name    string
+		stocks  []*domain.PvzStock
+		err     error
+		openErr error
+		wantSet bool
+		wantLog string
+	}{
+		{
+			name:    "stock is exported",
+			stocks:  []*domain.PvzStock{{PvzId: pvzId, OnHand: 7}},
+			wantSet: true,
+		},
+		{
+			name:    "unexpected error",
+			err:     errors.New("db is down"),
+			wantLog: "Failed to read PVZ stock",
+		},
+		{
+			name:    "open receptions count error",
+			stocks:  []*domain.PvzStock{{PvzId: pvzId, OnHand: 7}},
+			openErr: errors.New("db is down"),
+			wantSet: true,
+			wantLog: "Failed to count open receptions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			appService := servicemocks.NewMockService(t)
+			metrics := metricsmocks.NewMockCollector(t)
+			l, buf := logger.NewTestLogger()
+
+			appService.EXPECT().AllPvzsStock(mock.Anything).Return(tt.stocks, tt.err)
+			if tt.wantSet {
+				metrics.EXPECT().SetPvzProductsOnHand(pvzId.String(), 7).Return()
+			}
+			appService.EXPECT().OpenReceptionsCount(mock.Anything).Return(2, tt.openErr)
+			if tt.openErr == nil {
+				metrics.EXPECT().SetOpenReceptions(2).Return()
+			}
+
+			NewPvzStockReporter(new(sync.WaitGroup), appService, metrics, l, time.Minute).runOnce(context.Background())
+
+			if tt.wantLog != "" {
+				assert.Contains(t, buf.String(), tt.wantLog)
+			}
+		})
+	}
+}
+
+func TestPvzStockReporter_Start(t *testing.T) {
+	t.Parallel()
+
+	appService := servicemocks.NewMockService(t)
+	metrics := metricsmocks.NewMockCollector(t)
+	l, _ := logger.NewTestLogger()
+
+	ran := make(chan struct{}, 1)
+	appService.EXPECT().AllPvzsStock(mock.Anything).
+		Run(func(context.Context) {
+			select {
+			case ran <- struct{}{}:
+			default:
+			}
+		}).Return([]*domain.PvzStock{}, nil)
+	appService.EXPECT().OpenReceptionsCount(mock.Anything).Return(0, nil)
+	metrics.EXPECT().SetOpenReceptions(0).Return()
+
+	var wg sync.WaitGroup
+	ctx, cancel := context.WithCancel(context.Background())
+	NewPvzStockReporter(&wg, appService, metrics, l, time.Hour).Start(ctx)
+
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("stock reporter did not run")
+	}
+
+	cancel()
+	wg.Wait()
+}
@@ -21,11 +21,12 @@ export const options = {
 };
 
 const BASE_URL = __ENV.BASE_URL || 'http://localhost:8080';
+const API_URL = `${BASE_URL}/api/v1`;
 
 // The Test Scenario
 export default function () {
   // Step 1: get a moderator token and create a unique PVZ
-  let loginRes = http.post(`${BASE_URL}/dummyLogin`, JSON.stringify({ role: 'moderator' }), {
+  let loginRes = http.post(`${API_URL}/dummyLogin`, JSON.stringify({ role: 'moderator' }), {
     headers: { 'Content-Type': 'application/json' },
     tags: { name: '/dummyLogin (moderator)' },
   });
@@ -33,7 +34,7 @@ export default function () {
   const moderatorToken = loginRes.json('jwt');
   const modAuthParams = { headers: { 'Content-Type': 'application/json', 'Authorization': `Bearer ${moderatorToken}` } };
 
-  const pvzRes = http.post(`${BASE_URL}/pvz`, JSON.stringify({ city: 'Москва' }), {
+  const pvzRes = http.post(`${API_URL}/pvz`, JSON.stringify({ city: 'Москва' }), {
     ...modAuthParams,
     tags: { name: '/pvz (create)' },
   });
@@ -44,7 +45,7 @@ export default function () {
   const pvzId = pvzRes.json('id');
 
   // Step 2: get an employee token
-  loginRes = http.post(`${BASE_URL}/dummyLogin`, JSON.stringify({ role: 'employee' }), {
+  loginRes = http.post(`${API_URL}/dummyLogin`, JSON.stringify({ role: 'employee' }), {
     headers: { 'Content-Type': 'application/json' },
     tags: { name: '/dummyLogin (employee)' },
   });
@@ -55,7 +56,7 @@ export default function () {
   group('Reception and Products Workload', () => {
     // Step 3: open a reception with the employee token
     const receptionPayload = JSON.stringify({ pvzId: pvzId });
-    const receptionRes = http.post(`${BASE_URL}/receptions`, receptionPayload, {
+    const receptionRes = http.post(`${API_URL}/receptions`, receptionPayload, {
       ...empAuthParams,
       tags: { name: '/receptions (create)' },
     });
@@ -65,7 +66,7 @@ export default function () {
     // Step 4: add 5 products to this reception
     for (let i = 0; i < 5; i++) {
       const productPayload = JSON.stringify({ pvzId: pvzId, type: 'одежда' });
-      const productRes = http.post(`${BASE_URL}/products`, productPayload, {
+      const productRes = http.post(`${API_URL}/products`, productPayload, {
         ...empAuthParams,
         tags: { name: '/products (create)' },
       });
@@ -83,7 +84,7 @@ export default function () {
     const startDate = new Date();
     startDate.setDate(endDate.getDate() - 7); // last 7 days
 
-    const url = `${BASE_URL}/pvz?page=${page}&limit=${limit}&startDate=${encodeURIComponent(startDate.toISOString())}&endDate=${encodeURIComponent(endDate.toISOString())}`;
+    const url = `${API_URL}/pvz?page=${page}&limit=${limit}&startDate=${encodeURIComponent(startDate.toISOString())}&endDate=${encodeURIComponent(endDate.toISOString())}`;
 
     const getPvzRes = http.get(url, {
       ...empAuthParams,
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE products
+  ADD COLUMN barcode VARCHAR(64),
+  ADD COLUMN weight_grams INTEGER CHECK (weight_grams > 0),
+  ADD COLUMN length_mm INTEGER CHECK (length_mm > 0),
+  ADD COLUMN width_mm INTEGER CHECK (width_mm > 0),
+  ADD COLUMN height_mm INTEGER CHECK (height_mm > 0);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE products
+  DROP COLUMN IF EXISTS barcode,
+  DROP COLUMN IF EXISTS weight_grams,
+  DROP COLUMN IF EXISTS length_mm,
+  DROP COLUMN IF EXISTS width_mm,
+  DROP COLUMN IF EXISTS height_mm;
+
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE UNIQUE INDEX uniq_products_reception_id_barcode ON products (reception_id, barcode);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS uniq_products_reception_id_barcode;
+
+-- +goose StatementEnd
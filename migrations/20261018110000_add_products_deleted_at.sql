@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE products
+  ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE products
+  DROP COLUMN IF EXISTS deleted_at;
+
+-- +goose StatementEnd
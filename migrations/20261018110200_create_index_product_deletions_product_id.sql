@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_product_deletions_product_id ON product_deletions (product_id, deleted_at DESC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_product_deletions_product_id;
+
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP INDEX IF EXISTS uniq_products_reception_id_barcode;
+CREATE UNIQUE INDEX uniq_products_reception_id_barcode ON products (reception_id, barcode) WHERE deleted_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS uniq_products_reception_id_barcode;
+CREATE UNIQUE INDEX uniq_products_reception_id_barcode ON products (reception_id, barcode);
+
+-- +goose StatementEnd
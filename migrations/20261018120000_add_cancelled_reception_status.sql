@@ -0,0 +1,7 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE reception_statuses ADD VALUE IF NOT EXISTS 'cancelled';
+
+-- +goose Down
+-- Postgres can not drop a value from an enum, so cancelled receptions are kept as is.
+SELECT 1;
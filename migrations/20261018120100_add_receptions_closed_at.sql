@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE receptions
+ADD COLUMN closed_at TIMESTAMPTZ;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE receptions
+DROP COLUMN IF EXISTS closed_at;
+
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS reception_manifest_items (
+  reception_id UUID NOT NULL,
+  barcode VARCHAR(64) NOT NULL,
+  PRIMARY KEY (reception_id, barcode),
+  CONSTRAINT fk_reception_id FOREIGN KEY (reception_id) REFERENCES receptions (id) ON DELETE CASCADE
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reception_manifest_items;
+
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE receptions
+ADD COLUMN auto_closed BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE receptions
+DROP COLUMN IF EXISTS auto_closed;
+
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_receptions_in_progress_created_at ON receptions (created_at)
+WHERE
+  status = 'in_progress';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_receptions_in_progress_created_at;
+
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE product_statuses AS ENUM('in_storage', 'issued', 'returned');
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TYPE IF EXISTS product_statuses;
+
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE products
+  ADD COLUMN status product_statuses NOT NULL DEFAULT 'in_storage',
+  ADD COLUMN pickup_code_hash BYTEA;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE products
+  DROP COLUMN IF EXISTS pickup_code_hash,
+  DROP COLUMN IF EXISTS status;
+
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_product_status_history_product_id ON product_status_history (product_id, changed_at);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_product_status_history_product_id;
+
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvzs
+  ADD COLUMN products_on_hand INTEGER NOT NULL DEFAULT 0
+  CONSTRAINT chk_pvzs_products_on_hand CHECK (products_on_hand >= 0);
+
+UPDATE pvzs
+SET products_on_hand = (
+  SELECT COUNT(*)
+  FROM products AS p
+  JOIN receptions AS r
+    ON r.id = p.reception_id
+  WHERE r.pvz_id = pvzs.id AND p.status = 'in_storage' AND p.deleted_at IS NULL
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pvzs
+  DROP COLUMN IF EXISTS products_on_hand;
+
+-- +goose StatementEnd
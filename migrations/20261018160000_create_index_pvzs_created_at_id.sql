@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_pvzs_created_at_id ON pvzs (created_at, id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_pvzs_created_at_id;
+
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS export_jobs (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  format TEXT NOT NULL CHECK (format IN ('csv', 'xlsx')),
+  params JSONB NOT NULL,
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'done', 'failed')),
+  created_by UUID NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  started_at TIMESTAMPTZ,
+  finished_at TIMESTAMPTZ,
+  error TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_export_jobs_pending ON export_jobs (created_at)
+WHERE
+  status = 'pending';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS export_jobs;
+
+-- +goose StatementEnd
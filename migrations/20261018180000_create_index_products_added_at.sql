@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_products_added_at ON products (added_at) INCLUDE (reception_id, type)
+WHERE
+  deleted_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_products_added_at;
+
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_receptions_closed_created_at ON receptions (created_at) INCLUDE (pvz_id, closed_at)
+WHERE
+  status = 'close';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_receptions_closed_created_at;
+
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Products that weren't deleted, per UTC day of scanning, PVZ and product type.
+CREATE TABLE IF NOT EXISTS daily_product_stats (
+  day DATE NOT NULL,
+  pvz_id UUID NOT NULL,
+  product_type product_types NOT NULL,
+  products INT NOT NULL,
+  PRIMARY KEY (day, pvz_id, product_type),
+  CONSTRAINT fk_pvz_id FOREIGN KEY (pvz_id) REFERENCES pvzs (id) ON DELETE CASCADE
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS daily_product_stats;
+
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Closed receptions per UTC day of opening and PVZ. Totals are kept instead of
+-- averages so that they can be summed over any range before dividing.
+CREATE TABLE IF NOT EXISTS daily_reception_stats (
+  day DATE NOT NULL,
+  pvz_id UUID NOT NULL,
+  receptions INT NOT NULL,
+  total_duration_seconds DOUBLE PRECISION NOT NULL,
+  total_products INT NOT NULL,
+  PRIMARY KEY (day, pvz_id),
+  CONSTRAINT fk_pvz_id FOREIGN KEY (pvz_id) REFERENCES pvzs (id) ON DELETE CASCADE
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS daily_reception_stats;
+
+-- +goose StatementEnd
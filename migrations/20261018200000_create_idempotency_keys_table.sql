@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Keys of anonymous requests are kept under the nil user id, so there is no
+-- reference to users. Response columns are null while the request is in progress.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+  user_id UUID NOT NULL,
+  key TEXT NOT NULL,
+  fingerprint TEXT NOT NULL,
+  status_code INT,
+  headers JSONB,
+  body BYTEA,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  expires_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (user_id, key)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS idempotency_keys;
+
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvzs
+  ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pvzs
+  DROP COLUMN IF EXISTS version;
+
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE receptions
+  ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE receptions
+  DROP COLUMN IF EXISTS version;
+
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Token buckets of the rate limiter shared by all replicas. Losing them on a
+-- crash only resets the limits, so the table isn't logged. A bucket past its
+-- full_at is as good as a missing one, so such rows are pruned.
+CREATE UNLOGGED TABLE IF NOT EXISTS rate_limit_buckets (
+  key TEXT PRIMARY KEY,
+  tokens DOUBLE PRECISION NOT NULL,
+  allowed BOOLEAN NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  full_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS rate_limit_buckets_full_at_idx ON rate_limit_buckets (full_at);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS rate_limit_buckets;
+
+-- +goose StatementEnd
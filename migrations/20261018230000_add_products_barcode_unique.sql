@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE products
+  ADD COLUMN barcode_unique BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE products
+  DROP COLUMN IF EXISTS barcode_unique;
+
+-- +goose StatementEnd
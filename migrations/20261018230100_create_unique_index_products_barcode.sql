@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE UNIQUE INDEX uniq_products_barcode ON products (barcode) WHERE barcode_unique AND deleted_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS uniq_products_barcode;
+
+-- +goose StatementEnd
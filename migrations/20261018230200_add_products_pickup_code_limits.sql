@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE products
+  ADD COLUMN pickup_code_expires_at TIMESTAMPTZ,
+  ADD COLUMN pickup_code_attempts INT NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE products
+  DROP COLUMN IF EXISTS pickup_code_attempts,
+  DROP COLUMN IF EXISTS pickup_code_expires_at;
+
+-- +goose StatementEnd
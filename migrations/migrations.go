@@ -0,0 +1,44 @@
+// Package migrations embeds the goose migrations of the database schema, so the
+// app knows which schema version it is built for.
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.sql
+var FS embed.FS
+
+// LatestVersion returns the version of the newest migration, taken from the
+// timestamp prefix of its file name.
+func LatestVersion() (int64, error) {
+	files, err := fs.Glob(FS, "*.sql")
+	if err != nil {
+		return 0, err
+	}
+
+	var latest int64
+	for _, f := range files {
+		prefix, _, _ := strings.Cut(f, "_")
+		v, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("migration %s has no version prefix: %w", f, err)
+		}
+		latest = max(latest, v)
+	}
+
+	return latest, nil
+}
+
+// MustLatestVersion is LatestVersion panicking on malformed file names.
+func MustLatestVersion() int64 {
+	v, err := LatestVersion()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,17 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatestVersion(t *testing.T) {
+	t.Parallel()
+
+	v, err := LatestVersion()
+
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, v, int64(20261018210100))
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -58,6 +60,16 @@ func FromCtx(ctx context.Context) *slog.Logger {
 	return l
 }
 
+// WithTraceID adds the id of the trace ctx belongs to, if any, so that log lines can be
+// matched with spans.
+func WithTraceID(ctx context.Context, l *slog.Logger) *slog.Logger {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return l
+	}
+	return l.With(slog.String("trace_id", sc.TraceID().String()))
+}
+
 func WithErr(err error) slog.Attr {
 	return slog.String("error", err.Error())
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestLoggerCreation(t *testing.T) {
@@ -40,3 +41,17 @@ func TestNewTestLogger(t *testing.T) {
 	l.Info("test message")
 	assert.Contains(t, buf.String(), "test message")
 }
+
+func TestWithTraceID(t *testing.T) {
+	l, buf := NewTestLogger()
+	WithTraceID(context.Background(), l).Info("no trace")
+	assert.NotContains(t, buf.String(), "trace_id")
+
+	traceID := trace.TraceID{1, 2, 3}
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  trace.SpanID{1},
+	}))
+	WithTraceID(ctx, l).Info("traced")
+	assert.Contains(t, buf.String(), `"trace_id":"`+traceID.String()+`"`)
+}
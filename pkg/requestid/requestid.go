@@ -0,0 +1,51 @@
+// Package requestid carries the id correlating a request across services, logs and responses.
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Header is the HTTP header the id is accepted from and echoed in.
+	Header = "X-Request-ID"
+	// MDKey is the gRPC metadata key the id is accepted from and echoed in.
+	MDKey = "x-request-id"
+
+	maxLen = 128
+)
+
+type ctxKey struct{}
+
+func ToCtx(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromCtx returns the request id, or an empty string outside of a request.
+func FromCtx(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
+
+// FromUpstream returns the id passed by the caller, or a new one if there is none.
+// Ids that are too long or contain anything but printable ASCII are replaced too,
+// since they end up in logs and response headers.
+func FromUpstream(id string) string {
+	if !valid(id) {
+		return uuid.NewString()
+	}
+	return id
+}
+
+func valid(id string) bool {
+	if id == "" || len(id) > maxLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
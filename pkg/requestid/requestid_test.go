@@ -0,0 +1,48 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromUpstream(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		id       string
+		wantKept bool
+	}{
+		{name: "valid id", id: "a1b2-c3d4", wantKept: true},
+		{name: "max length", id: strings.Repeat("a", maxLen), wantKept: true},
+		{name: "empty", id: ""},
+		{name: "too long", id: strings.Repeat("a", maxLen+1)},
+		{name: "whitespace", id: "a b"},
+		{name: "control characters", id: "a\r\nb"},
+		{name: "non ascii", id: "идентификатор"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := FromUpstream(tt.id)
+			if tt.wantKept {
+				assert.Equal(t, tt.id, got)
+				return
+			}
+			assert.NoError(t, uuid.Validate(got))
+		})
+	}
+}
+
+func TestCtx(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, FromCtx(context.Background()))
+	assert.Equal(t, "id", FromCtx(ToCtx(context.Background(), "id")))
+}
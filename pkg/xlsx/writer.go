@@ -0,0 +1,148 @@
+// Package xlsx writes single sheet XLSX workbooks row by row, so that large
+// spreadsheets never have to be kept in memory.
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var ErrClosed = errors.New("xlsx: writer is closed")
+
+const (
+	contentTypes = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ` +
+		`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ` +
+		`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+
+	rootRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" ` +
+		`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" ` +
+		`Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+
+	workbookRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" ` +
+		`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" ` +
+		`Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+
+	workbookTpl = xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+	sheetStart = xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<sheetData>`
+	sheetEnd = `</sheetData></worksheet>`
+)
+
+// Writer streams rows of string cells into the only sheet of a workbook.
+// Rows are written straight into the zip stream, Close must be called to
+// produce a valid file.
+type Writer struct {
+	zw     *zip.Writer
+	sheet  *bufio.Writer
+	rows   int
+	closed bool
+}
+
+// NewWriter writes the workbook parts and opens a sheet with the given name.
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	zw := zip.NewWriter(w)
+
+	var name bytes.Buffer
+	if err := xml.EscapeText(&name, []byte(sheetName)); err != nil {
+		return nil, err
+	}
+
+	parts := []struct{ name, body string }{
+		{"[Content_Types].xml", contentTypes},
+		{"_rels/.rels", rootRels},
+		{"xl/workbook.xml", fmt.Sprintf(workbookTpl, name.String())},
+		{"xl/_rels/workbook.xml.rels", workbookRels},
+	}
+	for _, p := range parts {
+		f, err := zw.Create(p.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(f, p.body); err != nil {
+			return nil, err
+		}
+	}
+
+	sheet, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+
+	xw := &Writer{zw: zw, sheet: bufio.NewWriter(sheet)}
+	if _, err := xw.sheet.WriteString(sheetStart); err != nil {
+		return nil, err
+	}
+	return xw, nil
+}
+
+// WriteRow appends a row of inline string cells.
+func (w *Writer) WriteRow(cells []string) error {
+	if w.closed {
+		return ErrClosed
+	}
+
+	w.rows++
+	if _, err := w.sheet.WriteString(`<row r="` + strconv.Itoa(w.rows) + `">`); err != nil {
+		return err
+	}
+	for i, c := range cells {
+		if c == "" {
+			continue
+		}
+		ref := columnName(i) + strconv.Itoa(w.rows)
+		if _, err := w.sheet.WriteString(`<c r="` + ref + `" t="inlineStr"><is><t xml:space="preserve">`); err != nil {
+			return err
+		}
+		if err := xml.EscapeText(w.sheet, []byte(c)); err != nil {
+			return err
+		}
+		if _, err := w.sheet.WriteString(`</t></is></c>`); err != nil {
+			return err
+		}
+	}
+	_, err := w.sheet.WriteString(`</row>`)
+	return err
+}
+
+// Close finishes the sheet and the zip archive. It doesn't close the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return ErrClosed
+	}
+	w.closed = true
+
+	if _, err := w.sheet.WriteString(sheetEnd); err != nil {
+		return err
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.zw.Close()
+}
+
+// columnName converts a zero based column index into its letters: 0 is A, 26 is AA.
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
@@ -0,0 +1,71 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	t.Parallel()
+
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, "Приемки & товары")
+	require.NoError(t, err)
+	require.NoError(t, w.WriteRow([]string{"id", "city"}))
+	require.NoError(t, w.WriteRow([]string{"1", "", "<Москва>"}))
+	require.NoError(t, w.Close())
+	assert.ErrorIs(t, w.WriteRow(nil), ErrClosed)
+	assert.ErrorIs(t, w.Close(), ErrClosed)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		b, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		files[f.Name] = string(b)
+	}
+
+	for _, name := range []string{
+		"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/worksheets/sheet1.xml",
+	} {
+		require.Contains(t, files, name)
+		assert.NoError(t, xml.Unmarshal([]byte(files[name]), new(any)), name)
+	}
+	assert.Contains(t, files["xl/workbook.xml"], `name="Приемки &amp; товары"`)
+
+	var sheet struct {
+		Rows []struct {
+			R     string `xml:"r,attr"`
+			Cells []struct {
+				R    string `xml:"r,attr"`
+				Text string `xml:"is>t"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	require.NoError(t, xml.Unmarshal([]byte(files["xl/worksheets/sheet1.xml"]), &sheet))
+	require.Len(t, sheet.Rows, 2)
+	assert.Equal(t, "1", sheet.Rows[0].R)
+	require.Len(t, sheet.Rows[1].Cells, 2)
+	assert.Equal(t, "A2", sheet.Rows[1].Cells[0].R)
+	assert.Equal(t, "C2", sheet.Rows[1].Cells[1].R)
+	assert.Equal(t, "<Москва>", sheet.Rows[1].Cells[1].Text)
+}
+
+func Test_columnName(t *testing.T) {
+	t.Parallel()
+
+	for i, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		assert.Equal(t, want, columnName(i))
+	}
+}
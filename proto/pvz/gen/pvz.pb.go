@@ -27,6 +27,7 @@ type ReceptionStatus int32
 const (
 	ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS ReceptionStatus = 0
 	ReceptionStatus_RECEPTION_STATUS_CLOSED      ReceptionStatus = 1
+	ReceptionStatus_RECEPTION_STATUS_CANCELLED   ReceptionStatus = 2
 )
 
 // Enum value maps for ReceptionStatus.
@@ -34,10 +35,12 @@ var (
 	ReceptionStatus_name = map[int32]string{
 		0: "RECEPTION_STATUS_IN_PROGRESS",
 		1: "RECEPTION_STATUS_CLOSED",
+		2: "RECEPTION_STATUS_CANCELLED",
 	}
 	ReceptionStatus_value = map[string]int32{
 		"RECEPTION_STATUS_IN_PROGRESS": 0,
 		"RECEPTION_STATUS_CLOSED":      1,
+		"RECEPTION_STATUS_CANCELLED":   2,
 	}
 )
 
@@ -68,13 +71,113 @@ func (ReceptionStatus) EnumDescriptor() ([]byte, []int) {
 	return file_pvz_proto_rawDescGZIP(), []int{0}
 }
 
+type PvzSortField int32
+
+const (
+	PvzSortField_PVZ_SORT_FIELD_REGISTRATION_DATE PvzSortField = 0
+	PvzSortField_PVZ_SORT_FIELD_CITY              PvzSortField = 1
+)
+
+// Enum value maps for PvzSortField.
+var (
+	PvzSortField_name = map[int32]string{
+		0: "PVZ_SORT_FIELD_REGISTRATION_DATE",
+		1: "PVZ_SORT_FIELD_CITY",
+	}
+	PvzSortField_value = map[string]int32{
+		"PVZ_SORT_FIELD_REGISTRATION_DATE": 0,
+		"PVZ_SORT_FIELD_CITY":              1,
+	}
+)
+
+func (x PvzSortField) Enum() *PvzSortField {
+	p := new(PvzSortField)
+	*p = x
+	return p
+}
+
+func (x PvzSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PvzSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_pvz_proto_enumTypes[1].Descriptor()
+}
+
+func (PvzSortField) Type() protoreflect.EnumType {
+	return &file_pvz_proto_enumTypes[1]
+}
+
+func (x PvzSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PvzSortField.Descriptor instead.
+func (PvzSortField) EnumDescriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{1}
+}
+
+type BatchItemStatus int32
+
+const (
+	BatchItemStatus_BATCH_ITEM_STATUS_ADDED             BatchItemStatus = 0
+	BatchItemStatus_BATCH_ITEM_STATUS_DUPLICATE_BARCODE BatchItemStatus = 1
+	BatchItemStatus_BATCH_ITEM_STATUS_NOT_ADDED         BatchItemStatus = 2
+	BatchItemStatus_BATCH_ITEM_STATUS_OVER_CAPACITY     BatchItemStatus = 3
+)
+
+// Enum value maps for BatchItemStatus.
+var (
+	BatchItemStatus_name = map[int32]string{
+		0: "BATCH_ITEM_STATUS_ADDED",
+		1: "BATCH_ITEM_STATUS_DUPLICATE_BARCODE",
+		2: "BATCH_ITEM_STATUS_NOT_ADDED",
+		3: "BATCH_ITEM_STATUS_OVER_CAPACITY",
+	}
+	BatchItemStatus_value = map[string]int32{
+		"BATCH_ITEM_STATUS_ADDED":             0,
+		"BATCH_ITEM_STATUS_DUPLICATE_BARCODE": 1,
+		"BATCH_ITEM_STATUS_NOT_ADDED":         2,
+		"BATCH_ITEM_STATUS_OVER_CAPACITY":     3,
+	}
+)
+
+func (x BatchItemStatus) Enum() *BatchItemStatus {
+	p := new(BatchItemStatus)
+	*p = x
+	return p
+}
+
+func (x BatchItemStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchItemStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pvz_proto_enumTypes[2].Descriptor()
+}
+
+func (BatchItemStatus) Type() protoreflect.EnumType {
+	return &file_pvz_proto_enumTypes[2]
+}
+
+func (x BatchItemStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchItemStatus.Descriptor instead.
+func (BatchItemStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{2}
+}
+
 type PVZ struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	RegistrationDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=registration_date,json=registrationDate,proto3" json:"registration_date,omitempty"`
 	City             string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// version is incremented on every change of the PVZ.
+	Version       int32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PVZ) Reset() {
@@ -128,10 +231,30 @@ func (x *PVZ) GetCity() string {
 	return ""
 }
 
+func (x *PVZ) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// GetPVZListRequest filters and sorts PVZs. Empty filters match every PVZ.
 type GetPVZListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Cities         []string               `protobuf:"bytes,1,rep,name=cities,proto3" json:"cities,omitempty"`
+	RegisteredFrom *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=registered_from,json=registeredFrom,proto3" json:"registered_from,omitempty"`
+	RegisteredTo   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=registered_to,json=registeredTo,proto3" json:"registered_to,omitempty"`
+	// reception_from, reception_to, reception_statuses and product_types
+	// must all be matched by the same reception of a PVZ.
+	ReceptionFrom     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=reception_from,json=receptionFrom,proto3" json:"reception_from,omitempty"`
+	ReceptionTo       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=reception_to,json=receptionTo,proto3" json:"reception_to,omitempty"`
+	ReceptionStatuses []ReceptionStatus      `protobuf:"varint,6,rep,packed,name=reception_statuses,json=receptionStatuses,proto3,enum=pvz.v1.ReceptionStatus" json:"reception_statuses,omitempty"`
+	ProductTypes      []string               `protobuf:"bytes,7,rep,name=product_types,json=productTypes,proto3" json:"product_types,omitempty"`
+	HasOpenReception  *bool                  `protobuf:"varint,8,opt,name=has_open_reception,json=hasOpenReception,proto3,oneof" json:"has_open_reception,omitempty"`
+	SortBy            PvzSortField           `protobuf:"varint,9,opt,name=sort_by,json=sortBy,proto3,enum=pvz.v1.PvzSortField" json:"sort_by,omitempty"`
+	SortDesc          bool                   `protobuf:"varint,10,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GetPVZListRequest) Reset() {
@@ -164,6 +287,76 @@ func (*GetPVZListRequest) Descriptor() ([]byte, []int) {
 	return file_pvz_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *GetPVZListRequest) GetCities() []string {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetRegisteredFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegisteredFrom
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetRegisteredTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegisteredTo
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetReceptionFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceptionFrom
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetReceptionTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceptionTo
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetReceptionStatuses() []ReceptionStatus {
+	if x != nil {
+		return x.ReceptionStatuses
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetProductTypes() []string {
+	if x != nil {
+		return x.ProductTypes
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetHasOpenReception() bool {
+	if x != nil && x.HasOpenReception != nil {
+		return *x.HasOpenReception
+	}
+	return false
+}
+
+func (x *GetPVZListRequest) GetSortBy() PvzSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return PvzSortField_PVZ_SORT_FIELD_REGISTRATION_DATE
+}
+
+func (x *GetPVZListRequest) GetSortDesc() bool {
+	if x != nil {
+		return x.SortDesc
+	}
+	return false
+}
+
 type GetPVZListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pvzs          []*PVZ                 `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
@@ -208,25 +401,758 @@ func (x *GetPVZListResponse) GetPvzs() []*PVZ {
 	return nil
 }
 
-var File_pvz_proto protoreflect.FileDescriptor
+type ProductDimensions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        int32                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Width         int32                  `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_pvz_proto_rawDesc = "" +
-	"\n" +
-	"\tpvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"r\n" +
-	"\x03PVZ\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
-	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
-	"\x04city\x18\x03 \x01(\tR\x04city\"\x13\n" +
-	"\x11GetPVZListRequest\"5\n" +
-	"\x12GetPVZListResponse\x12\x1f\n" +
-	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs*P\n" +
+func (x *ProductDimensions) Reset() {
+	*x = ProductDimensions{}
+	mi := &file_pvz_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductDimensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductDimensions) ProtoMessage() {}
+
+func (x *ProductDimensions) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductDimensions.ProtoReflect.Descriptor instead.
+func (*ProductDimensions) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProductDimensions) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *ProductDimensions) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ProductDimensions) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type ProductInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Barcode       *string                `protobuf:"bytes,2,opt,name=barcode,proto3,oneof" json:"barcode,omitempty"`
+	Weight        *int32                 `protobuf:"varint,3,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	Dimensions    *ProductDimensions     `protobuf:"bytes,4,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductInput) Reset() {
+	*x = ProductInput{}
+	mi := &file_pvz_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductInput) ProtoMessage() {}
+
+func (x *ProductInput) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductInput.ProtoReflect.Descriptor instead.
+func (*ProductInput) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProductInput) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProductInput) GetBarcode() string {
+	if x != nil && x.Barcode != nil {
+		return *x.Barcode
+	}
+	return ""
+}
+
+func (x *ProductInput) GetWeight() int32 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *ProductInput) GetDimensions() *ProductDimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+type Product struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ReceptionId   string                 `protobuf:"bytes,4,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Barcode       *string                `protobuf:"bytes,5,opt,name=barcode,proto3,oneof" json:"barcode,omitempty"`
+	Weight        *int32                 `protobuf:"varint,6,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	Dimensions    *ProductDimensions     `protobuf:"bytes,7,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_pvz_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Product) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Product) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+func (x *Product) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Product) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *Product) GetBarcode() string {
+	if x != nil && x.Barcode != nil {
+		return *x.Barcode
+	}
+	return ""
+}
+
+func (x *Product) GetWeight() int32 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *Product) GetDimensions() *ProductDimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+type BatchItemResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Status        BatchItemStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=pvz.v1.BatchItemStatus" json:"status,omitempty"`
+	Product       *Product               `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemResult) Reset() {
+	*x = BatchItemResult{}
+	mi := &file_pvz_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemResult) ProtoMessage() {}
+
+func (x *BatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemResult.ProtoReflect.Descriptor instead.
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchItemResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetStatus() BatchItemStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BatchItemStatus_BATCH_ITEM_STATUS_ADDED
+}
+
+func (x *BatchItemResult) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type AddProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Atomic        bool                   `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	Product       *ProductInput          `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductsRequest) Reset() {
+	*x = AddProductsRequest{}
+	mi := &file_pvz_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductsRequest) ProtoMessage() {}
+
+func (x *AddProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductsRequest.ProtoReflect.Descriptor instead.
+func (*AddProductsRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddProductsRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *AddProductsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *AddProductsRequest) GetProduct() *ProductInput {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type AddProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         int32                  `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	Items         []*BatchItemResult     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductsResponse) Reset() {
+	*x = AddProductsResponse{}
+	mi := &file_pvz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductsResponse) ProtoMessage() {}
+
+func (x *AddProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductsResponse.ProtoReflect.Descriptor instead.
+func (*AddProductsResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddProductsResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *AddProductsResponse) GetItems() []*BatchItemResult {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type Reception struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	PvzId      string                 `protobuf:"bytes,3,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Status     ReceptionStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=pvz.v1.ReceptionStatus" json:"status,omitempty"`
+	ClosedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	AutoClosed bool                   `protobuf:"varint,6,opt,name=auto_closed,json=autoClosed,proto3" json:"auto_closed,omitempty"`
+	// version is incremented on every change of the reception status or manifest.
+	Version       int32 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reception) Reset() {
+	*x = Reception{}
+	mi := &file_pvz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reception) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reception) ProtoMessage() {}
+
+func (x *Reception) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reception.ProtoReflect.Descriptor instead.
+func (*Reception) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Reception) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reception) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+func (x *Reception) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *Reception) GetStatus() ReceptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+}
+
+func (x *Reception) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *Reception) GetAutoClosed() bool {
+	if x != nil {
+		return x.AutoClosed
+	}
+	return false
+}
+
+func (x *Reception) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ReceptionProducts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	Products      []*Product             `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceptionProducts) Reset() {
+	*x = ReceptionProducts{}
+	mi := &file_pvz_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceptionProducts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceptionProducts) ProtoMessage() {}
+
+func (x *ReceptionProducts) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceptionProducts.ProtoReflect.Descriptor instead.
+func (*ReceptionProducts) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReceptionProducts) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+func (x *ReceptionProducts) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type PVZData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	Receptions    []*ReceptionProducts   `protobuf:"bytes,2,rep,name=receptions,proto3" json:"receptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZData) Reset() {
+	*x = PVZData{}
+	mi := &file_pvz_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZData) ProtoMessage() {}
+
+func (x *PVZData) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZData.ProtoReflect.Descriptor instead.
+func (*PVZData) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PVZData) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+func (x *PVZData) GetReceptions() []*ReceptionProducts {
+	if x != nil {
+		return x.Receptions
+	}
+	return nil
+}
+
+type StreamPVZDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query *GetPVZListRequest     `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// page defaults to 1, limit defaults to 10 and can't exceed 1000.
+	Page          int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamPVZDataRequest) Reset() {
+	*x = StreamPVZDataRequest{}
+	mi := &file_pvz_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPVZDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPVZDataRequest) ProtoMessage() {}
+
+func (x *StreamPVZDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPVZDataRequest.ProtoReflect.Descriptor instead.
+func (*StreamPVZDataRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StreamPVZDataRequest) GetQuery() *GetPVZListRequest {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *StreamPVZDataRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *StreamPVZDataRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+var File_pvz_proto protoreflect.FileDescriptor
+
+const file_pvz_proto_rawDesc = "" +
+	"\n" +
+	"\tpvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8c\x01\n" +
+	"\x03PVZ\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
+	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\"\xb6\x04\n" +
+	"\x11GetPVZListRequest\x12\x16\n" +
+	"\x06cities\x18\x01 \x03(\tR\x06cities\x12C\n" +
+	"\x0fregistered_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0eregisteredFrom\x12?\n" +
+	"\rregistered_to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fregisteredTo\x12A\n" +
+	"\x0ereception_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rreceptionFrom\x12=\n" +
+	"\freception_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vreceptionTo\x12F\n" +
+	"\x12reception_statuses\x18\x06 \x03(\x0e2\x17.pvz.v1.ReceptionStatusR\x11receptionStatuses\x12#\n" +
+	"\rproduct_types\x18\a \x03(\tR\fproductTypes\x121\n" +
+	"\x12has_open_reception\x18\b \x01(\bH\x00R\x10hasOpenReception\x88\x01\x01\x12-\n" +
+	"\asort_by\x18\t \x01(\x0e2\x14.pvz.v1.PvzSortFieldR\x06sortBy\x12\x1b\n" +
+	"\tsort_desc\x18\n" +
+	" \x01(\bR\bsortDescB\x15\n" +
+	"\x13_has_open_reception\"5\n" +
+	"\x12GetPVZListResponse\x12\x1f\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs\"Y\n" +
+	"\x11ProductDimensions\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x05R\x06length\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x05R\x06height\"\xb0\x01\n" +
+	"\fProductInput\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1d\n" +
+	"\abarcode\x18\x02 \x01(\tH\x00R\abarcode\x88\x01\x01\x12\x1b\n" +
+	"\x06weight\x18\x03 \x01(\x05H\x01R\x06weight\x88\x01\x01\x129\n" +
+	"\n" +
+	"dimensions\x18\x04 \x01(\v2\x19.pvz.v1.ProductDimensionsR\n" +
+	"dimensionsB\n" +
+	"\n" +
+	"\b_barcodeB\t\n" +
+	"\a_weight\"\x97\x02\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12!\n" +
+	"\freception_id\x18\x04 \x01(\tR\vreceptionId\x12\x1d\n" +
+	"\abarcode\x18\x05 \x01(\tH\x00R\abarcode\x88\x01\x01\x12\x1b\n" +
+	"\x06weight\x18\x06 \x01(\x05H\x01R\x06weight\x88\x01\x01\x129\n" +
+	"\n" +
+	"dimensions\x18\a \x01(\v2\x19.pvz.v1.ProductDimensionsR\n" +
+	"dimensionsB\n" +
+	"\n" +
+	"\b_barcodeB\t\n" +
+	"\a_weight\"\x83\x01\n" +
+	"\x0fBatchItemResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12/\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x17.pvz.v1.BatchItemStatusR\x06status\x12)\n" +
+	"\aproduct\x18\x03 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"s\n" +
+	"\x12AddProductsRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\x12.\n" +
+	"\aproduct\x18\x03 \x01(\v2\x14.pvz.v1.ProductInputR\aproduct\"Z\n" +
+	"\x13AddProductsResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x05R\x05added\x12-\n" +
+	"\x05items\x18\x02 \x03(\v2\x17.pvz.v1.BatchItemResultR\x05items\"\x90\x02\n" +
+	"\tReception\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x15\n" +
+	"\x06pvz_id\x18\x03 \x01(\tR\x05pvzId\x12/\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.pvz.v1.ReceptionStatusR\x06status\x127\n" +
+	"\tclosed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12\x1f\n" +
+	"\vauto_closed\x18\x06 \x01(\bR\n" +
+	"autoClosed\x12\x18\n" +
+	"\aversion\x18\a \x01(\x05R\aversion\"q\n" +
+	"\x11ReceptionProducts\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\x12+\n" +
+	"\bproducts\x18\x02 \x03(\v2\x0f.pvz.v1.ProductR\bproducts\"c\n" +
+	"\aPVZData\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\x129\n" +
+	"\n" +
+	"receptions\x18\x02 \x03(\v2\x19.pvz.v1.ReceptionProductsR\n" +
+	"receptions\"q\n" +
+	"\x14StreamPVZDataRequest\x12/\n" +
+	"\x05query\x18\x01 \x01(\v2\x19.pvz.v1.GetPVZListRequestR\x05query\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit*p\n" +
 	"\x0fReceptionStatus\x12 \n" +
 	"\x1cRECEPTION_STATUS_IN_PROGRESS\x10\x00\x12\x1b\n" +
-	"\x17RECEPTION_STATUS_CLOSED\x10\x012Q\n" +
+	"\x17RECEPTION_STATUS_CLOSED\x10\x01\x12\x1e\n" +
+	"\x1aRECEPTION_STATUS_CANCELLED\x10\x02*M\n" +
+	"\fPvzSortField\x12$\n" +
+	" PVZ_SORT_FIELD_REGISTRATION_DATE\x10\x00\x12\x17\n" +
+	"\x13PVZ_SORT_FIELD_CITY\x10\x01*\x9d\x01\n" +
+	"\x0fBatchItemStatus\x12\x1b\n" +
+	"\x17BATCH_ITEM_STATUS_ADDED\x10\x00\x12'\n" +
+	"#BATCH_ITEM_STATUS_DUPLICATE_BARCODE\x10\x01\x12\x1f\n" +
+	"\x1bBATCH_ITEM_STATUS_NOT_ADDED\x10\x02\x12#\n" +
+	"\x1fBATCH_ITEM_STATUS_OVER_CAPACITY\x10\x032\xdd\x01\n" +
 	"\n" +
 	"PVZService\x12C\n" +
 	"\n" +
-	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponseB>Z<github.com/shrtyk/avito-backend-spring-2025/proto/gen;pvz_v1b\x06proto3"
+	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponse\x12H\n" +
+	"\vAddProducts\x12\x1a.pvz.v1.AddProductsRequest\x1a\x1b.pvz.v1.AddProductsResponse(\x01\x12@\n" +
+	"\rStreamPVZData\x12\x1c.pvz.v1.StreamPVZDataRequest\x1a\x0f.pvz.v1.PVZData0\x01B0Z.github.com/shrtyk/pvz-service/proto/gen;pvz_v1b\x06proto3"
 
 var (
 	file_pvz_proto_rawDescOnce sync.Once
@@ -240,25 +1166,62 @@ func file_pvz_proto_rawDescGZIP() []byte {
 	return file_pvz_proto_rawDescData
 }
 
-var file_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_pvz_proto_goTypes = []any{
 	(ReceptionStatus)(0),          // 0: pvz.v1.ReceptionStatus
-	(*PVZ)(nil),                   // 1: pvz.v1.PVZ
-	(*GetPVZListRequest)(nil),     // 2: pvz.v1.GetPVZListRequest
-	(*GetPVZListResponse)(nil),    // 3: pvz.v1.GetPVZListResponse
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(PvzSortField)(0),             // 1: pvz.v1.PvzSortField
+	(BatchItemStatus)(0),          // 2: pvz.v1.BatchItemStatus
+	(*PVZ)(nil),                   // 3: pvz.v1.PVZ
+	(*GetPVZListRequest)(nil),     // 4: pvz.v1.GetPVZListRequest
+	(*GetPVZListResponse)(nil),    // 5: pvz.v1.GetPVZListResponse
+	(*ProductDimensions)(nil),     // 6: pvz.v1.ProductDimensions
+	(*ProductInput)(nil),          // 7: pvz.v1.ProductInput
+	(*Product)(nil),               // 8: pvz.v1.Product
+	(*BatchItemResult)(nil),       // 9: pvz.v1.BatchItemResult
+	(*AddProductsRequest)(nil),    // 10: pvz.v1.AddProductsRequest
+	(*AddProductsResponse)(nil),   // 11: pvz.v1.AddProductsResponse
+	(*Reception)(nil),             // 12: pvz.v1.Reception
+	(*ReceptionProducts)(nil),     // 13: pvz.v1.ReceptionProducts
+	(*PVZData)(nil),               // 14: pvz.v1.PVZData
+	(*StreamPVZDataRequest)(nil),  // 15: pvz.v1.StreamPVZDataRequest
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
 }
 var file_pvz_proto_depIdxs = []int32{
-	4, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
-	1, // 1: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
-	2, // 2: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
-	3, // 3: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	16, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
+	16, // 1: pvz.v1.GetPVZListRequest.registered_from:type_name -> google.protobuf.Timestamp
+	16, // 2: pvz.v1.GetPVZListRequest.registered_to:type_name -> google.protobuf.Timestamp
+	16, // 3: pvz.v1.GetPVZListRequest.reception_from:type_name -> google.protobuf.Timestamp
+	16, // 4: pvz.v1.GetPVZListRequest.reception_to:type_name -> google.protobuf.Timestamp
+	0,  // 5: pvz.v1.GetPVZListRequest.reception_statuses:type_name -> pvz.v1.ReceptionStatus
+	1,  // 6: pvz.v1.GetPVZListRequest.sort_by:type_name -> pvz.v1.PvzSortField
+	3,  // 7: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
+	6,  // 8: pvz.v1.ProductInput.dimensions:type_name -> pvz.v1.ProductDimensions
+	16, // 9: pvz.v1.Product.date_time:type_name -> google.protobuf.Timestamp
+	6,  // 10: pvz.v1.Product.dimensions:type_name -> pvz.v1.ProductDimensions
+	2,  // 11: pvz.v1.BatchItemResult.status:type_name -> pvz.v1.BatchItemStatus
+	8,  // 12: pvz.v1.BatchItemResult.product:type_name -> pvz.v1.Product
+	7,  // 13: pvz.v1.AddProductsRequest.product:type_name -> pvz.v1.ProductInput
+	9,  // 14: pvz.v1.AddProductsResponse.items:type_name -> pvz.v1.BatchItemResult
+	16, // 15: pvz.v1.Reception.date_time:type_name -> google.protobuf.Timestamp
+	0,  // 16: pvz.v1.Reception.status:type_name -> pvz.v1.ReceptionStatus
+	16, // 17: pvz.v1.Reception.closed_at:type_name -> google.protobuf.Timestamp
+	12, // 18: pvz.v1.ReceptionProducts.reception:type_name -> pvz.v1.Reception
+	8,  // 19: pvz.v1.ReceptionProducts.products:type_name -> pvz.v1.Product
+	3,  // 20: pvz.v1.PVZData.pvz:type_name -> pvz.v1.PVZ
+	13, // 21: pvz.v1.PVZData.receptions:type_name -> pvz.v1.ReceptionProducts
+	4,  // 22: pvz.v1.StreamPVZDataRequest.query:type_name -> pvz.v1.GetPVZListRequest
+	4,  // 23: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
+	10, // 24: pvz.v1.PVZService.AddProducts:input_type -> pvz.v1.AddProductsRequest
+	15, // 25: pvz.v1.PVZService.StreamPVZData:input_type -> pvz.v1.StreamPVZDataRequest
+	5,  // 26: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
+	11, // 27: pvz.v1.PVZService.AddProducts:output_type -> pvz.v1.AddProductsResponse
+	14, // 28: pvz.v1.PVZService.StreamPVZData:output_type -> pvz.v1.PVZData
+	26, // [26:29] is the sub-list for method output_type
+	23, // [23:26] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_pvz_proto_init() }
@@ -266,13 +1229,16 @@ func file_pvz_proto_init() {
 	if File_pvz_proto != nil {
 		return
 	}
+	file_pvz_proto_msgTypes[1].OneofWrappers = []any{}
+	file_pvz_proto_msgTypes[4].OneofWrappers = []any{}
+	file_pvz_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pvz_proto_rawDesc), len(file_pvz_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      3,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PVZService_GetPVZList_FullMethodName = "/pvz.v1.PVZService/GetPVZList"
+	PVZService_GetPVZList_FullMethodName    = "/pvz.v1.PVZService/GetPVZList"
+	PVZService_AddProducts_FullMethodName   = "/pvz.v1.PVZService/AddProducts"
+	PVZService_StreamPVZData_FullMethodName = "/pvz.v1.PVZService/StreamPVZData"
 )
 
 // PVZServiceClient is the client API for PVZService service.
@@ -27,6 +29,12 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PVZServiceClient interface {
 	GetPVZList(ctx context.Context, in *GetPVZListRequest, opts ...grpc.CallOption) (*GetPVZListResponse, error)
+	// AddProducts scans a stream of products into the active reception of a PVZ.
+	// pvz_id and atomic are taken from the first message. Requires employee role.
+	AddProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddProductsRequest, AddProductsResponse], error)
+	// StreamPVZData sends PVZs with their receptions and products one by one,
+	// each as soon as it is read. Requires employee or moderator role.
+	StreamPVZData(ctx context.Context, in *StreamPVZDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PVZData], error)
 }
 
 type pVZServiceClient struct {
@@ -47,11 +55,49 @@ func (c *pVZServiceClient) GetPVZList(ctx context.Context, in *GetPVZListRequest
 	return out, nil
 }
 
+func (c *pVZServiceClient) AddProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddProductsRequest, AddProductsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PVZService_ServiceDesc.Streams[0], PVZService_AddProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AddProductsRequest, AddProductsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_AddProductsClient = grpc.ClientStreamingClient[AddProductsRequest, AddProductsResponse]
+
+func (c *pVZServiceClient) StreamPVZData(ctx context.Context, in *StreamPVZDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PVZData], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PVZService_ServiceDesc.Streams[1], PVZService_StreamPVZData_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamPVZDataRequest, PVZData]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_StreamPVZDataClient = grpc.ServerStreamingClient[PVZData]
+
 // PVZServiceServer is the server API for PVZService service.
 // All implementations must embed UnimplementedPVZServiceServer
 // for forward compatibility.
 type PVZServiceServer interface {
 	GetPVZList(context.Context, *GetPVZListRequest) (*GetPVZListResponse, error)
+	// AddProducts scans a stream of products into the active reception of a PVZ.
+	// pvz_id and atomic are taken from the first message. Requires employee role.
+	AddProducts(grpc.ClientStreamingServer[AddProductsRequest, AddProductsResponse]) error
+	// StreamPVZData sends PVZs with their receptions and products one by one,
+	// each as soon as it is read. Requires employee or moderator role.
+	StreamPVZData(*StreamPVZDataRequest, grpc.ServerStreamingServer[PVZData]) error
 	mustEmbedUnimplementedPVZServiceServer()
 }
 
@@ -65,6 +111,12 @@ type UnimplementedPVZServiceServer struct{}
 func (UnimplementedPVZServiceServer) GetPVZList(context.Context, *GetPVZListRequest) (*GetPVZListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPVZList not implemented")
 }
+func (UnimplementedPVZServiceServer) AddProducts(grpc.ClientStreamingServer[AddProductsRequest, AddProductsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method AddProducts not implemented")
+}
+func (UnimplementedPVZServiceServer) StreamPVZData(*StreamPVZDataRequest, grpc.ServerStreamingServer[PVZData]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPVZData not implemented")
+}
 func (UnimplementedPVZServiceServer) mustEmbedUnimplementedPVZServiceServer() {}
 func (UnimplementedPVZServiceServer) testEmbeddedByValue()                    {}
 
@@ -104,6 +156,24 @@ func _PVZService_GetPVZList_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PVZService_AddProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PVZServiceServer).AddProducts(&grpc.GenericServerStream[AddProductsRequest, AddProductsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_AddProductsServer = grpc.ClientStreamingServer[AddProductsRequest, AddProductsResponse]
+
+func _PVZService_StreamPVZData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPVZDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PVZServiceServer).StreamPVZData(m, &grpc.GenericServerStream[StreamPVZDataRequest, PVZData]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_StreamPVZDataServer = grpc.ServerStreamingServer[PVZData]
+
 // PVZService_ServiceDesc is the grpc.ServiceDesc for PVZService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -116,6 +186,17 @@ var PVZService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _PVZService_GetPVZList_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AddProducts",
+			Handler:       _PVZService_AddProducts_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamPVZData",
+			Handler:       _PVZService_StreamPVZData_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "pvz.proto",
 }